@@ -141,6 +141,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			Operator: pb.NodeOperator{
 				Email:  config.Operator.Email,
 				Wallet: config.Operator.Wallet,
+				Tags:   config.Operator.TagList(),
 			},
 			Version: *pbVersion,
 		}
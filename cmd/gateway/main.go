@@ -256,6 +256,8 @@ func (flags GatewayFlags) openProject(ctx context.Context) (*libuplink.Project,
 	}
 	cfg.Volatile.MaxInlineSize = flags.Client.MaxInlineSize
 	cfg.Volatile.MaxMemory = flags.RS.MaxBufferMem
+	cfg.Volatile.UploadParallelism = flags.Client.UploadParallelism
+	cfg.Volatile.DownloadParallelism = flags.Client.DownloadParallelism
 
 	apiKey, err := libuplink.ParseAPIKey(flags.Client.APIKey)
 	if err != nil {
@@ -12,6 +12,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
@@ -69,6 +71,15 @@ var (
 		Use:   "health",
 		Short: "commands for querying health of a stored data",
 	}
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "commands for the audit scheduler",
+	}
+	auditQueuesCmd = &cobra.Command{
+		Use:   "queues",
+		Short: "list the audit queues of nodes, riskiest nodes first",
+		RunE:  AuditQueues,
+	}
 	irreparableCmd = &cobra.Command{
 		Use:   "irreparable",
 		Short: "list segments in irreparable database",
@@ -128,6 +139,7 @@ type Inspector struct {
 	overlayclient pb.OverlayInspectorClient
 	irrdbclient   pb.IrreparableInspectorClient
 	healthclient  pb.HealthInspectorClient
+	auditclient   pb.AuditInspectorClient
 }
 
 // NewInspector creates a new gRPC inspector client for access to kad,
@@ -154,6 +166,7 @@ func NewInspector(address, path string) (*Inspector, error) {
 		overlayclient: pb.NewOverlayInspectorClient(conn),
 		irrdbclient:   pb.NewIrreparableInspectorClient(conn),
 		healthclient:  pb.NewHealthInspectorClient(conn),
+		auditclient:   pb.NewAuditInspectorClient(conn),
 	}, nil
 }
 
@@ -544,6 +557,35 @@ func printRedundancyTable(w *csv.Writer, redundancy eestream.RedundancyStrategy)
 	return nil
 }
 
+// AuditQueues prints the audit queues of nodes
+func AuditQueues(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.auditclient.AuditQueues(context.Background(), &pb.AuditQueuesRequest{})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return "never"
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Node\tPriority\tNew\tQueued\tLast Audited\tLast Failure")
+	for _, queue := range res.Queues {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%d\t%s\t%s\n",
+			queue.NodeId, queue.Priority, queue.New, queue.Queued,
+			formatTime(queue.LastAudited), formatTime(queue.LastFailure))
+	}
+	return w.Flush()
+}
+
 func getSegments(cmd *cobra.Command, args []string) error {
 	if irreparableLimit <= int32(0) {
 		return ErrArgs.New("limit must be greater than 0")
@@ -608,6 +650,7 @@ func init() {
 	rootCmd.AddCommand(statsCmd)
 	rootCmd.AddCommand(irreparableCmd)
 	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(auditCmd)
 
 	kadCmd.AddCommand(countNodeCmd)
 	kadCmd.AddCommand(pingNodeCmd)
@@ -619,6 +662,8 @@ func init() {
 	healthCmd.AddCommand(objectHealthCmd)
 	healthCmd.AddCommand(segmentHealthCmd)
 
+	auditCmd.AddCommand(auditQueuesCmd)
+
 	objectHealthCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
 
 	irreparableCmd.Flags().Int32Var(&irreparableLimit, "limit", 50, "max number of results per page")
@@ -0,0 +1,307 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/satellite/admin"
+)
+
+var (
+	adminCmd = &cobra.Command{
+		Use:   "admin",
+		Short: "Manage users, projects, nodes and segments using the satellite admin api",
+	}
+
+	adminUserCmd = &cobra.Command{
+		Use:   "user",
+		Short: "Manage console users",
+	}
+	adminUserGetCmd = &cobra.Command{
+		Use:   "get [email]",
+		Short: "Show the user and the projects of the user",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminUserGet,
+	}
+	adminUserSuspendCmd = &cobra.Command{
+		Use:   "suspend [email]",
+		Short: "Prevent the user from logging in",
+		Long:  "Prevent the user from logging in to the console. The api keys of the projects of the user keep working until they are revoked.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminUserSuspend,
+	}
+	adminUserUnsuspendCmd = &cobra.Command{
+		Use:   "unsuspend [email]",
+		Short: "Let a suspended user log in again",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminUserUnsuspend,
+	}
+	adminUserDeleteCmd = &cobra.Command{
+		Use:   "delete [email]",
+		Short: "Delete the user account",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminUserDelete,
+	}
+
+	adminProjectCmd = &cobra.Command{
+		Use:   "project",
+		Short: "Manage projects",
+	}
+	adminProjectGetCmd = &cobra.Command{
+		Use:   "get [project id]",
+		Short: "Show the project with its usage limits",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminProjectGet,
+	}
+	adminProjectLimitsCmd = &cobra.Command{
+		Use:   "set-limits [project id] [name=value]...",
+		Short: "Change the usage limits of the project",
+		Long: "Change the usage limits of the project. Supported limits are storage, bandwidth, segments and buckets, " +
+			"e.g. storage=50GB buckets=10. Setting a limit to 0 resets it to the satellite default.",
+		Args: cobra.MinimumNArgs(2),
+		RunE: cmdAdminProjectLimits,
+	}
+	adminProjectAPIKeysCmd = &cobra.Command{
+		Use:   "apikeys [project id]",
+		Short: "List the api keys of the project",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminProjectAPIKeys,
+	}
+	adminRevokeAPIKeyCmd = &cobra.Command{
+		Use:   "revoke-apikey [api key id]",
+		Short: "Revoke the api key",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminRevokeAPIKey,
+	}
+
+	adminNodeCmd = &cobra.Command{
+		Use:   "node",
+		Short: "Manage storage nodes",
+	}
+	adminNodeGetCmd = &cobra.Command{
+		Use:   "get [node id]",
+		Short: "Show the node information tracked by the overlay",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminNodeGet,
+	}
+	adminNodeDisqualifyCmd = &cobra.Command{
+		Use:   "disqualify [node id]",
+		Short: "Disqualify the node",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminNodeDisqualify,
+	}
+	adminNodeReinstateCmd = &cobra.Command{
+		Use:   "reinstate [node id]",
+		Short: "Remove the disqualification of the node and reset its reputation",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminNodeReinstate,
+	}
+
+	adminSegmentCmd = &cobra.Command{
+		Use:   "segment",
+		Short: "Inspect and repair segments",
+	}
+	adminSegmentGetCmd = &cobra.Command{
+		Use:   "get [path]",
+		Short: "Show the pointer of the segment and the health of its pieces",
+		Long:  "Show the pointer of the segment and the health of its pieces. The path has the form project-id/segment/bucket/encrypted-path, e.g. as listed by qdiag.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminSegmentGet,
+	}
+	adminSegmentRepairCmd = &cobra.Command{
+		Use:   "repair [path]",
+		Short: "Add the segment to the repair queue",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminSegmentRepair,
+	}
+
+	adminCfg struct {
+		Address   string `help:"address of the satellite admin api" default:"127.0.0.1:7780"`
+		AuthToken string `help:"auth token of the satellite admin api" default:""`
+	}
+)
+
+// adminCommands adds the subcommands of the admin command
+// and returns the ones which need the admin api config.
+func adminCommands() []*cobra.Command {
+	adminCmd.AddCommand(adminUserCmd, adminProjectCmd, adminNodeCmd, adminSegmentCmd)
+	adminUserCmd.AddCommand(adminUserGetCmd, adminUserSuspendCmd, adminUserUnsuspendCmd, adminUserDeleteCmd)
+	adminProjectCmd.AddCommand(adminProjectGetCmd, adminProjectLimitsCmd, adminProjectAPIKeysCmd, adminRevokeAPIKeyCmd)
+	adminNodeCmd.AddCommand(adminNodeGetCmd, adminNodeDisqualifyCmd, adminNodeReinstateCmd)
+	adminSegmentCmd.AddCommand(adminSegmentGetCmd, adminSegmentRepairCmd)
+
+	return []*cobra.Command{
+		adminUserGetCmd, adminUserSuspendCmd, adminUserUnsuspendCmd, adminUserDeleteCmd,
+		adminProjectGetCmd, adminProjectLimitsCmd, adminProjectAPIKeysCmd, adminRevokeAPIKeyCmd,
+		adminNodeGetCmd, adminNodeDisqualifyCmd, adminNodeReinstateCmd,
+		adminSegmentGetCmd, adminSegmentRepairCmd,
+	}
+}
+
+func newAdminClient() *admin.Client {
+	return admin.NewClient(adminCfg.Address, adminCfg.AuthToken)
+}
+
+func cmdAdminUserGet(cmd *cobra.Command, args []string) (err error) {
+	user, err := newAdminClient().GetUser(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+	return printJSON(user)
+}
+
+func cmdAdminUserSuspend(cmd *cobra.Command, args []string) (err error) {
+	if err = newAdminClient().SuspendUser(process.Ctx(cmd), args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("user %s suspended\n", args[0])
+	return nil
+}
+
+func cmdAdminUserUnsuspend(cmd *cobra.Command, args []string) (err error) {
+	if err = newAdminClient().UnsuspendUser(process.Ctx(cmd), args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("user %s unsuspended\n", args[0])
+	return nil
+}
+
+func cmdAdminUserDelete(cmd *cobra.Command, args []string) (err error) {
+	if err = newAdminClient().DeleteUser(process.Ctx(cmd), args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("user %s deleted\n", args[0])
+	return nil
+}
+
+func cmdAdminProjectGet(cmd *cobra.Command, args []string) (err error) {
+	project, err := newAdminClient().GetProject(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+	return printJSON(project)
+}
+
+func cmdAdminProjectLimits(cmd *cobra.Command, args []string) (err error) {
+	var update admin.LimitsUpdate
+	for _, arg := range args[1:] {
+		if err := parseLimit(&update, arg); err != nil {
+			return err
+		}
+	}
+
+	limits, err := newAdminClient().UpdateProjectLimits(process.Ctx(cmd), args[0], update)
+	if err != nil {
+		return err
+	}
+	return printJSON(limits)
+}
+
+func cmdAdminProjectAPIKeys(cmd *cobra.Command, args []string) (err error) {
+	keys, err := newAdminClient().ListAPIKeys(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+	return printJSON(keys)
+}
+
+func cmdAdminRevokeAPIKey(cmd *cobra.Command, args []string) (err error) {
+	if err = newAdminClient().RevokeAPIKey(process.Ctx(cmd), args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("api key %s revoked\n", args[0])
+	return nil
+}
+
+func cmdAdminNodeGet(cmd *cobra.Command, args []string) (err error) {
+	node, err := newAdminClient().GetNode(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+	return printJSON(node)
+}
+
+func cmdAdminNodeDisqualify(cmd *cobra.Command, args []string) (err error) {
+	if err = newAdminClient().DisqualifyNode(process.Ctx(cmd), args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("node %s disqualified\n", args[0])
+	return nil
+}
+
+func cmdAdminNodeReinstate(cmd *cobra.Command, args []string) (err error) {
+	if err = newAdminClient().ReinstateNode(process.Ctx(cmd), args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("node %s reinstated\n", args[0])
+	return nil
+}
+
+func cmdAdminSegmentGet(cmd *cobra.Command, args []string) (err error) {
+	segment, err := newAdminClient().GetSegment(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+	return printJSON(segment)
+}
+
+func cmdAdminSegmentRepair(cmd *cobra.Command, args []string) (err error) {
+	segment, err := newAdminClient().RepairSegment(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+	return printJSON(segment)
+}
+
+// parseLimit parses a name=value argument and sets the matching limit of the update.
+func parseLimit(update *admin.LimitsUpdate, arg string) error {
+	parts := strings.SplitN(arg, "=", 2)
+	if len(parts) != 2 {
+		return errs.New("invalid limit %q, expected name=value", arg)
+	}
+	name, value := parts[0], parts[1]
+
+	var parse func(string) (int64, error)
+	var target **int64
+	switch name {
+	case "storage":
+		parse, target = memory.ParseString, &update.Storage
+	case "bandwidth":
+		parse, target = memory.ParseString, &update.Bandwidth
+	case "segments":
+		parse, target = parseCount, &update.Segments
+	case "buckets":
+		parse, target = parseCount, &update.Buckets
+	default:
+		return errs.New("unknown limit %q, expected storage, bandwidth, segments or buckets", name)
+	}
+
+	limit, err := parse(value)
+	if err != nil {
+		return errs.New("invalid %s limit %q: %v", name, value, err)
+	}
+	*target = &limit
+	return nil
+}
+
+func parseCount(value string) (int64, error) {
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// printJSON prints v as indented json.
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -158,6 +158,21 @@ func printDashboard(data *pb.DashboardResponse) error {
 		color.Yellow("Loading...\n")
 	}
 
+	if len(data.GetSatellites()) > 0 {
+		w = tabwriter.NewWriter(color.Output, 0, 0, 5, ' ', tabwriter.AlignRight)
+		fmt.Fprintf(w, "\n\t%s\t%s\t%s\t%s\t\n", color.GreenString("Disk Used"), color.GreenString("Disk Quota"), color.GreenString("Bandwidth Used"), color.GreenString("Bandwidth Quota"))
+		for _, satellite := range data.GetSatellites() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", satellite.SatelliteId.String(),
+				color.WhiteString(memory.Size(satellite.SpaceUsed).Base10String()),
+				quotaString(satellite.SpaceUsed, satellite.SpaceQuota),
+				color.WhiteString(memory.Size(satellite.BandwidthUsed).Base10String()),
+				quotaString(satellite.BandwidthUsed, satellite.BandwidthQuota))
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+
 	w = tabwriter.NewWriter(color.Output, 0, 0, 1, ' ', 0)
 	// TODO: Get addresses from server data
 	fmt.Fprintf(w, "\nBootstrap\t%s\n", color.WhiteString(data.GetBootstrapAddress()))
@@ -165,6 +180,14 @@ func printDashboard(data *pb.DashboardResponse) error {
 	fmt.Fprintf(w, "External\t%s\n", color.WhiteString(data.GetExternalAddress()))
 	fmt.Fprintf(w, "Dashboard\t%s\n", color.WhiteString(data.GetDashboardAddress()))
 	fmt.Fprintf(w, "\nNeighborhood Size %+v\n", whiteInt(data.GetNodeConnections()))
+	if data.GetDamagedPieces() > 0 {
+		fmt.Fprintf(w, "Damaged Pieces %s\n", color.RedString(fmt.Sprintf("%d", data.GetDamagedPieces())))
+	}
+	if data.GetExpiredOrders() > 0 {
+		fmt.Fprintf(w, "Expired Orders %s (%s unsettled)\n",
+			color.RedString(fmt.Sprintf("%d", data.GetExpiredOrders())),
+			color.RedString(memory.Size(data.GetExpiredOrderBytes()).Base10String()))
+	}
 	if err = w.Flush(); err != nil {
 		return err
 	}
@@ -176,6 +199,18 @@ func printDashboard(data *pb.DashboardResponse) error {
 	return nil
 }
 
+// quotaString formats the quota, exceeded quotas are shown in red
+func quotaString(used, quota int64) string {
+	switch {
+	case quota <= 0:
+		return color.WhiteString("-")
+	case used >= quota:
+		return color.RedString(memory.Size(quota).Base10String())
+	default:
+		return color.WhiteString(memory.Size(quota).Base10String())
+	}
+}
+
 func whiteInt(value int64) string {
 	return color.WhiteString(fmt.Sprintf("%+v", value))
 }
@@ -0,0 +1,225 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+)
+
+// exportPageSize is the number of orders requested from the inspector at once.
+const exportPageSize = 1000
+
+// exportedOrder is a single row of the order export.
+type exportedOrder struct {
+	SatelliteID   string     `json:"satelliteId"`
+	SerialNumber  string     `json:"serialNumber"`
+	PieceID       string     `json:"pieceId"`
+	Action        string     `json:"action"`
+	Limit         int64      `json:"limit"`
+	Amount        int64      `json:"amount"`
+	OrderCreation time.Time  `json:"orderCreation"`
+	Status        string     `json:"status"`
+	ArchivedAt    *time.Time `json:"archivedAt,omitempty"`
+}
+
+// exportedSummary is a single row of the archive summary export.
+type exportedSummary struct {
+	SatelliteID string    `json:"satelliteId"`
+	Day         time.Time `json:"day"`
+	Action      string    `json:"action"`
+	Status      string    `json:"status"`
+	OrderCount  int64     `json:"orderCount"`
+	TotalAmount int64     `json:"totalAmount"`
+}
+
+func cmdExportOrders(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if exportOrdersCfg.Format != "csv" && exportOrdersCfg.Format != "json" {
+		return errs.New("unknown format %q, expected csv or json", exportOrdersCfg.Format)
+	}
+
+	client, err := dialDashboardClient(ctx, exportOrdersCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing inspector client failed", err)
+		}
+	}()
+
+	var output io.Writer = os.Stdout
+	if exportOrdersCfg.Output != "" {
+		file, err := os.Create(exportOrdersCfg.Output)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, file.Close()) }()
+		output = file
+	}
+
+	if exportOrdersCfg.Summaries {
+		summaries, err := fetchArchiveSummaries(ctx, client)
+		if err != nil {
+			return err
+		}
+		if exportOrdersCfg.Format == "json" {
+			return writeJSON(output, summaries)
+		}
+		return writeSummariesCSV(output, summaries)
+	}
+
+	exported, err := fetchOrders(ctx, client)
+	if err != nil {
+		return err
+	}
+	if exportOrdersCfg.Format == "json" {
+		return writeJSON(output, exported)
+	}
+	return writeOrdersCSV(output, exported)
+}
+
+// fetchOrders pages through all the orders matching the export config.
+func fetchOrders(ctx context.Context, client *dashboardClient) ([]exportedOrder, error) {
+	var archivedAfter time.Time
+	if exportOrdersCfg.Since > 0 {
+		archivedAfter = time.Now().Add(-exportOrdersCfg.Since)
+	}
+
+	exported := []exportedOrder{}
+	for offset := int64(0); ; {
+		resp, err := client.client.ExportOrders(ctx, &pb.ExportOrdersRequest{
+			ArchivedAfter: archivedAfter,
+			Limit:         exportPageSize,
+			Offset:        offset,
+			Unsent:        exportOrdersCfg.Unsent,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, order := range resp.Orders {
+			exported = append(exported, exportedOrder{
+				SatelliteID:   order.SatelliteId.String(),
+				SerialNumber:  order.SerialNumber.String(),
+				PieceID:       order.PieceId.String(),
+				Action:        order.Action,
+				Limit:         order.Limit,
+				Amount:        order.Amount,
+				OrderCreation: order.OrderCreation,
+				Status:        order.Status,
+				ArchivedAt:    order.ArchivedAt,
+			})
+		}
+
+		if len(resp.Orders) < exportPageSize {
+			return exported, nil
+		}
+		offset += int64(len(resp.Orders))
+	}
+}
+
+// fetchArchiveSummaries returns the totals of orders removed by the retention policy.
+func fetchArchiveSummaries(ctx context.Context, client *dashboardClient) ([]exportedSummary, error) {
+	resp, err := client.client.ArchiveSummaries(ctx, &pb.ArchiveSummariesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := []exportedSummary{}
+	for _, summary := range resp.Summaries {
+		summaries = append(summaries, exportedSummary{
+			SatelliteID: summary.SatelliteId.String(),
+			Day:         summary.Day,
+			Action:      summary.Action,
+			Status:      summary.Status,
+			OrderCount:  summary.OrderCount,
+			TotalAmount: summary.TotalAmount,
+		})
+	}
+	return summaries, nil
+}
+
+func writeJSON(w io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+func writeOrdersCSV(w io.Writer, exported []exportedOrder) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"satellite_id", "serial_number", "piece_id", "action",
+		"limit", "amount", "order_creation", "status", "archived_at",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, order := range exported {
+		var archivedAt string
+		if order.ArchivedAt != nil {
+			archivedAt = order.ArchivedAt.UTC().Format(time.RFC3339)
+		}
+
+		err := writer.Write([]string{
+			order.SatelliteID,
+			order.SerialNumber,
+			order.PieceID,
+			order.Action,
+			strconv.FormatInt(order.Limit, 10),
+			strconv.FormatInt(order.Amount, 10),
+			order.OrderCreation.UTC().Format(time.RFC3339),
+			order.Status,
+			archivedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeSummariesCSV(w io.Writer, summaries []exportedSummary) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"satellite_id", "day", "action", "status", "order_count", "total_amount",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range summaries {
+		err := writer.Write([]string{
+			summary.SatelliteID,
+			summary.Day.UTC().Format("2006-01-02"),
+			summary.Action,
+			summary.Status,
+			strconv.FormatInt(summary.OrderCount, 10),
+			strconv.FormatInt(summary.TotalAmount, 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
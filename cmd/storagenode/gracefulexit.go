@@ -0,0 +1,94 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+func cmdExitSatellite(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id: %v", err)
+	}
+
+	client, err := dialDashboardClient(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing inspector client failed", err)
+		}
+	}()
+
+	_, err = client.client.GracefulExitSatellite(ctx, &pb.GracefulExitSatelliteRequest{
+		SatelliteId: satelliteID,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Initiated graceful exit from satellite %s\n", satelliteID)
+	return nil
+}
+
+func cmdExitStatus(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	client, err := dialDashboardClient(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing inspector client failed", err)
+		}
+	}()
+
+	resp, err := client.client.GracefulExitStatus(ctx, &pb.GracefulExitStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Progress) == 0 {
+		fmt.Println("No graceful exits initiated")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Satellite\tInitiated\tTransferred\tFailed\tBytes\tStatus")
+	for _, progress := range resp.Progress {
+		status := "in progress"
+		if progress.FinishedAt != nil {
+			status = "failed"
+			if progress.Successful {
+				status = "completed"
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\n",
+			progress.SatelliteId,
+			progress.InitiatedAt.Format(time.RFC3339),
+			progress.PiecesTransferred,
+			progress.PiecesFailed,
+			memory.Size(progress.BytesTransferred),
+			status,
+		)
+	}
+	return w.Flush()
+}
@@ -67,6 +67,38 @@ var (
 		RunE:        cmdDashboard,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	restoreTrashCmd = &cobra.Command{
+		Use:         "restore-trash <satellite-id> <trashed-after>",
+		Short:       "Restore pieces of a satellite trashed after the specified time (RFC3339)",
+		Args:        cobra.ExactArgs(2),
+		RunE:        cmdRestoreTrash,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	exitSatelliteCmd = &cobra.Command{
+		Use:         "exit-satellite <satellite-id>",
+		Short:       "Initiate the graceful exit from a satellite",
+		Args:        cobra.ExactArgs(1),
+		RunE:        cmdExitSatellite,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	exitStatusCmd = &cobra.Command{
+		Use:         "exit-status",
+		Short:       "Display the progress of the graceful exits",
+		RunE:        cmdExitStatus,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	scrubStatusCmd = &cobra.Command{
+		Use:         "scrub-status",
+		Short:       "Display the results of the last piece scrub",
+		RunE:        cmdScrubStatus,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	exportOrdersCmd = &cobra.Command{
+		Use:         "export-orders",
+		Short:       "Export archived or unsent orders as CSV or JSON",
+		RunE:        cmdExportOrders,
+		Annotations: map[string]string{"type": "helper"},
+	}
 
 	runCfg       StorageNodeFlags
 	setupCfg     StorageNodeFlags
@@ -74,6 +106,23 @@ var (
 	dashboardCfg struct {
 		Address string `default:"127.0.0.1:7778" help:"address for dashboard service"`
 	}
+	restoreTrashCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"address for the private inspector service"`
+	}
+	gracefulExitCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"address for the private inspector service"`
+	}
+	scrubCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"address for the private inspector service"`
+	}
+	exportOrdersCfg struct {
+		Address   string        `default:"127.0.0.1:7778" help:"address for the private inspector service"`
+		Format    string        `default:"csv" help:"output format, either csv or json"`
+		Output    string        `default:"" help:"file to write the export to, stdout when empty"`
+		Since     time.Duration `default:"0s" help:"only export orders archived within this duration, zero exports all"`
+		Unsent    bool          `default:"false" help:"export unsent orders instead of archived orders"`
+		Summaries bool          `default:"false" help:"export the totals of archived orders removed by the retention policy"`
+	}
 	defaultDiagDir string
 	confDir        string
 	identityDir    string
@@ -98,11 +147,21 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(restoreTrashCmd)
+	rootCmd.AddCommand(exitSatelliteCmd)
+	rootCmd.AddCommand(exitStatusCmd)
+	rootCmd.AddCommand(scrubStatusCmd)
+	rootCmd.AddCommand(exportOrdersCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(configCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(diagCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(dashboardCmd, &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(restoreTrashCmd, &restoreTrashCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(exitSatelliteCmd, &gracefulExitCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(exitStatusCmd, &gracefulExitCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(scrubStatusCmd, &scrubCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(exportOrdersCmd, &exportOrdersCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 }
 
 func databaseConfig(config storagenode.Config) storagenodedb.Config {
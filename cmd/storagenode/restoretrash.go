@@ -0,0 +1,52 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+func cmdRestoreTrash(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id: %v", err)
+	}
+
+	trashedAfter, err := time.Parse(time.RFC3339, args[1])
+	if err != nil {
+		return errs.New("invalid time, expected RFC3339 format: %v", err)
+	}
+
+	client, err := dialDashboardClient(ctx, restoreTrashCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing inspector client failed", err)
+		}
+	}()
+
+	resp, err := client.client.RestoreTrash(ctx, &pb.RestoreTrashRequest{
+		SatelliteId:  satelliteID,
+		TrashedAfter: trashedAfter,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Restored %d pieces of satellite %s\n", resp.RestoredCount, satelliteID)
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+)
+
+func cmdScrubStatus(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	client, err := dialDashboardClient(ctx, scrubCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing inspector client failed", err)
+		}
+	}()
+
+	resp, err := client.client.ScrubStatus(ctx, &pb.ScrubStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	if resp.LastFinished == nil {
+		fmt.Println("No scrub finished yet")
+		return nil
+	}
+
+	fmt.Printf("Last scrub finished at %s, %d pieces checked, %d damaged\n",
+		resp.LastFinished.Format(time.RFC3339), resp.PiecesChecked, len(resp.Damaged))
+	if len(resp.Damaged) == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\nSatellite\tPiece\tReason\tDetected\tReported")
+	for _, piece := range resp.Damaged {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n",
+			piece.SatelliteId,
+			piece.PieceId,
+			piece.Reason,
+			piece.DetectedAt.Format(time.RFC3339),
+			piece.Reported,
+		)
+	}
+	return w.Flush()
+}
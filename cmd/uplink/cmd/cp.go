@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -23,8 +24,11 @@ import (
 )
 
 var (
-	progress *bool
-	expires  *string
+	progress      *bool
+	expires       *string
+	cpRecursive   *bool
+	cpParallelism *int
+	resume        *bool
 )
 
 func init() {
@@ -35,6 +39,26 @@ func init() {
 	}, RootCmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
 	expires = cpCmd.Flags().String("expires", "", "optional expiration date of an object. Please use format (yyyy-mm-ddThh:mm:ssZhh:mm)")
+	cpRecursive = cpCmd.Flags().BoolP("recursive", "r", false, "if true, copy all files in the source directory or all objects under the source prefix")
+	cpParallelism = cpCmd.Flags().Int("parallelism", 4, "number of files to copy in parallel when copying recursively")
+	resume = cpCmd.Flags().Bool("resume", false, "if true, continue an interrupted upload of the same file to the same destination")
+}
+
+// parseExpiration parses the expiration date from the expires flag.
+func parseExpiration() (expiration time.Time, err error) {
+	if *expires == "" {
+		return time.Time{}, nil
+	}
+
+	expiration, err = time.Parse(time.RFC3339, *expires)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if expiration.Before(time.Now()) {
+		return time.Time{}, fmt.Errorf("Invalid expiration date: (%s) has already passed", *expires)
+	}
+
+	return expiration.UTC(), nil
 }
 
 // upload transfers src from local machine to s3 compatible object dst
@@ -47,15 +71,9 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return fmt.Errorf("destination must be Storj URL: %s", dst)
 	}
 
-	var expiration time.Time
-	if *expires != "" {
-		expiration, err = time.Parse(time.RFC3339, *expires)
-		if err != nil {
-			return err
-		}
-		if expiration.Before(time.Now()) {
-			return fmt.Errorf("Invalid expiration date: (%s) has already passed", *expires)
-		}
+	expiration, err := parseExpiration()
+	if err != nil {
+		return err
 	}
 
 	// if object name not specified, default to filename
@@ -83,6 +101,10 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return fmt.Errorf("source cannot be a directory: %s", src)
 	}
 
+	if *resume && !fileInfo.Mode().IsRegular() {
+		return fmt.Errorf("only uploads of regular files can be resumed: %s", src)
+	}
+
 	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
 	if err != nil {
 		return err
@@ -95,26 +117,33 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 
 	defer closeProjectAndBucket(project, bucket)
 
-	reader := io.Reader(file)
 	var bar *progressbar.ProgressBar
 	if showProgress {
 		bar = progressbar.New64(fileInfo.Size()).SetUnits(progressbar.U_BYTES).SetWidth(80)
 		bar.ShowSpeed = true
 		bar.Start()
-		reader = bar.NewProxyReader(reader)
 	}
 
-	opts := &libuplink.UploadOptions{}
-
-	if *expires != "" {
-		opts.Expires = expiration.UTC()
+	opts := &libuplink.UploadOptions{
+		Expires: expiration,
 	}
 
 	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
 	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
 
-	if err := bucket.UploadObject(ctx, dst.Path(), reader, opts); err != nil {
-		return err
+	if *resume {
+		if err := uploadResumable(ctx, bucket, file, fileInfo, src, dst, opts, bar); err != nil {
+			return err
+		}
+	} else {
+		reader := io.Reader(file)
+		if bar != nil {
+			reader = bar.NewProxyReader(reader)
+		}
+
+		if err := bucket.UploadObject(ctx, dst.Path(), reader, opts); err != nil {
+			return err
+		}
 	}
 
 	if bar != nil {
@@ -274,6 +303,104 @@ func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err erro
 	return nil
 }
 
+// copyRecursive copies all files in the local directory or all objects under
+// the prefix src to the directory or prefix dst
+func copyRecursive(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
+	expiration, err := parseExpiration()
+	if err != nil {
+		return err
+	}
+
+	remote := src
+	if src.IsLocal() {
+		remote = dst
+	}
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, remote.Bucket(), access)
+	if err != nil {
+		return convertError(err, remote)
+	}
+
+	defer closeProjectAndBucket(project, bucket)
+
+	var files map[string]transferFile
+	if src.IsLocal() {
+		files, err = listLocalFiles(src.Path())
+	} else {
+		files, err = listRemoteFiles(ctx, bucket, src.Path())
+	}
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	if len(files) == 0 {
+		fmt.Printf("Nothing to copy in %s\n", src)
+		return nil
+	}
+
+	paths := make([]string, 0, len(files))
+	var total int64
+	for relPath, file := range files {
+		paths = append(paths, relPath)
+		total += file.Size
+	}
+	sort.Strings(paths)
+
+	var bar *progressbar.ProgressBar
+	if *progress {
+		bar = newTransferBar(total)
+		bar.Start()
+	}
+
+	opts := libuplink.UploadOptions{
+		Expires: expiration,
+	}
+	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
+	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+
+	err = runParallel(ctx, *cpParallelism, len(paths), func(ctx context.Context, i int) error {
+		relPath := paths[i]
+		from, to := src.Join(relPath), dst.Join(relPath)
+
+		var err error
+		switch {
+		case src.IsLocal():
+			err = uploadFile(ctx, bucket, from.Path(), to.Path(), opts, bar)
+		case dst.IsLocal():
+			err = downloadFile(ctx, bucket, from.Path(), to.Path(), files[relPath].ModTime, bar)
+		default:
+			err = bucket.CopyObject(ctx, from.Path(), to.Bucket(), to.Path())
+			if err == nil && bar != nil {
+				bar.Add64(files[relPath].Size)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", from, err)
+		}
+
+		if bar == nil {
+			fmt.Printf("%s copied to %s\n", from, to)
+		}
+		return nil
+	})
+
+	if bar != nil {
+		bar.Finish()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Copied %d files from %s to %s\n", len(paths), src, dst)
+	return nil
+}
+
 // copyMain is the function executed when cpCmd is called
 func copyMain(cmd *cobra.Command, args []string) (err error) {
 	if len(args) == 0 {
@@ -300,6 +427,10 @@ func copyMain(cmd *cobra.Command, args []string) (err error) {
 		return errors.New("At least one of the source or the desination must be a Storj URL")
 	}
 
+	if *cpRecursive {
+		return copyRecursive(ctx, src, dst)
+	}
+
 	// if uploading
 	if src.IsLocal() {
 		return upload(ctx, src, dst, *progress)
@@ -16,12 +16,17 @@ import (
 	"storj.io/storj/pkg/storj"
 )
 
+var (
+	versioningFlag *bool
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	mbCmd := addCmd(&cobra.Command{
 		Use:   "mb",
 		Short: "Create a new bucket",
 		RunE:  makeBucket,
 	}, RootCmd)
+	versioningFlag = mbCmd.Flags().Bool("versioning", false, "if true, keep all versions of the objects in the bucket")
 }
 
 func makeBucket(cmd *cobra.Command, args []string) error {
@@ -57,6 +62,7 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 	bucketCfg := &uplink.BucketConfig{}
 	bucketCfg.PathCipher = cfg.GetPathCipherSuite()
 	bucketCfg.EncryptionParameters = cfg.GetEncryptionParameters()
+	bucketCfg.Versioning = *versioningFlag
 	bucketCfg.Volatile = struct {
 		RedundancyScheme storj.RedundancyScheme
 		SegmentsSize     memory.Size
@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	progressbar "github.com/cheggaaa/pb"
+
+	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
+)
+
+// resumeState is the state of an interrupted upload stored in the
+// configuration directory.
+type resumeState struct {
+	Token string
+	// Size and ModTime identify the version of the source file
+	Size    int64
+	ModTime int64
+}
+
+// resumeStatePath returns the path of the file storing the state of the
+// upload from src to dst.
+func resumeStatePath(src, dst fpath.FPath) (string, error) {
+	source, err := filepath.Abs(src.Path())
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(source + "\n" + dst.String()))
+	return filepath.Join(confDir, "uploads", hex.EncodeToString(hash[:])), nil
+}
+
+func loadResumeState(statePath string) (*resumeState, error) {
+	data, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var state resumeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid upload state in %s: %v", statePath, err)
+	}
+	return &state, nil
+}
+
+func saveResumeState(statePath string, state resumeState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(statePath), 0700); err != nil {
+		return err
+	}
+
+	// replace the state atomically, so an interruption doesn't corrupt it
+	tmpPath := statePath + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, statePath)
+}
+
+// uploadResumable uploads file to dst continuing a previously interrupted
+// upload of the same version of the file.
+func uploadResumable(ctx context.Context, bucket *libuplink.Bucket, file *os.File, fileInfo os.FileInfo, src, dst fpath.FPath, opts *libuplink.UploadOptions, bar *progressbar.ProgressBar) (err error) {
+	statePath, err := resumeStatePath(src, dst)
+	if err != nil {
+		return err
+	}
+
+	state, err := loadResumeState(statePath)
+	if err != nil {
+		return err
+	}
+
+	var upload *libuplink.ResumableUpload
+	if state != nil {
+		upload, err = bucket.ResumeUpload(ctx, state.Token)
+		if err != nil {
+			return fmt.Errorf("unable to resume upload, remove %s to start over: %v", statePath, err)
+		}
+
+		if state.Size != fileInfo.Size() || state.ModTime != fileInfo.ModTime().UnixNano() {
+			fmt.Printf("%s changed since the upload was interrupted, starting over\n", src)
+			if err := upload.Abort(ctx); err != nil {
+				return err
+			}
+			upload = nil
+		}
+	}
+
+	if upload == nil {
+		upload, err = bucket.BeginResumableUpload(ctx, dst.Path(), opts)
+		if err != nil {
+			return err
+		}
+	} else if upload.Offset() > 0 {
+		fmt.Printf("Resuming upload of %s at %d bytes\n", src, upload.Offset())
+	}
+
+	if _, err := file.Seek(upload.Offset(), io.SeekStart); err != nil {
+		return err
+	}
+
+	reader := io.Reader(file)
+	if bar != nil {
+		bar.Set64(upload.Offset())
+		reader = bar.NewProxyReader(reader)
+	}
+
+	var saveErr error
+	err = upload.Upload(ctx, reader, func(token string) {
+		saveErr = saveResumeState(statePath, resumeState{
+			Token:   token,
+			Size:    fileInfo.Size(),
+			ModTime: fileInfo.ModTime().UnixNano(),
+		})
+	})
+	if err != nil {
+		if saveErr == nil {
+			fmt.Fprintf(os.Stderr, "Upload of %s interrupted, run the same command with --resume to continue\n", src)
+		}
+		return err
+	}
+
+	err = os.Remove(statePath)
+	if os.IsNotExist(err) {
+		err = nil
+	}
+	return err
+}
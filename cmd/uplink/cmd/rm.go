@@ -4,21 +4,35 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"path"
+	"sort"
 
+	progressbar "github.com/cheggaaa/pb"
 	"github.com/spf13/cobra"
 
 	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/uplink/setup"
 )
 
+var (
+	rmRecursive   *bool
+	rmParallelism *int
+	rmProgress    *bool
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	rmCmd := addCmd(&cobra.Command{
 		Use:   "rm",
 		Short: "Delete an object",
 		RunE:  deleteObject,
 	}, RootCmd)
+	rmRecursive = rmCmd.Flags().BoolP("recursive", "r", false, "if true, delete all objects under the prefix")
+	rmParallelism = rmCmd.Flags().Int("parallelism", 4, "number of objects to delete in parallel when deleting recursively")
+	rmProgress = rmCmd.Flags().Bool("progress", true, "if true, show progress when deleting recursively")
 }
 
 func deleteObject(cmd *cobra.Command, args []string) error {
@@ -49,6 +63,10 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 
 	defer closeProjectAndBucket(project, bucket)
 
+	if *rmRecursive {
+		return deleteRecursive(ctx, bucket, dst)
+	}
+
 	err = bucket.DeleteObject(ctx, dst.Path())
 	if err != nil {
 		return convertError(err, dst)
@@ -58,3 +76,53 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// deleteRecursive deletes all objects under the prefix dst
+func deleteRecursive(ctx context.Context, bucket *libuplink.Bucket, dst fpath.FPath) error {
+	files, err := listRemoteFiles(ctx, bucket, dst.Path())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	if len(files) == 0 {
+		fmt.Printf("No objects found under %s\n", dst)
+		return nil
+	}
+
+	paths := make([]string, 0, len(files))
+	for relPath := range files {
+		paths = append(paths, relPath)
+	}
+	sort.Strings(paths)
+
+	var bar *progressbar.ProgressBar
+	if *rmProgress {
+		bar = progressbar.New(len(paths)).SetWidth(80)
+		bar.Start()
+	}
+
+	err = runParallel(ctx, *rmParallelism, len(paths), func(ctx context.Context, i int) error {
+		key := path.Join(dst.Path(), paths[i])
+		if err := bucket.DeleteObject(ctx, key); err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+
+		if bar != nil {
+			bar.Increment()
+		} else {
+			fmt.Printf("Deleted %s\n", key)
+		}
+		return nil
+	})
+
+	if bar != nil {
+		bar.Finish()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted %d objects under %s\n", len(paths), dst)
+	return nil
+}
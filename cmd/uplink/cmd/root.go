@@ -78,6 +78,8 @@ func (cliCfg *UplinkFlags) NewUplink(ctx context.Context) (*libuplink.Uplink, er
 	libuplinkCfg := &libuplink.Config{}
 	libuplinkCfg.Volatile.MaxInlineSize = cliCfg.Client.MaxInlineSize
 	libuplinkCfg.Volatile.MaxMemory = cliCfg.RS.MaxBufferMem
+	libuplinkCfg.Volatile.UploadParallelism = cliCfg.Client.UploadParallelism
+	libuplinkCfg.Volatile.DownloadParallelism = cliCfg.Client.DownloadParallelism
 	libuplinkCfg.Volatile.PeerIDVersion = cliCfg.TLS.PeerIDVersions
 	libuplinkCfg.Volatile.TLS = struct {
 		SkipPeerCAWhitelist bool
@@ -0,0 +1,253 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/uplink/setup"
+)
+
+var (
+	syncDelete      *bool
+	syncDryRun      *bool
+	syncInclude     *[]string
+	syncExclude     *[]string
+	syncParallelism *int
+)
+
+func init() {
+	syncCmd := addCmd(&cobra.Command{
+		Use:   "sync",
+		Short: "Synchronizes a local directory with a Storj bucket or prefix in either direction",
+		RunE:  syncMain,
+	}, RootCmd)
+	syncDelete = syncCmd.Flags().Bool("delete", false, "if true, delete files in the destination, which don't exist in the source")
+	syncDryRun = syncCmd.Flags().Bool("dry-run", false, "if true, only print the changes without making them")
+	syncInclude = syncCmd.Flags().StringSlice("include", nil, "only synchronize files matching one of the patterns")
+	syncExclude = syncCmd.Flags().StringSlice("exclude", nil, "don't synchronize files matching one of the patterns")
+	syncParallelism = syncCmd.Flags().Int("parallelism", 4, "number of files to transfer in parallel")
+}
+
+// syncOp is the operation to apply to a single path.
+type syncOp int
+
+const (
+	syncCopy syncOp = iota
+	syncRemove
+)
+
+// syncAction is an operation to apply to a path relative to the source and
+// the destination.
+type syncAction struct {
+	Op   syncOp
+	Path string
+	File transferFile
+}
+
+// syncFilter selects which paths take part in a sync.
+type syncFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// newSyncFilter checks the patterns and returns a filter using them.
+func newSyncFilter(include, exclude []string) (*syncFilter, error) {
+	for _, pattern := range append(append([]string{}, include...), exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+	}
+	return &syncFilter{Include: include, Exclude: exclude}, nil
+}
+
+// Matches returns whether the path takes part in the sync. Patterns match
+// either the whole relative path or its last element.
+func (filter *syncFilter) Matches(relPath string) bool {
+	if len(filter.Include) > 0 && !matchesAny(filter.Include, relPath) {
+		return false
+	}
+	return !matchesAny(filter.Exclude, relPath)
+}
+
+func matchesAny(patterns []string, relPath string) bool {
+	for _, pattern := range patterns {
+		for _, name := range []string{relPath, path.Base(relPath)} {
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// planSync returns the actions needed to make dst match src. Files are
+// copied when they are missing in dst or differ in size or modification
+// time. Modification times are compared with a precision of a second.
+func planSync(src, dst map[string]transferFile, filter *syncFilter, deleteExtraneous bool) []syncAction {
+	var actions []syncAction
+
+	for relPath, file := range src {
+		if !filter.Matches(relPath) {
+			continue
+		}
+		existing, ok := dst[relPath]
+		if ok && existing.Size == file.Size && existing.ModTime.Unix() == file.ModTime.Unix() {
+			continue
+		}
+		actions = append(actions, syncAction{Op: syncCopy, Path: relPath, File: file})
+	}
+
+	if deleteExtraneous {
+		for relPath, file := range dst {
+			if !filter.Matches(relPath) {
+				continue
+			}
+			if _, ok := src[relPath]; !ok {
+				actions = append(actions, syncAction{Op: syncRemove, Path: relPath, File: file})
+			}
+		}
+	}
+
+	sort.Slice(actions, func(i, k int) bool {
+		if actions[i].Op != actions[k].Op {
+			return actions[i].Op < actions[k].Op
+		}
+		return actions[i].Path < actions[k].Path
+	})
+
+	return actions
+}
+
+// syncMain is the function executed when syncCmd is called
+func syncMain(cmd *cobra.Command, args []string) (err error) {
+	if len(args) == 0 {
+		return fmt.Errorf("No source specified for sync")
+	}
+	if len(args) == 1 {
+		return fmt.Errorf("No destination specified")
+	}
+
+	ctx := process.Ctx(cmd)
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() == dst.IsLocal() {
+		return errors.New("Exactly one of the source or the destination must be a Storj URL")
+	}
+
+	filter, err := newSyncFilter(*syncInclude, *syncExclude)
+	if err != nil {
+		return err
+	}
+
+	local, remote := src, dst
+	if !src.IsLocal() {
+		local, remote = dst, src
+	}
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, remote.Bucket(), access)
+	if err != nil {
+		return convertError(err, remote)
+	}
+
+	defer closeProjectAndBucket(project, bucket)
+
+	localFiles, err := listLocalFiles(local.Path())
+	if err != nil {
+		// a missing destination directory is created by the sync
+		if !os.IsNotExist(err) || src.IsLocal() {
+			return err
+		}
+		localFiles = map[string]transferFile{}
+	}
+
+	remoteFiles, err := listRemoteFiles(ctx, bucket, remote.Path())
+	if err != nil {
+		return convertError(err, remote)
+	}
+
+	remoteKey := func(relPath string) string {
+		return path.Join(remote.Path(), relPath)
+	}
+	localPath := func(relPath string) string {
+		return filepath.Join(local.Path(), filepath.FromSlash(relPath))
+	}
+
+	opts := libuplink.UploadOptions{}
+	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
+	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+
+	var actions []syncAction
+	var apply func(context.Context, syncAction) error
+	if src.IsLocal() {
+		actions = planSync(localFiles, remoteFiles, filter, *syncDelete)
+		apply = func(ctx context.Context, action syncAction) error {
+			if action.Op == syncRemove {
+				return bucket.DeleteObject(ctx, remoteKey(action.Path))
+			}
+			return uploadFile(ctx, bucket, localPath(action.Path), remoteKey(action.Path), opts, nil)
+		}
+	} else {
+		actions = planSync(remoteFiles, localFiles, filter, *syncDelete)
+		apply = func(ctx context.Context, action syncAction) error {
+			if action.Op == syncRemove {
+				return os.Remove(localPath(action.Path))
+			}
+			return downloadFile(ctx, bucket, remoteKey(action.Path), localPath(action.Path), action.File.ModTime, nil)
+		}
+	}
+
+	if len(actions) == 0 {
+		fmt.Println("Already in sync")
+		return nil
+	}
+
+	return runParallel(ctx, *syncParallelism, len(actions), func(ctx context.Context, i int) error {
+		action := actions[i]
+		target := dst.Join(action.Path)
+
+		verb := "Copied"
+		if action.Op == syncRemove {
+			verb = "Deleted"
+		}
+
+		if *syncDryRun {
+			fmt.Printf("Would have %s %s\n", strings.ToLower(verb), target)
+			return nil
+		}
+
+		if err := apply(ctx, action); err != nil {
+			return fmt.Errorf("%s: %v", target, err)
+		}
+
+		fmt.Printf("%s %s\n", verb, target)
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanSync(t *testing.T) {
+	now := time.Now()
+
+	src := map[string]transferFile{
+		"same":           {Size: 1, ModTime: now},
+		"same-second":    {Size: 1, ModTime: now.Truncate(time.Second)},
+		"changed-size":   {Size: 1, ModTime: now},
+		"changed-time":   {Size: 1, ModTime: now},
+		"missing":        {Size: 1, ModTime: now},
+		"dir/missing":    {Size: 1, ModTime: now},
+		"excluded.tmp":   {Size: 1, ModTime: now},
+		"dir/not-synced": {Size: 1, ModTime: now},
+	}
+	dst := map[string]transferFile{
+		"same":              {Size: 1, ModTime: now},
+		"same-second":       {Size: 1, ModTime: now.Truncate(time.Second).Add(time.Millisecond)},
+		"changed-size":      {Size: 2, ModTime: now},
+		"changed-time":      {Size: 1, ModTime: now.Add(-time.Hour)},
+		"extraneous":        {Size: 1, ModTime: now},
+		"extraneous.tmp":    {Size: 1, ModTime: now},
+		"dir/not-synced":    {Size: 2, ModTime: now},
+		"dir/extraneous":    {Size: 1, ModTime: now},
+		"other/extraneous2": {Size: 1, ModTime: now},
+	}
+
+	filter, err := newSyncFilter(nil, []string{"*.tmp", "dir/not-*"})
+	require.NoError(t, err)
+
+	paths := func(actions []syncAction, op syncOp) []string {
+		var result []string
+		for _, action := range actions {
+			if action.Op == op {
+				result = append(result, action.Path)
+			}
+		}
+		return result
+	}
+
+	actions := planSync(src, dst, filter, false)
+	assert.Equal(t, []string{"changed-size", "changed-time", "dir/missing", "missing"}, paths(actions, syncCopy))
+	assert.Empty(t, paths(actions, syncRemove))
+
+	actions = planSync(src, dst, filter, true)
+	assert.Equal(t, []string{"changed-size", "changed-time", "dir/missing", "missing"}, paths(actions, syncCopy))
+	assert.Equal(t, []string{"dir/extraneous", "extraneous", "other/extraneous2"}, paths(actions, syncRemove))
+
+	filter, err = newSyncFilter([]string{"dir/*"}, nil)
+	require.NoError(t, err)
+
+	actions = planSync(src, dst, filter, true)
+	assert.Equal(t, []string{"dir/missing", "dir/not-synced"}, paths(actions, syncCopy))
+	assert.Equal(t, []string{"dir/extraneous"}, paths(actions, syncRemove))
+
+	_, err = newSyncFilter([]string{"["}, nil)
+	assert.Error(t, err)
+}
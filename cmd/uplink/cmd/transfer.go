@@ -0,0 +1,231 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	progressbar "github.com/cheggaaa/pb"
+	"github.com/zeebo/errs"
+
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+// modTimeKey is the metadata key, which stores the modification time
+// of the local file an object was uploaded from
+const modTimeKey = "mtime"
+
+// transferFile is a local file or an object taking part in a transfer of many files.
+type transferFile struct {
+	Size    int64
+	ModTime time.Time
+}
+
+// runParallel calls fn for every index from 0 to count using parallelism
+// goroutines. All calls are made, even when some of them fail. The failures
+// are printed as a summary at the end and result in an error.
+func runParallel(ctx context.Context, parallelism, count int, fn func(ctx context.Context, i int) error) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	queue := make(chan int)
+	var mu sync.Mutex
+	var failures []error
+
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				if err := fn(ctx, i); err != nil {
+					mu.Lock()
+					failures = append(failures, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "%d of %d operations failed:\n", len(failures), count)
+	for _, err := range failures {
+		fmt.Fprintf(os.Stderr, "\t%v\n", err)
+	}
+	return fmt.Errorf("%d of %d operations failed", len(failures), count)
+}
+
+// newTransferBar returns a progress bar for transferring total bytes.
+func newTransferBar(total int64) *progressbar.ProgressBar {
+	bar := progressbar.New64(total).SetUnits(progressbar.U_BYTES).SetWidth(80)
+	bar.ShowSpeed = true
+	return bar
+}
+
+// listLocalFiles returns the regular files under root keyed by their
+// slash separated path relative to root.
+func listLocalFiles(root string) (map[string]transferFile, error) {
+	files := make(map[string]transferFile)
+
+	err := filepath.Walk(root, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fullPath == root && !info.IsDir() {
+			return fmt.Errorf("%s is not a directory", root)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, fullPath)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(relPath)] = transferFile{
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// listRemoteFiles returns the objects under prefix keyed by their path
+// relative to prefix.
+func listRemoteFiles(ctx context.Context, bucket *libuplink.Bucket, prefix string) (map[string]transferFile, error) {
+	files := make(map[string]transferFile)
+
+	startAfter := ""
+	for {
+		list, err := bucket.ListObjects(ctx, &storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    startAfter,
+			Prefix:    strings.TrimSuffix(prefix, "/"),
+			Recursive: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range list.Items {
+			if object.IsPrefix {
+				continue
+			}
+			files[object.Path] = transferFile{
+				Size:    object.Size,
+				ModTime: objectModTime(object),
+			}
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			return files, nil
+		}
+		startAfter = list.Items[len(list.Items)-1].Path
+	}
+}
+
+// objectModTime returns the modification time of the local file the object
+// was uploaded from, or the time of the upload if it's unknown.
+func objectModTime(object storj.Object) time.Time {
+	if value, ok := object.Metadata[modTimeKey]; ok {
+		if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Unix(0, nanos)
+		}
+	}
+	return object.Modified
+}
+
+// uploadFile uploads the local file to the object at key and stores the
+// modification time of the file with the object.
+func uploadFile(ctx context.Context, bucket *libuplink.Bucket, localPath, key string, opts libuplink.UploadOptions, bar *progressbar.ProgressBar) (err error) {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	metadata := make(map[string]string, len(opts.Metadata)+1)
+	for k, v := range opts.Metadata {
+		metadata[k] = v
+	}
+	metadata[modTimeKey] = strconv.FormatInt(fileInfo.ModTime().UnixNano(), 10)
+	opts.Metadata = metadata
+
+	reader := io.Reader(file)
+	if bar != nil {
+		reader = bar.NewProxyReader(reader)
+	}
+
+	return bucket.UploadObject(ctx, key, reader, &opts)
+}
+
+// downloadFile downloads the object at key to the local file. The
+// modification time of the file is set to modTime, unless it's zero.
+func downloadFile(ctx context.Context, bucket *libuplink.Bucket, key, localPath string, modTime time.Time, bar *progressbar.ProgressBar) (err error) {
+	if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return err
+	}
+
+	download, err := bucket.NewReader(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	reader := io.Reader(download)
+	if bar != nil {
+		reader = bar.NewProxyReader(reader)
+	}
+
+	// download into a temporary file, so an interrupted transfer doesn't
+	// leave a partial file behind, which looks complete
+	tmpPath := localPath + ".uplink-tmp"
+	writer, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(writer, reader)
+	err = errs.Combine(err, writer.Close())
+	if err == nil && !modTime.IsZero() {
+		err = os.Chtimes(tmpPath, modTime, modTime)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, localPath)
+	}
+	if err != nil {
+		return errs.Combine(err, os.Remove(tmpPath))
+	}
+	return nil
+}
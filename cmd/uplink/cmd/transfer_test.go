@@ -0,0 +1,38 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunParallel(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	called := map[int]bool{}
+
+	err := runParallel(ctx, 3, 10, func(ctx context.Context, i int) error {
+		mu.Lock()
+		called[i] = true
+		mu.Unlock()
+
+		if i%4 == 0 {
+			return errors.New("failure")
+		}
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, "3 of 10 operations failed", err.Error())
+	assert.Len(t, called, 10, "all operations are attempted")
+
+	err = runParallel(ctx, 0, 5, func(ctx context.Context, i int) error { return nil })
+	assert.NoError(t, err)
+}
@@ -77,6 +77,42 @@ func (slow *SlowBlobs) Delete(ctx context.Context, ref storage.BlobRef) error {
 	return slow.blobs.Delete(ctx, ref)
 }
 
+// Trash moves the blob with the namespace and key to the trash.
+func (slow *SlowBlobs) Trash(ctx context.Context, ref storage.BlobRef) error {
+	slow.sleep()
+	return slow.blobs.Trash(ctx, ref)
+}
+
+// RestoreTrash restores all blobs in the namespace, which were trashed after the specified time.
+func (slow *SlowBlobs) RestoreTrash(ctx context.Context, namespace []byte, trashedAfter time.Time) ([][]byte, error) {
+	slow.sleep()
+	return slow.blobs.RestoreTrash(ctx, namespace, trashedAfter)
+}
+
+// EmptyTrash permanently deletes all blobs in the namespace, which were trashed before the specified time.
+func (slow *SlowBlobs) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error) {
+	slow.sleep()
+	return slow.blobs.EmptyTrash(ctx, namespace, trashedBefore)
+}
+
+// ListNamespaces returns all namespaces that have blobs or trash.
+func (slow *SlowBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
+	slow.sleep()
+	return slow.blobs.ListNamespaces(ctx)
+}
+
+// WalkNamespace calls fn for every blob in the namespace.
+func (slow *SlowBlobs) WalkNamespace(ctx context.Context, namespace []byte, fn func(key []byte) error) error {
+	slow.sleep()
+	return slow.blobs.WalkNamespace(ctx, namespace, fn)
+}
+
+// SpaceUsed returns how much space the blobs and the trash use.
+func (slow *SlowBlobs) SpaceUsed(ctx context.Context) (int64, error) {
+	slow.sleep()
+	return slow.blobs.SpaceUsed(ctx)
+}
+
 // FreeSpace return how much free space left for writing.
 func (slow *SlowBlobs) FreeSpace() (int64, error) {
 	slow.sleep()
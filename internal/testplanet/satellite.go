@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/accounting/tally"
 	"storj.io/storj/pkg/audit"
@@ -23,8 +24,12 @@ import (
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/marketingweb"
 	"storj.io/storj/satellite/metainfo"
@@ -122,6 +127,10 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 					UptimeReputationWeight:       1,
 					UptimeReputationDQ:           0.6,
 				},
+				Recorder: overlay.RecorderConfig{
+					Interval:  time.Hour,
+					BatchSize: 100,
+				},
 			},
 			Discovery: discovery.Config{
 				DiscoveryInterval: 1 * time.Second,
@@ -162,15 +171,43 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Interval:           30 * time.Second,
 				MinBytesPerSecond:  1 * memory.KB,
 				MinDownloadTimeout: 5 * time.Second,
+
+				QueueSize:             10,
+				MinNodeAuditInterval:  time.Hour,
+				FailurePriorityWindow: time.Hour,
+			},
+			GarbageCollection: gc.Config{
+				Interval:          1 * time.Minute,
+				Enabled:           true,
+				InitialPieces:     10,
+				FalsePositiveRate: 0.1,
+				ConcurrentSends:   1,
+			},
+			Lifecycle: lifecycle.Config{
+				Interval:  1 * time.Minute,
+				BatchSize: 1,
+			},
+			GracefulExit: gracefulexit.Config{
+				Enabled:                      true,
+				ChoreInterval:                1 * time.Minute,
+				ChoreBatchSize:               10,
+				EndpointBatchSize:            10,
+				MaxFailuresPerPiece:          3,
+				OverallMaxFailuresPercentage: 10,
 			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
 			},
 			Rollup: rollup.Config{
 				Interval:      2 * time.Minute,
-				MaxAlphaUsage: 25 * memory.GB,
 				DeleteTallies: false,
 			},
+			ProjectLimits: accounting.ProjectLimitConfig{
+				DefaultMaxStorage:   25 * memory.GB,
+				DefaultMaxBandwidth: 25 * memory.GB,
+				DefaultMaxSegments:  1000000,
+				DefaultMaxBuckets:   100,
+			},
 			Mail: mailservice.Config{
 				SMTPServerAddress: "smtp.mail.test:587",
 				From:              "Labs <storj@mail.test>",
@@ -187,6 +224,10 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Address:   "127.0.0.1:0",
 				StaticDir: filepath.Join(developmentRoot, "web/marketing"),
 			},
+			Admin: admin.Config{
+				Address:   "127.0.0.1:0",
+				AuthToken: "very-secret-admin-token",
+			},
 			Vouchers: vouchers.Config{
 				Expiration: 30 * 24 * time.Hour,
 			},
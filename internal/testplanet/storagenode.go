@@ -20,9 +20,12 @@ import (
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/vouchers"
 )
@@ -106,7 +109,8 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 				WhitelistedSatellites:  whitelistedSatellites,
 			},
 			Collector: collector.Config{
-				Interval: time.Minute,
+				Interval:        time.Minute,
+				TrashExpiration: 24 * time.Hour,
 			},
 			Console: consoleserver.Config{
 				Address:   "127.0.0.1:0",
@@ -116,6 +120,7 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 				ExpirationGracePeriod: 0,
 				MaxConcurrentRequests: 100,
 				OrderLimitGracePeriod: time.Hour * 24,
+				RetainEnabled:         true,
 				Sender: orders.SenderConfig{
 					Interval: time.Hour,
 					Timeout:  time.Hour,
@@ -124,10 +129,23 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 					MinimumBandwidth: 100 * memory.MB,
 					MinimumDiskSpace: 100 * memory.MB,
 				},
+				Pieces: pieces.Config{
+					Placement: string(pieces.PlacementFreeSpace),
+					Drain: pieces.DrainConfig{
+						Interval: time.Hour,
+					},
+				},
 			},
 			Vouchers: vouchers.Config{
 				Interval: time.Hour,
 			},
+			GracefulExit: gracefulexit.Config{
+				ChoreInterval: time.Minute,
+			},
+			Scrubber: scrubber.Config{
+				Interval:      time.Hour,
+				ReportDamaged: true,
+			},
 			Version: planet.NewVersionConfig(),
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
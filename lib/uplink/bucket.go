@@ -22,9 +22,10 @@ type Bucket struct {
 	Name    string
 	Created time.Time
 
-	bucket   storj.Bucket
-	metainfo *kvmetainfo.DB
-	streams  streams.Store
+	bucket      storj.Bucket
+	metainfo    *kvmetainfo.DB
+	streams     streams.Store
+	parallelism streams.Parallelism
 }
 
 // TODO: move the object related OpenObject to object.go
@@ -32,17 +33,29 @@ type Bucket struct {
 // OpenObject returns an Object handle, if authorized.
 func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return b.OpenObjectVersion(ctx, path, "")
+}
+
+// OpenObjectVersion returns an Object handle for a specific version of an
+// Object, if authorized. An empty versionID opens the latest version.
+func (b *Bucket) OpenObjectVersion(ctx context.Context, path storj.Path, versionID string) (o *Object, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	info, err := b.metainfo.GetObject(ctx, b.Name, path)
+	info, err := b.metainfo.GetObjectVersion(ctx, b.Name, path, versionID)
 	if err != nil {
 		return nil, err
 	}
 
+	if info.IsDeleteMarker {
+		return nil, storj.ErrObjectNotFound.New("version %s of %s is a delete marker", versionID, path)
+	}
+
 	return &Object{
 		Meta: ObjectMeta{
 			Bucket:      info.Bucket.Name,
 			Path:        info.Path,
 			IsPrefix:    info.IsPrefix,
+			VersionID:   info.VersionID,
 			ContentType: info.ContentType,
 			Metadata:    info.Metadata,
 			Created:     info.Created,
@@ -91,6 +104,11 @@ type UploadOptions struct {
 		// Error Correction encoding parameters to be used for this
 		// Object.
 		RedundancyScheme storj.RedundancyScheme
+
+		// UploadParallelism is the number of segments of the Object,
+		// which are encrypted and uploaded concurrently. If not set,
+		// the Uplink's default will be used.
+		UploadParallelism int
 	}
 }
 
@@ -108,12 +126,128 @@ func (b *Bucket) UploadObject(ctx context.Context, path storj.Path, data io.Read
 	return errs.Combine(err, upload.Close())
 }
 
-// DeleteObject removes an object, if authorized.
+// DeleteObject removes an object, if authorized. If the Bucket has
+// versioning enabled, a delete marker is created instead.
 func (b *Bucket) DeleteObject(ctx context.Context, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	return b.metainfo.DeleteObject(ctx, b.bucket.Name, path)
 }
 
+// DeleteObjectVersion permanently removes a specific version of an object,
+// if authorized. An empty versionID behaves like DeleteObject.
+func (b *Bucket) DeleteObjectVersion(ctx context.Context, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.DeleteObjectVersion(ctx, b.bucket.Name, path, versionID)
+}
+
+// MoveObject moves an object to newPath in the bucket newBucket, if
+// authorized. Only the metadata of the object is changed, its data isn't
+// transferred again. An existing object at newPath is replaced.
+func (b *Bucket) MoveObject(ctx context.Context, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.MoveObject(ctx, b.bucket.Name, path, newBucket, newPath)
+}
+
+// ConcatObjects concatenates the objects at paths into a new object at
+// newPath, if authorized. The data of the objects isn't transferred again and
+// the objects are removed. An existing object at newPath is replaced. The
+// objects must be stored with the same encryption parameters.
+func (b *Bucket) ConcatObjects(ctx context.Context, paths []storj.Path, newPath storj.Path, opts *UploadOptions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+	createInfo := storj.CreateObject{
+		ContentType: opts.ContentType,
+		Metadata:    opts.Metadata,
+		Expires:     opts.Expires,
+	}
+	return b.metainfo.ConcatObjects(ctx, b.bucket.Name, paths, newPath, &createInfo)
+}
+
+// CopyObject copies an object to newPath in the bucket newBucket, if
+// authorized. The copy keeps the metadata, expiration and encoding
+// parameters of the object.
+//
+// The data of the object is downloaded and uploaded again, because pieces
+// can't be shared between objects: deleting either object deletes its pieces
+// from the storage nodes. Use MoveObject to avoid the transfer.
+func (b *Bucket) CopyObject(ctx context.Context, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segmentStream, err := b.metainfo.GetObjectStream(ctx, b.bucket.Name, path)
+	if err != nil {
+		return err
+	}
+
+	info := segmentStream.Info()
+	createInfo := storj.CreateObject{
+		ContentType:          info.ContentType,
+		Metadata:             info.Metadata,
+		Expires:              info.Expires,
+		RedundancyScheme:     info.RedundancyScheme,
+		EncryptionParameters: info.EncryptionParameters,
+	}
+
+	obj, err := b.metainfo.CreateObject(ctx, newBucket, newPath, &createInfo)
+	if err != nil {
+		return err
+	}
+
+	mutableStream, err := obj.CreateStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	download := stream.NewDownload(ctx, segmentStream, b.streams)
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	upload := stream.NewUpload(ctx, mutableStream, b.streams)
+	_, err = io.Copy(upload, download)
+
+	err = errs.Combine(err, upload.Close())
+	if err != nil || obj.Info().VersionID == "" {
+		return err
+	}
+	return obj.Commit(ctx)
+}
+
+// LifecycleRule expires the objects of a bucket under a prefix after a
+// number of days since they were uploaded.
+type LifecycleRule = storj.LifecycleRule
+
+// SetLifecycle replaces the lifecycle rules of the bucket, if authorized.
+// The satellite deletes the objects, which expire according to the rules.
+// Setting no rules removes the lifecycle configuration.
+func (b *Bucket) SetLifecycle(ctx context.Context, rules []LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.SetBucketLifecycle(ctx, b.bucket.Name, rules)
+}
+
+// GetLifecycle returns the lifecycle rules of the bucket, if authorized.
+func (b *Bucket) GetLifecycle(ctx context.Context) (_ []LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.GetBucketLifecycle(ctx, b.bucket.Name)
+}
+
+// Placement restricts the storage nodes, which store the data of a bucket.
+type Placement = storj.Placement
+
+// SetPlacement replaces the placement policy of the bucket, if authorized.
+// The policy applies to segments uploaded afterwards and to repaired
+// segments. Setting an empty policy removes it.
+func (b *Bucket) SetPlacement(ctx context.Context, placement Placement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.SetBucketPlacement(ctx, b.bucket.Name, placement)
+}
+
+// GetPlacement returns the placement policy of the bucket, if authorized.
+func (b *Bucket) GetPlacement(ctx context.Context) (_ Placement, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.GetBucketPlacement(ctx, b.bucket.Name)
+}
+
 // ListOptions controls options for the ListObjects() call.
 type ListOptions = storj.ListOptions
 
@@ -130,6 +264,56 @@ func (b *Bucket) ListObjects(ctx context.Context, cfg *ListOptions) (list storj.
 func (b *Bucket) NewWriter(ctx context.Context, path storj.Path, opts *UploadOptions) (_ io.WriteCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	streams := b.streams
+	if opts != nil && opts.Volatile.UploadParallelism > 0 {
+		parallelism := b.parallelism
+		parallelism.Upload = opts.Volatile.UploadParallelism
+		streams = streams.WithParallelism(parallelism)
+	}
+
+	obj, err := b.createObject(ctx, path, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	mutableStream, err := obj.CreateStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := stream.NewUpload(ctx, mutableStream, streams)
+	if obj.Info().VersionID == "" {
+		return upload, nil
+	}
+	return &versionWriter{ctx: ctx, upload: upload, object: obj}, nil
+}
+
+// versionWriter uploads a new version of an object in a versioned bucket
+// and makes it the latest version, once the upload is closed.
+type versionWriter struct {
+	ctx    context.Context
+	upload *stream.Upload
+	object storj.MutableObject
+}
+
+// Write writes len(data) bytes to the uploaded version.
+func (writer *versionWriter) Write(data []byte) (n int, err error) {
+	return writer.upload.Write(data)
+}
+
+// Close finishes the upload and commits the version.
+func (writer *versionWriter) Close() error {
+	if err := writer.upload.Close(); err != nil {
+		return err
+	}
+	return writer.object.Commit(writer.ctx)
+}
+
+// createObject creates the object at path, using the defaults of the
+// bucket for the options, which aren't set.
+func (b *Bucket) createObject(ctx context.Context, path storj.Path, opts *UploadOptions) (_ storj.MutableObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if opts == nil {
 		opts = &UploadOptions{}
 	}
@@ -166,18 +350,7 @@ func (b *Bucket) NewWriter(ctx context.Context, path storj.Path, opts *UploadOpt
 		EncryptionParameters: opts.Volatile.EncryptionParameters,
 	}
 
-	obj, err := b.metainfo.CreateObject(ctx, b.Name, path, &createInfo)
-	if err != nil {
-		return nil, err
-	}
-
-	mutableStream, err := obj.CreateStream(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	upload := stream.NewUpload(ctx, mutableStream, b.streams)
-	return upload, nil
+	return b.metainfo.CreateObject(ctx, b.Name, path, &createInfo)
 }
 
 // ReadSeekCloser combines interfaces io.Reader, io.Seeker, io.Closer
@@ -190,8 +363,15 @@ type ReadSeekCloser interface {
 // NewReader creates a new reader that downloads the object data.
 func (b *Bucket) NewReader(ctx context.Context, path storj.Path) (_ ReadSeekCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return b.NewVersionReader(ctx, path, "")
+}
+
+// NewVersionReader creates a new reader that downloads the data of a
+// specific version of the object. An empty versionID reads the latest version.
+func (b *Bucket) NewVersionReader(ctx context.Context, path storj.Path, versionID string) (_ ReadSeekCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	segmentStream, err := b.metainfo.GetObjectStream(ctx, b.Name, path)
+	segmentStream, err := b.metainfo.GetObjectVersionStream(ctx, b.Name, path, versionID)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,9 @@ type ObjectMeta struct {
 	// Object, but to some arbitrary point in the path hierarchy. This would
 	// be called a "folder" or "directory" in a typical filesystem.
 	IsPrefix bool
+	// VersionID identifies the version of the Object, if it is stored in a
+	// Bucket with versioning enabled.
+	VersionID string
 
 	// ContentType, if set, gives a MIME content-type for the Object, as
 	// set when the object was created.
@@ -83,7 +86,7 @@ type Object struct {
 func (o *Object) DownloadRange(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	readOnlyStream, err := o.metainfoDB.GetObjectStream(ctx, o.Meta.Bucket, o.Meta.Path)
+	readOnlyStream, err := o.metainfoDB.GetObjectVersionStream(ctx, o.Meta.Bucket, o.Meta.Path, o.Meta.VersionID)
 	if err != nil {
 		return nil, err
 	}
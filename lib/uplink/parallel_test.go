@@ -0,0 +1,130 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestParallelTransfers(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "parallel"
+		shareSize      = memory.KiB.Int32()
+		requiredShares = 2
+		bucketConfig   = uplink.BucketConfig{
+			PathCipher: storj.EncAESGCM,
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   2 * shareSize * int32(requiredShares),
+			},
+			Volatile: struct {
+				RedundancyScheme storj.RedundancyScheme
+				SegmentsSize     memory.Size
+			}{
+				RedundancyScheme: storj.RedundancyScheme{
+					Algorithm:      storj.ReedSolomon,
+					ShareSize:      shareSize,
+					RequiredShares: int16(requiredShares),
+					RepairShares:   3,
+					OptimalShares:  4,
+					TotalShares:    5,
+				},
+				SegmentsSize: 8 * memory.KiB,
+			},
+		}
+	)
+
+	var cfg testConfig
+	cfg.uplinkCfg.Volatile.UploadParallelism = 3
+	cfg.uplinkCfg.Volatile.DownloadParallelism = 2
+
+	testPlanetWithLibUplink(t, cfg,
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &bucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			for _, tt := range []struct {
+				path string
+				size memory.Size
+				opts *uplink.UploadOptions
+			}{
+				{path: "empty", size: 0},
+				{path: "inline", size: memory.KiB},
+				{path: "partial-last-segment", size: 30 * memory.KiB},
+				{path: "full-last-segment", size: 32 * memory.KiB},
+				{path: "sequential", size: 30 * memory.KiB, opts: &uplink.UploadOptions{}},
+			} {
+				if tt.opts != nil {
+					tt.opts.Volatile.UploadParallelism = 1
+				}
+
+				data := testrand.Bytes(tt.size)
+				err := bucket.UploadObject(ctx, tt.path, bytes.NewReader(data), tt.opts)
+				require.NoError(t, err, tt.path)
+
+				object, err := bucket.OpenObject(ctx, tt.path)
+				require.NoError(t, err, tt.path)
+				assert.EqualValues(t, len(data), object.Meta.Size, tt.path)
+
+				reader, err := object.DownloadRange(ctx, 0, -1)
+				require.NoError(t, err, tt.path)
+				downloaded, err := ioutil.ReadAll(reader)
+				require.NoError(t, err, tt.path)
+				assert.Equal(t, data, downloaded, tt.path)
+				require.NoError(t, reader.Close())
+
+				if len(data) > 10*memory.KiB.Int() {
+					// a range across the segment boundaries
+					reader, err := object.DownloadRange(ctx, 5*memory.KiB.Int64(), 20*memory.KiB.Int64())
+					require.NoError(t, err, tt.path)
+					downloaded, err := ioutil.ReadAll(reader)
+					require.NoError(t, err, tt.path)
+					assert.Equal(t, data[5*memory.KiB:25*memory.KiB], downloaded, tt.path)
+					require.NoError(t, reader.Close())
+				}
+
+				require.NoError(t, object.Close())
+			}
+
+			// the segments committed by a failed parallel upload are resumable
+			data := testrand.Bytes(30 * memory.KiB)
+
+			upload, err := bucket.BeginResumableUpload(ctx, "resumable", nil)
+			require.NoError(t, err)
+
+			failing := io.MultiReader(bytes.NewReader(data[:20*memory.KiB]), failingReader{})
+			err = upload.Upload(ctx, failing, nil)
+			require.Error(t, err)
+			assert.EqualValues(t, 16*memory.KiB, upload.Offset())
+
+			err = upload.Upload(ctx, bytes.NewReader(data[upload.Offset():]), nil)
+			require.NoError(t, err)
+
+			reader, err := bucket.NewReader(ctx, "resumable")
+			require.NoError(t, err)
+			defer ctx.Check(reader.Close)
+
+			downloaded, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, data, downloaded)
+		})
+}
@@ -44,6 +44,11 @@ type BucketConfig struct {
 	// be used for data encryption of new Objects in this bucket.
 	EncryptionParameters storj.EncryptionParameters
 
+	// Versioning, if set, keeps every uploaded version of the Objects in
+	// the Bucket. Deleting an Object creates a delete marker instead of
+	// removing its previous versions.
+	Versioning bool
+
 	// Volatile groups config values that are likely to change semantics
 	// or go away entirely between releases. Be careful when using them!
 	Volatile struct {
@@ -107,6 +112,7 @@ func (p *Project) CreateBucket(ctx context.Context, name string, cfg *BucketConf
 		EncryptionParameters: cfg.EncryptionParameters,
 		RedundancyScheme:     cfg.Volatile.RedundancyScheme,
 		SegmentsSize:         cfg.Volatile.SegmentsSize.Int64(),
+		Versioning:           cfg.Versioning,
 	}
 	return p.project.CreateBucket(ctx, name, &bucket)
 }
@@ -140,12 +146,32 @@ func (p *Project) GetBucketInfo(ctx context.Context, bucket string) (b storj.Buc
 	cfg := &BucketConfig{
 		PathCipher:           b.PathCipher,
 		EncryptionParameters: b.EncryptionParameters,
+		Versioning:           b.Versioning,
 	}
 	cfg.Volatile.RedundancyScheme = b.RedundancyScheme
 	cfg.Volatile.SegmentsSize = memory.Size(b.SegmentsSize)
 	return b, cfg, nil
 }
 
+// EnableBucketVersioning enables versioning for an existing bucket, if
+// authorized. The Objects stored in the bucket before become versions with
+// the version ID storj.NullVersionID. Versioning can't be disabled again.
+func (p *Project) EnableBucketVersioning(ctx context.Context, bucket string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	b, err := p.project.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if b.Versioning {
+		return nil
+	}
+
+	b.Versioning = true
+	_, err = p.project.CreateBucket(ctx, bucket, &b)
+	return err
+}
+
 // TODO: move the bucket related OpenBucket to bucket.go
 
 // OpenBucket returns a Bucket handle with the given EncryptionAccess
@@ -197,6 +223,11 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 	if err != nil {
 		return nil, err
 	}
+	parallelism := streams.Parallelism{
+		Upload:   p.uplinkCfg.Volatile.UploadParallelism,
+		Download: p.uplinkCfg.Volatile.DownloadParallelism,
+	}
+	streamStore = streamStore.WithParallelism(parallelism)
 
 	return &Bucket{
 		BucketConfig: *cfg,
@@ -205,6 +236,7 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 		bucket:       bucketInfo,
 		metainfo:     kvmetainfo.New(p.project, p.metainfo, streamStore, segmentStore, access.store),
 		streams:      streamStore,
+		parallelism:  parallelism,
 	}, nil
 }
 
@@ -263,6 +295,7 @@ func (p *Project) updateBucket(ctx context.Context, bucketInfo storj.Bucket) (bu
 		EncryptionParameters: bucketInfo.EncryptionParameters,
 		RedundancyScheme:     bucketInfo.RedundancyScheme,
 		SegmentsSize:         bucketInfo.SegmentsSize,
+		Versioning:           bucketInfo.Versioning,
 	}
 	return p.project.CreateBucket(ctx, bucketInfo.Name, &bucket)
 }
@@ -0,0 +1,211 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// ResumableUpload is an upload of an object, which keeps the segments
+// committed so far when it fails. It can be continued from the last committed
+// segment, even from another process, with the token of the upload.
+//
+// The object becomes visible only when the upload finishes.
+type ResumableUpload struct {
+	bucket *Bucket
+
+	mu       sync.Mutex
+	state    pb.ResumableUpload
+	finished bool
+}
+
+// BeginResumableUpload starts a resumable upload of an object to path.
+// An existing object at path is deleted, unless the bucket has versioning
+// enabled.
+func (b *Bucket) BeginResumableUpload(ctx context.Context, path storj.Path, opts *UploadOptions) (_ *ResumableUpload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obj, err := b.createObject(ctx, path, opts)
+	if err != nil {
+		return nil, err
+	}
+	info := obj.Info()
+
+	metadata, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: info.ContentType,
+		UserDefined: info.Metadata,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	upload := &ResumableUpload{
+		bucket: b,
+		state: pb.ResumableUpload{
+			Bucket:              b.Name,
+			Path:                path,
+			VersionId:           info.VersionID,
+			Metadata:            metadata,
+			SegmentsSize:        b.Volatile.SegmentsSize.Int64(),
+			EncryptionType:      int32(b.EncryptionParameters.CipherSuite),
+			EncryptionBlockSize: b.EncryptionParameters.BlockSize,
+		},
+	}
+	if !info.Expires.IsZero() {
+		upload.state.ExpirationUnixNano = info.Expires.UnixNano()
+	}
+
+	if info.VersionID == "" {
+		// the segments of the new object would overwrite the segments of
+		// the existing one
+		err = b.streams.Delete(ctx, upload.streamPath(), b.bucket.PathCipher)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return nil, err
+		}
+	}
+
+	return upload, nil
+}
+
+// ResumeUpload continues the resumable upload with the token returned by
+// ResumableUpload.Token.
+func (b *Bucket) ResumeUpload(ctx context.Context, token string) (_ *ResumableUpload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, version, err := base58.CheckDecode(token)
+	if err != nil || version != 0 {
+		return nil, Error.New("invalid upload token format")
+	}
+
+	upload := &ResumableUpload{bucket: b}
+	if err := proto.Unmarshal(data, &upload.state); err != nil {
+		return nil, Error.New("unable to unmarshal upload token: %v", err)
+	}
+
+	switch {
+	case upload.state.Bucket != b.Name:
+		return nil, Error.New("upload token is for bucket %q", upload.state.Bucket)
+	case upload.state.SegmentsSize != b.Volatile.SegmentsSize.Int64():
+		return nil, Error.New("segment size %d of the upload doesn't match the bucket", upload.state.SegmentsSize)
+	case storj.CipherSuite(upload.state.EncryptionType) != b.EncryptionParameters.CipherSuite,
+		upload.state.EncryptionBlockSize != b.EncryptionParameters.BlockSize:
+		return nil, Error.New("encryption parameters of the upload don't match the bucket")
+	}
+
+	return upload, nil
+}
+
+// Token returns the serialized state of the upload, which can be passed to
+// Bucket.ResumeUpload to continue the upload.
+func (upload *ResumableUpload) Token() (string, error) {
+	upload.mu.Lock()
+	defer upload.mu.Unlock()
+
+	return upload.token()
+}
+
+func (upload *ResumableUpload) token() (string, error) {
+	data, err := proto.Marshal(&upload.state)
+	if err != nil {
+		return "", Error.New("unable to marshal upload token: %v", err)
+	}
+	return base58.CheckEncode(data, 0), nil
+}
+
+// Path returns the path of the object being uploaded.
+func (upload *ResumableUpload) Path() storj.Path {
+	return upload.state.Path
+}
+
+// Offset returns the number of bytes of the object, which are already
+// committed. The data passed to Upload has to start at this offset.
+func (upload *ResumableUpload) Offset() int64 {
+	upload.mu.Lock()
+	defer upload.mu.Unlock()
+
+	return upload.state.CommittedSize
+}
+
+// Upload uploads data starting at Offset and finishes the upload, when data
+// ends. committed is called with a new token after every committed segment.
+// If Upload fails, the upload can be continued from the last committed segment.
+func (upload *ResumableUpload) Upload(ctx context.Context, data io.Reader, committed func(token string)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	upload.mu.Lock()
+	if upload.finished {
+		upload.mu.Unlock()
+		return Error.New("upload already finished")
+	}
+	state := streams.UploadState{
+		Segments: upload.state.CommittedSegments,
+		Size:     upload.state.CommittedSize,
+	}
+	metadata := upload.state.Metadata
+	var expiration time.Time
+	if upload.state.ExpirationUnixNano != 0 {
+		expiration = time.Unix(0, upload.state.ExpirationUnixNano)
+	}
+	upload.mu.Unlock()
+
+	_, err = upload.bucket.streams.PutResumable(ctx, upload.streamPath(), upload.bucket.bucket.PathCipher, data, metadata, expiration, state,
+		func(state streams.UploadState) {
+			upload.mu.Lock()
+			upload.state.CommittedSegments = state.Segments
+			upload.state.CommittedSize = state.Size
+			token, err := upload.token()
+			upload.mu.Unlock()
+
+			if err == nil && committed != nil {
+				committed(token)
+			}
+		})
+	if err != nil {
+		return err
+	}
+
+	if upload.state.VersionId != "" {
+		err = upload.bucket.metainfo.CommitObjectVersion(ctx, upload.state.Bucket, upload.state.Path, upload.state.VersionId)
+		if err != nil {
+			return err
+		}
+	}
+
+	upload.mu.Lock()
+	upload.finished = true
+	upload.mu.Unlock()
+
+	return nil
+}
+
+// Abort deletes the segments committed by the upload.
+func (upload *ResumableUpload) Abort(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	upload.mu.Lock()
+	state := streams.UploadState{
+		Segments: upload.state.CommittedSegments,
+		Size:     upload.state.CommittedSize,
+	}
+	upload.mu.Unlock()
+
+	return upload.bucket.streams.DeleteResumable(ctx, upload.streamPath(), upload.bucket.bucket.PathCipher, state)
+}
+
+// streamPath returns the path of the stream of the object.
+func (upload *ResumableUpload) streamPath() storj.Path {
+	object := storj.Object{Path: upload.state.Path, VersionID: upload.state.VersionId}
+	return storj.JoinPaths(upload.state.Bucket, object.StreamPath())
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("network failure") }
+
+func TestResumableUpload(t *testing.T) {
+	var (
+		access         = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName     = "resumable"
+		shareSize      = memory.KiB.Int32()
+		requiredShares = 2
+		bucketConfig   = uplink.BucketConfig{
+			PathCipher: storj.EncAESGCM,
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   2 * shareSize * int32(requiredShares),
+			},
+			Volatile: struct {
+				RedundancyScheme storj.RedundancyScheme
+				SegmentsSize     memory.Size
+			}{
+				RedundancyScheme: storj.RedundancyScheme{
+					Algorithm:      storj.ReedSolomon,
+					ShareSize:      shareSize,
+					RequiredShares: int16(requiredShares),
+					RepairShares:   3,
+					OptimalShares:  4,
+					TotalShares:    5,
+				},
+				SegmentsSize: 8 * memory.KiB,
+			},
+		}
+	)
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &bucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			data := testrand.Bytes(30 * memory.KiB)
+
+			upload, err := bucket.BeginResumableUpload(ctx, "object", &uplink.UploadOptions{
+				ContentType: "text/plain",
+			})
+			require.NoError(t, err)
+			assert.EqualValues(t, 0, upload.Offset())
+
+			var token string
+			failing := io.MultiReader(bytes.NewReader(data[:20*memory.KiB]), failingReader{})
+			err = upload.Upload(ctx, failing, func(committed string) { token = committed })
+			require.Error(t, err)
+			require.NotEmpty(t, token)
+
+			// the object isn't visible until the upload finishes
+			_, err = bucket.OpenObject(ctx, "object")
+			assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+			// continue with a new bucket handle, like another process would
+			other, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(other.Close)
+
+			resumed, err := other.ResumeUpload(ctx, token)
+			require.NoError(t, err)
+			assert.Equal(t, "object", resumed.Path())
+			assert.EqualValues(t, 16*memory.KiB, resumed.Offset(), "two segments are committed")
+
+			err = resumed.Upload(ctx, bytes.NewReader(data[resumed.Offset():]), nil)
+			require.NoError(t, err)
+
+			err = resumed.Upload(ctx, bytes.NewReader(nil), nil)
+			assert.Error(t, err, "upload is already finished")
+
+			object, err := bucket.OpenObject(ctx, "object")
+			require.NoError(t, err)
+			defer ctx.Check(object.Close)
+			assert.Equal(t, "text/plain", object.Meta.ContentType)
+			assert.EqualValues(t, len(data), object.Meta.Size)
+
+			reader, err := bucket.NewReader(ctx, "object")
+			require.NoError(t, err)
+			defer ctx.Check(reader.Close)
+
+			downloaded, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, data, downloaded)
+
+			// aborting deletes the committed segments
+			upload, err = bucket.BeginResumableUpload(ctx, "aborted", nil)
+			require.NoError(t, err)
+
+			failing = io.MultiReader(bytes.NewReader(data[:10*memory.KiB]), failingReader{})
+			err = upload.Upload(ctx, failing, nil)
+			require.Error(t, err)
+			assert.EqualValues(t, 8*memory.KiB, upload.Offset())
+
+			require.NoError(t, upload.Abort(ctx))
+
+			_, err = bucket.ResumeUpload(ctx, "invalid")
+			assert.Error(t, err)
+		})
+}
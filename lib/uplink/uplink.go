@@ -59,6 +59,19 @@ type Config struct {
 		// smallest amount of memory it can.
 		MaxMemory memory.Size
 
+		// UploadParallelism is the number of segments of an object,
+		// which are encrypted and uploaded concurrently. Every segment
+		// in flight is buffered in memory. (This option is overrideable
+		// per upload with UploadOptions.) If set to zero or one,
+		// segments are uploaded one at a time.
+		UploadParallelism int
+
+		// DownloadParallelism is the number of segments of an object,
+		// which are downloaded ahead of the segment being read. Every
+		// prefetched segment is buffered in memory. If set to zero or
+		// one, segments are downloaded one at a time.
+		DownloadParallelism int
+
 		// PartnerID is the identity given to the partner for value
 		// attribution
 		PartnerID string
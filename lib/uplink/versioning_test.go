@@ -0,0 +1,69 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestEnableBucketVersioning(t *testing.T) {
+	access := uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, "versioned", nil)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, "versioned", access)
+			require.NoError(t, err)
+			require.NoError(t, bucket.UploadObject(ctx, "object", bytes.NewReader([]byte("first")), nil))
+			require.NoError(t, bucket.Close())
+
+			require.NoError(t, proj.EnableBucketVersioning(ctx, "versioned"))
+
+			_, cfg, err := proj.GetBucketInfo(ctx, "versioned")
+			require.NoError(t, err)
+			assert.True(t, cfg.Versioning)
+
+			bucket, err = proj.OpenBucket(ctx, "versioned", access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			require.NoError(t, bucket.UploadObject(ctx, "object", bytes.NewReader([]byte("second")), nil))
+
+			upload, err := bucket.BeginResumableUpload(ctx, "object", nil)
+			require.NoError(t, err)
+			require.NoError(t, upload.Upload(ctx, bytes.NewReader([]byte("third")), nil))
+
+			list, err := bucket.ListObjects(ctx, &uplink.ListOptions{Direction: storj.After, Recursive: true})
+			require.NoError(t, err)
+			require.Len(t, list.Items, 1)
+
+			versions, err := bucket.ListObjects(ctx, &uplink.ListOptions{Direction: storj.After, Recursive: true, Versions: true})
+			require.NoError(t, err)
+			require.Len(t, versions.Items, 3)
+			assert.Equal(t, list.Items[0].VersionID, versions.Items[0].VersionID)
+			assert.True(t, versions.Items[0].IsLatest)
+			assert.Equal(t, storj.NullVersionID, versions.Items[2].VersionID)
+
+			for i, expected := range []string{"third", "second", "first"} {
+				reader, err := bucket.NewVersionReader(ctx, "object", versions.Items[i].VersionID)
+				require.NoError(t, err)
+				data, err := ioutil.ReadAll(reader)
+				require.NoError(t, err)
+				require.NoError(t, reader.Close())
+				assert.Equal(t, expected, string(data))
+			}
+		})
+}
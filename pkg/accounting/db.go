@@ -9,7 +9,6 @@ import (
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 
-	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -85,6 +84,10 @@ type ProjectAccounting interface {
 	GetAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error)
 	// GetStorageTotals returns the current inline and remote storage usage for a projectID
 	GetStorageTotals(ctx context.Context, projectID uuid.UUID) (int64, int64, error)
-	// GetProjectUsageLimits returns project usage limit
-	GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (memory.Size, error)
+	// GetSegmentCount returns the number of segments of a projectID in the latest tally
+	GetSegmentCount(ctx context.Context, projectID uuid.UUID) (int64, error)
+	// GetProjectLimits returns the usage limits set for a projectID, zero values mean the limit is not set
+	GetProjectLimits(ctx context.Context, projectID uuid.UUID) (ProjectLimits, error)
+	// UpdateProjectLimits sets the usage limits of a projectID, zero values unset the limit
+	UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limits ProjectLimits) error
 }
@@ -28,20 +28,160 @@ var (
 	ErrProjectUsage = errs.Class("project usage error")
 )
 
+// ProjectLimitConfig contains the default usage limits of projects
+type ProjectLimitConfig struct {
+	DefaultMaxStorage   memory.Size `help:"the default storage usage limit of a project" default:"25GB"`
+	DefaultMaxBandwidth memory.Size `help:"the default egress bandwidth usage limit of a project in the past 30 days" default:"25GB"`
+	DefaultMaxSegments  int64       `help:"the default maximum number of segments stored by a project" default:"1000000"`
+	DefaultMaxBuckets   int64       `help:"the default maximum number of buckets of a project" default:"100"`
+}
+
+// WithMaxAlphaUsage returns the config with the deprecated single limit of storage and bandwidth
+// usage applied to the default storage and bandwidth limits. A zero maxAlphaUsage changes nothing.
+func (config ProjectLimitConfig) WithMaxAlphaUsage(maxAlphaUsage memory.Size) ProjectLimitConfig {
+	if maxAlphaUsage > 0 {
+		config.DefaultMaxStorage = maxAlphaUsage
+		config.DefaultMaxBandwidth = maxAlphaUsage
+	}
+	return config
+}
+
+// ProjectLimits contains the usage limits of a project.
+// Zero values of the limits stored for a project mean the satellite defaults apply.
+type ProjectLimits struct {
+	Storage   memory.Size
+	Bandwidth memory.Size
+	Segments  int64
+	Buckets   int64
+}
+
+// ProjectLimitUsage contains the usage limits of a project and how much of them is used.
+// Storage and bandwidth usage are divided by the expansion factor to be comparable with the limits.
+type ProjectLimitUsage struct {
+	Limits ProjectLimits
+
+	Storage   memory.Size
+	Bandwidth memory.Size
+	Segments  int64
+	Buckets   int64
+}
+
+// BucketCounter counts the buckets of a project
+type BucketCounter interface {
+	// CountBuckets returns the number of buckets of a project, counting at most max buckets
+	CountBuckets(ctx context.Context, projectID uuid.UUID, max int64) (int64, error)
+}
+
 // ProjectUsage defines project usage
 type ProjectUsage struct {
 	projectAccountingDB ProjectAccounting
 	liveAccounting      live.Service
-	maxAlphaUsage       memory.Size
+	buckets             BucketCounter
+	defaults            ProjectLimitConfig
 }
 
 // NewProjectUsage created new instance of project usage service
-func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.Service, maxAlphaUsage memory.Size) *ProjectUsage {
+func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.Service, buckets BucketCounter, defaults ProjectLimitConfig) *ProjectUsage {
 	return &ProjectUsage{
 		projectAccountingDB: projectAccountingDB,
 		liveAccounting:      liveAccounting,
-		maxAlphaUsage:       maxAlphaUsage,
+		buckets:             buckets,
+		defaults:            defaults,
+	}
+}
+
+// GetProjectLimits returns the usage limits of a project with the satellite defaults
+// applied to the limits that aren't set for the project.
+func (usage *ProjectUsage) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ ProjectLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// TODO(michal): to reduce db load, consider using a cache to retrieve the project limits if needed
+	limits, err := usage.projectAccountingDB.GetProjectLimits(ctx, projectID)
+	if err != nil {
+		return ProjectLimits{}, ErrProjectUsage.Wrap(err)
+	}
+
+	if limits.Storage <= 0 {
+		limits.Storage = usage.defaults.DefaultMaxStorage
+	}
+	if limits.Bandwidth <= 0 {
+		limits.Bandwidth = usage.defaults.DefaultMaxBandwidth
+	}
+	if limits.Segments <= 0 {
+		limits.Segments = usage.defaults.DefaultMaxSegments
+	}
+	if limits.Buckets <= 0 {
+		limits.Buckets = usage.defaults.DefaultMaxBuckets
+	}
+
+	return limits, nil
+}
+
+// GetCustomProjectLimits returns the usage limits set for a project,
+// zero values mean the project uses the satellite defaults.
+func (usage *ProjectUsage) GetCustomProjectLimits(ctx context.Context, projectID uuid.UUID) (_ ProjectLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.projectAccountingDB.GetProjectLimits(ctx, projectID)
+	return limits, ErrProjectUsage.Wrap(err)
+}
+
+// SetProjectLimits changes the usage limits of a project, zero values reset the limits to the satellite defaults.
+func (usage *ProjectUsage) SetProjectLimits(ctx context.Context, projectID uuid.UUID, limits ProjectLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limits.Storage < 0 || limits.Bandwidth < 0 || limits.Segments < 0 || limits.Buckets < 0 {
+		return ErrProjectUsage.New("limits can not be negative")
 	}
+
+	return ErrProjectUsage.Wrap(usage.projectAccountingDB.UpdateProjectLimits(ctx, projectID, limits))
+}
+
+// GetProjectLimitUsage returns the usage limits of a project and how much of them is used.
+func (usage *ProjectUsage) GetProjectLimitUsage(ctx context.Context, projectID uuid.UUID) (_ *ProjectLimitUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errgroup.Group
+	var result ProjectLimitUsage
+	var inlineTotal, remoteTotal, bandwidthTotal int64
+
+	group.Go(func() error {
+		var err error
+		result.Limits, err = usage.GetProjectLimits(ctx, projectID)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		inlineTotal, remoteTotal, err = usage.getProjectStorageTotals(ctx, projectID)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		from := time.Now().AddDate(0, 0, -AverageDaysInMonth) // past 30 days
+		bandwidthTotal, err = usage.projectAccountingDB.GetAllocatedBandwidthTotal(ctx, projectID, from)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		result.Segments, err = usage.projectAccountingDB.GetSegmentCount(ctx, projectID)
+		return err
+	})
+	err = group.Wait()
+	if err != nil {
+		return nil, ErrProjectUsage.Wrap(err)
+	}
+
+	if usage.buckets != nil {
+		result.Buckets, err = usage.buckets.CountBuckets(ctx, projectID, result.Limits.Buckets)
+		if err != nil {
+			return nil, ErrProjectUsage.Wrap(err)
+		}
+	}
+
+	result.Storage = memory.Size((inlineTotal + remoteTotal) / ExpansionFactor)
+	result.Bandwidth = memory.Size(bandwidthTotal / ExpansionFactor)
+
+	return &result, nil
 }
 
 // ExceedsBandwidthUsage returns true if the bandwidth usage limits have been exceeded
@@ -53,14 +193,10 @@ func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID
 
 	var group errgroup.Group
 	var bandwidthGetTotal int64
-	limit = usage.maxAlphaUsage
 
-	// TODO(michal): to reduce db load, consider using a cache to retrieve the project.UsageLimit value if needed
 	group.Go(func() error {
-		projectLimit, err := usage.projectAccountingDB.GetProjectUsageLimits(ctx, projectID)
-		if projectLimit > 0 {
-			limit = projectLimit
-		}
+		limits, err := usage.GetProjectLimits(ctx, projectID)
+		limit = limits.Bandwidth
 		return err
 	})
 	group.Go(func() error {
@@ -92,14 +228,10 @@ func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uu
 
 	var group errgroup.Group
 	var inlineTotal, remoteTotal int64
-	limit = usage.maxAlphaUsage
 
-	// TODO(michal): to reduce db load, consider using a cache to retrieve the project.UsageLimit value if needed
 	group.Go(func() error {
-		projectLimit, err := usage.projectAccountingDB.GetProjectUsageLimits(ctx, projectID)
-		if projectLimit > 0 {
-			limit = projectLimit
-		}
+		limits, err := usage.GetProjectLimits(ctx, projectID)
+		limit = limits.Storage
 		return err
 	})
 	group.Go(func() error {
@@ -120,6 +252,54 @@ func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uu
 	return false, limit, nil
 }
 
+// ExceedsSegmentCount returns true if the project stores as many segments as its limit allows.
+// The segment count comes from the latest tally, so segments uploaded since then are not counted.
+func (usage *ProjectUsage) ExceedsSegmentCount(ctx context.Context, projectID uuid.UUID) (_ bool, limit int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errgroup.Group
+	var segmentCount int64
+
+	group.Go(func() error {
+		limits, err := usage.GetProjectLimits(ctx, projectID)
+		limit = limits.Segments
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		segmentCount, err = usage.projectAccountingDB.GetSegmentCount(ctx, projectID)
+		return err
+	})
+	err = group.Wait()
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	return segmentCount >= limit, limit, nil
+}
+
+// ExceedsBucketCount returns true if the project has as many buckets as its limit allows.
+func (usage *ProjectUsage) ExceedsBucketCount(ctx context.Context, projectID uuid.UUID) (_ bool, limit int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.GetProjectLimits(ctx, projectID)
+	if err != nil {
+		return false, 0, err
+	}
+	limit = limits.Buckets
+
+	if usage.buckets == nil {
+		return false, limit, nil
+	}
+
+	bucketCount, err := usage.buckets.CountBuckets(ctx, projectID, limit)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	return bucketCount >= limit, limit, nil
+}
+
 func (usage *ProjectUsage) getProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (inline int64, remote int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
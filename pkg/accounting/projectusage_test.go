@@ -20,6 +20,8 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -32,7 +34,7 @@ func TestProjectUsageStorage(t *testing.T) {
 		expectedErrMsg   string
 	}{
 		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false, expectedErrMsg: ""},
-		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit"},
+		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Storage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Storage Limit"},
 	}
 
 	testplanet.Run(t, testplanet.Config{
@@ -86,7 +88,7 @@ func TestProjectUsageBandwidth(t *testing.T) {
 		expectedErrMsg   string
 	}{
 		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false, expectedErrMsg: ""},
-		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit"},
+		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Bandwidth Limit"},
 	}
 
 	for _, tt := range cases {
@@ -129,6 +131,10 @@ func TestProjectUsageBandwidth(t *testing.T) {
 				_, actualErr := planet.Uplinks[0].Download(ctx, planet.Satellites[0], bucketName, filePath)
 				if testCase.expectedResource == "bandwidth" {
 					assert.EqualError(t, actualErr, testCase.expectedErrMsg)
+
+					limit, ok := metainfo.ExceededLimit(actualErr)
+					assert.True(t, ok)
+					assert.Equal(t, metainfo.BandwidthLimit, limit)
 				} else {
 					require.NoError(t, actualErr)
 				}
@@ -155,7 +161,7 @@ func setUpStorageTallies(ctx *testcontext.Context, projectID uuid.UUID, acctDB a
 			IntervalStart: time,
 
 			// In order to exceed the project limits, create storage tally records
-			// that sum greater than the maxAlphaUsage * expansionFactor
+			// that sum greater than the default project limit * expansionFactor
 			RemoteBytes: memory.GB.Int64() * accounting.ExpansionFactor,
 		}
 		err := acctDB.CreateStorageTally(ctx, tally)
@@ -236,7 +242,7 @@ func setUpBucketBandwidthAllocations(ctx *testcontext.Context, projectID uuid.UU
 		bucketName := fmt.Sprintf("%s%d", "testbucket", i)
 
 		// In order to exceed the project limits, create bandwidth allocation records
-		// that sum greater than the maxAlphaUsage * expansionFactor
+		// that sum greater than the default project limit * expansionFactor
 		amount := 10 * memory.GB.Int64() * accounting.ExpansionFactor
 		action := pb.PieceAction_GET
 		intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
@@ -255,18 +261,23 @@ func TestProjectUsageCustomLimit(t *testing.T) {
 		satDB := planet.Satellites[0].DB
 		acctDB := satDB.ProjectAccounting()
 
-		projectsDB := satDB.Console().Projects()
-		projects, err := projectsDB.GetAll(ctx)
+		projects, err := satDB.Console().Projects().GetAll(ctx)
 		require.NoError(t, err)
-
 		project := projects[0]
-		// set custom usage limit for project
-		project.UsageLimit = memory.GiB.Int64() * 10
-		err = projectsDB.Update(ctx, &project)
-		require.NoError(t, err)
 
 		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
 
+		// set custom storage limit for project
+		err = projectUsage.SetProjectLimits(ctx, project.ID, accounting.ProjectLimits{
+			Storage: 10 * memory.GiB,
+		})
+		require.NoError(t, err)
+
+		limits, err := projectUsage.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, 10*memory.GiB, limits.Storage)
+		require.Equal(t, 25*memory.GB, limits.Bandwidth)
+
 		// Setup: create BucketStorageTally records to test exceeding storage project limit
 		now := time.Now()
 		err = setUpStorageTallies(ctx, project.ID, acctDB, 11, now)
@@ -275,7 +286,7 @@ func TestProjectUsageCustomLimit(t *testing.T) {
 		actualExceeded, limit, err := projectUsage.ExceedsStorageUsage(ctx, project.ID)
 		require.NoError(t, err)
 		require.True(t, actualExceeded)
-		require.Equal(t, project.UsageLimit, limit.Int64())
+		require.Equal(t, 10*memory.GiB, limit)
 
 		// Setup: create some bytes for the uplink to upload
 		expectedData := testrand.Bytes(50 * memory.KiB)
@@ -285,3 +296,145 @@ func TestProjectUsageCustomLimit(t *testing.T) {
 		assert.Error(t, actualErr)
 	})
 }
+
+func TestProjectUsageSegmentLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satDB := planet.Satellites[0].DB
+
+		projects, err := satDB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
+
+		err = projectUsage.SetProjectLimits(ctx, projectID, accounting.ProjectLimits{Segments: 10})
+		require.NoError(t, err)
+
+		// Setup: create BucketStorageTally record with as many segments as the limit allows
+		err = satDB.ProjectAccounting().CreateStorageTally(ctx, accounting.BucketStorageTally{
+			BucketName:         "testbucket",
+			ProjectID:          projectID,
+			IntervalStart:      time.Now(),
+			InlineSegmentCount: 4,
+			RemoteSegmentCount: 6,
+		})
+		require.NoError(t, err)
+
+		exceeded, limit, err := projectUsage.ExceedsSegmentCount(ctx, projectID)
+		require.NoError(t, err)
+		require.True(t, exceeded)
+		require.EqualValues(t, 10, limit)
+
+		actualErr := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path", testrand.Bytes(50*memory.KiB))
+		require.Error(t, actualErr)
+		assert.Contains(t, actualErr.Error(), "Exceeded Segment Limit")
+
+		exceededLimit, ok := metainfo.ExceededLimit(actualErr)
+		assert.True(t, ok)
+		assert.Equal(t, metainfo.SegmentLimit, exceededLimit)
+	})
+}
+
+func TestProjectUsageBucketLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		projects, err := planet.Satellites[0].DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
+
+		err = projectUsage.SetProjectLimits(ctx, projectID, accounting.ProjectLimits{Buckets: 1})
+		require.NoError(t, err)
+
+		exceeded, _, err := projectUsage.ExceedsBucketCount(ctx, projectID)
+		require.NoError(t, err)
+		require.False(t, exceeded)
+
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket1", "test/path", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		exceeded, limit, err := projectUsage.ExceedsBucketCount(ctx, projectID)
+		require.NoError(t, err)
+		require.True(t, exceeded)
+		require.EqualValues(t, 1, limit)
+
+		// uploading to the existing bucket is still allowed
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket1", "test/path2", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket2", "test/path", testrand.Bytes(5*memory.KiB))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Exceeded Bucket Limit")
+
+		exceededLimit, ok := metainfo.ExceededLimit(err)
+		assert.True(t, ok)
+		assert.Equal(t, metainfo.BucketLimit, exceededLimit)
+	})
+}
+
+func TestProjectLimitConfigWithMaxAlphaUsage(t *testing.T) {
+	defaults := accounting.ProjectLimitConfig{
+		DefaultMaxStorage:   25 * memory.GB,
+		DefaultMaxBandwidth: 25 * memory.GB,
+		DefaultMaxSegments:  1000,
+		DefaultMaxBuckets:   10,
+	}
+
+	// the deprecated limit isn't set
+	assert.Equal(t, defaults, defaults.WithMaxAlphaUsage(0))
+
+	assert.Equal(t, accounting.ProjectLimitConfig{
+		DefaultMaxStorage:   50 * memory.GB,
+		DefaultMaxBandwidth: 50 * memory.GB,
+		DefaultMaxSegments:  1000,
+		DefaultMaxBuckets:   10,
+	}, defaults.WithMaxAlphaUsage(50*memory.GB))
+}
+
+func TestProjectLimits(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "limited"})
+		require.NoError(t, err)
+
+		pdb := db.ProjectAccounting()
+
+		limits, err := pdb.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, accounting.ProjectLimits{}, limits)
+
+		expected := accounting.ProjectLimits{
+			Storage:   50 * memory.GB,
+			Bandwidth: 100 * memory.GB,
+			Segments:  500,
+			Buckets:   5,
+		}
+		err = pdb.UpdateProjectLimits(ctx, project.ID, expected)
+		require.NoError(t, err)
+
+		limits, err = pdb.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, limits)
+
+		// zero values unset the limits
+		err = pdb.UpdateProjectLimits(ctx, project.ID, accounting.ProjectLimits{Buckets: 7})
+		require.NoError(t, err)
+
+		limits, err = pdb.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, accounting.ProjectLimits{Buckets: 7}, limits)
+
+		err = pdb.UpdateProjectLimits(ctx, testrand.UUID(), expected)
+		assert.Error(t, err)
+
+		count, err := pdb.GetSegmentCount(ctx, project.ID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
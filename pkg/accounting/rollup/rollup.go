@@ -18,7 +18,7 @@ import (
 // Config contains configurable values for rollup
 type Config struct {
 	Interval      time.Duration `help:"how frequently rollup should run" releaseDefault:"24h" devDefault:"120s"`
-	MaxAlphaUsage memory.Size   `help:"the bandwidth and storage usage limit for the alpha release" default:"25GB"`
+	MaxAlphaUsage memory.Size   `help:"deprecated: when set, it's used as project-limits.default-max-storage and project-limits.default-max-bandwidth" default:"0"`
 	DeleteTallies bool          `help:"option for deleting tallies after they are rolled up" default:"false"`
 }
 
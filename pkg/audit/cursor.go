@@ -28,7 +28,10 @@ type Stripe struct {
 	SegmentPath storj.Path
 }
 
-// Cursor keeps track of audit location in pointer db
+// Cursor keeps track of audit location in pointer db.
+//
+// The audit service selects stripes with the Scheduler, the Cursor is only
+// used as a fallback when the Scheduler fails to select a stripe.
 type Cursor struct {
 	metainfo *metainfo.Service
 	lastPath storj.Path
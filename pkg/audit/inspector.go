@@ -0,0 +1,44 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Inspector is a gRPC service for inspecting the audit scheduler
+type Inspector struct {
+	scheduler *Scheduler
+}
+
+// NewInspector creates an Inspector
+func NewInspector(scheduler *Scheduler) *Inspector {
+	return &Inspector{scheduler: scheduler}
+}
+
+// AuditQueues returns the state of the audit queues, riskiest nodes first
+func (srv *Inspector) AuditQueues(ctx context.Context, req *pb.AuditQueuesRequest) (_ *pb.AuditQueuesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp := &pb.AuditQueuesResponse{}
+	for _, queue := range srv.scheduler.Queues() {
+		queue := queue
+		status := &pb.AuditQueue{
+			NodeId:   queue.NodeID,
+			Priority: queue.Priority.String(),
+			New:      queue.New,
+			Queued:   int64(queue.Queued),
+		}
+		if !queue.LastAudited.IsZero() {
+			status.LastAudited = &queue.LastAudited
+		}
+		if !queue.LastFailure.IsZero() {
+			status.LastFailure = &queue.LastFailure
+		}
+		resp.Queues = append(resp.Queues, status)
+	}
+	return resp, nil
+}
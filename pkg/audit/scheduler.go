@@ -0,0 +1,366 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+// Priority is the audit priority of a node, higher priorities are audited first.
+type Priority int
+
+// Priorities of the audit scheduler.
+const (
+	// PriorityNormal is the priority of vetted nodes without recent failures.
+	PriorityNormal Priority = iota
+	// PriorityRecentFailure is the priority of nodes which recently failed an audit or were offline.
+	PriorityRecentFailure
+	// PriorityNew is the priority of nodes which haven't been audited enough to be vetted.
+	PriorityNew
+	// PriorityOverdue is the priority of nodes which haven't been audited within the minimum audit interval.
+	PriorityOverdue
+)
+
+// String returns the name of the priority.
+func (priority Priority) String() string {
+	switch priority {
+	case PriorityNormal:
+		return "normal"
+	case PriorityRecentFailure:
+		return "recent-failure"
+	case PriorityNew:
+		return "new"
+	case PriorityOverdue:
+		return "overdue"
+	default:
+		return fmt.Sprintf("priority(%d)", int(priority))
+	}
+}
+
+// QueueStatus describes the audit queue of a single node.
+type QueueStatus struct {
+	NodeID      storj.NodeID
+	Priority    Priority
+	New         bool
+	Queued      int
+	LastAudited time.Time
+	LastFailure time.Time
+}
+
+// nodeQueue contains the segments queued for auditing a node.
+type nodeQueue struct {
+	id    storj.NodeID
+	paths []storj.Path
+
+	new         bool
+	lastAudited time.Time
+	lastFailure time.Time
+	pass        int
+}
+
+// Scheduler selects stripes to audit by maintaining a queue of segments for
+// every node and auditing the nodes with the highest risk first.
+//
+// Nodes which haven't been audited within the minimum audit interval come
+// first, followed by new nodes and nodes with recent audit failures.
+type Scheduler struct {
+	log      *zap.Logger
+	metainfo *metainfo.Service
+	overlay  *overlay.Cache
+
+	queueSize        int
+	minAuditInterval time.Duration
+	failureWindow    time.Duration
+
+	mu       sync.Mutex
+	nextPath storj.Path
+	pass     int
+	nodes    map[storj.NodeID]*nodeQueue
+}
+
+// NewScheduler creates a Scheduler which queues segments from metainfo.
+func NewScheduler(log *zap.Logger, metainfo *metainfo.Service, overlay *overlay.Cache, config Config) *Scheduler {
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+
+	return &Scheduler{
+		log:      log,
+		metainfo: metainfo,
+		overlay:  overlay,
+
+		queueSize:        queueSize,
+		minAuditInterval: config.MinNodeAuditInterval,
+		failureWindow:    config.FailurePriorityWindow,
+
+		nodes: make(map[storj.NodeID]*nodeQueue),
+	}
+}
+
+// NextStripe returns the next stripe to audit. "more" is false when there is
+// nothing left to audit until metainfo is iterated again.
+func (scheduler *Scheduler) NextStripe(ctx context.Context) (stripe *Stripe, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	now := time.Now()
+
+	// refill the queues when the riskiest node has nothing queued
+	if best := scheduler.selectNode(now, false); best == nil || len(best.paths) == 0 {
+		if err := scheduler.fill(ctx, now); err != nil {
+			return nil, true, err
+		}
+	}
+
+	for {
+		node := scheduler.selectNode(now, true)
+		if node == nil {
+			return nil, false, nil
+		}
+
+		path := node.paths[0]
+		node.paths = node.paths[1:]
+
+		pointer, err := scheduler.metainfo.Get(ctx, path)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				continue
+			}
+			return nil, true, err
+		}
+		if !auditable(pointer, now) {
+			continue
+		}
+
+		index, err := getRandomStripe(ctx, pointer)
+		if err != nil {
+			return nil, true, err
+		}
+
+		// every node holding a piece of the segment is audited
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			if queue, ok := scheduler.nodes[piece.NodeId]; ok {
+				queue.lastAudited = now
+			}
+		}
+
+		return &Stripe{
+			Index:       index,
+			Segment:     pointer,
+			SegmentPath: path,
+		}, true, nil
+	}
+}
+
+// RecordReport raises the priority of nodes that failed the audit or were offline.
+func (scheduler *Scheduler) RecordReport(report *Report) {
+	if report == nil {
+		return
+	}
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	now := time.Now()
+	for _, list := range []storj.NodeIDList{report.Fails, report.Offlines} {
+		for _, id := range list {
+			if queue, ok := scheduler.nodes[id]; ok {
+				queue.lastFailure = now
+			}
+		}
+	}
+}
+
+// Queues returns the state of the audit queues, riskiest nodes first.
+func (scheduler *Scheduler) Queues() []QueueStatus {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	now := time.Now()
+	queues := scheduler.sortedNodes(now)
+
+	statuses := make([]QueueStatus, 0, len(queues))
+	for _, queue := range queues {
+		statuses = append(statuses, QueueStatus{
+			NodeID:      queue.id,
+			Priority:    scheduler.priority(queue, now),
+			New:         queue.new,
+			Queued:      len(queue.paths),
+			LastAudited: queue.lastAudited,
+			LastFailure: queue.lastFailure,
+		})
+	}
+	return statuses
+}
+
+// priority returns the audit priority of the node.
+func (scheduler *Scheduler) priority(queue *nodeQueue, now time.Time) Priority {
+	switch {
+	case scheduler.minAuditInterval > 0 && now.Sub(queue.lastAudited) >= scheduler.minAuditInterval:
+		return PriorityOverdue
+	case queue.new:
+		return PriorityNew
+	case !queue.lastFailure.IsZero() && now.Sub(queue.lastFailure) < scheduler.failureWindow:
+		return PriorityRecentFailure
+	default:
+		return PriorityNormal
+	}
+}
+
+// sortedNodes returns the nodes ordered by priority and then by the time they were last audited.
+func (scheduler *Scheduler) sortedNodes(now time.Time) []*nodeQueue {
+	queues := make([]*nodeQueue, 0, len(scheduler.nodes))
+	for _, queue := range scheduler.nodes {
+		queues = append(queues, queue)
+	}
+
+	sort.Slice(queues, func(i, k int) bool {
+		a, b := scheduler.priority(queues[i], now), scheduler.priority(queues[k], now)
+		if a != b {
+			return a > b
+		}
+		if !queues[i].lastAudited.Equal(queues[k].lastAudited) {
+			return queues[i].lastAudited.Before(queues[k].lastAudited)
+		}
+		return queues[i].id.Less(queues[k].id)
+	})
+	return queues
+}
+
+// selectNode returns the riskiest node, optionally considering only nodes with queued segments.
+func (scheduler *Scheduler) selectNode(now time.Time, nonEmpty bool) *nodeQueue {
+	for _, queue := range scheduler.sortedNodes(now) {
+		if !nonEmpty || len(queue.paths) > 0 {
+			return queue
+		}
+	}
+	return nil
+}
+
+// fillBatch is the number of segments read from metainfo when refilling the queues.
+const fillBatch = 1000
+
+// fill queues the segments of the next batch of metainfo for the nodes holding their pieces.
+func (scheduler *Scheduler) fill(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return scheduler.metainfo.Iterate(ctx, "", scheduler.nextPath, true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+
+			for count := 0; count < fillBatch; count++ {
+				if !it.Next(ctx, &item) {
+					scheduler.finishPass()
+					return nil
+				}
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("unable to unmarshal pointer %q: %v", item.Key.String(), err)
+				}
+				if !auditable(pointer, now) {
+					continue
+				}
+
+				scheduler.enqueue(ctx, item.Key.String(), pointer, now)
+			}
+
+			// continue at the next item in the next call
+			var next storage.ListItem
+			if !it.Next(ctx, &next) {
+				scheduler.finishPass()
+				return nil
+			}
+			scheduler.nextPath = next.Key.String()
+			return nil
+		})
+}
+
+// enqueue queues the segment for every node holding one of its pieces.
+func (scheduler *Scheduler) enqueue(ctx context.Context, path storj.Path, pointer *pb.Pointer, now time.Time) {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		queue, ok := scheduler.nodes[piece.NodeId]
+		if !ok {
+			// nodes become overdue only after the minimum audit interval since they were
+			// first queued, otherwise every node would be overdue after a restart
+			queue = &nodeQueue{id: piece.NodeId, lastAudited: now}
+			scheduler.nodes[piece.NodeId] = queue
+		}
+
+		if queue.pass != scheduler.pass+1 {
+			queue.pass = scheduler.pass + 1
+
+			disqualified, err := scheduler.refreshNode(ctx, queue)
+			if err != nil {
+				scheduler.log.Debug("unable to refresh node", zap.Stringer("Node ID", queue.id), zap.Error(err))
+			}
+			if disqualified {
+				delete(scheduler.nodes, queue.id)
+				continue
+			}
+		}
+
+		if len(queue.paths) < scheduler.queueSize {
+			queue.paths = append(queue.paths, path)
+		}
+	}
+}
+
+// finishPass starts iterating metainfo from the beginning and forgets nodes which no longer hold any pieces.
+func (scheduler *Scheduler) finishPass() {
+	scheduler.pass++
+	for id, queue := range scheduler.nodes {
+		if queue.pass != scheduler.pass && len(queue.paths) == 0 {
+			delete(scheduler.nodes, id)
+		}
+	}
+	scheduler.nextPath = ""
+}
+
+// refreshNode updates the vetting state of the node and returns whether it has been disqualified.
+func (scheduler *Scheduler) refreshNode(ctx context.Context, queue *nodeQueue) (disqualified bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := scheduler.overlay.Get(ctx, queue.id)
+	if err != nil {
+		return false, err
+	}
+	if node.Disqualified != nil {
+		return true, nil
+	}
+
+	queue.new = scheduler.overlay.IsNew(node)
+	return false, nil
+}
+
+// auditable returns whether the pointer is a remote segment that hasn't expired.
+func auditable(pointer *pb.Pointer, now time.Time) bool {
+	if pointer.GetType() != pb.Pointer_REMOTE || pointer.GetSegmentSize() == 0 {
+		return false
+	}
+	if expiration := pointer.GetExpirationDate(); expiration != nil {
+		t, err := ptypes.Timestamp(expiration)
+		if err != nil || !t.After(now) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+)
+
+func TestSchedulerPriorities(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		stripe, more, err := audits.Scheduler.NextStripe(ctx)
+		require.NoError(t, err)
+		require.True(t, more)
+		require.NotNil(t, stripe)
+
+		audited := map[storj.NodeID]bool{}
+		for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+			audited[piece.NodeId] = true
+		}
+		require.NotEmpty(t, audited)
+
+		queues := audits.Scheduler.Queues()
+		require.NotEmpty(t, queues)
+		for _, queue := range queues {
+			// nodes which were only queued aren't overdue before the minimum audit interval
+			require.Equal(t, audit.PriorityNormal, queue.Priority)
+			require.False(t, queue.LastAudited.IsZero())
+		}
+
+		// failing an audit raises the priority
+		var failed storj.NodeID
+		for id := range audited {
+			failed = id
+			break
+		}
+		audits.Scheduler.RecordReport(&audit.Report{Fails: storj.NodeIDList{failed}})
+
+		for _, queue := range audits.Scheduler.Queues() {
+			if queue.NodeID == failed {
+				require.Equal(t, audit.PriorityRecentFailure, queue.Priority)
+				require.False(t, queue.LastFailure.IsZero())
+			}
+		}
+
+		// the queue state is exposed through the inspector
+		resp, err := satellite.Audit.Inspector.AuditQueues(ctx, &pb.AuditQueuesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Queues, len(queues))
+		for _, queue := range resp.Queues {
+			if queue.NodeId == failed {
+				require.Equal(t, audit.PriorityRecentFailure.String(), queue.Priority)
+				require.NotNil(t, queue.LastFailure)
+			}
+		}
+	})
+}
+
+func TestSchedulerNewNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.AuditCount = 1
+				config.Overlay.Node.NewNodePercentage = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit.Service
+		err := audits.Close()
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		stripe, _, err := audits.Scheduler.NextStripe(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, stripe)
+
+		// nodes which haven't been vetted yet are audited with priority
+		queues := audits.Scheduler.Queues()
+		require.NotEmpty(t, queues)
+		for _, queue := range queues {
+			require.Equal(t, audit.PriorityNew, queue.Priority)
+			require.True(t, queue.New)
+		}
+	})
+}
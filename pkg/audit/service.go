@@ -30,29 +30,35 @@ type Config struct {
 	MinBytesPerSecond  memory.Size   `help:"the minimum acceptable bytes that storage nodes can transfer per second to the satellite" default:"128B"`
 	MinDownloadTimeout time.Duration `help:"the minimum duration for downloading a share from storage nodes before timing out" default:"25s"`
 	MaxReverifyCount   int           `help:"limit above which we consider an audit is failed" default:"3"`
+
+	QueueSize             int           `help:"maximum number of segments queued for auditing per node" default:"10"`
+	MinNodeAuditInterval  time.Duration `help:"nodes which haven't been audited for this long are audited before all other nodes" default:"24h0m0s"`
+	FailurePriorityWindow time.Duration `help:"how long a node is audited with higher priority after failing an audit or being offline" default:"24h0m0s"`
 }
 
-// Service helps coordinate Cursor and Verifier to run the audit process continuously
+// Service helps coordinate Scheduler and Verifier to run the audit process continuously
 type Service struct {
 	log *zap.Logger
 
-	Cursor   *Cursor
-	Verifier *Verifier
-	Reporter reporter
+	Cursor    *Cursor
+	Scheduler *Scheduler
+	Verifier  *Verifier
+	Reporter  reporter
 
 	Loop sync2.Cycle
 }
 
-// NewService instantiates a Service with access to a Cursor and Verifier
+// NewService instantiates a Service with access to a Scheduler and Verifier
 func NewService(log *zap.Logger, config Config, metainfo *metainfo.Service,
 	orders *orders.Service, transport transport.Client, overlay *overlay.Cache,
 	containment Containment, identity *identity.FullIdentity) (*Service, error) {
 	return &Service{
 		log: log,
 
-		Cursor:   NewCursor(metainfo),
-		Verifier: NewVerifier(log.Named("audit:verifier"), metainfo, transport, overlay, containment, orders, identity, config.MinBytesPerSecond, config.MinDownloadTimeout),
-		Reporter: NewReporter(log.Named("audit:reporter"), overlay, containment, config.MaxRetriesStatDB, int32(config.MaxReverifyCount)),
+		Cursor:    NewCursor(metainfo),
+		Scheduler: NewScheduler(log.Named("audit:scheduler"), metainfo, overlay, config),
+		Verifier:  NewVerifier(log.Named("audit:verifier"), metainfo, transport, overlay, containment, orders, identity, config.MinBytesPerSecond, config.MinDownloadTimeout),
+		Reporter:  NewReporter(log.Named("audit:reporter"), overlay, containment, config.MaxRetriesStatDB, int32(config.MaxReverifyCount)),
 
 		Loop: *sync2.NewCycle(config.Interval),
 	}, nil
@@ -78,22 +84,15 @@ func (service *Service) Close() error {
 	return nil
 }
 
-// process picks a random stripe and verifies correctness
+// process picks the stripe of the riskiest node and verifies correctness
 func (service *Service) process(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	var stripe *Stripe
-	for {
-		s, more, err := service.Cursor.NextStripe(ctx)
-		if err != nil {
-			return err
-		}
-		if s != nil {
-			stripe = s
-			break
-		}
-		if !more {
-			return nil
-		}
+	stripe, err := service.nextStripe(ctx)
+	if err != nil {
+		return err
+	}
+	if stripe == nil {
+		return nil
 	}
 
 	var errlist errs.Group
@@ -102,6 +101,7 @@ func (service *Service) process(ctx context.Context) (err error) {
 	if err != nil {
 		errlist.Add(err)
 	}
+	service.Scheduler.RecordReport(report)
 
 	// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
 	_, err = service.Reporter.RecordAudits(ctx, report)
@@ -130,6 +130,7 @@ func (service *Service) process(ctx context.Context) (err error) {
 	if err != nil {
 		errlist.Add(err)
 	}
+	service.Scheduler.RecordReport(report)
 
 	// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
 	_, err = service.Reporter.RecordAudits(ctx, report)
@@ -139,3 +140,20 @@ func (service *Service) process(ctx context.Context) (err error) {
 
 	return errlist.Err()
 }
+
+// nextStripe returns the stripe of the riskiest node. When the Scheduler fails,
+// the stripe is selected by the Cursor, so nodes are still audited without priorities.
+func (service *Service) nextStripe(ctx context.Context) (_ *Stripe, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for {
+		stripe, more, err := service.Scheduler.NextStripe(ctx)
+		if err != nil {
+			service.log.Warn("unable to schedule audit, falling back to the cursor", zap.Error(err))
+			stripe, _, err = service.Cursor.NextStripe(ctx)
+			return stripe, err
+		}
+		if stripe != nil || !more {
+			return stripe, nil
+		}
+	}
+}
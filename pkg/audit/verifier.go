@@ -45,6 +45,7 @@ type Share struct {
 	PieceNum int
 	NodeID   storj.NodeID
 	Data     []byte
+	Duration time.Duration // how long it took to download the share
 }
 
 // Verifier helps verify the correctness of a given stripe
@@ -261,13 +262,28 @@ func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.Addre
 		}(i, limit)
 	}
 
+	var samples []overlay.PerformanceSample
 	for range limits {
 		share := <-ch
-		if share != nil {
-			shares[share.PieceNum] = *share
+		if share == nil {
+			continue
+		}
+		shares[share.PieceNum] = *share
+
+		if share.Error == nil && share.Duration > 0 {
+			samples = append(samples, overlay.PerformanceSample{
+				NodeID:  share.NodeID,
+				Action:  pb.PieceAction_GET_AUDIT,
+				Latency: share.Duration,
+			})
 		}
 	}
 
+	// shares are small, the download time is dominated by the latency of the node
+	if err := verifier.overlay.UpdatePerformance(ctx, samples); err != nil {
+		verifier.log.Warn("unable to update node latencies", zap.Error(err))
+	}
+
 	return shares, nil
 }
 
@@ -456,6 +472,7 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 	target := &pb.Node{Id: storageNodeID, Address: limit.GetStorageNodeAddress()}
 	signer := signing.SignerFromFullIdentity(verifier.transport.Identity())
 
+	start := time.Now()
 	ps, err := piecestore.Dial(timedCtx, verifier.transport, target, log, signer, piecestore.DefaultConfig)
 	if err != nil {
 		return Share{}, Error.Wrap(err)
@@ -486,6 +503,7 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 		PieceNum: pieceNum,
 		NodeID:   storageNodeID,
 		Data:     buf,
+		Duration: time.Since(start),
 	}, nil
 }
 
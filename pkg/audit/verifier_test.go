@@ -61,6 +61,12 @@ func TestDownloadSharesHappyPath(t *testing.T) {
 
 		for _, share := range shares {
 			assert.NoError(t, share.Error)
+			assert.True(t, share.Duration > 0)
+
+			// the download time is recorded as the latency of the node
+			node, err := planet.Satellites[0].Overlay.Service.Get(ctx, share.NodeID)
+			require.NoError(t, err)
+			assert.True(t, node.Reputation.Latency90 > 0)
 		}
 	})
 }
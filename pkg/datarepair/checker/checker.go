@@ -19,10 +19,14 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/damagedpieces"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/storage"
 )
 
+// damagedPageSize is the number of damaged piece reports loaded at once
+const damagedPageSize = 1000
+
 // Error is a standard error class for this package.
 var (
 	Error = errs.Class("checker error")
@@ -51,14 +55,18 @@ type Checker struct {
 	repairQueue     queue.RepairQueue
 	overlay         *overlay.Cache
 	irrdb           irreparable.DB
+	damaged         damagedpieces.DB
 	logger          *zap.Logger
 	Loop            sync2.Cycle
 	IrreparableLoop sync2.Cycle
 	monStats        durabilityStats
+	passStarted     time.Time
+	// reports of damaged pieces found in pointers during the current pass
+	matched map[damagedpieces.Report]struct{}
 }
 
 // NewChecker creates a new instance of checker
-func NewChecker(metainfo *metainfo.Service, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, limit int, logger *zap.Logger, repairInterval, irreparableInterval time.Duration) *Checker {
+func NewChecker(metainfo *metainfo.Service, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, damaged damagedpieces.DB, limit int, logger *zap.Logger, repairInterval, irreparableInterval time.Duration) *Checker {
 	// TODO: reorder arguments
 	checker := &Checker{
 		metainfo:        metainfo,
@@ -66,6 +74,7 @@ func NewChecker(metainfo *metainfo.Service, repairQueue queue.RepairQueue, overl
 		repairQueue:     repairQueue,
 		overlay:         overlay,
 		irrdb:           irrdb,
+		damaged:         damaged,
 		logger:          logger,
 		Loop:            *sync2.NewCycle(repairInterval),
 		IrreparableLoop: *sync2.NewCycle(irreparableInterval),
@@ -101,6 +110,16 @@ func (checker *Checker) Close() error {
 func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if checker.lastChecked == "" {
+		checker.passStarted = time.Now()
+		checker.matched = make(map[damagedpieces.Report]struct{})
+	}
+
+	damaged, err := checker.loadDamagedPieces(ctx)
+	if err != nil {
+		return Error.New("error loading damaged pieces %s", err)
+	}
+
 	err = checker.metainfo.Iterate(ctx, "", checker.lastChecked, true, false,
 		func(ctx context.Context, it storage.Iterator) error {
 			var item storage.ListItem
@@ -132,7 +151,10 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 					return Error.New("error unmarshalling pointer %s", err)
 				}
 
-				err = checker.updateSegmentStatus(ctx, pointer, item.Key.String(), &checker.monStats)
+				damagedPieces := checker.findDamagedPieces(pointer, damaged)
+				mon.Meter("damaged_pieces_found").Mark(len(damagedPieces))
+
+				err = checker.updateSegmentStatus(ctx, pointer, item.Key.String(), damagedPieces, &checker.monStats)
 				if err != nil {
 					return err
 				}
@@ -144,9 +166,101 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		return err
 	}
 
+	if checker.lastChecked == "" {
+		err = checker.deleteUnmatchedReports(ctx, damaged)
+		if err != nil {
+			return Error.New("error deleting damaged pieces %s", err)
+		}
+	}
+
 	return nil
 }
 
+// deleteUnmatchedReports removes the loaded reports made before the pass started,
+// which don't belong to a piece in any pointer. The repairer removes the
+// reports of the pieces it has repaired.
+func (checker *Checker) deleteUnmatchedReports(ctx context.Context, damaged map[storj.NodeID]map[storj.PieceID]time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for nodeID, pieces := range damaged {
+		for pieceID, reportedAt := range pieces {
+			if !reportedAt.Before(checker.passStarted) {
+				continue
+			}
+			if _, ok := checker.matched[damagedpieces.Report{NodeID: nodeID, PieceID: pieceID}]; ok {
+				continue
+			}
+			err = errs.Combine(err, checker.damaged.Delete(ctx, nodeID, pieceID))
+		}
+	}
+	return err
+}
+
+// loadDamagedPieces returns the pieces storage nodes reported as damaged and
+// when they were reported. The reports are loaded in pages of damagedPageSize.
+func (checker *Checker) loadDamagedPieces(ctx context.Context) (_ map[storj.NodeID]map[storj.PieceID]time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if checker.damaged == nil {
+		return nil, nil
+	}
+
+	damaged := make(map[storj.NodeID]map[storj.PieceID]time.Time)
+	var cursor damagedpieces.Report
+	for {
+		reports, err := checker.damaged.List(ctx, cursor, damagedPageSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(reports) == 0 {
+			return damaged, nil
+		}
+		cursor = reports[len(reports)-1]
+
+		for _, report := range reports {
+			pieces, ok := damaged[report.NodeID]
+			if !ok {
+				pieces = make(map[storj.PieceID]time.Time)
+				damaged[report.NodeID] = pieces
+			}
+			pieces[report.PieceID] = report.ReportedAt
+		}
+	}
+}
+
+// findDamagedPieces returns the numbers of the pieces in the pointer,
+// which were reported as damaged
+func (checker *Checker) findDamagedPieces(pointer *pb.Pointer, damaged map[storj.NodeID]map[storj.PieceID]time.Time) (pieceNums []int32) {
+	remote := pointer.GetRemote()
+	if remote == nil || len(damaged) == 0 {
+		return nil
+	}
+
+	for _, piece := range remote.RemotePieces {
+		reported, ok := damaged[piece.NodeId]
+		if !ok {
+			continue
+		}
+		// only the node storing the piece can report it
+		pieceID := remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+		if _, ok := reported[pieceID]; ok {
+			pieceNums = append(pieceNums, piece.PieceNum)
+			checker.matched[damagedpieces.Report{NodeID: piece.NodeId, PieceID: pieceID}] = struct{}{}
+		}
+	}
+	return pieceNums
+}
+
+// checks for a piece number in slice
+func containsPieceNum(a []int32, x int32) bool {
+	for _, n := range a {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
 // checks for a string in slice
 func contains(a []string, x string) bool {
 	for _, n := range a {
@@ -157,7 +271,7 @@ func contains(a []string, x string) bool {
 	return false
 }
 
-func (checker *Checker) updateSegmentStatus(ctx context.Context, pointer *pb.Pointer, path string, monStats *durabilityStats) (err error) {
+func (checker *Checker) updateSegmentStatus(ctx context.Context, pointer *pb.Pointer, path string, damagedPieces []int32, monStats *durabilityStats) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	remote := pointer.GetRemote()
 	if remote == nil {
@@ -174,6 +288,11 @@ func (checker *Checker) updateSegmentStatus(ctx context.Context, pointer *pb.Poi
 	if err != nil {
 		return Error.New("error getting missing pieces %s", err)
 	}
+	for _, pieceNum := range damagedPieces {
+		if !containsPieceNum(missingPieces, pieceNum) {
+			missingPieces = append(missingPieces, pieceNum)
+		}
+	}
 
 	monStats.remoteSegmentsChecked++
 	pathElements := storj.SplitPath(path)
@@ -184,17 +303,21 @@ func (checker *Checker) updateSegmentStatus(ctx context.Context, pointer *pb.Poi
 	numHealthy := int32(len(pieces) - len(missingPieces))
 	redundancy := pointer.Remote.Redundancy
 	// we repair when the number of healthy pieces is less than or equal to the repair threshold
-	// except for the case when the repair and success thresholds are the same (a case usually seen during testing)
-	if numHealthy > redundancy.MinReq && numHealthy <= redundancy.RepairThreshold && numHealthy < redundancy.SuccessThreshold {
+	// except for the case when the repair and success thresholds are the same (a case usually seen during testing).
+	// Segments with damaged pieces are repaired regardless of the threshold.
+	needsRepair := numHealthy <= redundancy.RepairThreshold && numHealthy < redundancy.SuccessThreshold
+	if numHealthy > redundancy.MinReq && (needsRepair || len(damagedPieces) > 0) {
 		if len(missingPieces) == 0 {
 			checker.logger.Warn("Missing pieces is zero in checker, but this should be impossible -- bad redundancy scheme.")
 			return nil
 		}
 		monStats.remoteSegmentsNeedingRepair++
+		// segments closest to the minimum are repaired first
+		segmentHealth := int(numHealthy - redundancy.MinReq)
 		err = checker.repairQueue.Insert(ctx, &pb.InjuredSegment{
 			Path:       path,
 			LostPieces: missingPieces,
-		})
+		}, segmentHealth)
 		if err != nil {
 			return Error.New("error adding injured segment to queue %s", err)
 		}
@@ -256,7 +379,7 @@ func (checker *Checker) IrreparableProcess(ctx context.Context) (err error) {
 			break
 		}
 
-		err = checker.updateSegmentStatus(ctx, seg[0].GetSegmentDetail(), string(seg[0].GetPath()), &durabilityStats{})
+		err = checker.updateSegmentStatus(ctx, seg[0].GetSegmentDetail(), string(seg[0].GetPath()), nil, &durabilityStats{})
 		if err != nil {
 			checker.logger.Error("irrepair segment checker failed: ", zap.Error(err))
 		}
@@ -208,7 +208,7 @@ func TestCheckerResume(t *testing.T) {
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 		repairQueue := &mockRepairQueue{}
 		irrepairQueue := planet.Satellites[0].DB.Irreparable()
-		c := checker.NewChecker(planet.Satellites[0].Metainfo.Service, repairQueue, planet.Satellites[0].Overlay.Service, irrepairQueue, 0, nil, 30*time.Second, 15*time.Second)
+		c := checker.NewChecker(planet.Satellites[0].Metainfo.Service, repairQueue, planet.Satellites[0].Overlay.Service, irrepairQueue, planet.Satellites[0].DB.DamagedPieces(), 0, nil, 30*time.Second, 15*time.Second)
 
 		// create pointer that needs repair
 		makePointer(t, planet, "a", true)
@@ -262,7 +262,7 @@ type mockRepairQueue struct {
 	injuredSegments []pb.InjuredSegment
 }
 
-func (mockRepairQueue *mockRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment) error {
+func (mockRepairQueue *mockRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment, segmentHealth int) error {
 	if s.Path == "b" || s.Path == "d" {
 		return errs.New("mock Insert error")
 	}
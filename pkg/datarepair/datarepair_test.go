@@ -6,6 +6,7 @@ package datarepair_test
 import (
 	"testing"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/memory"
@@ -145,9 +146,22 @@ func TestDataRepair(t *testing.T) {
 		require.NoError(t, err)
 
 		remotePieces = pointer.GetRemote().GetRemotePieces()
+		var repairedNodes storj.NodeIDList
 		for _, piece := range remotePieces {
 			require.False(t, nodesToKill[piece.NodeId])
 			require.False(t, nodesToDisqualify[piece.NodeId])
+			if !nodesToKeepAlive[piece.NodeId] {
+				repairedNodes = append(repairedNodes, piece.NodeId)
+			}
+		}
+		require.NotEmpty(t, repairedNodes)
+
+		// the satellite measured the throughput of the uploads to the new nodes
+		satellite.Overlay.Recorder.Loop.TriggerWait()
+		performances, err := satellite.DB.OverlayCache().GetPerformance(ctx, repairedNodes)
+		require.NoError(t, err)
+		for _, id := range repairedNodes {
+			require.True(t, performances[id].UploadBytesPerSecond > 0, id.String())
 		}
 	})
 }
@@ -266,6 +280,100 @@ func TestRepairMultipleDisqualified(t *testing.T) {
 	})
 }
 
+// TestRepairPlacement checks that the repaired pieces are uploaded to nodes,
+// which satisfy the placement policy of the bucket.
+func TestRepairPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 12,
+		UplinkCount:      1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		satellite.Discovery.Service.Discovery.Stop()
+		satellite.Discovery.Service.Refresh.Stop()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		err := ul.UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     3,
+			RepairThreshold:  5,
+			SuccessThreshold: 7,
+			MaxThreshold:     7,
+		}, "testbucket", "test/path", testrand.Bytes(1*memory.MiB))
+		require.NoError(t, err)
+
+		metainfo := satellite.Metainfo.Service
+		listResponse, _, err := metainfo.List(ctx, "", "", "", true, 0, 0)
+		require.NoError(t, err)
+
+		var path string
+		var pointer *pb.Pointer
+		for _, v := range listResponse {
+			path = v.GetPath()
+			pointer, err = metainfo.Get(ctx, path)
+			require.NoError(t, err)
+			if pointer.GetType() == pb.Pointer_REMOTE {
+				break
+			}
+		}
+
+		holders := make(map[storj.NodeID]bool)
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			holders[piece.NodeId] = true
+		}
+
+		// only some of the nodes without pieces satisfy the placement
+		tagged := make(map[storj.NodeID]bool)
+		for _, node := range planet.StorageNodes {
+			if holders[node.ID()] || len(tagged) >= 3 {
+				continue
+			}
+			_, err := satellite.Overlay.Service.UpdateNodeInfo(ctx, node.ID(), &pb.InfoResponse{
+				Operator: &pb.NodeOperator{Tags: []string{"repair"}},
+			})
+			require.NoError(t, err)
+			tagged[node.ID()] = true
+		}
+
+		projectID, err := uuid.Parse(storj.SplitPath(path)[0])
+		require.NoError(t, err)
+		err = satellite.DB.Placements().Set(ctx, *projectID, []byte("testbucket"), &pb.PlacementPolicy{
+			RequiredTags: []string{"repair"},
+		})
+		require.NoError(t, err)
+
+		// disqualify enough nodes to trigger the repair
+		remotePieces := pointer.GetRemote().GetRemotePieces()
+		toDisqualify := len(remotePieces) - int(pointer.GetRemote().GetRedundancy().GetMinReq()+1)
+		for _, piece := range remotePieces[:toDisqualify] {
+			disqualifyNode(t, ctx, satellite, piece.NodeId)
+		}
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.Limiter.Wait()
+
+		pointer, err = metainfo.Get(ctx, path)
+		require.NoError(t, err)
+
+		repaired := 0
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			if holders[piece.NodeId] {
+				continue
+			}
+			require.True(t, tagged[piece.NodeId])
+			repaired++
+		}
+		require.NotZero(t, repaired)
+	})
+}
+
 func isDisqualified(t *testing.T, ctx *testcontext.Context, satellite *satellite.Peer, nodeID storj.NodeID) bool {
 	node, err := satellite.Overlay.Service.Get(ctx, nodeID)
 	require.NoError(t, err)
@@ -12,9 +12,11 @@ import (
 // RepairQueue implements queueing for segments that need repairing.
 // Implementation can be found at satellite/satellitedb/repairqueue.go.
 type RepairQueue interface {
-	// Insert adds an injured segment.
-	Insert(ctx context.Context, s *pb.InjuredSegment) error
-	// Select gets an injured segment.
+	// Insert adds an injured segment or updates the health of an already queued one.
+	// The segment health is the number of healthy pieces above the minimum required
+	// to recover the segment.
+	Insert(ctx context.Context, s *pb.InjuredSegment, segmentHealth int) error
+	// Select gets the injured segment with the lowest health.
 	Select(ctx context.Context) (*pb.InjuredSegment, error)
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s *pb.InjuredSegment) error
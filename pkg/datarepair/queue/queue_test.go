@@ -29,7 +29,7 @@ func TestInsertSelect(t *testing.T) {
 			Path:       "abc",
 			LostPieces: []int32{int32(1), int32(3)},
 		}
-		err := q.Insert(ctx, seg)
+		err := q.Insert(ctx, seg, 10)
 		require.NoError(t, err)
 		s, err := q.Select(ctx)
 		require.NoError(t, err)
@@ -50,13 +50,39 @@ func TestInsertDuplicate(t *testing.T) {
 			Path:       "abc",
 			LostPieces: []int32{int32(1), int32(3)},
 		}
-		err := q.Insert(ctx, seg)
+		err := q.Insert(ctx, seg, 10)
 		require.NoError(t, err)
-		err = q.Insert(ctx, seg)
+		err = q.Insert(ctx, seg, 10)
 		require.NoError(t, err)
 	})
 }
 
+func TestInsertUpdatesHealth(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		healthy := &pb.InjuredSegment{Path: "healthy", LostPieces: []int32{1}}
+		endangered := &pb.InjuredSegment{Path: "endangered", LostPieces: []int32{1}}
+		require.NoError(t, q.Insert(ctx, healthy, 5))
+		require.NoError(t, q.Insert(ctx, endangered, 3))
+
+		// the checker sees the segment again with more pieces lost
+		healthy.LostPieces = []int32{1, 2, 3}
+		require.NoError(t, q.Insert(ctx, healthy, 1))
+
+		s, err := q.Select(ctx)
+		require.NoError(t, err)
+		require.True(t, pb.Equal(healthy, s))
+
+		s, err = q.Select(ctx)
+		require.NoError(t, err)
+		require.True(t, pb.Equal(endangered, s))
+	})
+}
+
 func TestDequeueEmptyQueue(t *testing.T) {
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
 		ctx := testcontext.New(t)
@@ -84,7 +110,7 @@ func TestSequential(t *testing.T) {
 				Path:       strconv.Itoa(i),
 				LostPieces: []int32{int32(i)},
 			}
-			err := q.Insert(ctx, seg)
+			err := q.Insert(ctx, seg, 10)
 			require.NoError(t, err)
 			addSegs = append(addSegs, seg)
 		}
@@ -108,6 +134,33 @@ func TestSequential(t *testing.T) {
 	})
 }
 
+func TestSelectByHealth(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		const N = 20
+		for i := 0; i < N; i++ {
+			// insert the healthiest segments first
+			health := N - i
+			err := q.Insert(ctx, &pb.InjuredSegment{
+				Path:       strconv.Itoa(health),
+				LostPieces: []int32{int32(health)},
+			}, health)
+			require.NoError(t, err)
+		}
+
+		for health := 1; health <= N; health++ {
+			s, err := q.Select(ctx)
+			require.NoError(t, err)
+			require.Equal(t, strconv.Itoa(health), s.Path)
+			require.NoError(t, q.Delete(ctx, s))
+		}
+	})
+}
+
 func TestParallel(t *testing.T) {
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
 		ctx := testcontext.New(t)
@@ -125,7 +178,7 @@ func TestParallel(t *testing.T) {
 				return q.Insert(ctx, &pb.InjuredSegment{
 					Path:       strconv.Itoa(i),
 					LostPieces: []int32{int32(i)},
-				})
+				}, 10)
 			})
 		}
 		require.Empty(t, inserts.Wait(), "unexpected queue.Insert errors")
@@ -21,8 +21,10 @@ import (
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/damagedpieces"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/storage"
 )
 
@@ -41,12 +43,12 @@ type Config struct {
 }
 
 // GetSegmentRepairer creates a new segment repairer from storeConfig values
-func (c Config) GetSegmentRepairer(ctx context.Context, log *zap.Logger, tc transport.Client, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache, identity *identity.FullIdentity) (ss SegmentRepairer, err error) {
+func (c Config) GetSegmentRepairer(ctx context.Context, log *zap.Logger, tc transport.Client, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache, recorder *overlay.PerformanceRecorder, placements placement.DB, damaged damagedpieces.DB, identity *identity.FullIdentity) (ss SegmentRepairer, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	ec := ecclient.NewClient(log.Named("ecclient"), tc, c.MaxBufferMem.Int())
 
-	return segments.NewSegmentRepairer(log.Named("repairer"), metainfo, orders, cache, ec, identity, c.Timeout), nil
+	return segments.NewSegmentRepairer(log.Named("repairer"), metainfo, orders, cache, recorder, placements, damaged, ec, identity, c.Timeout), nil
 }
 
 // SegmentRepairer is a repairer for segments
@@ -56,30 +58,36 @@ type SegmentRepairer interface {
 
 // Service contains the information needed to run the repair service
 type Service struct {
-	log       *zap.Logger
-	queue     queue.RepairQueue
-	config    *Config
-	Limiter   *sync2.Limiter
-	Loop      sync2.Cycle
-	transport transport.Client
-	metainfo  *metainfo.Service
-	orders    *orders.Service
-	cache     *overlay.Cache
-	repairer  SegmentRepairer
+	log        *zap.Logger
+	queue      queue.RepairQueue
+	config     *Config
+	Limiter    *sync2.Limiter
+	Loop       sync2.Cycle
+	transport  transport.Client
+	metainfo   *metainfo.Service
+	orders     *orders.Service
+	cache      *overlay.Cache
+	recorder   *overlay.PerformanceRecorder
+	placements placement.DB
+	damaged    damagedpieces.DB
+	repairer   SegmentRepairer
 }
 
 // NewService creates repairing service
-func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, interval time.Duration, concurrency int, transport transport.Client, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache) *Service {
+func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, interval time.Duration, concurrency int, transport transport.Client, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache, recorder *overlay.PerformanceRecorder, placements placement.DB, damaged damagedpieces.DB) *Service {
 	return &Service{
-		log:       log,
-		queue:     queue,
-		config:    config,
-		Limiter:   sync2.NewLimiter(concurrency),
-		Loop:      *sync2.NewCycle(interval),
-		transport: transport,
-		metainfo:  metainfo,
-		orders:    orders,
-		cache:     cache,
+		log:        log,
+		queue:      queue,
+		config:     config,
+		Limiter:    sync2.NewLimiter(concurrency),
+		Loop:       *sync2.NewCycle(interval),
+		transport:  transport,
+		metainfo:   metainfo,
+		orders:     orders,
+		cache:      cache,
+		recorder:   recorder,
+		placements: placements,
+		damaged:    damaged,
 	}
 }
 
@@ -98,6 +106,9 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		service.metainfo,
 		service.orders,
 		service.cache,
+		service.recorder,
+		service.placements,
+		service.damaged,
 		service.transport.Identity(),
 	)
 	if err != nil {
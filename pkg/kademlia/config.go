@@ -6,6 +6,7 @@ package kademlia
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -58,6 +59,18 @@ func (c Config) Verify(log *zap.Logger) error {
 type OperatorConfig struct {
 	Email  string `user:"true" help:"operator email address" default:""`
 	Wallet string `user:"true" help:"operator wallet address" default:""`
+	Tags   string `user:"true" help:"comma-separated list of tags describing the node, which satellites can require when selecting nodes" default:""`
+}
+
+// TagList returns the operator tags.
+func (c OperatorConfig) TagList() []string {
+	var tags []string
+	for _, tag := range strings.Split(c.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
 }
 
 // Verify verifies whether operator config is valid.
@@ -68,6 +81,9 @@ func (c OperatorConfig) Verify(log *zap.Logger) error {
 	if err := isOperatorWalletValid(log, c.Wallet); err != nil {
 		return err
 	}
+	if tags := c.TagList(); len(tags) > 0 {
+		log.Sugar().Info("Operator tags: ", tags)
+	}
 	return nil
 }
 
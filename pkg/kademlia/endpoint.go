@@ -10,18 +10,25 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 )
 
 // EndpointError defines errors class for Endpoint
 var EndpointError = errs.Class("kademlia endpoint error")
 
+// CapacityFunc returns the capacity advertised to the requesting node,
+// nil advertises the capacity of the local node.
+type CapacityFunc func(ctx context.Context, requester storj.NodeID) *pb.NodeCapacity
+
 // Endpoint implements the kademlia Endpoints
 type Endpoint struct {
 	log          *zap.Logger
 	service      *Kademlia
 	routingTable *RoutingTable
 	connected    int32
+	capacity     CapacityFunc
 }
 
 // NewEndpoint returns a new kademlia endpoint
@@ -33,6 +40,12 @@ func NewEndpoint(log *zap.Logger, service *Kademlia, routingTable *RoutingTable)
 	}
 }
 
+// SetCapacityFunc configures the capacity advertised to the requesting nodes,
+// it must be called before the server starts
+func (endpoint *Endpoint) SetCapacityFunc(capacity CapacityFunc) {
+	endpoint.capacity = capacity
+}
+
 // Query is a node to node communication query
 func (endpoint *Endpoint) Query(ctx context.Context, req *pb.QueryRequest) (_ *pb.QueryResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -88,10 +101,19 @@ func (endpoint *Endpoint) RequestInfo(ctx context.Context, req *pb.InfoRequest)
 	defer mon.Task()(&ctx)(&err)
 	self := endpoint.service.Local()
 
+	capacity := &self.Capacity
+	if endpoint.capacity != nil {
+		if peer, err := identity.PeerIdentityFromContext(ctx); err == nil {
+			if requested := endpoint.capacity(ctx, peer.ID); requested != nil {
+				capacity = requested
+			}
+		}
+	}
+
 	return &pb.InfoResponse{
 		Type:     self.Type,
 		Operator: &self.Operator,
-		Capacity: &self.Capacity,
+		Capacity: capacity,
 		Version:  &self.Version,
 	}, nil
 }
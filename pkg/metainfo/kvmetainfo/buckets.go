@@ -78,6 +78,7 @@ func (db *Project) CreateBucket(ctx context.Context, bucketName string, info *st
 		"default-rs-repair": strconv.Itoa(int(info.RedundancyScheme.RepairShares)),
 		"default-rs-optim":  strconv.Itoa(int(info.RedundancyScheme.OptimalShares)),
 		"default-rs-total":  strconv.Itoa(int(info.RedundancyScheme.TotalShares)),
+		"versioning":        strconv.FormatBool(info.Versioning),
 	}
 	var exp time.Time
 	m, err := db.buckets.Put(ctx, bucketName, r, pb.SerializableMeta{UserDefined: userMeta}, exp)
@@ -230,5 +231,12 @@ func bucketFromMeta(ctx context.Context, bucketName string, m objects.Meta) (out
 	applySetting("default-rs-optim", 16, func(v int64) { rs.OptimalShares = int16(v) })
 	applySetting("default-rs-total", 16, func(v int64) { rs.TotalShares = int16(v) })
 
+	if stringVal := m.UserDefined["versioning"]; stringVal != "" && err == nil {
+		out.Versioning, err = strconv.ParseBool(stringVal)
+		if err != nil {
+			err = errs.New("invalid metadata field for versioning: %v", err)
+		}
+	}
+
 	return out, err
 }
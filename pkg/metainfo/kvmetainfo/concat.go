@@ -0,0 +1,227 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// ConcatObjects concatenates the latest versions of the objects at
+// sourcePaths into a new object at newPath, replacing any object at newPath.
+// The data of the objects isn't re-uploaded, their segments become the
+// segments of the new object and only the keys of the segments are
+// re-encrypted for newPath. The objects at sourcePaths are removed.
+func (db *DB) ConcatObjects(ctx context.Context, bucket string, sourcePaths []storj.Path, newPath storj.Path, createInfo *storj.CreateObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if newPath == "" {
+		return storj.ErrNoPath.New("")
+	}
+	if len(sourcePaths) == 0 {
+		return errClass.New("no objects to concatenate")
+	}
+
+	newInfo := storj.Object{Bucket: bucketInfo, Path: newPath}
+	if bucketInfo.Versioning {
+		newInfo.VersionID, err = db.nextVersionID(ctx, bucketInfo, newPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	versionIDs, err := db.concatStreams(ctx, bucketInfo, sourcePaths, newInfo.StreamPath(), createInfo)
+	if err != nil {
+		return err
+	}
+
+	if newInfo.VersionID == "" {
+		return nil
+	}
+
+	err = db.commitVersion(ctx, bucketInfo, newPath, newInfo.VersionID)
+	if err != nil {
+		return err
+	}
+
+	// the previous versions remain at the old paths, so they have to be marked as deleted
+	for i, path := range sourcePaths {
+		err = db.putDeleteMarker(ctx, bucketInfo, path, latestVersionID(bucketInfo, versionIDs[i]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// concatStreams concatenates the streams at sourcePaths into a new stream at
+// newStreamPath. The new stream ends with an empty inline segment, which holds
+// the layout of the parts. It returns the version IDs stored in the streams.
+// The satellite refuses the concatenation, if any of the streams has been
+// modified since its metadata was read.
+func (db *DB) concatStreams(ctx context.Context, bucket storj.Bucket, sourcePaths []storj.Path, newStreamPath storj.Path, createInfo *storj.CreateObject) (versionIDs []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	newUnencPath := paths.NewUnencrypted(newStreamPath)
+	newEncPath, err := encryption.EncryptPath(bucket.Name, newUnencPath, bucket.PathCipher, db.encStore)
+	if err != nil {
+		return nil, err
+	}
+	newDerivedKey, err := encryption.DeriveContentKey(bucket.Name, newUnencPath, db.encStore)
+	if err != nil {
+		return nil, err
+	}
+
+	encPaths := make([]storj.Path, 0, len(sourcePaths))
+	for _, path := range sourcePaths {
+		encPath, err := encryption.EncryptPath(bucket.Name, paths.NewUnencrypted(path), bucket.PathCipher, db.encStore)
+		if err != nil {
+			return nil, err
+		}
+		encPaths = append(encPaths, encPath.Raw())
+	}
+
+	sources, err := db.metainfo.BeginConcatObjects(ctx, bucket.Name, encPaths, newEncPath.Raw())
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+	if len(sources) != len(sourcePaths) {
+		return nil, errClass.New("expected %d objects, got %d", len(sourcePaths), len(sources))
+	}
+
+	var first pb.StreamMeta
+	info := &pb.StreamInfo{}
+	for i, source := range sources {
+		part, streamMeta, err := db.concatPart(ctx, bucket.Name, sourcePaths[i], source, newDerivedKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			first = streamMeta
+		} else if streamMeta.EncryptionType != first.EncryptionType || streamMeta.EncryptionBlockSize != first.EncryptionBlockSize {
+			return nil, errClass.New("objects with different encryption parameters can't be concatenated")
+		}
+
+		info.Parts = append(info.Parts, part)
+		info.NumberOfSegments += part.NumberOfSegments
+		versionIDs = append(versionIDs, streamMeta.VersionId)
+	}
+	// the segments of the parts are followed by the empty last segment
+	info.NumberOfSegments++
+
+	info.Metadata, err = proto.Marshal(&pb.SerializableMeta{
+		ContentType: createInfo.ContentType,
+		UserDefined: createInfo.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inlineSegment, metadata, err := streams.EmptyLastSegment(info, storj.CipherSuite(first.EncryptionType), int(first.EncryptionBlockSize), newDerivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.metainfo.FinishConcatObjects(ctx, bucket.Name, newEncPath.Raw(), sources, inlineSegment, metadata, createInfo.Expires)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+
+	return versionIDs, nil
+}
+
+// concatPart re-encrypts the metadata of the segments of the stream at path
+// for the new stream it's concatenated into. The metadata of its last segment
+// becomes the metadata of a regular segment. It returns the layout and the
+// metadata of the stream.
+func (db *DB) concatPart(ctx context.Context, bucket string, path storj.Path, source *pb.ObjectConcatSource, newDerivedKey *storj.Key) (part *pb.StreamPart, streamMeta pb.StreamMeta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	unencPath := paths.NewUnencrypted(path)
+	derivedKey, err := encryption.DeriveContentKey(bucket, unencPath, db.encStore)
+	if err != nil {
+		return nil, pb.StreamMeta{}, err
+	}
+
+	var last *pb.ObjectMoveSegment
+	for _, segment := range source.Segments {
+		if segment.Segment == -1 {
+			last = segment
+		}
+	}
+	if last == nil {
+		return nil, pb.StreamMeta{}, errClass.New("last segment of %q is missing", path)
+	}
+
+	streamInfo, streamMeta, err := streams.TypedDecryptStreamInfo(ctx, last.EncryptedMetadata, streams.CreatePath(bucket, unencPath), db.encStore)
+	if err != nil {
+		return nil, pb.StreamMeta{}, err
+	}
+
+	var stream pb.StreamInfo
+	err = proto.Unmarshal(streamInfo, &stream)
+	if err != nil {
+		return nil, pb.StreamMeta{}, err
+	}
+
+	switch {
+	case len(stream.Parts) > 0:
+		return nil, pb.StreamMeta{}, errClass.New("%q is concatenated already", path)
+	case stream.NumberOfSegments != int64(len(source.Segments)):
+		return nil, pb.StreamMeta{}, errClass.New("%q has %d segments, expected %d", path, len(source.Segments), stream.NumberOfSegments)
+	}
+
+	cipher := storj.CipherSuite(streamMeta.EncryptionType)
+	for _, segment := range source.Segments {
+		if segment.Segment != -1 {
+			segment.EncryptedMetadata, err = reencryptSegmentMetadata(segment.Segment, segment.EncryptedMetadata, cipher, derivedKey, newDerivedKey, "")
+			if err != nil {
+				return nil, pb.StreamMeta{}, err
+			}
+			continue
+		}
+
+		if streamMeta.LastSegmentMeta == nil {
+			// segments without encryption have no metadata
+			segment.EncryptedMetadata = nil
+			continue
+		}
+
+		segmentMeta := *streamMeta.LastSegmentMeta
+		err = reencryptKey(&segmentMeta, cipher, derivedKey, newDerivedKey)
+		if err != nil {
+			return nil, pb.StreamMeta{}, err
+		}
+		segment.EncryptedMetadata, err = proto.Marshal(&segmentMeta)
+		if err != nil {
+			return nil, pb.StreamMeta{}, err
+		}
+	}
+
+	return &pb.StreamPart{
+		NumberOfSegments: stream.NumberOfSegments,
+		SegmentsSize:     stream.SegmentsSize,
+		LastSegmentSize:  stream.LastSegmentSize,
+	}, streamMeta, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+	"strings"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket. The prefixes of
+// the rules are encrypted, so the satellite can match them against the paths.
+func (db *DB) SetBucketLifecycle(ctx context.Context, bucket string, rules []storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	encRules := make([]*pb.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		encPrefix, err := encryptPrefix(bucket, rule.Prefix, bucketInfo.PathCipher, db.encStore)
+		if err != nil {
+			return err
+		}
+
+		encRules = append(encRules, &pb.LifecycleRule{
+			Id:              rule.ID,
+			EncryptedPrefix: []byte(encPrefix),
+			ExpirationDays:  int32(rule.ExpirationDays),
+		})
+	}
+
+	err = db.metainfo.SetBucketLifecycle(ctx, bucket, encRules)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrBucketNotFound.Wrap(err)
+	}
+	return err
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket
+func (db *DB) GetBucketLifecycle(ctx context.Context, bucket string) (rules []storj.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	encRules, err := db.metainfo.GetBucketLifecycle(ctx, bucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+
+	for _, rule := range encRules {
+		prefix, err := decryptPrefix(bucket, storj.Path(rule.EncryptedPrefix), bucketInfo.PathCipher, db.encStore)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, storj.LifecycleRule{
+			ID:             rule.Id,
+			Prefix:         prefix,
+			ExpirationDays: int(rule.ExpirationDays),
+		})
+	}
+	return rules, nil
+}
+
+// encryptPrefix encrypts the path components of a prefix. Only whole
+// components can be encrypted, so the prefix has to end with a slash unless
+// the paths aren't encrypted.
+func encryptPrefix(bucket string, prefix storj.Path, cipher storj.CipherSuite, store *encryption.Store) (storj.Path, error) {
+	if prefix == "" || cipher == storj.EncNull {
+		return prefix, nil
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		return "", errClass.New("lifecycle rule prefix %q must end with a slash", prefix)
+	}
+
+	encPrefix, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(strings.TrimSuffix(prefix, "/")), cipher, store)
+	if err != nil {
+		return "", err
+	}
+	return encPrefix.Raw() + "/", nil
+}
+
+// decryptPrefix reverts encryptPrefix
+func decryptPrefix(bucket string, encPrefix storj.Path, cipher storj.CipherSuite, store *encryption.Store) (storj.Path, error) {
+	if encPrefix == "" || cipher == storj.EncNull {
+		return encPrefix, nil
+	}
+
+	prefix, err := encryption.DecryptPath(bucket, paths.NewEncrypted(strings.TrimSuffix(encPrefix, "/")), cipher, store)
+	if err != nil {
+		return "", err
+	}
+	return prefix.Raw() + "/", nil
+}
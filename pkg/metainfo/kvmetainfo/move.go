@@ -0,0 +1,233 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// MoveObject moves the latest version of an object to a new path, replacing
+// any object at the new path once the move succeeds. The data of the object
+// isn't re-uploaded, only the keys of its segments are re-encrypted for the
+// new path.
+func (db *DB) MoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == newBucket && path == newPath {
+		_, _, err = db.getInfo(ctx, bucket, path, "")
+		return err
+	}
+
+	info, err := db.moveObject(ctx, bucket, path, newBucket, newPath)
+	if err != nil {
+		return err
+	}
+
+	if info.Bucket.Versioning {
+		// the previous versions remain at the old path, so it has to be marked as deleted
+		err = db.putDeleteMarker(ctx, info.Bucket, path, info.VersionID)
+	}
+
+	return err
+}
+
+// moveObject moves the latest version of an object. In a versioned bucket the
+// object is moved as a new version of the object at the new path.
+func (db *DB) moveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, info, err = db.getInfo(ctx, bucket, path, "")
+	if err != nil {
+		return info, err
+	}
+
+	newBucketInfo, err := db.GetBucket(ctx, newBucket)
+	if err != nil {
+		return info, err
+	}
+
+	if newPath == "" {
+		return info, storj.ErrNoPath.New("")
+	}
+
+	newInfo := storj.Object{Bucket: newBucketInfo, Path: newPath}
+	if newBucketInfo.Versioning {
+		newInfo.VersionID, err = db.nextVersionID(ctx, newBucketInfo, newPath)
+		if err != nil {
+			return info, err
+		}
+	}
+
+	err = db.moveStream(ctx, info, newBucketInfo, newInfo.StreamPath(), "", false)
+	if err != nil {
+		return info, err
+	}
+
+	if newInfo.VersionID != "" {
+		err = db.commitVersion(ctx, newBucketInfo, newPath, newInfo.VersionID)
+	}
+	return info, err
+}
+
+// moveStream moves the stream of the object version info to newStreamPath,
+// re-encrypting the metadata of its segments for newStreamPath. versionID is
+// stored in the stream metadata as the version ID of the latest version of the
+// object. The satellite refuses the move, if the stream has been modified
+// since its metadata was read.
+//
+// If keepReplaced is set, the latest version at newStreamPath isn't deleted,
+// but moved to storj.VersionsDir by the satellite in the same operation, so
+// there's no point in time, when the object has no latest version.
+func (db *DB) moveStream(ctx context.Context, info storj.Object, newBucket storj.Bucket, newStreamPath storj.Path, versionID string, keepReplaced bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := info.Bucket
+	streamPath := paths.NewUnencrypted(info.StreamPath())
+	newUnencPath := paths.NewUnencrypted(newStreamPath)
+
+	encPath, err := encryption.EncryptPath(bucket.Name, streamPath, bucket.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+	newEncPath, err := encryption.EncryptPath(newBucket.Name, newUnencPath, newBucket.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(bucket.Name, streamPath, db.encStore)
+	if err != nil {
+		return err
+	}
+	newDerivedKey, err := encryption.DeriveContentKey(newBucket.Name, newUnencPath, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	segments, revision, replaced, err := db.metainfo.BeginMoveObject(ctx, bucket.Name, encPath.Raw(), newBucket.Name, newEncPath.Raw(), keepReplaced)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return err
+	}
+
+	cipher := info.EncryptionParameters.CipherSuite
+	for _, segment := range segments {
+		segment.EncryptedMetadata, err = reencryptSegmentMetadata(segment.Segment, segment.EncryptedMetadata, cipher, derivedKey, newDerivedKey, versionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if replaced != nil && len(replaced.Segments) > 0 {
+		replaced.EncryptedPath, err = db.reencryptReplaced(newBucket, newUnencPath, replaced.Segments, newDerivedKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = db.metainfo.FinishMoveObject(ctx, bucket.Name, encPath.Raw(), newBucket.Name, newEncPath.Raw(), segments, revision, replaced)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// reencryptReplaced re-encrypts the metadata of the segments of the latest
+// version at path, which is replaced by a move, for its path in
+// storj.VersionsDir. It returns the encrypted path of the version.
+func (db *DB) reencryptReplaced(bucket storj.Bucket, path paths.Unencrypted, segments []*pb.ObjectMoveSegment, derivedKey *storj.Key) (_ storj.Path, err error) {
+	var streamMeta pb.StreamMeta
+	for _, segment := range segments {
+		if segment.Segment == -1 {
+			if err := proto.Unmarshal(segment.EncryptedMetadata, &streamMeta); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	version := storj.Object{Path: path.Raw(), VersionID: latestVersionID(bucket, streamMeta.VersionId)}
+	versionPath := paths.NewUnencrypted(version.StreamPath())
+
+	encPath, err := encryption.EncryptPath(bucket.Name, versionPath, bucket.PathCipher, db.encStore)
+	if err != nil {
+		return "", err
+	}
+	versionDerivedKey, err := encryption.DeriveContentKey(bucket.Name, versionPath, db.encStore)
+	if err != nil {
+		return "", err
+	}
+
+	cipher := storj.CipherSuite(streamMeta.EncryptionType)
+	for _, segment := range segments {
+		// previous versions don't store a version ID in their stream metadata
+		segment.EncryptedMetadata, err = reencryptSegmentMetadata(segment.Segment, segment.EncryptedMetadata, cipher, derivedKey, versionDerivedKey, "")
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return encPath.Raw(), nil
+}
+
+// reencryptSegmentMetadata re-encrypts the content key in the metadata of a segment
+// from the key derived from the old path to the key derived from the new path.
+// The metadata of the last segment gets versionID as the version ID of the stream.
+func reencryptSegmentMetadata(segmentIndex int64, metadata []byte, cipher storj.CipherSuite, derivedKey, newDerivedKey *storj.Key, versionID string) (_ []byte, err error) {
+	if len(metadata) == 0 {
+		return metadata, nil
+	}
+
+	if segmentIndex != -1 {
+		var segmentMeta pb.SegmentMeta
+		if err := proto.Unmarshal(metadata, &segmentMeta); err != nil {
+			return nil, err
+		}
+		if err := reencryptKey(&segmentMeta, cipher, derivedKey, newDerivedKey); err != nil {
+			return nil, err
+		}
+		return proto.Marshal(&segmentMeta)
+	}
+
+	var streamMeta pb.StreamMeta
+	if err := proto.Unmarshal(metadata, &streamMeta); err != nil {
+		return nil, err
+	}
+	// the stream info is encrypted with the content key of the last segment,
+	// so it stays the same
+	if err := reencryptKey(streamMeta.LastSegmentMeta, storj.CipherSuite(streamMeta.EncryptionType), derivedKey, newDerivedKey); err != nil {
+		return nil, err
+	}
+	streamMeta.VersionId = versionID
+	return proto.Marshal(&streamMeta)
+}
+
+func reencryptKey(segmentMeta *pb.SegmentMeta, cipher storj.CipherSuite, derivedKey, newDerivedKey *storj.Key) (err error) {
+	if segmentMeta == nil || len(segmentMeta.EncryptedKey) == 0 {
+		return nil
+	}
+
+	var nonce storj.Nonce
+	copy(nonce[:], segmentMeta.KeyNonce)
+
+	contentKey, err := encryption.DecryptKey(segmentMeta.EncryptedKey, cipher, derivedKey, &nonce)
+	if err != nil {
+		return err
+	}
+
+	segmentMeta.EncryptedKey, err = encryption.EncryptKey(contentKey, cipher, newDerivedKey, &nonce)
+	return err
+}
@@ -46,7 +46,17 @@ var DefaultES = storj.EncryptionParameters{
 func (db *DB) GetObject(ctx context.Context, bucket string, path storj.Path) (info storj.Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, info, err = db.getInfo(ctx, bucket, path)
+	_, info, err = db.getInfo(ctx, bucket, path, "")
+
+	return info, err
+}
+
+// GetObjectVersion returns information about a specific version of an object.
+// An empty version ID returns the latest version.
+func (db *DB) GetObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, info, err = db.getInfo(ctx, bucket, path, versionID)
 
 	return info, err
 }
@@ -54,12 +64,28 @@ func (db *DB) GetObject(ctx context.Context, bucket string, path storj.Path) (in
 // GetObjectStream returns interface for reading the object stream
 func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Path) (stream storj.ReadOnlyStream, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return db.getStream(ctx, bucket, path, "")
+}
 
-	meta, info, err := db.getInfo(ctx, bucket, path)
+// GetObjectVersionStream returns interface for reading the stream of a specific version of an object.
+// An empty version ID returns the stream of the latest version.
+func (db *DB) GetObjectVersionStream(ctx context.Context, bucket string, path storj.Path, versionID string) (stream storj.ReadOnlyStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.getStream(ctx, bucket, path, versionID)
+}
+
+func (db *DB) getStream(ctx context.Context, bucket string, path storj.Path, versionID string) (stream storj.ReadOnlyStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	meta, info, err := db.getInfo(ctx, bucket, path, versionID)
 	if err != nil {
 		return nil, err
 	}
 
+	if info.IsDeleteMarker {
+		return nil, storj.ErrObjectNotFound.New("version %s of %s is a delete marker", info.VersionID, path)
+	}
+
 	streamKey, err := encryption.DeriveContentKey(bucket, meta.fullpath.UnencryptedPath(), db.encStore)
 	if err != nil {
 		return nil, err
@@ -92,6 +118,13 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		Path:   path,
 	}
 
+	if bucketInfo.Versioning {
+		info.VersionID, err = db.nextVersionID(ctx, bucketInfo, path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if createInfo != nil {
 		info.Metadata = createInfo.Metadata
 		info.ContentType = createInfo.ContentType
@@ -132,7 +165,8 @@ func (db *DB) ModifyObject(ctx context.Context, bucket string, path storj.Path)
 	return nil, errors.New("not implemented")
 }
 
-// DeleteObject deletes an object from database
+// DeleteObject deletes an object from database.
+// In versioned buckets a delete marker is created instead.
 func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -143,6 +177,16 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 		}
 		return err
 	}
+
+	if bucketInfo.Versioning {
+		// only existing objects can be deleted
+		_, info, err := db.getInfo(ctx, bucket, path, "")
+		if err != nil {
+			return err
+		}
+		return db.putDeleteMarker(ctx, bucketInfo, path, info.VersionID)
+	}
+
 	prefixed := prefixedObjStore{
 		store:  objects.NewStore(db.streams, bucketInfo.PathCipher),
 		prefix: bucket,
@@ -150,6 +194,44 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 	return prefixed.Delete(ctx, path)
 }
 
+// DeleteObjectVersion permanently deletes a specific version of an object from database.
+// An empty version ID behaves like DeleteObject.
+func (db *DB) DeleteObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versionID == "" {
+		return db.DeleteObject(ctx, bucket, path)
+	}
+
+	_, err = db.GetBucket(ctx, bucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return err
+	}
+
+	_, info, err := db.getInfo(ctx, bucket, path, versionID)
+	if err != nil {
+		return err
+	}
+
+	return db.deleteVersion(ctx, info)
+}
+
+// CommitObjectVersion makes the uploaded version of an object in a versioned
+// bucket the latest version of the object.
+func (db *DB) CommitObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	return db.commitVersion(ctx, bucketInfo, path, versionID)
+}
+
 // ModifyPendingObject creates an interface for updating a partially uploaded object
 func (db *DB) ModifyPendingObject(ctx context.Context, bucket string, path storj.Path) (object storj.MutableObject, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -171,6 +253,10 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 		return storj.ObjectList{}, err
 	}
 
+	if options.Versions {
+		return db.listVersions(ctx, bucketInfo, options)
+	}
+
 	objects := prefixedObjStore{
 		store:  objects.NewStore(db.streams, bucketInfo.PathCipher),
 		prefix: bucket,
@@ -212,7 +298,18 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 	}
 
 	for _, item := range items {
-		list.Items = append(list.Items, objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta))
+		if isVersionsPath(options.Prefix, item.Path) {
+			continue
+		}
+		info := objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta)
+		if bucketInfo.Versioning && !item.IsPrefix {
+			info.IsLatest = true
+			setVersion(&info, latestVersionID(bucketInfo, item.Meta.VersionID))
+			if info.IsDeleteMarker {
+				continue
+			}
+		}
+		list.Items = append(list.Items, info)
 	}
 
 	return list, nil
@@ -227,7 +324,9 @@ type object struct {
 	streamMeta      pb.StreamMeta
 }
 
-func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj object, info storj.Object, err error) {
+// getInfo returns information about the version of the object at path.
+// An empty version ID refers to the latest version.
+func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path, versionID string) (obj object, info storj.Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// TODO: we shouldn't need to go load the bucket metadata every time we get object info
@@ -240,9 +339,35 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 		return object{}, storj.Object{}, storj.ErrNoPath.New("")
 	}
 
-	fullpath := streams.CreatePath(bucket, paths.NewUnencrypted(path))
+	obj, info, err = db.getVersionInfo(ctx, bucketInfo, path, "", true)
+	switch {
+	case err == nil && versionID == "":
+		if info.IsDeleteMarker {
+			return object{}, storj.Object{}, storj.ErrObjectNotFound.New("%s is deleted", path)
+		}
+		return obj, info, nil
+	case err == nil && versionID == info.VersionID:
+		return obj, info, nil
+	case err == nil && !bucketInfo.Versioning && versionID == storj.NullVersionID:
+		return obj, info, nil
+	case err != nil && (versionID == "" || !storj.ErrObjectNotFound.Has(err)):
+		return object{}, storj.Object{}, err
+	}
+
+	return db.getVersionInfo(ctx, bucketInfo, path, versionID, false)
+}
+
+// getVersionInfo returns information about the latest version of the object
+// at path or about its previous version with versionID.
+func (db *DB) getVersionInfo(ctx context.Context, bucketInfo storj.Bucket, path storj.Path, versionID string, latest bool) (obj object, info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := bucketInfo.Name
+	streamPath := storj.Object{Path: path, VersionID: versionID, IsLatest: latest}.StreamPath()
 
-	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
+	fullpath := streams.CreatePath(bucket, paths.NewUnencrypted(streamPath))
+
+	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(streamPath), bucketInfo.PathCipher, db.encStore)
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
@@ -292,6 +417,11 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
+	info.IsLatest = latest
+	if latest {
+		versionID = latestVersionID(bucketInfo, streamMeta.VersionId)
+	}
+	setVersion(&info, versionID)
 
 	return object{
 		fullpath:        fullpath,
@@ -325,6 +455,12 @@ func objectFromMeta(bucket storj.Bucket, path storj.Path, isPrefix bool, meta ob
 }
 
 func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segments.Meta, stream pb.StreamInfo, streamMeta pb.StreamMeta, redundancyScheme *pb.RedundancyScheme) (storj.Object, error) {
+	fixedSegmentSize := stream.SegmentsSize
+	if len(stream.Parts) > 0 {
+		// the segments of a concatenated stream have the sizes of their parts
+		fixedSegmentSize = -1
+	}
+
 	var nonce storj.Nonce
 	var encryptedKey storj.EncryptedPrivateKey
 	if streamMeta.LastSegmentMeta != nil {
@@ -352,11 +488,11 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
+			Size: streams.StreamSize(stream),
 			// Checksum: []byte(object.Checksum),
 
 			SegmentCount:     stream.NumberOfSegments,
-			FixedSegmentSize: stream.SegmentsSize,
+			FixedSegmentSize: fixedSegmentSize,
 
 			RedundancyScheme: storj.RedundancyScheme{
 				Algorithm:      storj.ReedSolomon,
@@ -418,7 +554,15 @@ func (object *mutableObject) DeleteStream(ctx context.Context) (err error) {
 
 func (object *mutableObject) Commit(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, info, err := object.db.getInfo(ctx, object.info.Bucket.Name, object.info.Path)
+
+	if object.info.VersionID != "" {
+		err = object.db.commitVersion(ctx, object.info.Bucket, object.info.Path, object.info.VersionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, info, err := object.db.getInfo(ctx, object.info.Bucket.Name, object.info.Path, object.info.VersionID)
 	object.info = info
 	return err
 }
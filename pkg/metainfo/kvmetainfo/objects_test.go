@@ -4,9 +4,11 @@
 package kvmetainfo_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -266,6 +268,135 @@ func TestDeleteObject(t *testing.T) {
 	})
 }
 
+func TestMoveObject(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
+		data := testrand.Bytes(32 * memory.KiB)
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		otherBucket, err := db.CreateBucket(ctx, TestBucket+"-other", nil)
+		require.NoError(t, err)
+
+		upload(ctx, t, db, streams, bucket, "small-file", []byte("test"))
+		upload(ctx, t, db, streams, bucket, "large-file", data)
+		upload(ctx, t, db, streams, bucket, "existing-file", []byte("replaced"))
+
+		err = db.MoveObject(ctx, bucket.Name, "non-existing-file", bucket.Name, "new-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		err = db.MoveObject(ctx, bucket.Name, "small-file", "non-existing-bucket", "new-file")
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = db.MoveObject(ctx, bucket.Name, "small-file", bucket.Name, "")
+		assert.True(t, storj.ErrNoPath.Has(err))
+
+		err = db.MoveObject(ctx, bucket.Name, "small-file", bucket.Name, "dir/small-file")
+		require.NoError(t, err)
+
+		err = db.MoveObject(ctx, bucket.Name, "large-file", bucket.Name, "existing-file")
+		require.NoError(t, err)
+
+		_, err = db.GetObject(ctx, bucket.Name, "small-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+		_, err = db.GetObject(ctx, bucket.Name, "large-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		assertStream(ctx, t, db, streams, bucket, "dir/small-file", 4, []byte("test"))
+		assertStream(ctx, t, db, streams, bucket, "existing-file", 32*memory.KiB.Int64(), data)
+
+		err = db.MoveObject(ctx, bucket.Name, "existing-file", otherBucket.Name, "large-file")
+		require.NoError(t, err)
+
+		_, err = db.GetObject(ctx, bucket.Name, "existing-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		readOnly, err := db.GetObjectStream(ctx, otherBucket.Name, "large-file")
+		require.NoError(t, err)
+
+		download := stream.NewDownload(ctx, readOnly, streams)
+		defer func() { assert.NoError(t, download.Close()) }()
+
+		moved, err := ioutil.ReadAll(download)
+		require.NoError(t, err)
+		assert.Equal(t, data, moved)
+	})
+}
+
+func TestConcatObjects(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
+		parts := [][]byte{
+			testrand.Bytes(32 * memory.KiB),
+			[]byte("test"),
+			testrand.Bytes(16 * memory.KiB),
+		}
+		paths := []storj.Path{"parts/1", "parts/2", "parts/3"}
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		for i, path := range paths {
+			upload(ctx, t, db, streams, bucket, path, parts[i])
+		}
+		upload(ctx, t, db, streams, bucket, "existing-file", []byte("replaced"))
+
+		createInfo := &storj.CreateObject{
+			ContentType: "media/foo",
+			Metadata:    map[string]string{"key": "value"},
+		}
+
+		err = db.ConcatObjects(ctx, bucket.Name, []storj.Path{"parts/1", "non-existing-file"}, "new-file", createInfo)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		err = db.ConcatObjects(ctx, bucket.Name, paths, "", createInfo)
+		assert.True(t, storj.ErrNoPath.Has(err))
+
+		err = db.ConcatObjects(ctx, bucket.Name, []storj.Path{"parts/1", "parts/1"}, "new-file", createInfo)
+		assert.Error(t, err)
+
+		err = db.ConcatObjects(ctx, bucket.Name, paths, "existing-file", createInfo)
+		require.NoError(t, err)
+
+		for _, path := range paths {
+			_, err = db.GetObject(ctx, bucket.Name, path)
+			assert.True(t, storj.ErrObjectNotFound.Has(err))
+		}
+
+		expected := bytes.Join(parts, nil)
+
+		readOnly, err := db.GetObjectStream(ctx, bucket.Name, "existing-file")
+		require.NoError(t, err)
+
+		info := readOnly.Info()
+		assert.Equal(t, int64(len(expected)), info.Size)
+		assert.Equal(t, "media/foo", info.ContentType)
+		assert.Equal(t, map[string]string{"key": "value"}, info.Metadata)
+
+		download := stream.NewDownload(ctx, readOnly, streams)
+		defer func() { assert.NoError(t, download.Close()) }()
+
+		concatenated, err := ioutil.ReadAll(download)
+		require.NoError(t, err)
+		assert.Equal(t, expected, concatenated)
+
+		// reading a range spanning the parts
+		_, err = download.Seek(30*memory.KiB.Int64(), io.SeekStart)
+		require.NoError(t, err)
+		spanning := make([]byte, 4*memory.KiB.Int())
+		_, err = io.ReadFull(download, spanning)
+		require.NoError(t, err)
+		assert.Equal(t, expected[30*memory.KiB.Int():34*memory.KiB.Int()], spanning)
+
+		// concatenated objects can be moved like any other object
+		err = db.MoveObject(ctx, bucket.Name, "existing-file", bucket.Name, "moved-file")
+		require.NoError(t, err)
+
+		moved, err := db.GetObject(ctx, bucket.Name, "moved-file")
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(expected)), moved.Size)
+	})
+}
+
 func TestListObjectsEmpty(t *testing.T) {
 	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
 		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
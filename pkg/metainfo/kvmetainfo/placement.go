@@ -0,0 +1,50 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// SetBucketPlacement replaces the placement policy of a bucket
+func (db *DB) SetBucketPlacement(ctx context.Context, bucket string, placement storj.Placement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.metainfo.SetBucketPlacement(ctx, bucket, &pb.PlacementPolicy{
+		AllowedCountries: placement.AllowedCountries,
+		DeniedCountries:  placement.DeniedCountries,
+		DeniedNetworks:   placement.DeniedNetworks,
+		RequiredTags:     placement.RequiredTags,
+		DistinctSubnets:  placement.DistinctSubnets,
+	})
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrBucketNotFound.Wrap(err)
+	}
+	return err
+}
+
+// GetBucketPlacement returns the placement policy of a bucket
+func (db *DB) GetBucketPlacement(ctx context.Context, bucket string) (_ storj.Placement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	policy, err := db.metainfo.GetBucketPlacement(ctx, bucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return storj.Placement{}, err
+	}
+
+	return storj.Placement{
+		AllowedCountries: policy.GetAllowedCountries(),
+		DeniedCountries:  policy.GetDeniedCountries(),
+		DeniedNetworks:   policy.GetDeniedNetworks(),
+		RequiredTags:     policy.GetRequiredTags(),
+		DistinctSubnets:  policy.GetDistinctSubnets(),
+	}, nil
+}
@@ -109,6 +109,9 @@ func (stream *readonlyStream) Segments(ctx context.Context, index int64, limit i
 	if index < 0 {
 		return nil, false, errors.New("invalid argument")
 	}
+	if stream.info.FixedSegmentSize <= 0 {
+		return nil, false, errors.New("not implemented")
+	}
 	if limit <= 0 {
 		limit = defaultSegmentLimit
 	}
@@ -0,0 +1,422 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/objects"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// The latest version of an object in a versioned bucket is stored at the path
+// of the object, so it's found and listed like an object in a bucket without
+// versioning. Its version ID is kept in the stream metadata stored on the
+// satellite. All previous versions are stored as separate objects under
+// storj.VersionsDir/<path>/<version id>.
+//
+// New versions are uploaded to storj.VersionsDir and committed by moving the
+// new version to the path of the object, while the satellite moves the latest
+// version out of the way in the same operation. Moving an object only
+// re-encrypts the keys of its segments.
+//
+// Objects stored before versioning was enabled have the version ID
+// storj.NullVersionID, which sorts before all other version IDs.
+
+// deleteMarkerKey is the metadata key, which marks a version as a delete marker
+const deleteMarkerKey = "storj-delete-marker"
+
+// newVersionID returns a new version ID, which sorts after the ID of the
+// latest version of the object. Version IDs start with a sequence number per
+// object, so they don't depend on the clock of the uplink, and end with a
+// random suffix, which distinguishes versions created concurrently.
+func newVersionID(latest string) (string, error) {
+	var sequence uint64
+	if latest != storj.NullVersionID && len(latest) >= 16 {
+		var err error
+		sequence, err = strconv.ParseUint(latest[:16], 16, 64)
+		if err != nil {
+			return "", errClass.New("invalid version id %q", latest)
+		}
+	}
+
+	var suffix [4]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return "", errClass.Wrap(err)
+	}
+	return fmt.Sprintf("%016x%s", sequence+1, hex.EncodeToString(suffix[:])), nil
+}
+
+// compareVersions compares two version IDs by their age.
+func compareVersions(a, b string) int {
+	if a == storj.NullVersionID {
+		a = ""
+	}
+	if b == storj.NullVersionID {
+		b = ""
+	}
+	return strings.Compare(a, b)
+}
+
+// latestVersionID returns the version ID of the latest version of an object
+// from the version ID in its stream metadata.
+func latestVersionID(bucket storj.Bucket, versionID string) string {
+	if !bucket.Versioning {
+		return ""
+	}
+	if versionID == "" {
+		return storj.NullVersionID
+	}
+	return versionID
+}
+
+// setVersion sets the version information of the object.
+func setVersion(info *storj.Object, versionID string) {
+	info.VersionID = versionID
+	if _, ok := info.Metadata[deleteMarkerKey]; ok {
+		info.IsDeleteMarker = true
+		delete(info.Metadata, deleteMarkerKey)
+	}
+}
+
+// isVersionsPath returns whether the path listed under prefix is in storj.VersionsDir.
+func isVersionsPath(prefix, path storj.Path) bool {
+	if strings.TrimSuffix(prefix, "/") != "" {
+		return false
+	}
+	return path == storj.VersionsDir+"/" || strings.HasPrefix(path, storj.VersionsDir+"/")
+}
+
+// joinPrefix returns the full path of a path listed under prefix.
+func joinPrefix(prefix, path storj.Path) storj.Path {
+	if prefix = strings.TrimSuffix(prefix, "/"); prefix != "" {
+		return storj.JoinPaths(prefix, path)
+	}
+	return path
+}
+
+// nextVersionID returns the version ID for a new version of the object at path.
+func (db *DB) nextVersionID(ctx context.Context, bucket storj.Bucket, path storj.Path) (versionID string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, latest, err := db.getVersionInfo(ctx, bucket, path, "", true)
+	if err != nil && !storj.ErrObjectNotFound.Has(err) {
+		return "", err
+	}
+	return newVersionID(latest.VersionID)
+}
+
+// commitVersion makes the uploaded version of the object at path the latest
+// version. The uploaded version is moved to the path of the object and the
+// current latest version is moved to storj.VersionsDir by the satellite in a
+// single operation, so a failure leaves the latest version in place.
+func (db *DB) commitVersion(ctx context.Context, bucket storj.Bucket, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, version, err := db.getVersionInfo(ctx, bucket, path, versionID, false)
+	if err != nil {
+		return err
+	}
+
+	return db.moveStream(ctx, version, bucket, path, versionID, true)
+}
+
+// putDeleteMarker creates a new version of the object at path, which marks it
+// as deleted. latest is the version ID of the latest version of the object.
+func (db *DB) putDeleteMarker(ctx context.Context, bucket storj.Bucket, path storj.Path, latest string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionID, err := newVersionID(latest)
+	if err != nil {
+		return err
+	}
+
+	prefixed := prefixedObjStore{
+		store:  objects.NewStore(db.streams, bucket.PathCipher),
+		prefix: bucket.Name,
+	}
+
+	metadata := pb.SerializableMeta{
+		UserDefined: map[string]string{deleteMarkerKey: "true"},
+	}
+
+	_, err = prefixed.Put(ctx, storj.Object{Path: path, VersionID: versionID}.StreamPath(), bytes.NewReader(nil), metadata, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	return db.commitVersion(ctx, bucket, path, versionID)
+}
+
+// deleteVersion deletes a version of an object. If the latest version is
+// deleted, the newest previous version becomes the latest version.
+func (db *DB) deleteVersion(ctx context.Context, version storj.Object) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := version.Bucket
+	prefixed := prefixedObjStore{
+		store:  objects.NewStore(db.streams, bucket.PathCipher),
+		prefix: bucket.Name,
+	}
+
+	if !version.IsLatest {
+		return prefixed.Delete(ctx, version.StreamPath())
+	}
+
+	previous, err := db.previousVersions(ctx, bucket, version.Path)
+	if err != nil {
+		return err
+	}
+	if len(previous) == 0 {
+		return prefixed.Delete(ctx, version.Path)
+	}
+
+	_, newest, err := db.getVersionInfo(ctx, bucket, version.Path, previous[0].VersionID, false)
+	if err != nil {
+		return err
+	}
+
+	// moving the previous version replaces the deleted version
+	return db.moveStream(ctx, newest, bucket, version.Path, newest.VersionID, false)
+}
+
+// previousVersions returns the versions of the object at path, which are
+// stored in storj.VersionsDir, from the newest to the oldest one.
+func (db *DB) previousVersions(ctx context.Context, bucket storj.Bucket, path storj.Path) (versions []storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefixed := prefixedObjStore{
+		store:  objects.NewStore(db.streams, bucket.PathCipher),
+		prefix: bucket.Name,
+	}
+
+	prefix := storj.JoinPaths(storj.VersionsDir, path)
+
+	startAfter := ""
+	for {
+		items, more, err := prefixed.List(ctx, prefix, startAfter, "", false, storage.LookupLimit, meta.All)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if item.IsPrefix {
+				// versions of objects with path as prefix
+				continue
+			}
+			version := objectFromMeta(bucket, path, false, item.Meta)
+			setVersion(&version, item.Path)
+			versions = append(versions, version)
+		}
+
+		// items are ordered by their encrypted path, so only the last item
+		// can be used as a cursor for the next page
+		if !more || len(items) == 0 {
+			break
+		}
+		startAfter = items[len(items)-1].Path
+	}
+
+	sort.Slice(versions, func(i, k int) bool {
+		return compareVersions(versions[i].VersionID, versions[k].VersionID) > 0
+	})
+
+	return versions, nil
+}
+
+// listVersions lists all versions of the objects in a bucket. The objects are
+// listed in the same order as without versions, each of them followed by its
+// previous versions from the newest to the oldest one.
+func (db *DB) listVersions(ctx context.Context, bucket storj.Bucket, options storj.ListOptions) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var startAfter string
+	switch options.Direction {
+	case storj.Forward:
+		// forward lists forwards from cursor, including cursor
+		startAfter = keyBefore(options.Cursor)
+	case storj.After:
+		// after lists forwards from cursor, without cursor
+		startAfter = options.Cursor
+	default:
+		return storj.ObjectList{}, errClass.New("invalid direction %d for listing versions", options.Direction)
+	}
+
+	limit := options.Limit
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	list = storj.ObjectList{
+		Bucket: bucket.Name,
+		Prefix: options.Prefix,
+	}
+
+	if options.Cursor != "" && options.VersionCursor != "" {
+		// continue with the versions of the object at cursor
+		versions, err := db.objectVersions(ctx, bucket, joinPrefix(options.Prefix, options.Cursor))
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return storj.ObjectList{}, err
+		}
+		for _, version := range versions {
+			cmp := compareVersions(version.VersionID, options.VersionCursor)
+			if cmp < 0 || (cmp == 0 && options.Direction == storj.Forward) {
+				version.Path = options.Cursor
+				list.Items = append(list.Items, version)
+			}
+		}
+		startAfter = options.Cursor
+	}
+
+	prefixed := prefixedObjStore{
+		store:  objects.NewStore(db.streams, bucket.PathCipher),
+		prefix: bucket.Name,
+	}
+
+	// one more item than requested is collected to find out, whether there are more
+	var latest []storj.Object
+	for len(list.Items)+len(latest) <= limit {
+		items, more, err := prefixed.List(ctx, options.Prefix, startAfter, "", options.Recursive, limit, meta.All)
+		if err != nil {
+			return storj.ObjectList{}, err
+		}
+
+		for _, item := range items {
+			if len(list.Items)+len(latest) > limit {
+				break
+			}
+			if isVersionsPath(options.Prefix, item.Path) {
+				continue
+			}
+			if item.IsPrefix {
+				latest = append(latest, objectFromMeta(bucket, item.Path, true, item.Meta))
+				continue
+			}
+
+			object := objectFromMeta(bucket, item.Path, false, item.Meta)
+			object.IsLatest = true
+			setVersion(&object, latestVersionID(bucket, item.Meta.VersionID))
+			latest = append(latest, object)
+		}
+
+		if !more || len(items) == 0 {
+			break
+		}
+		startAfter = items[len(items)-1].Path
+	}
+
+	previous, err := db.listPreviousVersions(ctx, bucket, options.Prefix, latest)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	for _, object := range latest {
+		list.Items = append(list.Items, object)
+		if !object.IsPrefix {
+			list.Items = append(list.Items, previous[object.Path]...)
+		}
+	}
+
+	if len(list.Items) > limit {
+		list.Items = list.Items[:limit]
+		list.More = true
+	}
+
+	return list, nil
+}
+
+// listPreviousVersions returns the versions of the objects listed under
+// prefix, which are stored in storj.VersionsDir, from the newest to the oldest
+// one by the path of the object relative to prefix. The versions of all
+// objects are found by a single recursive listing of storj.VersionsDir under
+// prefix instead of a listing per object.
+func (db *DB) listPreviousVersions(ctx context.Context, bucket storj.Bucket, prefix storj.Path, listed []storj.Object) (versions map[storj.Path][]storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versions = make(map[storj.Path][]storj.Object)
+	for _, object := range listed {
+		if !object.IsPrefix {
+			versions[object.Path] = nil
+		}
+	}
+	if len(versions) == 0 {
+		return versions, nil
+	}
+
+	prefixed := prefixedObjStore{
+		store:  objects.NewStore(db.streams, bucket.PathCipher),
+		prefix: bucket.Name,
+	}
+
+	versionsPrefix := storj.VersionsDir
+	if prefix = strings.TrimSuffix(prefix, "/"); prefix != "" {
+		versionsPrefix = storj.JoinPaths(storj.VersionsDir, prefix)
+	}
+
+	startAfter := ""
+	for {
+		items, more, err := prefixed.List(ctx, versionsPrefix, startAfter, "", true, storage.LookupLimit, meta.All)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			// the version ID is the last component of the path
+			i := strings.LastIndex(item.Path, "/")
+			if i < 0 {
+				continue
+			}
+			path, versionID := item.Path[:i], item.Path[i+1:]
+
+			previous, ok := versions[path]
+			if !ok {
+				// versions of an object, which isn't listed
+				continue
+			}
+			version := objectFromMeta(bucket, path, false, item.Meta)
+			setVersion(&version, versionID)
+			versions[path] = append(previous, version)
+		}
+
+		if !more || len(items) == 0 {
+			break
+		}
+		startAfter = items[len(items)-1].Path
+	}
+
+	for _, previous := range versions {
+		sort.Slice(previous, func(i, k int) bool {
+			return compareVersions(previous[i].VersionID, previous[k].VersionID) > 0
+		})
+	}
+
+	return versions, nil
+}
+
+// objectVersions returns all versions of the object at path, starting with
+// the latest version.
+func (db *DB) objectVersions(ctx context.Context, bucket storj.Bucket, path storj.Path) (versions []storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, latest, err := db.getVersionInfo(ctx, bucket, path, "", true)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := db.previousVersions(ctx, bucket, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]storj.Object{latest}, previous...), nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestObjectVersions(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.EncAESGCM, Versioning: true})
+		require.NoError(t, err)
+
+		bucket, err = db.GetBucket(ctx, TestBucket)
+		require.NoError(t, err)
+		require.True(t, bucket.Versioning)
+
+		upload(ctx, t, db, streams, bucket, TestFile, []byte("first"))
+		upload(ctx, t, db, streams, bucket, TestFile, []byte("second"))
+		upload(ctx, t, db, streams, bucket, "dir/file", []byte("nested"))
+
+		// the latest version is returned by default
+		latest, err := db.GetObject(ctx, bucket.Name, TestFile)
+		require.NoError(t, err)
+		assert.Equal(t, TestFile, latest.Path)
+		assert.NotEmpty(t, latest.VersionID)
+		assert.Equal(t, []byte("second"), readVersion(ctx, t, db, streams, TestFile, ""))
+
+		versions, err := db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After, Recursive: true, Versions: true})
+		require.NoError(t, err)
+		require.Len(t, versions.Items, 3)
+		assert.Equal(t, "dir/file", versions.Items[0].Path)
+		assert.Equal(t, TestFile, versions.Items[1].Path)
+		assert.Equal(t, latest.VersionID, versions.Items[1].VersionID)
+		assert.True(t, versions.Items[1].IsLatest)
+		assert.Equal(t, TestFile, versions.Items[2].Path)
+		assert.False(t, versions.Items[2].IsLatest)
+
+		first := versions.Items[2].VersionID
+		assert.True(t, first < latest.VersionID, "version ids increase")
+		assert.Equal(t, []byte("first"), readVersion(ctx, t, db, streams, TestFile, first))
+
+		// paging through the versions
+		page, err := db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After, Recursive: true, Versions: true, Limit: 2})
+		require.NoError(t, err)
+		assert.True(t, page.More)
+		require.Len(t, page.Items, 2)
+		page, err = db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After, Recursive: true, Versions: true, Limit: 2}.NextPage(page))
+		require.NoError(t, err)
+		assert.False(t, page.More)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, first, page.Items[0].VersionID)
+
+		list, err := db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dir/", TestFile}, listPaths(list))
+		assert.Equal(t, latest.VersionID, list.Items[1].VersionID)
+
+		// deleting creates a delete marker
+		err = db.DeleteObject(ctx, bucket.Name, TestFile)
+		require.NoError(t, err)
+
+		_, err = db.GetObject(ctx, bucket.Name, TestFile)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		err = db.DeleteObject(ctx, bucket.Name, TestFile)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		list, err = db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After, Recursive: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dir/file"}, listPaths(list))
+
+		versions, err = db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After, Prefix: TestFile, Recursive: true, Versions: true})
+		require.NoError(t, err)
+		assert.Empty(t, versions.Items, "versions of the object at the prefix itself aren't listed")
+
+		versions, err = db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After, Versions: true})
+		require.NoError(t, err)
+		require.Len(t, versions.Items, 4)
+		assert.True(t, versions.Items[0].IsPrefix)
+		marker := versions.Items[1]
+		assert.True(t, marker.IsDeleteMarker)
+		assert.True(t, marker.IsLatest)
+
+		_, err = db.GetObjectVersionStream(ctx, bucket.Name, TestFile, marker.VersionID)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		// removing the delete marker restores the object
+		err = db.DeleteObjectVersion(ctx, bucket.Name, TestFile, marker.VersionID)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("second"), readVersion(ctx, t, db, streams, TestFile, ""))
+
+		// removing the latest version makes the previous version the latest
+		err = db.DeleteObjectVersion(ctx, bucket.Name, TestFile, latest.VersionID)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("first"), readVersion(ctx, t, db, streams, TestFile, ""))
+
+		err = db.DeleteObjectVersion(ctx, bucket.Name, TestFile, latest.VersionID)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+	})
+}
+
+func readVersion(ctx context.Context, t *testing.T, db *kvmetainfo.DB, streams streams.Store, path storj.Path, versionID string) []byte {
+	readOnly, err := db.GetObjectVersionStream(ctx, TestBucket, path, versionID)
+	require.NoError(t, err)
+	assert.Equal(t, path, readOnly.Info().Path)
+
+	download := stream.NewDownload(ctx, readOnly, streams)
+	defer func() { assert.NoError(t, download.Close()) }()
+
+	data, err := ioutil.ReadAll(download)
+	require.NoError(t, err)
+	return data
+}
+
+func listPaths(list storj.ObjectList) []string {
+	paths := []string{}
+	for _, item := range list.Items {
+		paths = append(paths, item.Path)
+	}
+	return paths
+}
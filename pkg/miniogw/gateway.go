@@ -38,7 +38,6 @@ func NewStorjGateway(project *uplink.Project, access *uplink.EncryptionAccess, p
 		encryption:  encryption,
 		redundancy:  redundancy,
 		segmentSize: segmentSize,
-		multipart:   NewMultipartUploads(),
 	}
 }
 
@@ -50,7 +49,6 @@ type Gateway struct {
 	encryption  storj.EncryptionParameters
 	redundancy  storj.RedundancyScheme
 	segmentSize memory.Size
-	multipart   *MultipartUploads
 }
 
 // Name implements cmd.Gateway
@@ -68,6 +66,19 @@ func (gateway *Gateway) Production() bool {
 	return false
 }
 
+// gatewayLayer implements minio.ObjectLayer on top of a storj project.
+//
+// The vendored minio ObjectLayer has no API for versioning: there's no bucket
+// versioning configuration and no way to read, list or delete an object by its
+// version ID. The gateway therefore only sees the latest version of objects in
+// versioned buckets, where a delete creates a delete marker. Previous versions
+// are only accessible through lib/uplink.
+//
+// The vendored minio doesn't route the ?lifecycle bucket requests to the
+// ObjectLayer either and rejects them as not implemented. The lifecycle of a
+// bucket is mapped by SetBucketLifecycle and GetBucketLifecycle, which are
+// ready for a minio routing them; until then lifecycle rules are configured
+// through lib/uplink.
 type gatewayLayer struct {
 	minio.GatewayUnsupported
 	gateway *Gateway
@@ -99,7 +110,8 @@ func (layer *gatewayLayer) bucketEmpty(ctx context.Context, bucketName string) (
 	}
 	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	list, err := bucket.ListObjects(ctx, &storj.ListOptions{Direction: storj.After, Recursive: true, Limit: 1})
+	// versions and delete markers have to be removed before deleting a bucket
+	list, err := bucket.ListObjects(ctx, &storj.ListOptions{Direction: storj.After, Recursive: true, Versions: true, Limit: 1})
 	if err != nil {
 		return false, convertError(err, bucketName, "")
 	}
@@ -250,6 +262,10 @@ func (layer *gatewayLayer) ListObjects(ctx context.Context, bucketName, prefix,
 
 	if len(list.Items) > 0 {
 		for _, item := range list.Items {
+			if isMultipartPath(prefix, item.Path) {
+				// pending multipart uploads aren't objects
+				continue
+			}
 			path := item.Path
 			if recursive && prefix != "" {
 				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
@@ -324,6 +340,10 @@ func (layer *gatewayLayer) ListObjectsV2(ctx context.Context, bucketName, prefix
 
 	if len(list.Items) > 0 {
 		for _, item := range list.Items {
+			if isMultipartPath(prefix, item.Path) {
+				// pending multipart uploads aren't objects
+				continue
+			}
 			path := item.Path
 			if recursive && prefix != "" {
 				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
@@ -398,27 +418,16 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 	}
 	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	object, err := bucket.OpenObject(ctx, srcObject)
-	if err != nil {
-		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
-	}
-	defer func() { err = errs.Combine(err, object.Close()) }()
-
-	reader, err := object.DownloadRange(ctx, 0, -1)
+	err = bucket.CopyObject(ctx, srcObject, destBucket, destObject)
 	if err != nil {
+		if storj.ErrNoBucket.Has(err) || storj.ErrBucketNotFound.Has(err) {
+			// the source bucket has been opened already
+			return minio.ObjectInfo{}, convertError(err, destBucket, "")
+		}
 		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
 	}
-	defer func() { err = errs.Combine(err, reader.Close()) }()
-
-	opts := uplink.UploadOptions{
-		ContentType: object.Meta.ContentType,
-		Metadata:    object.Meta.Metadata,
-		Expires:     object.Meta.Expires,
-	}
-	opts.Volatile.EncryptionParameters = object.Meta.Volatile.EncryptionParameters
-	opts.Volatile.RedundancyScheme = object.Meta.Volatile.RedundancyScheme
 
-	return layer.putObject(ctx, destBucket, destObject, reader, &opts)
+	return layer.GetObjectInfo(ctx, destBucket, destObject)
 }
 
 func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath string, reader io.Reader, opts *uplink.UploadOptions) (objInfo minio.ObjectInfo, err error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/minio/minio/pkg/auth"
 	"github.com/minio/minio/pkg/hash"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vivint/infectious"
 
 	"storj.io/storj/internal/memory"
@@ -124,6 +125,113 @@ func TestDeleteBucket(t *testing.T) {
 	})
 }
 
+func TestVersionedBucket(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, m storj.Metainfo, strms streams.Store) {
+		_, err := m.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.EncAESGCM, Versioning: true})
+		require.NoError(t, err)
+
+		// Overwriting an object through the Minio API keeps the previous version
+		_, err = layer.PutObject(ctx, TestBucket, TestFile, newPartReader(t, []byte("first")), nil)
+		require.NoError(t, err)
+		_, err = layer.PutObject(ctx, TestBucket, TestFile, newPartReader(t, []byte("second")), nil)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, &buf, "")
+		require.NoError(t, err)
+		assert.Equal(t, "second", buf.String())
+
+		// Deleting the object leaves a delete marker
+		err = layer.DeleteObject(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+
+		_, err = layer.GetObjectInfo(ctx, TestBucket, TestFile)
+		assert.Equal(t, minio.ObjectNotFound{Bucket: TestBucket, Object: TestFile}, err)
+
+		list, err := layer.ListObjects(ctx, TestBucket, "", "", "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, list.Objects)
+
+		versions, err := m.ListObjects(ctx, TestBucket, storj.ListOptions{Direction: storj.After, Recursive: true, Versions: true})
+		require.NoError(t, err)
+		require.Len(t, versions.Items, 3)
+
+		// Check the error when deleting a bucket, which still has versions
+		err = layer.DeleteBucket(ctx, TestBucket)
+		assert.Equal(t, minio.BucketNotEmpty{Bucket: TestBucket}, err)
+
+		for _, version := range versions.Items {
+			err = m.DeleteObjectVersion(ctx, TestBucket, version.Path, version.VersionID)
+			require.NoError(t, err)
+		}
+
+		err = layer.DeleteBucket(ctx, TestBucket)
+		assert.NoError(t, err)
+	})
+}
+
+func TestBucketLifecycle(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, m storj.Metainfo, strms streams.Store) {
+		gateway := layer.(*gatewayLayer)
+
+		config := &LifecycleConfiguration{
+			Rules: []LifecycleRule{{
+				ID:         "logs",
+				Status:     "Enabled",
+				Filter:     &LifecycleFilter{Prefix: "logs/"},
+				Expiration: &LifecycleExpiration{Days: 7},
+			}},
+		}
+
+		// Check the error when setting the lifecycle of a missing bucket
+		err := gateway.SetBucketLifecycle(ctx, TestBucket, config)
+		assert.Equal(t, minio.BucketNotFound{Bucket: TestBucket}, err)
+
+		_, err = m.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.EncAESGCM})
+		require.NoError(t, err)
+
+		got, err := gateway.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Empty(t, got.Rules)
+
+		err = gateway.SetBucketLifecycle(ctx, TestBucket, config)
+		require.NoError(t, err)
+
+		got, err = gateway.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Equal(t, config, got)
+
+		// The deprecated prefix of a rule is supported too
+		prefix := "tmp/"
+		err = gateway.SetBucketLifecycle(ctx, TestBucket, &LifecycleConfiguration{
+			Rules: []LifecycleRule{{Status: "Enabled", Prefix: &prefix, Expiration: &LifecycleExpiration{Days: 1}}},
+		})
+		require.NoError(t, err)
+
+		rules, err := m.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Equal(t, []storj.LifecycleRule{{Prefix: "tmp/", ExpirationDays: 1}}, rules)
+
+		// Check the unsupported rules
+		for _, rule := range []LifecycleRule{
+			{Status: "Disabled", Expiration: &LifecycleExpiration{Days: 1}},
+			{Status: "Enabled", Expiration: &LifecycleExpiration{Date: "2019-01-01T00:00:00Z"}},
+			{Status: "Enabled", Expiration: &LifecycleExpiration{Days: 1}, Transitions: []struct{}{{}}},
+			{Status: "Enabled", Expiration: &LifecycleExpiration{Days: 1}, Filter: &LifecycleFilter{Tag: &struct{}{}}},
+		} {
+			err = gateway.SetBucketLifecycle(ctx, TestBucket, &LifecycleConfiguration{Rules: []LifecycleRule{rule}})
+			assert.Equal(t, minio.NotImplemented{}, err)
+		}
+
+		err = gateway.DeleteBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+
+		got, err = gateway.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Empty(t, got.Rules)
+	})
+}
+
 func TestListBuckets(t *testing.T) {
 	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, m storj.Metainfo, strms streams.Store) {
 		// Check that empty list is return if no buckets exist yet
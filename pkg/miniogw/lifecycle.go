@@ -0,0 +1,150 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"encoding/xml"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/lib/uplink"
+)
+
+// LifecycleConfiguration is the S3 lifecycle configuration of a bucket.
+type LifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"LifecycleConfiguration"`
+	Rules   []LifecycleRule `xml:"Rule"`
+}
+
+// LifecycleRule is a rule of the S3 lifecycle configuration. Only enabled
+// rules expiring objects after a number of days are supported.
+type LifecycleRule struct {
+	ID     string           `xml:"ID,omitempty"`
+	Status string           `xml:"Status"`
+	Filter *LifecycleFilter `xml:"Filter,omitempty"`
+	// Prefix is the deprecated way of filtering the objects of a rule
+	Prefix     *string              `xml:"Prefix,omitempty"`
+	Expiration *LifecycleExpiration `xml:"Expiration,omitempty"`
+
+	Transitions                 []struct{} `xml:"Transition"`
+	NoncurrentVersionExpiration *struct{}  `xml:"NoncurrentVersionExpiration"`
+}
+
+// LifecycleFilter selects the objects a lifecycle rule applies to
+type LifecycleFilter struct {
+	Prefix string    `xml:"Prefix"`
+	Tag    *struct{} `xml:"Tag"`
+	And    *struct{} `xml:"And"`
+}
+
+// LifecycleExpiration is the expiration action of a lifecycle rule
+type LifecycleExpiration struct {
+	Days int    `xml:"Days,omitempty"`
+	Date string `xml:"Date,omitempty"`
+}
+
+const lifecycleEnabled = "Enabled"
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket
+func (layer *gatewayLayer) SetBucketLifecycle(ctx context.Context, bucketName string, config *LifecycleConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := lifecycleRulesFromS3(config)
+	if err != nil {
+		return err
+	}
+
+	return layer.setLifecycle(ctx, bucketName, rules)
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket. A bucket
+// without lifecycle configuration has no rules.
+func (layer *gatewayLayer) GetBucketLifecycle(ctx context.Context, bucketName string) (config *LifecycleConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return nil, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	rules, err := bucket.GetLifecycle(ctx)
+	if err != nil {
+		return nil, convertError(err, bucketName, "")
+	}
+	return lifecycleRulesToS3(rules), nil
+}
+
+// DeleteBucketLifecycle removes the lifecycle rules of a bucket
+func (layer *gatewayLayer) DeleteBucketLifecycle(ctx context.Context, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return layer.setLifecycle(ctx, bucketName, nil)
+}
+
+func (layer *gatewayLayer) setLifecycle(ctx context.Context, bucketName string, rules []uplink.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	return convertError(bucket.SetLifecycle(ctx, rules), bucketName, "")
+}
+
+// lifecycleRulesFromS3 converts the S3 lifecycle configuration, failing with
+// minio.NotImplemented for the features, which aren't supported.
+func lifecycleRulesFromS3(config *LifecycleConfiguration) ([]uplink.LifecycleRule, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	rules := make([]uplink.LifecycleRule, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		if rule.Status != lifecycleEnabled {
+			return nil, minio.NotImplemented{}
+		}
+		if len(rule.Transitions) > 0 || rule.NoncurrentVersionExpiration != nil {
+			return nil, minio.NotImplemented{}
+		}
+		if rule.Expiration == nil || rule.Expiration.Date != "" || rule.Expiration.Days <= 0 {
+			return nil, minio.NotImplemented{}
+		}
+
+		var prefix string
+		switch {
+		case rule.Filter != nil:
+			if rule.Filter.Tag != nil || rule.Filter.And != nil {
+				return nil, minio.NotImplemented{}
+			}
+			prefix = rule.Filter.Prefix
+		case rule.Prefix != nil:
+			prefix = *rule.Prefix
+		}
+
+		rules = append(rules, uplink.LifecycleRule{
+			ID:             rule.ID,
+			Prefix:         prefix,
+			ExpirationDays: rule.Expiration.Days,
+		})
+	}
+	return rules, nil
+}
+
+func lifecycleRulesToS3(rules []uplink.LifecycleRule) *LifecycleConfiguration {
+	config := &LifecycleConfiguration{}
+	for _, rule := range rules {
+		config.Rules = append(config.Rules, LifecycleRule{
+			ID:         rule.ID,
+			Status:     lifecycleEnabled,
+			Filter:     &LifecycleFilter{Prefix: rule.Prefix},
+			Expiration: &LifecycleExpiration{Days: rule.ExpirationDays},
+		})
+	}
+	return config
+}
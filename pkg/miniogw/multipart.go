@@ -4,452 +4,572 @@
 package miniogw
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"sort"
 	"strconv"
-	"sync"
-	"sync/atomic"
+	"strings"
 	"time"
 
 	minio "github.com/minio/minio/cmd"
 	"github.com/minio/minio/pkg/hash"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
 )
 
-func (layer *gatewayLayer) NewMultipartUpload(ctx context.Context, bucket, object string, metadata map[string]string) (uploadID string, err error) {
-	defer mon.Task()(&ctx)(&err)
+// Pending multipart uploads are stored in the bucket itself, so they survive
+// gateway restarts and every part is committed to the network independently:
+//
+//	.storj-multipart/uploads/<upload id>             upload info and metadata
+//	.storj-multipart/parts/<upload id>/<part number> uploaded parts
+//
+// CompleteMultipartUpload concatenates the parts into the final object on the
+// satellite. The data of the parts isn't transferred again, the segments of
+// the parts become the segments of the final object and keep the sizes and
+// the encryption they were uploaded with.
+const (
+	multipartDir     = ".storj-multipart"
+	multipartUploads = multipartDir + "/uploads/"
+	multipartParts   = multipartDir + "/parts/"
+
+	// multipartObjectKey is the metadata key of the upload info, which stores
+	// the object name the upload was initiated for
+	multipartObjectKey = "storj-multipart-object"
+	// multipartETagKey is the metadata key, which stores the etag of a part
+	multipartETagKey = "storj-multipart-etag"
+
+	// maxPartNumber is the largest part number allowed by S3
+	maxPartNumber = 10000
+)
 
-	// Check that the bucket exists
-	_, _, err = layer.gateway.project.GetBucketInfo(ctx, bucket)
-	if err != nil {
-		return "", convertError(err, bucket, "")
+// isMultipartPath returns whether the path, listed with prefix, belongs to the pending multipart uploads
+func isMultipartPath(prefix, path string) bool {
+	full := path
+	if prefix := strings.TrimSuffix(prefix, "/"); prefix != "" {
+		full = storj.JoinPaths(prefix, path)
 	}
+	return full == multipartDir || strings.HasPrefix(full, multipartDir+"/")
+}
 
-	uploads := layer.gateway.multipart
+func uploadInfoPath(uploadID string) string {
+	return multipartUploads + uploadID
+}
 
-	upload, err := uploads.Create(bucket, object, metadata)
-	if err != nil {
-		return "", err
-	}
+func uploadPartsPrefix(uploadID string) string {
+	return multipartParts + uploadID + "/"
+}
 
-	go func() {
-		contentType := metadata["content-type"]
-		delete(metadata, "content-type")
+func uploadPartPath(uploadID string, partID int) string {
+	return uploadPartsPrefix(uploadID) + fmt.Sprintf("%05d", partID)
+}
 
-		opts := uplink.UploadOptions{
-			ContentType: contentType,
-			Metadata:    metadata,
-		}
-		objInfo, err := layer.putObject(ctx, bucket, object, upload.Stream, &opts)
+func partETagPath(partPath string) string {
+	return partPath + ".etag"
+}
 
-		uploads.RemoveByID(upload.ID)
+// newUploadID returns a new random upload id
+func newUploadID() (string, error) {
+	var id [16]byte
+	_, err := rand.Read(id[:])
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return hex.EncodeToString(id[:]), nil
+}
 
-		if err != nil {
-			upload.fail(err)
-		} else {
-			upload.complete(objInfo)
-		}
-	}()
+// multipartUpload is the info about a pending upload
+type multipartUpload struct {
+	ID          string
+	Object      string
+	Initiated   time.Time
+	ContentType string
+	Metadata    map[string]string
+}
 
-	return upload.ID, nil
+// multipartUploadFromObject parses the upload info stored in the object
+func multipartUploadFromObject(uploadID string, object storj.Object) multipartUpload {
+	upload := multipartUpload{
+		ID:          uploadID,
+		Object:      object.Metadata[multipartObjectKey],
+		Initiated:   object.Created,
+		ContentType: object.ContentType,
+		Metadata:    map[string]string{},
+	}
+	for key, value := range object.Metadata {
+		if key != multipartObjectKey {
+			upload.Metadata[key] = value
+		}
+	}
+	return upload
 }
 
-func (layer *gatewayLayer) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data *hash.Reader) (info minio.PartInfo, err error) {
+func (layer *gatewayLayer) NewMultipartUpload(ctx context.Context, bucketName, object string, metadata map[string]string) (uploadID string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-
-	upload, err := uploads.Get(bucket, object, uploadID)
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
 	if err != nil {
-		return minio.PartInfo{}, err
+		return "", convertError(err, bucketName, "")
 	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	part, err := upload.Stream.AddPart(partID, data)
+	uploadID, err = newUploadID()
 	if err != nil {
-		return minio.PartInfo{}, err
+		return "", err
 	}
 
-	err = <-part.Done
-	if err != nil {
-		return minio.PartInfo{}, err
+	opts := uplink.UploadOptions{
+		ContentType: metadata["content-type"],
+		Metadata:    map[string]string{multipartObjectKey: object},
 	}
-
-	partInfo := minio.PartInfo{
-		PartNumber:   part.Number,
-		LastModified: time.Now(),
-		ETag:         data.SHA256HexString(),
-		Size:         atomic.LoadInt64(&part.Size),
+	for key, value := range metadata {
+		if key != "content-type" {
+			opts.Metadata[key] = value
+		}
 	}
 
-	upload.addCompletedPart(partInfo)
+	err = bucket.UploadObject(ctx, uploadInfoPath(uploadID), bytes.NewReader(nil), &opts)
+	if err != nil {
+		return "", convertError(err, bucketName, object)
+	}
 
-	return partInfo, nil
+	return uploadID, nil
 }
 
-func (layer *gatewayLayer) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) (err error) {
+// getMultipartUpload returns the pending upload with the id, which must belong to the object
+func (layer *gatewayLayer) getMultipartUpload(ctx context.Context, bucket *uplink.Bucket, object, uploadID string) (_ multipartUpload, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
+	if uploadID == "" || strings.Contains(uploadID, "/") {
+		return multipartUpload{}, minio.MalformedUploadID{UploadID: uploadID}
+	}
 
-	upload, err := uploads.Remove(bucket, object, uploadID)
+	info, err := bucket.OpenObject(ctx, uploadInfoPath(uploadID))
 	if err != nil {
-		return err
+		if storj.ErrObjectNotFound.Has(err) {
+			return multipartUpload{}, minio.InvalidUploadID{UploadID: uploadID}
+		}
+		return multipartUpload{}, convertError(err, bucket.Name, object)
 	}
+	defer func() { err = errs.Combine(err, info.Close()) }()
 
-	errAbort := Error.New("abort")
-	upload.Stream.Abort(errAbort)
-	r := <-upload.Done
-	if r.Error != errAbort {
-		return r.Error
+	upload := multipartUploadFromObject(uploadID, storj.Object{
+		Created:     info.Meta.Created,
+		ContentType: info.Meta.ContentType,
+		Metadata:    info.Meta.Metadata,
+	})
+	if upload.Object != object {
+		return multipartUpload{}, minio.InvalidUploadID{UploadID: uploadID}
 	}
-	return nil
+
+	return upload, nil
 }
 
-func (layer *gatewayLayer) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, uploadedParts []minio.CompletePart) (objInfo minio.ObjectInfo, err error) {
+func (layer *gatewayLayer) PutObjectPart(ctx context.Context, bucketName, object, uploadID string, partID int, data *hash.Reader) (info minio.PartInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Remove(bucket, object, uploadID)
-	if err != nil {
-		return minio.ObjectInfo{}, err
+	if partID < 1 || partID > maxPartNumber {
+		return minio.PartInfo{}, minio.InvalidPart{}
 	}
 
-	// notify stream that there aren't more parts coming
-	upload.Stream.Close()
-	// wait for completion
-	result := <-upload.Done
-	// return the final info
-	return result.Info, result.Error
-}
-
-func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
-	defer mon.Task()(&ctx)(&err)
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return minio.PartInfo{}, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Get(bucket, object, uploadID)
+	_, err = layer.getMultipartUpload(ctx, bucket, object, uploadID)
 	if err != nil {
-		return minio.ListPartsInfo{}, err
+		return minio.PartInfo{}, err
 	}
 
-	list := minio.ListPartsInfo{}
+	// S3 clients expect the etag of a part to be its md5 sum
+	md5sum := md5.New()
+	reader := io.TeeReader(data, md5sum)
 
-	list.Bucket = bucket
-	list.Object = object
-	list.UploadID = uploadID
-	list.PartNumberMarker = partNumberMarker
-	list.MaxParts = maxParts
-	list.UserDefined = upload.Metadata
-	list.Parts = upload.getCompletedParts()
+	// the etag is only known after the data has been read, hence the part is
+	// uploaded first and the etag is stored once the part is committed
+	partPath := uploadPartPath(uploadID, partID)
 
-	sort.Slice(list.Parts, func(i, k int) bool {
-		return list.Parts[i].PartNumber < list.Parts[k].PartNumber
-	})
+	// an uploaded part is only listed together with its etag, so remove the
+	// etag of a previous upload of the same part first
+	err = bucket.DeleteObject(ctx, partETagPath(partPath))
+	if err != nil && !storj.ErrObjectNotFound.Has(err) {
+		return minio.PartInfo{}, convertError(err, bucketName, object)
+	}
 
-	var first int
-	for i, p := range list.Parts {
-		first = i
-		if partNumberMarker <= p.PartNumber {
-			break
-		}
+	writer, err := bucket.NewWriter(ctx, partPath, &uplink.UploadOptions{})
+	if err != nil {
+		return minio.PartInfo{}, convertError(err, bucketName, object)
+	}
+	_, copyErr := io.Copy(writer, reader)
+	if copyErr != nil {
+		// don't commit a partial part
+		_ = writer.Close()
+		_ = bucket.DeleteObject(ctx, partPath)
+		return minio.PartInfo{}, copyErr
 	}
+	if err := writer.Close(); err != nil {
+		return minio.PartInfo{}, convertError(err, bucketName, object)
+	}
+
+	etag := hex.EncodeToString(md5sum.Sum(nil))
 
-	list.Parts = list.Parts[first:]
-	if len(list.Parts) > maxParts {
-		list.NextPartNumberMarker = list.Parts[maxParts].PartNumber
-		list.Parts = list.Parts[:maxParts]
-		list.IsTruncated = true
+	err = layer.setPartETag(ctx, bucket, partPath, etag)
+	if err != nil {
+		return minio.PartInfo{}, convertError(err, bucketName, object)
 	}
 
-	return list, nil
+	return minio.PartInfo{
+		PartNumber:   partID,
+		LastModified: time.Now(),
+		ETag:         etag,
+		Size:         data.Size(),
+	}, nil
 }
 
-// TODO: implement
-// func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
-// func (layer *gatewayLayer) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, uploadID string, partID int, startOffset int64, length int64, srcInfo minio.ObjectInfo) (info minio.PartInfo, err error) {
+// setPartETag stores the etag in the metadata of an uploaded part
+func (layer *gatewayLayer) setPartETag(ctx context.Context, bucket *uplink.Bucket, partPath, etag string) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// MultipartUploads manages pending multipart uploads
-type MultipartUploads struct {
-	mu      sync.RWMutex
-	lastID  int
-	pending map[string]*MultipartUpload
+	// the metadata of an object can't be changed, so the etag is stored in
+	// a zero sized object next to the part
+	return bucket.UploadObject(ctx, partETagPath(partPath), bytes.NewReader(nil), &uplink.UploadOptions{
+		Metadata: map[string]string{multipartETagKey: etag},
+	})
 }
 
-// NewMultipartUploads creates new MultipartUploads
-func NewMultipartUploads() *MultipartUploads {
-	return &MultipartUploads{
-		pending: map[string]*MultipartUpload{},
-	}
-}
+// listParts returns all the uploaded parts of the upload with a part number larger than partNumberMarker
+func (layer *gatewayLayer) listParts(ctx context.Context, bucket *uplink.Bucket, uploadID string, partNumberMarker int, limit int) (parts []minio.PartInfo, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// Create creates a new upload
-func (uploads *MultipartUploads) Create(bucket, object string, metadata map[string]string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
+	sizes := map[int]storj.Object{}
+	etags := map[int]string{}
 
-	for id, upload := range uploads.pending {
-		if upload.Bucket == bucket && upload.Object == object {
-			upload.Stream.Abort(Error.New("aborted by another upload to the same location"))
-			delete(uploads.pending, id)
+	// listing is ordered by the encrypted paths, hence all parts are loaded
+	err = listAll(ctx, bucket, uploadPartsPrefix(uploadID), func(item storj.Object) {
+		name := strings.TrimSuffix(item.Path, ".etag")
+		partNumber, err := strconv.Atoi(name)
+		if err != nil || partNumber <= partNumberMarker {
+			return
+		}
+		if name != item.Path {
+			etags[partNumber] = item.Metadata[multipartETagKey]
+		} else {
+			sizes[partNumber] = item
 		}
+	})
+	if err != nil {
+		return nil, false, err
 	}
 
-	uploads.lastID++
-	uploadID := "Upload" + strconv.Itoa(uploads.lastID)
+	for partNumber, item := range sizes {
+		etag, ok := etags[partNumber]
+		if !ok {
+			// the part is still being uploaded
+			continue
+		}
+		parts = append(parts, minio.PartInfo{
+			PartNumber:   partNumber,
+			LastModified: item.Modified,
+			ETag:         etag,
+			Size:         item.Size,
+		})
+	}
 
-	upload := NewMultipartUpload(uploadID, bucket, object, metadata)
-	uploads.pending[uploadID] = upload
+	sort.Slice(parts, func(i, k int) bool {
+		return parts[i].PartNumber < parts[k].PartNumber
+	})
 
-	return upload, nil
+	if limit > 0 && len(parts) > limit {
+		return parts[:limit], true, nil
+	}
+	return parts, false, nil
 }
 
-// Get finds a pending upload
-func (uploads *MultipartUploads) Get(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
+// deleteMultipartUpload deletes all parts and the info of the upload
+func (layer *gatewayLayer) deleteMultipartUpload(ctx context.Context, bucket *uplink.Bucket, uploadID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
+	var errlist errs.Group
+	err = listAll(ctx, bucket, uploadPartsPrefix(uploadID), func(item storj.Object) {
+		err := bucket.DeleteObject(ctx, uploadPartsPrefix(uploadID)+item.Path)
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			errlist.Add(err)
+		}
+	})
+	errlist.Add(err)
+
+	// the info is deleted last, so a failed cleanup can be retried by aborting
+	if err := errlist.Err(); err != nil {
+		return err
 	}
 
-	return upload, nil
+	err = bucket.DeleteObject(ctx, uploadInfoPath(uploadID))
+	if storj.ErrObjectNotFound.Has(err) {
+		return nil
+	}
+	return err
 }
 
-// Remove returns and removes a pending upload
-func (uploads *MultipartUploads) Remove(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
+func (layer *gatewayLayer) AbortMultipartUpload(ctx context.Context, bucketName, object, uploadID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return convertError(err, bucketName, "")
 	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	delete(uploads.pending, uploadID)
+	_, err = layer.getMultipartUpload(ctx, bucket, object, uploadID)
+	if err != nil {
+		return err
+	}
 
-	return upload, nil
+	err = layer.deleteMultipartUpload(ctx, bucket, uploadID)
+	return convertError(err, bucketName, object)
 }
 
-// RemoveByID removes pending upload by id
-func (uploads *MultipartUploads) RemoveByID(uploadID string) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
-	delete(uploads.pending, uploadID)
-}
+func (layer *gatewayLayer) CompleteMultipartUpload(ctx context.Context, bucketName, object, uploadID string, uploadedParts []minio.CompletePart) (objInfo minio.ObjectInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// MultipartUpload is partial info about a pending upload
-type MultipartUpload struct {
-	ID       string
-	Bucket   string
-	Object   string
-	Metadata map[string]string
-	Done     chan (*MultipartUploadResult)
-	Stream   *MultipartStream
-
-	mu        sync.Mutex
-	completed []minio.PartInfo
-}
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-// MultipartUploadResult contains either an Error or the uploaded ObjectInfo
-type MultipartUploadResult struct {
-	Error error
-	Info  minio.ObjectInfo
-}
+	upload, err := layer.getMultipartUpload(ctx, bucket, object, uploadID)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
 
-// NewMultipartUpload creates a new MultipartUpload
-func NewMultipartUpload(uploadID string, bucket, object string, metadata map[string]string) *MultipartUpload {
-	upload := &MultipartUpload{
-		ID:       uploadID,
-		Bucket:   bucket,
-		Object:   object,
-		Metadata: metadata,
-		Done:     make(chan *MultipartUploadResult, 1),
-		Stream:   NewMultipartStream(),
+	parts, _, err := layer.listParts(ctx, bucket, uploadID, 0, 0)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, object)
 	}
-	return upload
-}
 
-// addCompletedPart adds a completed part to the list
-func (upload *MultipartUpload) addCompletedPart(part minio.PartInfo) {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
+	available := map[int]minio.PartInfo{}
+	for _, part := range parts {
+		available[part.PartNumber] = part
+	}
 
-	upload.completed = append(upload.completed, part)
-}
+	// verify that the requested parts were uploaded and are in ascending order
+	var partPaths []string
+	var etags []byte
+	lastPartNumber := 0
+	for _, uploaded := range uploadedParts {
+		part, ok := available[uploaded.PartNumber]
+		if !ok || uploaded.PartNumber <= lastPartNumber {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+		if etag := strings.Trim(uploaded.ETag, `"`); etag != "" && etag != part.ETag {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+		lastPartNumber = uploaded.PartNumber
 
-func (upload *MultipartUpload) getCompletedParts() []minio.PartInfo {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
+		md5sum, err := hex.DecodeString(part.ETag)
+		if err != nil {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+		etags = append(etags, md5sum...)
+		partPaths = append(partPaths, uploadPartPath(uploadID, part.PartNumber))
+	}
+	if len(partPaths) == 0 {
+		return minio.ObjectInfo{}, minio.InvalidPart{}
+	}
 
-	return append([]minio.PartInfo{}, upload.completed...)
-}
+	opts := uplink.UploadOptions{
+		ContentType: upload.ContentType,
+		Metadata:    upload.Metadata,
+	}
 
-// fail aborts the upload with an error
-func (upload *MultipartUpload) fail(err error) {
-	upload.Done <- &MultipartUploadResult{Error: err}
-	close(upload.Done)
-}
+	err = bucket.ConcatObjects(ctx, partPaths, object, &opts)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, object)
+	}
 
-// complete completes the upload
-func (upload *MultipartUpload) complete(info minio.ObjectInfo) {
-	upload.Done <- &MultipartUploadResult{Info: info}
-	close(upload.Done)
-}
+	objInfo, err = layer.GetObjectInfo(ctx, bucketName, object)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
 
-// MultipartStream serializes multiple readers into a single reader
-type MultipartStream struct {
-	mu          sync.Mutex
-	moreParts   sync.Cond
-	err         error
-	closed      bool
-	finished    bool
-	nextID      int
-	nextNumber  int
-	currentPart *StreamPart
-	parts       []*StreamPart
-}
+	// S3 clients expect the etag of a multipart object to be the md5 sum of the part md5 sums
+	etag := md5.Sum(etags)
+	objInfo.ETag = hex.EncodeToString(etag[:]) + "-" + strconv.Itoa(len(partPaths))
 
-// StreamPart is a reader waiting in MultipartStream
-type StreamPart struct {
-	Number int
-	ID     int
-	Size   int64
-	Reader *hash.Reader
-	Done   chan error
-}
+	// the object is complete, a failed cleanup can be retried by aborting the upload
+	_ = layer.deleteMultipartUpload(ctx, bucket, uploadID)
 
-// NewMultipartStream creates a new MultipartStream
-func NewMultipartStream() *MultipartStream {
-	stream := &MultipartStream{}
-	stream.moreParts.L = &stream.mu
-	stream.nextID = 1
-	return stream
+	return objInfo, nil
 }
 
-// Abort aborts the stream reading
-func (stream *MultipartStream) Abort(err error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucketName, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return minio.ListPartsInfo{}, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	if stream.finished {
-		return
+	upload, err := layer.getMultipartUpload(ctx, bucket, object, uploadID)
+	if err != nil {
+		return minio.ListPartsInfo{}, err
 	}
 
-	if stream.err == nil {
-		stream.err = err
+	parts, more, err := layer.listParts(ctx, bucket, uploadID, partNumberMarker, maxParts)
+	if err != nil {
+		return minio.ListPartsInfo{}, convertError(err, bucketName, object)
 	}
-	stream.finished = true
-	stream.closed = true
 
-	for _, part := range stream.parts {
-		part.Done <- err
-		close(part.Done)
+	result = minio.ListPartsInfo{
+		Bucket:           bucketName,
+		Object:           object,
+		UploadID:         uploadID,
+		PartNumberMarker: partNumberMarker,
+		MaxParts:         maxParts,
+		IsTruncated:      more,
+		Parts:            parts,
+		UserDefined:      upload.Metadata,
+	}
+	if more {
+		result.NextPartNumberMarker = parts[len(parts)-1].PartNumber
 	}
-	stream.parts = nil
 
-	stream.moreParts.Broadcast()
+	return result, nil
 }
 
-// Close closes the stream, but lets it complete
-func (stream *MultipartStream) Close() {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucketName, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	stream.closed = true
-	stream.moreParts.Broadcast()
-}
+	if delimiter != "" && delimiter != "/" {
+		return minio.ListMultipartsInfo{}, minio.UnsupportedDelimiter{Delimiter: delimiter}
+	}
 
-// Read implements io.Reader interface, blocking when there's no part
-func (stream *MultipartStream) Read(data []byte) (n int, err error) {
-	stream.mu.Lock()
-	for {
-		// has an error occurred?
-		if stream.err != nil {
-			stream.mu.Unlock()
-			return 0, Error.Wrap(err)
-		}
-		// still uploading the current part?
-		if stream.currentPart != nil {
-			break
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return minio.ListMultipartsInfo{}, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	// the uploads are stored by id, so all of them need to be loaded for sorting by object
+	var uploads []multipartUpload
+	err = listAll(ctx, bucket, multipartUploads, func(item storj.Object) {
+		upload := multipartUploadFromObject(item.Path, item)
+		if strings.HasPrefix(upload.Object, prefix) {
+			uploads = append(uploads, upload)
 		}
-		// do we have the next part?
-		if len(stream.parts) > 0 && stream.nextID == stream.parts[0].ID {
-			stream.currentPart = stream.parts[0]
-			stream.parts = stream.parts[1:]
-			stream.nextID++
-			break
+	})
+	if err != nil {
+		return minio.ListMultipartsInfo{}, convertError(err, bucketName, "")
+	}
+
+	sort.Slice(uploads, func(i, k int) bool {
+		if uploads[i].Object != uploads[k].Object {
+			return uploads[i].Object < uploads[k].Object
 		}
-		// we don't have the next part and are closed, hence we are complete
-		if stream.closed {
-			stream.finished = true
-			stream.mu.Unlock()
-			return 0, io.EOF
+		if !uploads[i].Initiated.Equal(uploads[k].Initiated) {
+			return uploads[i].Initiated.Before(uploads[k].Initiated)
 		}
+		return uploads[i].ID < uploads[k].ID
+	})
 
-		stream.moreParts.Wait()
+	// skip everything up to and including the markers
+	if keyMarker != "" {
+		start := sort.Search(len(uploads), func(i int) bool {
+			return uploads[i].Object >= keyMarker
+		})
+		if uploadIDMarker == "" {
+			for start < len(uploads) && uploads[start].Object == keyMarker {
+				start++
+			}
+		} else {
+			for i := start; i < len(uploads) && uploads[i].Object == keyMarker; i++ {
+				if uploads[i].ID == uploadIDMarker {
+					start = i + 1
+					break
+				}
+			}
+		}
+		uploads = uploads[start:]
 	}
-	stream.mu.Unlock()
 
-	// read as much as we can
-	n, err = stream.currentPart.Reader.Read(data)
-	atomic.AddInt64(&stream.currentPart.Size, int64(n))
-
-	if err == io.EOF {
-		// the part completed, hence advance to the next one
-		err = nil
-		close(stream.currentPart.Done)
-		stream.currentPart = nil
-	} else if err != nil {
-		// something bad happened, abort the whole thing
-		stream.Abort(err)
-		return n, Error.Wrap(err)
+	result = minio.ListMultipartsInfo{
+		KeyMarker:      keyMarker,
+		UploadIDMarker: uploadIDMarker,
+		MaxUploads:     maxUploads,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
 	}
 
-	return n, err
-}
+	seenPrefixes := map[string]bool{}
+	for _, upload := range uploads {
+		if len(result.Uploads)+len(result.CommonPrefixes) >= maxUploads {
+			result.IsTruncated = true
+			break
+		}
 
-// AddPart adds a new part to the stream to wait
-func (stream *MultipartStream) AddPart(partID int, data *hash.Reader) (*StreamPart, error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+		if delimiter != "" {
+			rest := strings.TrimPrefix(upload.Object, prefix)
+			if i := strings.Index(rest, delimiter); i >= 0 {
+				commonPrefix := prefix + rest[:i+len(delimiter)]
+				if !seenPrefixes[commonPrefix] {
+					seenPrefixes[commonPrefix] = true
+					result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix)
+				}
+				result.NextKeyMarker = upload.Object
+				result.NextUploadIDMarker = upload.ID
+				continue
+			}
+		}
 
-	if partID < stream.nextID {
-		return nil, Error.New("part %d already uploaded, next part ID is %d", partID, stream.nextID)
+		result.Uploads = append(result.Uploads, minio.MultipartInfo{
+			Object:    upload.Object,
+			UploadID:  upload.ID,
+			Initiated: upload.Initiated,
+		})
+		result.NextKeyMarker = upload.Object
+		result.NextUploadIDMarker = upload.ID
 	}
 
-	for _, p := range stream.parts {
-		if p.ID == partID {
-			// Replace the reader of this part with the new one.
-			// This could happen if the read timeout for this part has expired
-			// and the client tries to upload the part again.
-			p.Reader = data
-			return p, nil
-		}
+	if !result.IsTruncated {
+		result.NextKeyMarker = ""
+		result.NextUploadIDMarker = ""
 	}
 
-	stream.nextNumber++
-	part := &StreamPart{
-		Number: stream.nextNumber - 1,
-		ID:     partID,
-		Size:   0,
-		Reader: data,
-		Done:   make(chan error, 1),
-	}
+	return result, nil
+}
 
-	stream.parts = append(stream.parts, part)
-	sort.Slice(stream.parts, func(i, k int) bool {
-		return stream.parts[i].ID < stream.parts[k].ID
-	})
+// listAll calls fn for every object in the directory, the paths are relative to the directory
+func listAll(ctx context.Context, bucket *uplink.Bucket, dir string, fn func(storj.Object)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cursor := ""
+	for {
+		list, err := bucket.ListObjects(ctx, &storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    cursor,
+			Prefix:    strings.TrimSuffix(dir, "/"),
+			Recursive: true,
+		})
+		if err != nil {
+			return err
+		}
 
-	stream.moreParts.Broadcast()
+		for _, item := range list.Items {
+			fn(item)
+		}
 
-	return part, nil
+		if !list.More || len(list.Items) == 0 {
+			return nil
+		}
+		cursor = list.Items[len(list.Items)-1].Path
+	}
 }
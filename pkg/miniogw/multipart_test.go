@@ -0,0 +1,193 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestMultipartUpload(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, m storj.Metainfo, strms streams.Store) {
+		// Check the error when starting an upload in a non-existing bucket
+		_, err := layer.NewMultipartUpload(ctx, TestBucket, TestFile, nil)
+		assert.Equal(t, minio.BucketNotFound{Bucket: TestBucket}, err)
+
+		_, err = m.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		// Check the error when uploading a part to a non-existing upload
+		_, err = layer.PutObjectPart(ctx, TestBucket, TestFile, "missing", 1, newPartReader(t, []byte("test")))
+		assert.Equal(t, minio.InvalidUploadID{UploadID: "missing"}, err)
+
+		uploadID, err := layer.NewMultipartUpload(ctx, TestBucket, TestFile, map[string]string{
+			"content-type": "media/foo",
+			"key1":         "value1",
+		})
+		require.NoError(t, err)
+
+		// Check the error when using the upload id for a different object
+		_, err = layer.PutObjectPart(ctx, TestBucket, DestFile, uploadID, 1, newPartReader(t, []byte("test")))
+		assert.Equal(t, minio.InvalidUploadID{UploadID: uploadID}, err)
+
+		parts := [][]byte{
+			testrand.Bytes(1 * memory.KiB),
+			testrand.Bytes(1 * memory.KiB),
+			testrand.Bytes(512),
+		}
+
+		// upload the parts in parallel and in reverse order
+		var group errgroup.Group
+		for i := len(parts) - 1; i >= 0; i-- {
+			partID, data := i+1, parts[i]
+			group.Go(func() error {
+				info, err := layer.PutObjectPart(ctx, TestBucket, TestFile, uploadID, partID, newPartReader(t, data))
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, partID, info.PartNumber)
+				assert.Equal(t, md5Hex(data), info.ETag)
+				assert.Equal(t, int64(len(data)), info.Size)
+				return nil
+			})
+		}
+		require.NoError(t, group.Wait())
+
+		// re-uploading a part replaces it
+		parts[1] = testrand.Bytes(1 * memory.KiB)
+		_, err = layer.PutObjectPart(ctx, TestBucket, TestFile, uploadID, 2, newPartReader(t, parts[1]))
+		require.NoError(t, err)
+
+		listParts, err := layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 0, 2)
+		require.NoError(t, err)
+		assert.True(t, listParts.IsTruncated)
+		assert.Equal(t, 2, listParts.NextPartNumberMarker)
+		assert.Equal(t, map[string]string{"key1": "value1"}, listParts.UserDefined)
+		require.Len(t, listParts.Parts, 2)
+		for i, part := range listParts.Parts {
+			assert.Equal(t, i+1, part.PartNumber)
+			assert.Equal(t, md5Hex(parts[i]), part.ETag)
+		}
+
+		listParts, err = layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 2, 2)
+		require.NoError(t, err)
+		assert.False(t, listParts.IsTruncated)
+		require.Len(t, listParts.Parts, 1)
+		assert.Equal(t, 3, listParts.Parts[0].PartNumber)
+
+		// start a second upload, which will be aborted
+		abortedID, err := layer.NewMultipartUpload(ctx, TestBucket, "dir/"+DestFile, nil)
+		require.NoError(t, err)
+		_, err = layer.PutObjectPart(ctx, TestBucket, "dir/"+DestFile, abortedID, 1, newPartReader(t, []byte("test")))
+		require.NoError(t, err)
+
+		uploads, err := layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 10)
+		require.NoError(t, err)
+		require.Len(t, uploads.Uploads, 2)
+		assert.Equal(t, "dir/"+DestFile, uploads.Uploads[0].Object)
+		assert.Equal(t, abortedID, uploads.Uploads[0].UploadID)
+		assert.Equal(t, TestFile, uploads.Uploads[1].Object)
+		assert.Equal(t, uploadID, uploads.Uploads[1].UploadID)
+
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "/", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dir/"}, uploads.CommonPrefixes)
+		require.Len(t, uploads.Uploads, 1)
+		assert.Equal(t, TestFile, uploads.Uploads[0].Object)
+
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 1)
+		require.NoError(t, err)
+		assert.True(t, uploads.IsTruncated)
+		require.Len(t, uploads.Uploads, 1)
+
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", uploads.NextKeyMarker, uploads.NextUploadIDMarker, "", 1)
+		require.NoError(t, err)
+		assert.False(t, uploads.IsTruncated)
+		require.Len(t, uploads.Uploads, 1)
+		assert.Equal(t, uploadID, uploads.Uploads[0].UploadID)
+
+		// pending uploads aren't listed as objects
+		objects, err := layer.ListObjects(ctx, TestBucket, "", "", "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, objects.Objects)
+		objects, err = layer.ListObjects(ctx, TestBucket, "", "", "/", 10)
+		require.NoError(t, err)
+		assert.Empty(t, objects.Objects)
+		assert.Empty(t, objects.Prefixes)
+
+		err = layer.AbortMultipartUpload(ctx, TestBucket, "dir/"+DestFile, abortedID)
+		require.NoError(t, err)
+
+		err = layer.AbortMultipartUpload(ctx, TestBucket, "dir/"+DestFile, abortedID)
+		assert.Equal(t, minio.InvalidUploadID{UploadID: abortedID}, err)
+
+		// Check the error when completing with a wrong etag
+		_, err = layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, []minio.CompletePart{
+			{PartNumber: 1, ETag: md5Hex(parts[1])},
+		})
+		assert.Equal(t, minio.InvalidPart{}, err)
+
+		// Check the error when completing with parts out of order
+		_, err = layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, []minio.CompletePart{
+			{PartNumber: 2, ETag: md5Hex(parts[1])},
+			{PartNumber: 1, ETag: md5Hex(parts[0])},
+		})
+		assert.Equal(t, minio.InvalidPart{}, err)
+
+		var completeParts []minio.CompletePart
+		for i, part := range parts {
+			completeParts = append(completeParts, minio.CompletePart{PartNumber: i + 1, ETag: `"` + md5Hex(part) + `"`})
+		}
+
+		info, err := layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, completeParts)
+		require.NoError(t, err)
+		assert.Equal(t, TestFile, info.Name)
+		assert.Equal(t, "media/foo", info.ContentType)
+		assert.Equal(t, map[string]string{"key1": "value1"}, info.UserDefined)
+		assert.Equal(t, "-3", info.ETag[len(info.ETag)-2:])
+
+		expected := bytes.Join(parts, nil)
+		assert.Equal(t, int64(len(expected)), info.Size)
+
+		var buf bytes.Buffer
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, &buf, "")
+		require.NoError(t, err)
+		assert.Equal(t, expected, buf.Bytes())
+
+		// the completed and aborted uploads are cleaned up
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, uploads.Uploads)
+
+		list, err := m.ListObjects(ctx, TestBucket, storj.ListOptions{Direction: storj.After, Recursive: true})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, TestFile, list.Items[0].Path)
+	})
+}
+
+func newPartReader(t *testing.T, data []byte) *hash.Reader {
+	reader, err := hash.NewReader(bytes.NewReader(data), int64(len(data)), "", "")
+	require.NoError(t, err)
+	return reader
+}
+
+func md5Hex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
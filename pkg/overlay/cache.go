@@ -59,10 +59,28 @@ type DB interface {
 	UpdateAddress(ctx context.Context, value *pb.Node, defaults NodeSelectionConfig) error
 	// UpdateStats all parts of single storagenode's stats.
 	UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
-	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
+	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, tags, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error)
+	// UpdateCountry updates the country the node is located in.
+	UpdateCountry(ctx context.Context, node storj.NodeID, countryCode string) error
 	// UpdateUptime updates a single storagenode's uptime stats.
 	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64) (stats *NodeStats, err error)
+	// UpdatePerformance updates the latency and throughput of the nodes with the samples.
+	// lambda is the forgetting factor of the throughput average.
+	UpdatePerformance(ctx context.Context, samples []PerformanceSample, lambda float64) error
+	// GetPerformance returns the measured performance of the nodes.
+	GetPerformance(ctx context.Context, nodeIDs storj.NodeIDList) (map[storj.NodeID]NodePerformance, error)
+	// DisqualifyNode disqualifies the node.
+	DisqualifyNode(ctx context.Context, nodeID storj.NodeID) error
+	// ReinstateNode removes the disqualification of the node and resets its reputation to the initial values.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID, defaults NodeSelectionConfig) error
+
+	// GetExitStatus returns the graceful exit status of a node.
+	GetExitStatus(ctx context.Context, nodeID storj.NodeID) (*ExitStatus, error)
+	// UpdateExitStatus updates the graceful exit status of a node.
+	UpdateExitStatus(ctx context.Context, status *ExitStatus) error
+	// GetExitingNodesLoopIncomplete returns the exiting nodes, whose pieces haven't been queued for transfer yet.
+	GetExitingNodesLoopIncomplete(ctx context.Context) (storj.NodeIDList, error)
 }
 
 // FindStorageNodesRequest defines easy request parameters.
@@ -73,6 +91,7 @@ type FindStorageNodesRequest struct {
 	FreeDisk             int64
 	ExcludedNodes        []storj.NodeID
 	MinimumVersion       string // semver or empty
+	Placement            *pb.PlacementPolicy
 }
 
 // NodeCriteria are the requirements for selecting nodes
@@ -86,6 +105,7 @@ type NodeCriteria struct {
 	MinimumVersion string // semver or empty
 	OnlineWindow   time.Duration
 	DistinctIP     bool
+	Placement      *pb.PlacementPolicy // restricts the countries, networks and tags of the nodes
 }
 
 // UpdateRequest is used to update a node status.
@@ -116,9 +136,18 @@ type NodeDossier struct {
 	Disqualified *time.Time
 }
 
+// ExitStatus is the graceful exit status of a node.
+type ExitStatus struct {
+	NodeID              storj.NodeID
+	ExitInitiatedAt     *time.Time
+	ExitLoopCompletedAt *time.Time
+	ExitFinishedAt      *time.Time
+	ExitSuccess         bool
+}
+
 // NodeStats contains statistics about a node.
 type NodeStats struct {
-	Latency90             int64
+	Latency90             int64 // milliseconds
 	AuditSuccessCount     int64
 	AuditCount            int64
 	UptimeSuccessCount    int64
@@ -136,14 +165,17 @@ type NodeStats struct {
 type Cache struct {
 	log         *zap.Logger
 	db          DB
+	geoip       *GeoIP
 	preferences NodeSelectionConfig
 }
 
-// NewCache returns a new Cache
-func NewCache(log *zap.Logger, db DB, preferences NodeSelectionConfig) *Cache {
+// NewCache returns a new Cache. The GeoIP database is optional, without it
+// the countries of the nodes are unknown.
+func NewCache(log *zap.Logger, db DB, geoip *GeoIP, preferences NodeSelectionConfig) *Cache {
 	return &Cache{
 		log:         log,
 		db:          db,
+		geoip:       geoip,
 		preferences: preferences,
 	}
 }
@@ -197,6 +229,7 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	}
 
 	excludedNodes := req.ExcludedNodes
+	distinctIP := preferences.DistinctIP || req.Placement.GetDistinctSubnets()
 
 	newNodeCount := 0
 	if preferences.NewNodePercentage > 0 {
@@ -212,7 +245,8 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 			ExcludedNodes:  excludedNodes,
 			MinimumVersion: preferences.MinimumVersion,
 			OnlineWindow:   preferences.OnlineWindow,
-			DistinctIP:     preferences.DistinctIP,
+			DistinctIP:     distinctIP,
+			Placement:      req.Placement,
 		})
 		if err != nil {
 			return nil, err
@@ -223,7 +257,7 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	// add selected new nodes and their IPs to the excluded lists for reputable node selection
 	for _, newNode := range newNodes {
 		excludedNodes = append(excludedNodes, newNode.Id)
-		if preferences.DistinctIP {
+		if distinctIP {
 			excludedIPs = append(excludedIPs, newNode.LastIp)
 		}
 	}
@@ -237,13 +271,29 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		ExcludedIPs:    excludedIPs,
 		MinimumVersion: preferences.MinimumVersion,
 		OnlineWindow:   preferences.OnlineWindow,
-		DistinctIP:     preferences.DistinctIP,
+		DistinctIP:     distinctIP,
+		Placement:      req.Placement,
+	}
+
+	// when weighting by performance, choose among more nodes than needed
+	reputableCount := reputableNodeCount - len(newNodes)
+	selectCount := reputableCount
+	if preferences.PerformanceWeight > 0 && preferences.PerformanceOversample > 1 {
+		selectCount *= preferences.PerformanceOversample
 	}
-	reputableNodes, err := cache.db.SelectStorageNodes(ctx, reputableNodeCount-len(newNodes), &criteria)
+
+	reputableNodes, err := cache.db.SelectStorageNodes(ctx, selectCount, &criteria)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(reputableNodes) > reputableCount {
+		reputableNodes, err = cache.selectFastest(ctx, reputableNodes, reputableCount, preferences.PerformanceWeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	nodes = append(nodes, newNodes...)
 	nodes = append(nodes, reputableNodes...)
 
@@ -254,6 +304,23 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	return nodes, nil
 }
 
+// selectFastest randomly selects count of the nodes, preferring nodes with higher throughput and lower latency.
+func (cache *Cache) selectFastest(ctx context.Context, nodes []*pb.Node, count int, exponent float64) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ids := make(storj.NodeIDList, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.Id)
+	}
+
+	performances, err := cache.db.GetPerformance(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectWeighted(nodes, performanceScores(nodes, performances), exponent, count), nil
+}
+
 // KnownOffline filters a set of nodes to offline nodes
 func (cache *Cache) KnownOffline(ctx context.Context, nodeIds storj.NodeIDList) (offlineNodes storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -287,12 +354,25 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 	if value.Address == nil {
 		return errors.New("node has no address")
 	}
-	// Resolve IP Address Network to ensure it is set
-	value.LastIp, err = GetNetwork(ctx, value.Address.Address)
+	// Resolve IP Address and Network to ensure they are set
+	value.LastIpAddress, value.LastIp, err = ResolveIPAndNetwork(ctx, value.Address.Address)
 	if err != nil {
 		return OverlayError.Wrap(err)
 	}
-	return cache.db.UpdateAddress(ctx, &value, cache.preferences)
+	err = cache.db.UpdateAddress(ctx, &value, cache.preferences)
+	if err != nil {
+		return err
+	}
+
+	if cache.geoip == nil {
+		return nil
+	}
+	return cache.db.UpdateCountry(ctx, nodeID, cache.geoip.Country(value.LastIpAddress))
+}
+
+// IsNew returns whether the node has been audited fewer times than required to not be considered a new node.
+func (cache *Cache) IsNew(node *NodeDossier) bool {
+	return node.Reputation.AuditCount < cache.preferences.AuditCount
 }
 
 // IsVetted returns whether or not the node reaches reputable thresholds
@@ -323,9 +403,17 @@ func (cache *Cache) UpdateStats(ctx context.Context, request *UpdateRequest) (st
 	return cache.db.UpdateStats(ctx, request)
 }
 
-// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
+// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, tags, capacity, and version.
 func (cache *Cache) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
+	if operator := nodeInfo.GetOperator(); operator != nil {
+		sanitized := *operator
+		sanitized.Tags = validTags(operator.Tags)
+
+		info := *nodeInfo
+		info.Operator = &sanitized
+		nodeInfo = &info
+	}
 	return cache.db.UpdateNodeInfo(ctx, node, nodeInfo)
 }
 
@@ -339,6 +427,46 @@ func (cache *Cache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp
 	return cache.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ)
 }
 
+// UpdatePerformance updates the latency and throughput of nodes with measurements the satellite made while auditing and repairing.
+func (cache *Cache) UpdatePerformance(ctx context.Context, samples []PerformanceSample) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(samples) == 0 {
+		return nil
+	}
+	return cache.db.UpdatePerformance(ctx, samples, cache.preferences.PerformanceLambda)
+}
+
+// DisqualifyNode disqualifies the node, e.g. on request of a satellite operator.
+func (cache *Cache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.DisqualifyNode(ctx, nodeID)
+}
+
+// ReinstateNode removes the disqualification of the node. The reputation of the node
+// is reset, otherwise the next reputation update would disqualify it again.
+func (cache *Cache) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.ReinstateNode(ctx, nodeID, cache.preferences)
+}
+
+// GetExitStatus returns the graceful exit status of a node.
+func (cache *Cache) GetExitStatus(ctx context.Context, nodeID storj.NodeID) (_ *ExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.GetExitStatus(ctx, nodeID)
+}
+
+// UpdateExitStatus updates the graceful exit status of a node.
+func (cache *Cache) UpdateExitStatus(ctx context.Context, status *ExitStatus) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.UpdateExitStatus(ctx, status)
+}
+
+// GetExitingNodesLoopIncomplete returns the exiting nodes, whose pieces haven't been queued for transfer yet.
+func (cache *Cache) GetExitingNodesLoopIncomplete(ctx context.Context) (_ storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.GetExitingNodesLoopIncomplete(ctx)
+}
+
 // ConnFailure implements the Transport Observer `ConnFailure` function
 func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError error) {
 	var err error
@@ -416,22 +544,30 @@ func getIP(ctx context.Context, target string) (ip net.IPAddr, err error) {
 func GetNetwork(ctx context.Context, target string) (network string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	_, network, err = ResolveIPAndNetwork(ctx, target)
+	return network, err
+}
+
+// ResolveIPAndNetwork resolves the target address and determines its IP address and its IP /24 Subnet
+func ResolveIPAndNetwork(ctx context.Context, target string) (ip, network string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	addr, err := getIP(ctx, target)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	// If addr can be converted to 4byte notation, it is an IPv4 address, else its an IPv6 address
 	if ipv4 := addr.IP.To4(); ipv4 != nil {
 		//Filter all IPv4 Addresses into /24 Subnet's
 		mask := net.CIDRMask(24, 32)
-		return ipv4.Mask(mask).String(), nil
+		return ipv4.String(), ipv4.Mask(mask).String(), nil
 	}
 	if ipv6 := addr.IP.To16(); ipv6 != nil {
 		//Filter all IPv6 Addresses into /64 Subnet's
 		mask := net.CIDRMask(64, 128)
-		return ipv6.Mask(mask).String(), nil
+		return ipv6.String(), ipv6.Mask(mask).String(), nil
 	}
 
-	return "", errors.New("unable to get network for address " + addr.String())
+	return "", "", errors.New("unable to get network for address " + addr.String())
 }
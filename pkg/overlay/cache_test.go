@@ -67,7 +67,7 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB) {
 	address := &pb.NodeAddress{Address: "127.0.0.1:0"}
 
 	nodeSelectionConfig := testNodeSelectionConfig(0, 0, false)
-	cache := overlay.NewCache(zaptest.NewLogger(t), store, nodeSelectionConfig)
+	cache := overlay.NewCache(zaptest.NewLogger(t), store, nil, nodeSelectionConfig)
 
 	{ // Put
 		err := cache.Put(ctx, valid1ID, pb.Node{Id: valid1ID, Address: address})
@@ -19,7 +19,9 @@ var (
 // Config is a configuration struct for everything you need to start the
 // Overlay cache responsibility.
 type Config struct {
-	Node NodeSelectionConfig
+	Node          NodeSelectionConfig
+	Recorder      RecorderConfig
+	GeoIPDatabase string `help:"path to a CSV file mapping networks to country codes, used by placement constraints" default:""`
 }
 
 // NodeSelectionConfig is a configuration struct to determine the minimum
@@ -32,6 +34,10 @@ type NodeSelectionConfig struct {
 	OnlineWindow      time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"1h"`
 	DistinctIP        bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
 
+	PerformanceWeight     float64 `help:"exponent applied to the measured throughput and latency of reputable nodes when choosing nodes for upload, 0 disables weighting" default:"0"`
+	PerformanceOversample int     `help:"how many times more reputable nodes are considered than requested when weighting by performance" default:"3"`
+	PerformanceLambda     float64 `help:"the forgetting factor used to average the measured throughput of nodes" default:"0.9"`
+
 	AuditReputationRepairWeight  float64 `help:"weight to apply to audit reputation for total repair reputation calculation" default:"1.0"`
 	AuditReputationUplinkWeight  float64 `help:"weight to apply to audit reputation for total uplink reputation calculation" default:"1.0"`
 	AuditReputationAlpha0        float64 `help:"the initial shape 'alpha' used to calculate audit SNs reputation" default:"1.0"`
@@ -0,0 +1,125 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// GeoIPError is the error class for loading GeoIP databases
+var GeoIPError = errs.Class("geoip error")
+
+// geoRange is a range of IP addresses located in a single country
+type geoRange struct {
+	first   net.IP
+	last    net.IP
+	country string
+}
+
+// GeoIP maps IP addresses to ISO 3166-1 alpha-2 country codes.
+type GeoIP struct {
+	ranges []geoRange
+}
+
+// LoadGeoIP loads a GeoIP database from a CSV file.
+func LoadGeoIP(path string) (_ *GeoIP, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, GeoIPError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, GeoIPError.Wrap(file.Close())) }()
+
+	return ParseGeoIP(file)
+}
+
+// ParseGeoIP parses a GeoIP database, where every line contains a network in
+// CIDR notation followed by a country code, e.g. "192.0.2.0/24,DE".
+// Lines, which don't start with a network, such as headers, are skipped.
+// The networks must not overlap.
+func ParseGeoIP(r io.Reader) (*GeoIP, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	geoip := &GeoIP{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, GeoIPError.Wrap(err)
+		}
+		if len(record) < 2 {
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(record[0]))
+		if err != nil {
+			continue
+		}
+
+		country := strings.ToUpper(strings.TrimSpace(record[1]))
+		if country == "" {
+			continue
+		}
+
+		first := network.IP.To16()
+		last := make(net.IP, len(first))
+		mask := network.Mask
+		if len(mask) == net.IPv4len {
+			mask = append(net.CIDRMask(96, 128)[:12], mask...)
+		}
+		for i := range first {
+			last[i] = first[i] | ^mask[i]
+		}
+
+		geoip.ranges = append(geoip.ranges, geoRange{
+			first:   first,
+			last:    last,
+			country: country,
+		})
+	}
+
+	sort.Slice(geoip.ranges, func(i, k int) bool {
+		return bytes.Compare(geoip.ranges[i].first, geoip.ranges[k].first) < 0
+	})
+
+	return geoip, nil
+}
+
+// Country returns the country code of the IP address or network, empty when it is unknown.
+func (geoip *GeoIP) Country(address string) string {
+	if geoip == nil {
+		return ""
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return ""
+	}
+	ip = ip.To16()
+
+	// find the last range starting at or before the address
+	i := sort.Search(len(geoip.ranges), func(i int) bool {
+		return bytes.Compare(geoip.ranges[i].first, ip) > 0
+	})
+	if i == 0 {
+		return ""
+	}
+
+	r := geoip.ranges[i-1]
+	if bytes.Compare(ip, r.last) > 0 {
+		return ""
+	}
+	return r.country
+}
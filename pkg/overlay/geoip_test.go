@@ -0,0 +1,45 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestGeoIP(t *testing.T) {
+	geoip, err := overlay.ParseGeoIP(strings.NewReader(`network,country_code
+# comments and headers are skipped
+192.0.2.0/24,de
+198.51.100.0/23,US
+2001:db8::/32,FR
+`))
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		address string
+		country string
+	}{
+		{"192.0.2.0", "DE"},
+		{"192.0.2.255", "DE"},
+		{"192.0.3.0", ""},
+		{"198.51.100.0", "US"},
+		{"198.51.101.17", "US"},
+		{"198.51.102.0", ""},
+		{"2001:db8::", "FR"},
+		{"2001:db9::", ""},
+		{"10.0.0.1", ""},
+		{"invalid", ""},
+	} {
+		assert.Equal(t, tt.country, geoip.Country(tt.address), tt.address)
+	}
+
+	var missing *overlay.GeoIP
+	assert.Equal(t, "", missing.Country("192.0.2.0"))
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	// minPerformanceScore and maxPerformanceScore limit how much a single node
+	// can be preferred or avoided because of its measured performance.
+	minPerformanceScore = 0.1
+	maxPerformanceScore = 10
+)
+
+// PerformanceSample is a single measurement of a transfer to or from a node.
+type PerformanceSample struct {
+	NodeID storj.NodeID
+	// Action determines whether the sample is an upload or a download.
+	Action pb.PieceAction
+	// Bytes and Duration are the amount of data transferred and how long it took,
+	// Duration is zero when the throughput is unknown.
+	Bytes    int64
+	Duration time.Duration
+	// Latency is how long it took the node to respond, zero when unknown.
+	Latency time.Duration
+}
+
+// IsUpload returns whether the sample measures data sent to the node.
+func (sample *PerformanceSample) IsUpload() bool {
+	return sample.Action == pb.PieceAction_PUT || sample.Action == pb.PieceAction_PUT_REPAIR
+}
+
+// NodePerformance is the measured performance of a node, zero values are unknown.
+type NodePerformance struct {
+	Latency90              time.Duration
+	UploadBytesPerSecond   float64
+	DownloadBytesPerSecond float64
+}
+
+// performanceScores scores the nodes relative to the median upload throughput
+// and latency of all the nodes. Nodes without measurements score 1.
+func performanceScores(nodes []*pb.Node, performances map[storj.NodeID]NodePerformance) []float64 {
+	var throughputs, latencies []float64
+	for _, node := range nodes {
+		performance := performances[node.Id]
+		if performance.UploadBytesPerSecond > 0 {
+			throughputs = append(throughputs, performance.UploadBytesPerSecond)
+		}
+		if performance.Latency90 > 0 {
+			latencies = append(latencies, float64(performance.Latency90))
+		}
+	}
+	medianThroughput, medianLatency := median(throughputs), median(latencies)
+
+	scores := make([]float64, len(nodes))
+	for i, node := range nodes {
+		performance := performances[node.Id]
+
+		score := 1.0
+		if performance.UploadBytesPerSecond > 0 && medianThroughput > 0 {
+			score *= performance.UploadBytesPerSecond / medianThroughput
+		}
+		if performance.Latency90 > 0 && medianLatency > 0 {
+			score *= medianLatency / float64(performance.Latency90)
+		}
+		scores[i] = math.Min(math.Max(score, minPerformanceScore), maxPerformanceScore)
+	}
+	return scores
+}
+
+// selectWeighted randomly selects count nodes without replacement, where the
+// probability of selecting a node is proportional to its score raised to exponent.
+func selectWeighted(nodes []*pb.Node, scores []float64, exponent float64, count int) []*pb.Node {
+	if count >= len(nodes) {
+		return nodes
+	}
+
+	// weighted random sampling, see Efraimidis and Spirakis, 2006
+	type keyed struct {
+		node *pb.Node
+		key  float64
+	}
+	keys := make([]keyed, len(nodes))
+	for i, node := range nodes {
+		weight := math.Pow(scores[i], exponent)
+		keys[i] = keyed{node: node, key: math.Pow(rand.Float64(), 1/weight)}
+	}
+	sort.Slice(keys, func(i, k int) bool { return keys[i].key > keys[k].key })
+
+	selected := make([]*pb.Node, 0, count)
+	for _, k := range keys[:count] {
+		selected = append(selected, k.node)
+	}
+	return selected
+}
+
+// median returns the median of the values, zero when there are none.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
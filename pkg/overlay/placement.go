@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"net"
+
+	"storj.io/storj/pkg/pb"
+)
+
+const (
+	// MaxNodeTags is the maximum number of tags a node operator can declare
+	MaxNodeTags = 16
+	// MaxTagLength is the maximum length of a tag
+	MaxTagLength = 64
+)
+
+// ValidTag returns whether the tag can be declared by node operators and required by placement policies.
+// Tags consist of letters, digits, dashes, dots and underscores.
+func ValidTag(tag string) bool {
+	if tag == "" || len(tag) > MaxTagLength {
+		return false
+	}
+	for _, r := range tag {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		case r == '-', r == '.', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// validTags returns the valid and distinct tags declared by a node operator.
+func validTags(tags []string) []string {
+	valid := []string{}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		if len(valid) >= MaxNodeTags {
+			break
+		}
+		if !ValidTag(tag) || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		valid = append(valid, tag)
+	}
+	return valid
+}
+
+// DeniedNetwork returns whether the node's IP address is within one of the networks denied by the placement policy.
+// Nodes with an unknown IP address are denied, when the policy denies any network.
+func DeniedNetwork(policy *pb.PlacementPolicy, node *pb.Node) bool {
+	if len(policy.GetDeniedNetworks()) == 0 {
+		return false
+	}
+
+	ip := net.ParseIP(node.GetLastIpAddress())
+	if ip == nil {
+		return true
+	}
+
+	for _, denied := range policy.GetDeniedNetworks() {
+		_, network, err := net.ParseCIDR(denied)
+		if err != nil {
+			continue
+		}
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
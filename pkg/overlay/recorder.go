@@ -0,0 +1,97 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+)
+
+// RecorderConfig configures how measured node performance is written to the database.
+type RecorderConfig struct {
+	Interval  time.Duration `help:"how often the measured throughput and latency of nodes are written to the database" default:"1m0s"`
+	BatchSize int           `help:"maximum number of measured transfers written to the database in a single transaction" default:"1000"`
+}
+
+// PerformanceRecorder collects performance samples measured by other services
+// and writes them to the overlay in batches, so that measuring a transfer
+// doesn't wait for the database.
+type PerformanceRecorder struct {
+	log    *zap.Logger
+	config RecorderConfig
+	cache  *Cache
+	Loop   sync2.Cycle
+
+	mu      sync.Mutex
+	samples []PerformanceSample
+}
+
+// NewPerformanceRecorder creates a new performance recorder.
+func NewPerformanceRecorder(log *zap.Logger, config RecorderConfig, cache *Cache) *PerformanceRecorder {
+	return &PerformanceRecorder{
+		log:    log,
+		config: config,
+		cache:  cache,
+		Loop:   *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Record queues the samples to be written with the next batch.
+func (recorder *PerformanceRecorder) Record(samples ...PerformanceSample) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.samples = append(recorder.samples, samples...)
+}
+
+// Run writes the queued samples on every interval.
+func (recorder *PerformanceRecorder) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return recorder.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := recorder.Flush(ctx); err != nil {
+			recorder.log.Warn("unable to update node performance", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Flush writes the queued samples in batches of at most BatchSize samples.
+// Samples that fail to be written are dropped.
+func (recorder *PerformanceRecorder) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	recorder.mu.Lock()
+	samples := recorder.samples
+	recorder.samples = nil
+	recorder.mu.Unlock()
+
+	mon.IntVal("performance_samples").Observe(int64(len(samples)))
+
+	batchSize := recorder.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = len(samples)
+	}
+	for len(samples) > 0 {
+		batch := samples
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		samples = samples[len(batch):]
+
+		if err := recorder.cache.UpdatePerformance(ctx, batch); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Close stops the recorder.
+func (recorder *PerformanceRecorder) Close() error {
+	recorder.Loop.Close()
+	return nil
+}
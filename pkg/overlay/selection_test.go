@@ -6,14 +6,17 @@ package overlay_test
 import (
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -246,6 +249,151 @@ func TestDistinctIPs(t *testing.T) {
 	})
 }
 
+func TestPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Overlay.Service
+		cache := satellite.DB.OverlayCache()
+
+		// nodes[0:3] are tagged, nodes[0:2] are located in Germany and the others in the US
+		located := map[storj.NodeID]string{}
+		tagged := map[storj.NodeID]bool{}
+		for i, node := range planet.StorageNodes {
+			country := "US"
+			if i < 2 {
+				country = "DE"
+			}
+			require.NoError(t, cache.UpdateCountry(ctx, node.ID(), country))
+			located[node.ID()] = country
+
+			var tags []string
+			if i < 3 {
+				tags = []string{"ssd", "invalid tag"}
+				tagged[node.ID()] = true
+			}
+			_, err := service.UpdateNodeInfo(ctx, node.ID(), &pb.InfoResponse{
+				Operator: &pb.NodeOperator{Tags: tags},
+			})
+			require.NoError(t, err)
+		}
+
+		preferences := testNodeSelectionConfig(0, 0, false)
+		for i, tt := range []struct {
+			placement      *pb.PlacementPolicy
+			requestCount   int
+			shouldFailWith *errs.Class
+		}{
+			{placement: &pb.PlacementPolicy{RequiredTags: []string{"ssd"}}, requestCount: 3},
+			{placement: &pb.PlacementPolicy{RequiredTags: []string{"ssd"}}, requestCount: 4, shouldFailWith: &overlay.ErrNotEnoughNodes},
+			{placement: &pb.PlacementPolicy{RequiredTags: []string{"invalid tag"}}, requestCount: 1, shouldFailWith: &overlay.ErrNotEnoughNodes},
+			{placement: &pb.PlacementPolicy{AllowedCountries: []string{"DE"}}, requestCount: 2},
+			{placement: &pb.PlacementPolicy{AllowedCountries: []string{"DE"}}, requestCount: 3, shouldFailWith: &overlay.ErrNotEnoughNodes},
+			{placement: &pb.PlacementPolicy{DeniedCountries: []string{"DE"}}, requestCount: 4},
+			{placement: &pb.PlacementPolicy{DeniedCountries: []string{"DE"}, RequiredTags: []string{"ssd"}}, requestCount: 1},
+			{placement: &pb.PlacementPolicy{DeniedNetworks: []string{"10.0.0.0/8"}}, requestCount: 6},
+			{placement: &pb.PlacementPolicy{DeniedNetworks: []string{"127.0.0.0/8"}}, requestCount: 1, shouldFailWith: &overlay.ErrNotEnoughNodes},
+			{placement: &pb.PlacementPolicy{DeniedNetworks: []string{"127.0.0.1/32"}}, requestCount: 1, shouldFailWith: &overlay.ErrNotEnoughNodes},
+			{placement: &pb.PlacementPolicy{DistinctSubnets: true}, requestCount: 2, shouldFailWith: &overlay.ErrNotEnoughNodes},
+		} {
+			nodes, err := service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: tt.requestCount,
+				Placement:      tt.placement,
+			}, &preferences)
+			if tt.shouldFailWith != nil {
+				require.Error(t, err, i)
+				require.True(t, tt.shouldFailWith.Has(err), i)
+				continue
+			}
+			require.NoError(t, err, i)
+			require.Len(t, nodes, tt.requestCount, i)
+
+			for _, node := range nodes {
+				if len(tt.placement.RequiredTags) > 0 {
+					assert.True(t, tagged[node.Id], i)
+				}
+				if len(tt.placement.AllowedCountries) > 0 {
+					assert.Contains(t, tt.placement.AllowedCountries, located[node.Id], i)
+				}
+				assert.NotContains(t, tt.placement.DeniedCountries, located[node.Id], i)
+			}
+		}
+	})
+}
+
+func TestPerformanceWeightedSelection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 10, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Audit.Service.Loop.Pause()
+		service := satellite.Overlay.Service
+
+		// half of the nodes are a hundred times faster than the others
+		fast := map[storj.NodeID]bool{}
+		var samples []overlay.PerformanceSample
+		for i, node := range planet.StorageNodes {
+			bytesPerSecond := int64(memory.MiB)
+			if i%2 == 0 {
+				bytesPerSecond = 100 * memory.MiB.Int64()
+				fast[node.ID()] = true
+			}
+			samples = append(samples, overlay.PerformanceSample{
+				NodeID:   node.ID(),
+				Action:   pb.PieceAction_PUT,
+				Bytes:    bytesPerSecond,
+				Duration: time.Second,
+			})
+		}
+		require.NoError(t, service.UpdatePerformance(ctx, samples))
+
+		// the estimated latency rises quickly and falls slowly
+		latencyNode := planet.StorageNodes[1].ID()
+		for _, latency := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 10 * time.Millisecond} {
+			require.NoError(t, service.UpdatePerformance(ctx, []overlay.PerformanceSample{
+				{NodeID: latencyNode, Action: pb.PieceAction_GET_AUDIT, Latency: latency},
+			}))
+		}
+		dossier, err := service.Get(ctx, latencyNode)
+		require.NoError(t, err)
+		require.Equal(t, int64(107), dossier.Reputation.Latency90)
+
+		performances, err := satellite.DB.OverlayCache().GetPerformance(ctx, storj.NodeIDList{latencyNode})
+		require.NoError(t, err)
+		require.Equal(t, 107*time.Millisecond, performances[latencyNode].Latency90)
+		require.Equal(t, float64(memory.MiB), performances[latencyNode].UploadBytesPerSecond)
+
+		selected := map[bool]int{}
+		for _, weight := range []float64{0, 4} {
+			preferences := testNodeSelectionConfig(0, 0, false)
+			preferences.PerformanceWeight = weight
+			preferences.PerformanceOversample = 2
+
+			selected = map[bool]int{}
+			for i := 0; i < 20; i++ {
+				nodes, err := service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{
+					RequestedCount: 5,
+				}, &preferences)
+				require.NoError(t, err)
+				require.Len(t, nodes, 5)
+
+				for _, node := range nodes {
+					selected[fast[node.Id]]++
+				}
+			}
+
+			if weight == 0 {
+				// without weighting, the slow nodes are selected as well
+				assert.NotZero(t, selected[false])
+			}
+		}
+
+		// with weighting, the fast nodes are selected almost exclusively
+		assert.True(t, selected[true] > 9*selected[false], "fast %d, slow %d", selected[true], selected[false])
+	})
+}
+
 func TestAddrtoNetwork_Conversion(t *testing.T) {
 	ctx := testcontext.New(t)
 
@@ -259,3 +407,17 @@ func TestAddrtoNetwork_Conversion(t *testing.T) {
 	require.Equal(t, "fc00::", network)
 	require.NoError(t, err)
 }
+
+func TestResolveIPAndNetwork(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ip, network, err := overlay.ResolveIPAndNetwork(ctx, "8.8.8.8:28967")
+	require.NoError(t, err)
+	require.Equal(t, "8.8.8.8", ip)
+	require.Equal(t, "8.8.8.0", network)
+
+	ip, network, err = overlay.ResolveIPAndNetwork(ctx, "[fc00::1:200]:28967")
+	require.NoError(t, err)
+	require.Equal(t, "fc00::1:200", ip)
+	require.Equal(t, "fc00::", network)
+}
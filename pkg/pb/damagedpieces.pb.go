@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: damagedpieces.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type DamagedPiece_Reason int32
+
+const (
+	DamagedPiece_CORRUPTED DamagedPiece_Reason = 0
+	DamagedPiece_MISSING   DamagedPiece_Reason = 1
+)
+
+var DamagedPiece_Reason_name = map[int32]string{
+	0: "CORRUPTED",
+	1: "MISSING",
+}
+
+var DamagedPiece_Reason_value = map[string]int32{
+	"CORRUPTED": 0,
+	"MISSING":   1,
+}
+
+func (x DamagedPiece_Reason) String() string {
+	return proto.EnumName(DamagedPiece_Reason_name, int32(x))
+}
+
+func (DamagedPiece_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e8ad89b27df18cbc, []int{0, 0}
+}
+
+// DamagedPiece is a piece, which a storage node found damaged on its disk
+type DamagedPiece struct {
+	PieceId              PieceID             `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	Reason               DamagedPiece_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=damagedpieces.DamagedPiece_Reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DamagedPiece) Reset()         { *m = DamagedPiece{} }
+func (m *DamagedPiece) String() string { return proto.CompactTextString(m) }
+func (*DamagedPiece) ProtoMessage()    {}
+func (*DamagedPiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8ad89b27df18cbc, []int{0}
+}
+func (m *DamagedPiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DamagedPiece.Unmarshal(m, b)
+}
+func (m *DamagedPiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DamagedPiece.Marshal(b, m, deterministic)
+}
+func (m *DamagedPiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DamagedPiece.Merge(m, src)
+}
+func (m *DamagedPiece) XXX_Size() int {
+	return xxx_messageInfo_DamagedPiece.Size(m)
+}
+func (m *DamagedPiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_DamagedPiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DamagedPiece proto.InternalMessageInfo
+
+func (m *DamagedPiece) GetReason() DamagedPiece_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return DamagedPiece_CORRUPTED
+}
+
+type DamagedPiecesReportRequest struct {
+	Pieces               []*DamagedPiece `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DamagedPiecesReportRequest) Reset()         { *m = DamagedPiecesReportRequest{} }
+func (m *DamagedPiecesReportRequest) String() string { return proto.CompactTextString(m) }
+func (*DamagedPiecesReportRequest) ProtoMessage()    {}
+func (*DamagedPiecesReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8ad89b27df18cbc, []int{1}
+}
+func (m *DamagedPiecesReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DamagedPiecesReportRequest.Unmarshal(m, b)
+}
+func (m *DamagedPiecesReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DamagedPiecesReportRequest.Marshal(b, m, deterministic)
+}
+func (m *DamagedPiecesReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DamagedPiecesReportRequest.Merge(m, src)
+}
+func (m *DamagedPiecesReportRequest) XXX_Size() int {
+	return xxx_messageInfo_DamagedPiecesReportRequest.Size(m)
+}
+func (m *DamagedPiecesReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DamagedPiecesReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DamagedPiecesReportRequest proto.InternalMessageInfo
+
+func (m *DamagedPiecesReportRequest) GetPieces() []*DamagedPiece {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
+}
+
+type DamagedPiecesReportResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DamagedPiecesReportResponse) Reset()         { *m = DamagedPiecesReportResponse{} }
+func (m *DamagedPiecesReportResponse) String() string { return proto.CompactTextString(m) }
+func (*DamagedPiecesReportResponse) ProtoMessage()    {}
+func (*DamagedPiecesReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8ad89b27df18cbc, []int{2}
+}
+func (m *DamagedPiecesReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DamagedPiecesReportResponse.Unmarshal(m, b)
+}
+func (m *DamagedPiecesReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DamagedPiecesReportResponse.Marshal(b, m, deterministic)
+}
+func (m *DamagedPiecesReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DamagedPiecesReportResponse.Merge(m, src)
+}
+func (m *DamagedPiecesReportResponse) XXX_Size() int {
+	return xxx_messageInfo_DamagedPiecesReportResponse.Size(m)
+}
+func (m *DamagedPiecesReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DamagedPiecesReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DamagedPiecesReportResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("damagedpieces.DamagedPiece_Reason", DamagedPiece_Reason_name, DamagedPiece_Reason_value)
+	proto.RegisterType((*DamagedPiece)(nil), "damagedpieces.DamagedPiece")
+	proto.RegisterType((*DamagedPiecesReportRequest)(nil), "damagedpieces.DamagedPiecesReportRequest")
+	proto.RegisterType((*DamagedPiecesReportResponse)(nil), "damagedpieces.DamagedPiecesReportResponse")
+}
+
+func init() { proto.RegisterFile("damagedpieces.proto", fileDescriptor_e8ad89b27df18cbc) }
+
+var fileDescriptor_e8ad89b27df18cbc = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0x49, 0xcc, 0x4d,
+	0x4c, 0x4f, 0x4d, 0x29, 0xc8, 0x4c, 0x4d, 0x4e, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0xe2, 0x45, 0x11, 0x94, 0xe2, 0x4a, 0xcf, 0x4f, 0xcf, 0x87, 0x48, 0x29, 0xcd, 0x61, 0xe4, 0xe2,
+	0x71, 0x81, 0xc8, 0x06, 0x80, 0x64, 0x85, 0xb4, 0xb8, 0x38, 0xc0, 0xca, 0xe2, 0x33, 0x53, 0x24,
+	0x18, 0x15, 0x18, 0x35, 0x78, 0x9c, 0xf8, 0x4f, 0xdc, 0x93, 0x67, 0xb8, 0x75, 0x4f, 0x9e, 0x1d,
+	0xac, 0xc0, 0xd3, 0x25, 0x88, 0x1d, 0xac, 0xc0, 0x33, 0x45, 0xc8, 0x8a, 0x8b, 0xad, 0x28, 0x35,
+	0xb1, 0x38, 0x3f, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xcf, 0x48, 0x49, 0x0f, 0xd5, 0x76, 0x64,
+	0x83, 0xf5, 0x82, 0xc0, 0x2a, 0x83, 0xa0, 0x3a, 0x94, 0x54, 0xb8, 0xd8, 0x20, 0x22, 0x42, 0xbc,
+	0x5c, 0x9c, 0xce, 0xfe, 0x41, 0x41, 0xa1, 0x01, 0x21, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0xdc, 0x5c,
+	0xec, 0xbe, 0x9e, 0xc1, 0xc1, 0x9e, 0x7e, 0xee, 0x02, 0x8c, 0x4a, 0x81, 0x5c, 0x52, 0xc8, 0x86,
+	0x14, 0x07, 0xa5, 0x16, 0xe4, 0x17, 0x95, 0x04, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x08, 0x19,
+	0x73, 0xb1, 0x41, 0x6c, 0x92, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xc6, 0x63, 0x7f, 0x10,
+	0x54, 0xa9, 0x92, 0x2c, 0x97, 0x34, 0x56, 0x23, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x8d, 0x8a,
+	0xb8, 0x78, 0x51, 0xa4, 0x85, 0x12, 0xb9, 0xd8, 0x20, 0x4a, 0x84, 0x34, 0xf1, 0x18, 0x8f, 0xea,
+	0x32, 0x29, 0x2d, 0x62, 0x94, 0x42, 0x6c, 0x54, 0x62, 0x70, 0x62, 0x89, 0x62, 0x2a, 0x48, 0x4a,
+	0x62, 0x03, 0xc7, 0x88, 0x31, 0x60, 0x00, 0xe1, 0xa8, 0x4f, 0xd6, 0xc3, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DamagedPiecesClient is the client API for DamagedPieces service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DamagedPiecesClient interface {
+	// Report tells the satellite about damaged pieces, so it can repair them early
+	Report(ctx context.Context, in *DamagedPiecesReportRequest, opts ...grpc.CallOption) (*DamagedPiecesReportResponse, error)
+}
+
+type damagedPiecesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDamagedPiecesClient(cc *grpc.ClientConn) DamagedPiecesClient {
+	return &damagedPiecesClient{cc}
+}
+
+func (c *damagedPiecesClient) Report(ctx context.Context, in *DamagedPiecesReportRequest, opts ...grpc.CallOption) (*DamagedPiecesReportResponse, error) {
+	out := new(DamagedPiecesReportResponse)
+	err := c.cc.Invoke(ctx, "/damagedpieces.DamagedPieces/Report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DamagedPiecesServer is the server API for DamagedPieces service.
+type DamagedPiecesServer interface {
+	// Report tells the satellite about damaged pieces, so it can repair them early
+	Report(context.Context, *DamagedPiecesReportRequest) (*DamagedPiecesReportResponse, error)
+}
+
+func RegisterDamagedPiecesServer(s *grpc.Server, srv DamagedPiecesServer) {
+	s.RegisterService(&_DamagedPieces_serviceDesc, srv)
+}
+
+func _DamagedPieces_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DamagedPiecesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DamagedPiecesServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/damagedpieces.DamagedPieces/Report",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DamagedPiecesServer).Report(ctx, req.(*DamagedPiecesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DamagedPieces_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "damagedpieces.DamagedPieces",
+	HandlerType: (*DamagedPiecesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Report",
+			Handler:    _DamagedPieces_Report_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "damagedpieces.proto",
+}
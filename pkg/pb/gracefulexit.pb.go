@@ -0,0 +1,644 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gracefulexit.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type TransferFailed_Error int32
+
+const (
+	TransferFailed_NOT_FOUND                TransferFailed_Error = 0
+	TransferFailed_STORAGE_NODE_UNAVAILABLE TransferFailed_Error = 1
+	TransferFailed_UNKNOWN                  TransferFailed_Error = 2
+)
+
+var TransferFailed_Error_name = map[int32]string{
+	0: "NOT_FOUND",
+	1: "STORAGE_NODE_UNAVAILABLE",
+	2: "UNKNOWN",
+}
+
+var TransferFailed_Error_value = map[string]int32{
+	"NOT_FOUND":                0,
+	"STORAGE_NODE_UNAVAILABLE": 1,
+	"UNKNOWN":                  2,
+}
+
+func (x TransferFailed_Error) String() string {
+	return proto.EnumName(TransferFailed_Error_name, int32(x))
+}
+
+func (TransferFailed_Error) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{2, 0}
+}
+
+type ExitFailed_Reason int32
+
+const (
+	ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED ExitFailed_Reason = 0
+)
+
+var ExitFailed_Reason_name = map[int32]string{
+	0: "OVERALL_FAILURE_PERCENTAGE_EXCEEDED",
+}
+
+var ExitFailed_Reason_value = map[string]int32{
+	"OVERALL_FAILURE_PERCENTAGE_EXCEEDED": 0,
+}
+
+func (x ExitFailed_Reason) String() string {
+	return proto.EnumName(ExitFailed_Reason_name, int32(x))
+}
+
+func (ExitFailed_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{8, 0}
+}
+
+// Expected order of messages from satellite:
+//
+//	<- NotReady (the transfer queue isn't complete yet, try again later)
+//
+// or
+//
+//	repeated
+//	   <- TransferPiece
+//	   StorageNodeMessage.{Succeeded|Failed} ->
+//	   <- DeletePiece (after a successful transfer)
+//	<- ExitCompleted or ExitFailed
+type StorageNodeMessage struct {
+	Succeeded            *TransferSucceeded `protobuf:"bytes,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed               *TransferFailed    `protobuf:"bytes,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StorageNodeMessage) Reset()         { *m = StorageNodeMessage{} }
+func (m *StorageNodeMessage) String() string { return proto.CompactTextString(m) }
+func (*StorageNodeMessage) ProtoMessage()    {}
+func (*StorageNodeMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{0}
+}
+func (m *StorageNodeMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageNodeMessage.Unmarshal(m, b)
+}
+func (m *StorageNodeMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageNodeMessage.Marshal(b, m, deterministic)
+}
+func (m *StorageNodeMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageNodeMessage.Merge(m, src)
+}
+func (m *StorageNodeMessage) XXX_Size() int {
+	return xxx_messageInfo_StorageNodeMessage.Size(m)
+}
+func (m *StorageNodeMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageNodeMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageNodeMessage proto.InternalMessageInfo
+
+func (m *StorageNodeMessage) GetSucceeded() *TransferSucceeded {
+	if m != nil {
+		return m.Succeeded
+	}
+	return nil
+}
+
+func (m *StorageNodeMessage) GetFailed() *TransferFailed {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+type TransferSucceeded struct {
+	// id of the piece on the exiting storage node
+	PieceId PieceID `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	// hash signed by the uplink, which uploaded the piece originally
+	OriginalPieceHash *PieceHash `protobuf:"bytes,2,opt,name=original_piece_hash,json=originalPieceHash,proto3" json:"original_piece_hash,omitempty"`
+	// id of the uplink, which uploaded the piece originally
+	OriginalUplinkId NodeID `protobuf:"bytes,3,opt,name=original_uplink_id,json=originalUplinkId,proto3,customtype=NodeID" json:"original_uplink_id"`
+	// hash signed by the storage node, which received the piece
+	ReplacementPieceHash *PieceHash `protobuf:"bytes,4,opt,name=replacement_piece_hash,json=replacementPieceHash,proto3" json:"replacement_piece_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TransferSucceeded) Reset()         { *m = TransferSucceeded{} }
+func (m *TransferSucceeded) String() string { return proto.CompactTextString(m) }
+func (*TransferSucceeded) ProtoMessage()    {}
+func (*TransferSucceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{1}
+}
+func (m *TransferSucceeded) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferSucceeded.Unmarshal(m, b)
+}
+func (m *TransferSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferSucceeded.Marshal(b, m, deterministic)
+}
+func (m *TransferSucceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferSucceeded.Merge(m, src)
+}
+func (m *TransferSucceeded) XXX_Size() int {
+	return xxx_messageInfo_TransferSucceeded.Size(m)
+}
+func (m *TransferSucceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferSucceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferSucceeded proto.InternalMessageInfo
+
+func (m *TransferSucceeded) GetOriginalPieceHash() *PieceHash {
+	if m != nil {
+		return m.OriginalPieceHash
+	}
+	return nil
+}
+
+func (m *TransferSucceeded) GetReplacementPieceHash() *PieceHash {
+	if m != nil {
+		return m.ReplacementPieceHash
+	}
+	return nil
+}
+
+type TransferFailed struct {
+	PieceId              PieceID              `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	Error                TransferFailed_Error `protobuf:"varint,2,opt,name=error,proto3,enum=gracefulexit.TransferFailed_Error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferFailed) Reset()         { *m = TransferFailed{} }
+func (m *TransferFailed) String() string { return proto.CompactTextString(m) }
+func (*TransferFailed) ProtoMessage()    {}
+func (*TransferFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{2}
+}
+func (m *TransferFailed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferFailed.Unmarshal(m, b)
+}
+func (m *TransferFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferFailed.Marshal(b, m, deterministic)
+}
+func (m *TransferFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFailed.Merge(m, src)
+}
+func (m *TransferFailed) XXX_Size() int {
+	return xxx_messageInfo_TransferFailed.Size(m)
+}
+func (m *TransferFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFailed proto.InternalMessageInfo
+
+func (m *TransferFailed) GetError() TransferFailed_Error {
+	if m != nil {
+		return m.Error
+	}
+	return TransferFailed_NOT_FOUND
+}
+
+type SatelliteMessage struct {
+	NotReady             *NotReady      `protobuf:"bytes,1,opt,name=not_ready,json=notReady,proto3" json:"not_ready,omitempty"`
+	TransferPiece        *TransferPiece `protobuf:"bytes,2,opt,name=transfer_piece,json=transferPiece,proto3" json:"transfer_piece,omitempty"`
+	DeletePiece          *DeletePiece   `protobuf:"bytes,3,opt,name=delete_piece,json=deletePiece,proto3" json:"delete_piece,omitempty"`
+	ExitCompleted        *ExitCompleted `protobuf:"bytes,4,opt,name=exit_completed,json=exitCompleted,proto3" json:"exit_completed,omitempty"`
+	ExitFailed           *ExitFailed    `protobuf:"bytes,5,opt,name=exit_failed,json=exitFailed,proto3" json:"exit_failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SatelliteMessage) Reset()         { *m = SatelliteMessage{} }
+func (m *SatelliteMessage) String() string { return proto.CompactTextString(m) }
+func (*SatelliteMessage) ProtoMessage()    {}
+func (*SatelliteMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{3}
+}
+func (m *SatelliteMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteMessage.Unmarshal(m, b)
+}
+func (m *SatelliteMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteMessage.Marshal(b, m, deterministic)
+}
+func (m *SatelliteMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteMessage.Merge(m, src)
+}
+func (m *SatelliteMessage) XXX_Size() int {
+	return xxx_messageInfo_SatelliteMessage.Size(m)
+}
+func (m *SatelliteMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteMessage proto.InternalMessageInfo
+
+func (m *SatelliteMessage) GetNotReady() *NotReady {
+	if m != nil {
+		return m.NotReady
+	}
+	return nil
+}
+
+func (m *SatelliteMessage) GetTransferPiece() *TransferPiece {
+	if m != nil {
+		return m.TransferPiece
+	}
+	return nil
+}
+
+func (m *SatelliteMessage) GetDeletePiece() *DeletePiece {
+	if m != nil {
+		return m.DeletePiece
+	}
+	return nil
+}
+
+func (m *SatelliteMessage) GetExitCompleted() *ExitCompleted {
+	if m != nil {
+		return m.ExitCompleted
+	}
+	return nil
+}
+
+func (m *SatelliteMessage) GetExitFailed() *ExitFailed {
+	if m != nil {
+		return m.ExitFailed
+	}
+	return nil
+}
+
+type NotReady struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotReady) Reset()         { *m = NotReady{} }
+func (m *NotReady) String() string { return proto.CompactTextString(m) }
+func (*NotReady) ProtoMessage()    {}
+func (*NotReady) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{4}
+}
+func (m *NotReady) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotReady.Unmarshal(m, b)
+}
+func (m *NotReady) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotReady.Marshal(b, m, deterministic)
+}
+func (m *NotReady) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotReady.Merge(m, src)
+}
+func (m *NotReady) XXX_Size() int {
+	return xxx_messageInfo_NotReady.Size(m)
+}
+func (m *NotReady) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotReady.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotReady proto.InternalMessageInfo
+
+type TransferPiece struct {
+	// id of the piece on the exiting storage node
+	PieceId PieceID `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	// order limit for uploading the piece to the receiving storage node
+	Limit                *OrderLimit  `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	StorageNodeAddress   *NodeAddress `protobuf:"bytes,3,opt,name=storage_node_address,json=storageNodeAddress,proto3" json:"storage_node_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TransferPiece) Reset()         { *m = TransferPiece{} }
+func (m *TransferPiece) String() string { return proto.CompactTextString(m) }
+func (*TransferPiece) ProtoMessage()    {}
+func (*TransferPiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{5}
+}
+func (m *TransferPiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferPiece.Unmarshal(m, b)
+}
+func (m *TransferPiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferPiece.Marshal(b, m, deterministic)
+}
+func (m *TransferPiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPiece.Merge(m, src)
+}
+func (m *TransferPiece) XXX_Size() int {
+	return xxx_messageInfo_TransferPiece.Size(m)
+}
+func (m *TransferPiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPiece proto.InternalMessageInfo
+
+func (m *TransferPiece) GetLimit() *OrderLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *TransferPiece) GetStorageNodeAddress() *NodeAddress {
+	if m != nil {
+		return m.StorageNodeAddress
+	}
+	return nil
+}
+
+type DeletePiece struct {
+	PieceId              PieceID  `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePiece) Reset()         { *m = DeletePiece{} }
+func (m *DeletePiece) String() string { return proto.CompactTextString(m) }
+func (*DeletePiece) ProtoMessage()    {}
+func (*DeletePiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{6}
+}
+func (m *DeletePiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePiece.Unmarshal(m, b)
+}
+func (m *DeletePiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePiece.Marshal(b, m, deterministic)
+}
+func (m *DeletePiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePiece.Merge(m, src)
+}
+func (m *DeletePiece) XXX_Size() int {
+	return xxx_messageInfo_DeletePiece.Size(m)
+}
+func (m *DeletePiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePiece proto.InternalMessageInfo
+
+type ExitCompleted struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExitCompleted) Reset()         { *m = ExitCompleted{} }
+func (m *ExitCompleted) String() string { return proto.CompactTextString(m) }
+func (*ExitCompleted) ProtoMessage()    {}
+func (*ExitCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{7}
+}
+func (m *ExitCompleted) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitCompleted.Unmarshal(m, b)
+}
+func (m *ExitCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitCompleted.Marshal(b, m, deterministic)
+}
+func (m *ExitCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitCompleted.Merge(m, src)
+}
+func (m *ExitCompleted) XXX_Size() int {
+	return xxx_messageInfo_ExitCompleted.Size(m)
+}
+func (m *ExitCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitCompleted proto.InternalMessageInfo
+
+type ExitFailed struct {
+	Reason               ExitFailed_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=gracefulexit.ExitFailed_Reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExitFailed) Reset()         { *m = ExitFailed{} }
+func (m *ExitFailed) String() string { return proto.CompactTextString(m) }
+func (*ExitFailed) ProtoMessage()    {}
+func (*ExitFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{8}
+}
+func (m *ExitFailed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitFailed.Unmarshal(m, b)
+}
+func (m *ExitFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitFailed.Marshal(b, m, deterministic)
+}
+func (m *ExitFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitFailed.Merge(m, src)
+}
+func (m *ExitFailed) XXX_Size() int {
+	return xxx_messageInfo_ExitFailed.Size(m)
+}
+func (m *ExitFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitFailed proto.InternalMessageInfo
+
+func (m *ExitFailed) GetReason() ExitFailed_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED
+}
+
+func init() {
+	proto.RegisterEnum("gracefulexit.TransferFailed_Error", TransferFailed_Error_name, TransferFailed_Error_value)
+	proto.RegisterEnum("gracefulexit.ExitFailed_Reason", ExitFailed_Reason_name, ExitFailed_Reason_value)
+	proto.RegisterType((*StorageNodeMessage)(nil), "gracefulexit.StorageNodeMessage")
+	proto.RegisterType((*TransferSucceeded)(nil), "gracefulexit.TransferSucceeded")
+	proto.RegisterType((*TransferFailed)(nil), "gracefulexit.TransferFailed")
+	proto.RegisterType((*SatelliteMessage)(nil), "gracefulexit.SatelliteMessage")
+	proto.RegisterType((*NotReady)(nil), "gracefulexit.NotReady")
+	proto.RegisterType((*TransferPiece)(nil), "gracefulexit.TransferPiece")
+	proto.RegisterType((*DeletePiece)(nil), "gracefulexit.DeletePiece")
+	proto.RegisterType((*ExitCompleted)(nil), "gracefulexit.ExitCompleted")
+	proto.RegisterType((*ExitFailed)(nil), "gracefulexit.ExitFailed")
+}
+
+func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_8f0acbf2ce5fa631) }
+
+var fileDescriptor_8f0acbf2ce5fa631 = []byte{
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0xee, 0x16, 0xda, 0xc2, 0xe9, 0x0f, 0xed, 0x88, 0xa4, 0x22, 0x11, 0xb2, 0x5e, 0x48, 0xbc,
+	0x68, 0x14, 0x4c, 0x94, 0x04, 0x2f, 0xb6, 0xed, 0x82, 0x8d, 0x75, 0x8b, 0xd3, 0x16, 0x8d, 0x37,
+	0x9b, 0xa5, 0x7b, 0x28, 0xab, 0xcb, 0x4e, 0x33, 0xb3, 0x24, 0xf8, 0x08, 0xbc, 0x8a, 0x6f, 0xe0,
+	0x1b, 0xf0, 0x0c, 0x5e, 0xf0, 0x2c, 0x66, 0x66, 0xa7, 0x7f, 0x80, 0x26, 0x5c, 0x75, 0xcf, 0x99,
+	0xef, 0x7c, 0xe7, 0x9b, 0xaf, 0x67, 0x0e, 0x90, 0x21, 0xf7, 0x06, 0x78, 0x7a, 0x11, 0xe2, 0x65,
+	0x10, 0xd7, 0x46, 0x9c, 0xc5, 0x8c, 0x14, 0x66, 0x73, 0xeb, 0x30, 0x64, 0x43, 0x96, 0x9c, 0xac,
+	0x43, 0xc4, 0x7c, 0xd4, 0xdf, 0x05, 0xc6, 0x7d, 0xe4, 0x22, 0x89, 0xcc, 0x2b, 0x03, 0x48, 0x37,
+	0x66, 0xdc, 0x1b, 0xa2, 0xc3, 0x7c, 0xfc, 0x84, 0x42, 0x78, 0x43, 0x24, 0xef, 0x61, 0x59, 0x5c,
+	0x0c, 0x06, 0x88, 0x3e, 0xfa, 0x55, 0x63, 0xcb, 0xd8, 0xce, 0xef, 0x6c, 0xd6, 0xe6, 0x5a, 0xf6,
+	0xb8, 0x17, 0x89, 0x53, 0xe4, 0xdd, 0x31, 0x8c, 0x4e, 0x2b, 0xc8, 0x1b, 0xc8, 0x9e, 0x7a, 0x41,
+	0x88, 0x7e, 0x35, 0xad, 0x6a, 0x37, 0xee, 0xaf, 0x3d, 0x50, 0x18, 0xaa, 0xb1, 0xe6, 0x55, 0x1a,
+	0x2a, 0x77, 0x68, 0xc9, 0x4b, 0x58, 0x1a, 0x05, 0x38, 0x40, 0x37, 0x48, 0x94, 0x14, 0xea, 0x2b,
+	0xd7, 0x37, 0x9b, 0xa9, 0x3f, 0x37, 0x9b, 0xb9, 0x23, 0x99, 0x6f, 0x35, 0x69, 0x4e, 0x01, 0x5a,
+	0x3e, 0xb1, 0xe0, 0x11, 0xe3, 0xc1, 0x30, 0x88, 0xbc, 0xd0, 0x4d, 0x8a, 0xce, 0x3c, 0x71, 0xa6,
+	0x45, 0x54, 0x6a, 0xfa, 0xe6, 0xaa, 0xec, 0x83, 0x27, 0xce, 0x68, 0x65, 0x8c, 0x9e, 0xa4, 0xc8,
+	0x3e, 0x90, 0x09, 0xc5, 0xc5, 0x28, 0x0c, 0xa2, 0x1f, 0xb2, 0xf1, 0x82, 0x6a, 0x5c, 0xd2, 0x8d,
+	0xb3, 0xd2, 0xaa, 0x56, 0x93, 0x96, 0xc7, 0xc8, 0xbe, 0x02, 0xb6, 0x7c, 0x72, 0x08, 0x6b, 0x1c,
+	0x47, 0xa1, 0x37, 0xc0, 0x73, 0x8c, 0xe2, 0x59, 0x0d, 0x8b, 0xff, 0xd2, 0xb0, 0x3a, 0x53, 0x30,
+	0xc9, 0x9a, 0xbf, 0x0d, 0x28, 0xcd, 0xdb, 0xf4, 0x20, 0x23, 0xde, 0x41, 0x06, 0x39, 0x67, 0x5c,
+	0x5d, 0xbd, 0xb4, 0x63, 0xfe, 0xcf, 0xff, 0x9a, 0x2d, 0x91, 0x34, 0x29, 0x30, 0x2d, 0xc8, 0xa8,
+	0x98, 0x14, 0x61, 0xd9, 0xe9, 0xf4, 0xdc, 0x83, 0x4e, 0xdf, 0x69, 0x96, 0x53, 0x64, 0x03, 0xaa,
+	0xdd, 0x5e, 0x87, 0x5a, 0x87, 0xb6, 0xeb, 0x74, 0x9a, 0xb6, 0xdb, 0x77, 0xac, 0x63, 0xab, 0xd5,
+	0xb6, 0xea, 0x6d, 0xbb, 0x6c, 0x90, 0x3c, 0xe4, 0xfa, 0xce, 0x47, 0xa7, 0xf3, 0xc5, 0x29, 0xa7,
+	0xcd, 0xeb, 0x34, 0x94, 0xbb, 0x5e, 0x8c, 0x61, 0x18, 0xc4, 0x93, 0x89, 0xda, 0x85, 0xe5, 0x88,
+	0xc5, 0x2e, 0x47, 0xcf, 0xff, 0xa9, 0x27, 0x6a, 0x6d, 0x5e, 0x95, 0xc3, 0x62, 0x2a, 0x4f, 0xe9,
+	0x52, 0xa4, 0xbf, 0x48, 0x1d, 0x4a, 0xb1, 0xd6, 0x9a, 0x78, 0xa9, 0xff, 0xca, 0xa7, 0xf7, 0xdf,
+	0x47, 0xd9, 0x40, 0x8b, 0xf1, 0x6c, 0x48, 0xf6, 0xa1, 0xe0, 0x63, 0x88, 0x31, 0x6a, 0x86, 0x05,
+	0xc5, 0xf0, 0x64, 0x9e, 0xa1, 0xa9, 0x10, 0x49, 0x7d, 0xde, 0x9f, 0x06, 0x52, 0x81, 0x04, 0xb8,
+	0x03, 0x76, 0x3e, 0x92, 0x59, 0xbf, 0xba, 0x78, 0x9f, 0x02, 0xfb, 0x32, 0x88, 0x1b, 0x63, 0x08,
+	0x2d, 0xe2, 0x6c, 0x48, 0xf6, 0x20, 0xaf, 0x38, 0xf4, 0x93, 0xc8, 0x28, 0x82, 0xea, 0x5d, 0x02,
+	0xfd, 0x1c, 0x00, 0x27, 0xdf, 0x26, 0xc0, 0xd2, 0xd8, 0x16, 0xf3, 0x97, 0x01, 0xc5, 0xb9, 0x9b,
+	0x3e, 0x68, 0x22, 0xb6, 0x21, 0x13, 0x06, 0xe7, 0x41, 0xac, 0x1d, 0x24, 0xe3, 0x41, 0xec, 0xc8,
+	0x9f, 0xb6, 0x3c, 0xa1, 0x09, 0x80, 0x34, 0x60, 0x55, 0x24, 0x1b, 0xc1, 0x95, 0x6b, 0xc3, 0xf5,
+	0x7c, 0x9f, 0xa3, 0x10, 0xda, 0xb8, 0x4a, 0x4d, 0x26, 0x6b, 0xf2, 0x05, 0x58, 0xc9, 0x01, 0x25,
+	0x62, 0xba, 0x40, 0x74, 0xce, 0xdc, 0x83, 0xfc, 0x8c, 0xa7, 0x0f, 0x51, 0x6a, 0xae, 0x40, 0x71,
+	0xce, 0x4e, 0xf3, 0x12, 0x60, 0x6a, 0x0f, 0x79, 0x0b, 0x59, 0x8e, 0x9e, 0x60, 0x91, 0x22, 0x2a,
+	0xdd, 0xde, 0x4b, 0x53, 0x64, 0x8d, 0x2a, 0x18, 0xd5, 0x70, 0xf3, 0x35, 0x64, 0x93, 0x0c, 0x79,
+	0x01, 0xcf, 0x3b, 0xc7, 0x36, 0xb5, 0xda, 0x6d, 0xf7, 0xc0, 0x6a, 0xb5, 0xfb, 0xd4, 0x76, 0x8f,
+	0x6c, 0xda, 0xb0, 0x9d, 0x9e, 0x1c, 0x6f, 0xfb, 0x6b, 0xc3, 0xb6, 0x9b, 0x76, 0xb3, 0x9c, 0xda,
+	0xf9, 0x0e, 0x8f, 0x27, 0x83, 0x7c, 0xa8, 0xbb, 0xc8, 0x06, 0xe4, 0x33, 0xe4, 0x8e, 0x38, 0x1b,
+	0xa0, 0x10, 0x64, 0x6b, 0xbe, 0xff, 0xdd, 0x65, 0xba, 0xfe, 0xec, 0x16, 0xe2, 0xd6, 0xd3, 0x30,
+	0x53, 0xdb, 0xc6, 0x2b, 0xa3, 0xbe, 0xf8, 0x2d, 0x3d, 0x3a, 0x39, 0xc9, 0xaa, 0xb5, 0xbc, 0xfb,
+	0x77, 0x00, 0xf0, 0x29, 0xe8, 0x56, 0xe0, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SatelliteGracefulExitClient is the client API for SatelliteGracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SatelliteGracefulExitClient interface {
+	// Process is called by a storage node to exit the satellite. The satellite
+	// initiates the exit on the first call and instructs the storage node which
+	// pieces to transfer to which storage nodes.
+	Process(ctx context.Context, opts ...grpc.CallOption) (SatelliteGracefulExit_ProcessClient, error)
+}
+
+type satelliteGracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSatelliteGracefulExitClient(cc *grpc.ClientConn) SatelliteGracefulExitClient {
+	return &satelliteGracefulExitClient{cc}
+}
+
+func (c *satelliteGracefulExitClient) Process(ctx context.Context, opts ...grpc.CallOption) (SatelliteGracefulExit_ProcessClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SatelliteGracefulExit_serviceDesc.Streams[0], "/gracefulexit.SatelliteGracefulExit/Process", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &satelliteGracefulExitProcessClient{stream}
+	return x, nil
+}
+
+type SatelliteGracefulExit_ProcessClient interface {
+	Send(*StorageNodeMessage) error
+	Recv() (*SatelliteMessage, error)
+	grpc.ClientStream
+}
+
+type satelliteGracefulExitProcessClient struct {
+	grpc.ClientStream
+}
+
+func (x *satelliteGracefulExitProcessClient) Send(m *StorageNodeMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *satelliteGracefulExitProcessClient) Recv() (*SatelliteMessage, error) {
+	m := new(SatelliteMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SatelliteGracefulExitServer is the server API for SatelliteGracefulExit service.
+type SatelliteGracefulExitServer interface {
+	// Process is called by a storage node to exit the satellite. The satellite
+	// initiates the exit on the first call and instructs the storage node which
+	// pieces to transfer to which storage nodes.
+	Process(SatelliteGracefulExit_ProcessServer) error
+}
+
+func RegisterSatelliteGracefulExitServer(s *grpc.Server, srv SatelliteGracefulExitServer) {
+	s.RegisterService(&_SatelliteGracefulExit_serviceDesc, srv)
+}
+
+func _SatelliteGracefulExit_Process_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SatelliteGracefulExitServer).Process(&satelliteGracefulExitProcessServer{stream})
+}
+
+type SatelliteGracefulExit_ProcessServer interface {
+	Send(*SatelliteMessage) error
+	Recv() (*StorageNodeMessage, error)
+	grpc.ServerStream
+}
+
+type satelliteGracefulExitProcessServer struct {
+	grpc.ServerStream
+}
+
+func (x *satelliteGracefulExitProcessServer) Send(m *SatelliteMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *satelliteGracefulExitProcessServer) Recv() (*StorageNodeMessage, error) {
+	m := new(StorageNodeMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _SatelliteGracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.SatelliteGracefulExit",
+	HandlerType: (*SatelliteGracefulExitServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Process",
+			Handler:       _SatelliteGracefulExit_Process_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gracefulexit.proto",
+}
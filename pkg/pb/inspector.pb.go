@@ -12,12 +12,14 @@ import (
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	math "math"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -72,6 +74,146 @@ func (m *ListIrreparableSegmentsRequest) GetOffset() int32 {
 	return 0
 }
 
+// AuditQueues
+type AuditQueuesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditQueuesRequest) Reset()         { *m = AuditQueuesRequest{} }
+func (m *AuditQueuesRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditQueuesRequest) ProtoMessage()    {}
+func (*AuditQueuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{1}
+}
+func (m *AuditQueuesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditQueuesRequest.Unmarshal(m, b)
+}
+func (m *AuditQueuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditQueuesRequest.Marshal(b, m, deterministic)
+}
+func (m *AuditQueuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditQueuesRequest.Merge(m, src)
+}
+func (m *AuditQueuesRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditQueuesRequest.Size(m)
+}
+func (m *AuditQueuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditQueuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditQueuesRequest proto.InternalMessageInfo
+
+type AuditQueuesResponse struct {
+	Queues               []*AuditQueue `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditQueuesResponse) Reset()         { *m = AuditQueuesResponse{} }
+func (m *AuditQueuesResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditQueuesResponse) ProtoMessage()    {}
+func (*AuditQueuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{2}
+}
+func (m *AuditQueuesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditQueuesResponse.Unmarshal(m, b)
+}
+func (m *AuditQueuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditQueuesResponse.Marshal(b, m, deterministic)
+}
+func (m *AuditQueuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditQueuesResponse.Merge(m, src)
+}
+func (m *AuditQueuesResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditQueuesResponse.Size(m)
+}
+func (m *AuditQueuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditQueuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditQueuesResponse proto.InternalMessageInfo
+
+func (m *AuditQueuesResponse) GetQueues() []*AuditQueue {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+type AuditQueue struct {
+	NodeId               NodeID     `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Priority             string     `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	New                  bool       `protobuf:"varint,3,opt,name=new,proto3" json:"new,omitempty"`
+	Queued               int64      `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
+	LastAudited          *time.Time `protobuf:"bytes,5,opt,name=last_audited,json=lastAudited,proto3,stdtime" json:"last_audited,omitempty"`
+	LastFailure          *time.Time `protobuf:"bytes,6,opt,name=last_failure,json=lastFailure,proto3,stdtime" json:"last_failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *AuditQueue) Reset()         { *m = AuditQueue{} }
+func (m *AuditQueue) String() string { return proto.CompactTextString(m) }
+func (*AuditQueue) ProtoMessage()    {}
+func (*AuditQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{3}
+}
+func (m *AuditQueue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditQueue.Unmarshal(m, b)
+}
+func (m *AuditQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditQueue.Marshal(b, m, deterministic)
+}
+func (m *AuditQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditQueue.Merge(m, src)
+}
+func (m *AuditQueue) XXX_Size() int {
+	return xxx_messageInfo_AuditQueue.Size(m)
+}
+func (m *AuditQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditQueue proto.InternalMessageInfo
+
+func (m *AuditQueue) GetPriority() string {
+	if m != nil {
+		return m.Priority
+	}
+	return ""
+}
+
+func (m *AuditQueue) GetNew() bool {
+	if m != nil {
+		return m.New
+	}
+	return false
+}
+
+func (m *AuditQueue) GetQueued() int64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *AuditQueue) GetLastAudited() *time.Time {
+	if m != nil {
+		return m.LastAudited
+	}
+	return nil
+}
+
+func (m *AuditQueue) GetLastFailure() *time.Time {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
 type IrreparableSegment struct {
 	Path                 []byte   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	SegmentDetail        *Pointer `protobuf:"bytes,2,opt,name=segment_detail,json=segmentDetail,proto3" json:"segment_detail,omitempty"`
@@ -87,7 +229,7 @@ func (m *IrreparableSegment) Reset()         { *m = IrreparableSegment{} }
 func (m *IrreparableSegment) String() string { return proto.CompactTextString(m) }
 func (*IrreparableSegment) ProtoMessage()    {}
 func (*IrreparableSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{1}
+	return fileDescriptor_a07d9034b2dd9d26, []int{4}
 }
 func (m *IrreparableSegment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IrreparableSegment.Unmarshal(m, b)
@@ -153,7 +295,7 @@ func (m *ListIrreparableSegmentsResponse) Reset()         { *m = ListIrreparable
 func (m *ListIrreparableSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListIrreparableSegmentsResponse) ProtoMessage()    {}
 func (*ListIrreparableSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{2}
+	return fileDescriptor_a07d9034b2dd9d26, []int{5}
 }
 func (m *ListIrreparableSegmentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListIrreparableSegmentsResponse.Unmarshal(m, b)
@@ -192,7 +334,7 @@ func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
 func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountNodesResponse) ProtoMessage()    {}
 func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{3}
+	return fileDescriptor_a07d9034b2dd9d26, []int{6}
 }
 func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
@@ -229,7 +371,7 @@ func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
 func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountNodesRequest) ProtoMessage()    {}
 func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{4}
+	return fileDescriptor_a07d9034b2dd9d26, []int{7}
 }
 func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
@@ -259,7 +401,7 @@ func (m *GetBucketListRequest) Reset()         { *m = GetBucketListRequest{} }
 func (m *GetBucketListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListRequest) ProtoMessage()    {}
 func (*GetBucketListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{5}
+	return fileDescriptor_a07d9034b2dd9d26, []int{8}
 }
 func (m *GetBucketListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListRequest.Unmarshal(m, b)
@@ -290,7 +432,7 @@ func (m *GetBucketListResponse) Reset()         { *m = GetBucketListResponse{} }
 func (m *GetBucketListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListResponse) ProtoMessage()    {}
 func (*GetBucketListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{6}
+	return fileDescriptor_a07d9034b2dd9d26, []int{9}
 }
 func (m *GetBucketListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListResponse.Unmarshal(m, b)
@@ -330,7 +472,7 @@ func (m *GetBucketListResponse_Bucket) Reset()         { *m = GetBucketListRespo
 func (m *GetBucketListResponse_Bucket) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListResponse_Bucket) ProtoMessage()    {}
 func (*GetBucketListResponse_Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{6, 0}
+	return fileDescriptor_a07d9034b2dd9d26, []int{9, 0}
 }
 func (m *GetBucketListResponse_Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListResponse_Bucket.Unmarshal(m, b)
@@ -375,7 +517,7 @@ func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
 func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsRequest) ProtoMessage()    {}
 func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+	return fileDescriptor_a07d9034b2dd9d26, []int{10}
 }
 func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
@@ -407,7 +549,7 @@ func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
 func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsResponse) ProtoMessage()    {}
 func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+	return fileDescriptor_a07d9034b2dd9d26, []int{11}
 }
 func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
@@ -446,7 +588,7 @@ func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
 func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketRequest) ProtoMessage()    {}
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+	return fileDescriptor_a07d9034b2dd9d26, []int{12}
 }
 func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
@@ -478,7 +620,7 @@ func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
 func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketResponse) ProtoMessage()    {}
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+	return fileDescriptor_a07d9034b2dd9d26, []int{13}
 }
 func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
@@ -516,7 +658,7 @@ func (m *BucketList) Reset()         { *m = BucketList{} }
 func (m *BucketList) String() string { return proto.CompactTextString(m) }
 func (*BucketList) ProtoMessage()    {}
 func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+	return fileDescriptor_a07d9034b2dd9d26, []int{14}
 }
 func (m *BucketList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketList.Unmarshal(m, b)
@@ -556,7 +698,7 @@ func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
 func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*PingNodeRequest) ProtoMessage()    {}
 func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+	return fileDescriptor_a07d9034b2dd9d26, []int{15}
 }
 func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
@@ -594,7 +736,7 @@ func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
 func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*PingNodeResponse) ProtoMessage()    {}
 func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{13}
+	return fileDescriptor_a07d9034b2dd9d26, []int{16}
 }
 func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
@@ -633,7 +775,7 @@ func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
 func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeRequest) ProtoMessage()    {}
 func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{14}
+	return fileDescriptor_a07d9034b2dd9d26, []int{17}
 }
 func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
@@ -679,7 +821,7 @@ func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
 func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeResponse) ProtoMessage()    {}
 func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{15}
+	return fileDescriptor_a07d9034b2dd9d26, []int{18}
 }
 func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
@@ -725,7 +867,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{16}
+	return fileDescriptor_a07d9034b2dd9d26, []int{19}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -766,7 +908,7 @@ func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
 func (m *NodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoResponse) ProtoMessage()    {}
 func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{17}
+	return fileDescriptor_a07d9034b2dd9d26, []int{20}
 }
 func (m *NodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoResponse.Unmarshal(m, b)
@@ -827,7 +969,7 @@ func (m *FindNearRequest) Reset()         { *m = FindNearRequest{} }
 func (m *FindNearRequest) String() string { return proto.CompactTextString(m) }
 func (*FindNearRequest) ProtoMessage()    {}
 func (*FindNearRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{18}
+	return fileDescriptor_a07d9034b2dd9d26, []int{21}
 }
 func (m *FindNearRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearRequest.Unmarshal(m, b)
@@ -865,7 +1007,7 @@ func (m *FindNearResponse) Reset()         { *m = FindNearResponse{} }
 func (m *FindNearResponse) String() string { return proto.CompactTextString(m) }
 func (*FindNearResponse) ProtoMessage()    {}
 func (*FindNearResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{19}
+	return fileDescriptor_a07d9034b2dd9d26, []int{22}
 }
 func (m *FindNearResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearResponse.Unmarshal(m, b)
@@ -902,7 +1044,7 @@ func (m *DumpNodesRequest) Reset()         { *m = DumpNodesRequest{} }
 func (m *DumpNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesRequest) ProtoMessage()    {}
 func (*DumpNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{20}
+	return fileDescriptor_a07d9034b2dd9d26, []int{23}
 }
 func (m *DumpNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesRequest.Unmarshal(m, b)
@@ -933,7 +1075,7 @@ func (m *DumpNodesResponse) Reset()         { *m = DumpNodesResponse{} }
 func (m *DumpNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesResponse) ProtoMessage()    {}
 func (*DumpNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{21}
+	return fileDescriptor_a07d9034b2dd9d26, []int{24}
 }
 func (m *DumpNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesResponse.Unmarshal(m, b)
@@ -970,7 +1112,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{22}
+	return fileDescriptor_a07d9034b2dd9d26, []int{25}
 }
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
@@ -1006,7 +1148,7 @@ func (m *StatSummaryResponse) Reset()         { *m = StatSummaryResponse{} }
 func (m *StatSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse) ProtoMessage()    {}
 func (*StatSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{23}
+	return fileDescriptor_a07d9034b2dd9d26, []int{26}
 }
 func (m *StatSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummaryResponse.Unmarshal(m, b)
@@ -1078,7 +1220,7 @@ func (m *DashboardRequest) Reset()         { *m = DashboardRequest{} }
 func (m *DashboardRequest) String() string { return proto.CompactTextString(m) }
 func (*DashboardRequest) ProtoMessage()    {}
 func (*DashboardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{24}
+	return fileDescriptor_a07d9034b2dd9d26, []int{27}
 }
 func (m *DashboardRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardRequest.Unmarshal(m, b)
@@ -1109,6 +1251,10 @@ type DashboardResponse struct {
 	Uptime               *duration.Duration   `protobuf:"bytes,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
 	LastPinged           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_pinged,json=lastPinged,proto3" json:"last_pinged,omitempty"`
 	LastQueried          *timestamp.Timestamp `protobuf:"bytes,10,opt,name=last_queried,json=lastQueried,proto3" json:"last_queried,omitempty"`
+	DamagedPieces        int64                `protobuf:"varint,11,opt,name=damaged_pieces,json=damagedPieces,proto3" json:"damaged_pieces,omitempty"`
+	Satellites           []*SatelliteUsage    `protobuf:"bytes,12,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	ExpiredOrders        int64                `protobuf:"varint,13,opt,name=expired_orders,json=expiredOrders,proto3" json:"expired_orders,omitempty"`
+	ExpiredOrderBytes    int64                `protobuf:"varint,14,opt,name=expired_order_bytes,json=expiredOrderBytes,proto3" json:"expired_order_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1118,7 +1264,7 @@ func (m *DashboardResponse) Reset()         { *m = DashboardResponse{} }
 func (m *DashboardResponse) String() string { return proto.CompactTextString(m) }
 func (*DashboardResponse) ProtoMessage()    {}
 func (*DashboardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{25}
+	return fileDescriptor_a07d9034b2dd9d26, []int{28}
 }
 func (m *DashboardResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardResponse.Unmarshal(m, b)
@@ -1201,180 +1347,556 @@ func (m *DashboardResponse) GetLastQueried() *timestamp.Timestamp {
 	return nil
 }
 
-type SegmentHealthRequest struct {
-	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
-	SegmentIndex         int64    `protobuf:"varint,3,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
-	ProjectId            []byte   `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+func (m *DashboardResponse) GetDamagedPieces() int64 {
+	if m != nil {
+		return m.DamagedPieces
+	}
+	return 0
+}
+
+func (m *DashboardResponse) GetSatellites() []*SatelliteUsage {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+func (m *DashboardResponse) GetExpiredOrders() int64 {
+	if m != nil {
+		return m.ExpiredOrders
+	}
+	return 0
+}
+
+func (m *DashboardResponse) GetExpiredOrderBytes() int64 {
+	if m != nil {
+		return m.ExpiredOrderBytes
+	}
+	return 0
+}
+
+type SatelliteUsage struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	SpaceUsed            int64    `protobuf:"varint,2,opt,name=space_used,json=spaceUsed,proto3" json:"space_used,omitempty"`
+	SpaceQuota           int64    `protobuf:"varint,3,opt,name=space_quota,json=spaceQuota,proto3" json:"space_quota,omitempty"`
+	BandwidthUsed        int64    `protobuf:"varint,4,opt,name=bandwidth_used,json=bandwidthUsed,proto3" json:"bandwidth_used,omitempty"`
+	BandwidthQuota       int64    `protobuf:"varint,5,opt,name=bandwidth_quota,json=bandwidthQuota,proto3" json:"bandwidth_quota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
-func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
-func (*SegmentHealthRequest) ProtoMessage()    {}
-func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+func (m *SatelliteUsage) Reset()         { *m = SatelliteUsage{} }
+func (m *SatelliteUsage) String() string { return proto.CompactTextString(m) }
+func (*SatelliteUsage) ProtoMessage()    {}
+func (*SatelliteUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{29}
 }
-func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
+func (m *SatelliteUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteUsage.Unmarshal(m, b)
 }
-func (m *SegmentHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SegmentHealthRequest.Marshal(b, m, deterministic)
+func (m *SatelliteUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteUsage.Marshal(b, m, deterministic)
 }
-func (m *SegmentHealthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SegmentHealthRequest.Merge(m, src)
+func (m *SatelliteUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteUsage.Merge(m, src)
 }
-func (m *SegmentHealthRequest) XXX_Size() int {
-	return xxx_messageInfo_SegmentHealthRequest.Size(m)
+func (m *SatelliteUsage) XXX_Size() int {
+	return xxx_messageInfo_SatelliteUsage.Size(m)
 }
-func (m *SegmentHealthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SegmentHealthRequest.DiscardUnknown(m)
+func (m *SatelliteUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteUsage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SegmentHealthRequest proto.InternalMessageInfo
+var xxx_messageInfo_SatelliteUsage proto.InternalMessageInfo
 
-func (m *SegmentHealthRequest) GetBucket() []byte {
+func (m *SatelliteUsage) GetSpaceUsed() int64 {
 	if m != nil {
-		return m.Bucket
+		return m.SpaceUsed
 	}
-	return nil
+	return 0
 }
 
-func (m *SegmentHealthRequest) GetEncryptedPath() []byte {
+func (m *SatelliteUsage) GetSpaceQuota() int64 {
 	if m != nil {
-		return m.EncryptedPath
+		return m.SpaceQuota
 	}
-	return nil
+	return 0
 }
 
-func (m *SegmentHealthRequest) GetSegmentIndex() int64 {
+func (m *SatelliteUsage) GetBandwidthUsed() int64 {
 	if m != nil {
-		return m.SegmentIndex
+		return m.BandwidthUsed
 	}
 	return 0
 }
 
-func (m *SegmentHealthRequest) GetProjectId() []byte {
+func (m *SatelliteUsage) GetBandwidthQuota() int64 {
 	if m != nil {
-		return m.ProjectId
+		return m.BandwidthQuota
 	}
-	return nil
+	return 0
 }
 
-type SegmentHealth struct {
-	HealthyIds           []NodeID `protobuf:"bytes,1,rep,name=healthy_ids,json=healthyIds,proto3,customtype=NodeID" json:"healthy_ids,omitempty"`
-	UnhealthyIds         []NodeID `protobuf:"bytes,2,rep,name=unhealthy_ids,json=unhealthyIds,proto3,customtype=NodeID" json:"unhealthy_ids,omitempty"`
-	OfflineIds           []NodeID `protobuf:"bytes,3,rep,name=offline_ids,json=offlineIds,proto3,customtype=NodeID" json:"offline_ids,omitempty"`
-	Segment              []byte   `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RestoreTrashRequest struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	TrashedAfter         time.Time `protobuf:"bytes,2,opt,name=trashed_after,json=trashedAfter,proto3,stdtime" json:"trashed_after"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
-func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
-func (*SegmentHealth) ProtoMessage()    {}
-func (*SegmentHealth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{27}
+func (m *RestoreTrashRequest) Reset()         { *m = RestoreTrashRequest{} }
+func (m *RestoreTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashRequest) ProtoMessage()    {}
+func (*RestoreTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{30}
 }
-func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
+func (m *RestoreTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashRequest.Unmarshal(m, b)
 }
-func (m *SegmentHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SegmentHealth.Marshal(b, m, deterministic)
+func (m *RestoreTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashRequest.Marshal(b, m, deterministic)
 }
-func (m *SegmentHealth) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SegmentHealth.Merge(m, src)
+func (m *RestoreTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashRequest.Merge(m, src)
 }
-func (m *SegmentHealth) XXX_Size() int {
-	return xxx_messageInfo_SegmentHealth.Size(m)
+func (m *RestoreTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashRequest.Size(m)
 }
-func (m *SegmentHealth) XXX_DiscardUnknown() {
-	xxx_messageInfo_SegmentHealth.DiscardUnknown(m)
+func (m *RestoreTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SegmentHealth proto.InternalMessageInfo
+var xxx_messageInfo_RestoreTrashRequest proto.InternalMessageInfo
 
-func (m *SegmentHealth) GetSegment() []byte {
+func (m *RestoreTrashRequest) GetTrashedAfter() time.Time {
 	if m != nil {
-		return m.Segment
+		return m.TrashedAfter
 	}
-	return nil
+	return time.Time{}
 }
 
-type SegmentHealthResponse struct {
-	Health               *SegmentHealth    `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
-	Redundancy           *RedundancyScheme `protobuf:"bytes,2,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type RestoreTrashResponse struct {
+	RestoredCount        int64    `protobuf:"varint,1,opt,name=restored_count,json=restoredCount,proto3" json:"restored_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
-func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
-func (*SegmentHealthResponse) ProtoMessage()    {}
-func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{28}
+func (m *RestoreTrashResponse) Reset()         { *m = RestoreTrashResponse{} }
+func (m *RestoreTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashResponse) ProtoMessage()    {}
+func (*RestoreTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
 }
-func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
+func (m *RestoreTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashResponse.Unmarshal(m, b)
 }
-func (m *SegmentHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SegmentHealthResponse.Marshal(b, m, deterministic)
+func (m *RestoreTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashResponse.Marshal(b, m, deterministic)
 }
-func (m *SegmentHealthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SegmentHealthResponse.Merge(m, src)
+func (m *RestoreTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashResponse.Merge(m, src)
 }
-func (m *SegmentHealthResponse) XXX_Size() int {
-	return xxx_messageInfo_SegmentHealthResponse.Size(m)
+func (m *RestoreTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashResponse.Size(m)
 }
-func (m *SegmentHealthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SegmentHealthResponse.DiscardUnknown(m)
+func (m *RestoreTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SegmentHealthResponse proto.InternalMessageInfo
-
-func (m *SegmentHealthResponse) GetHealth() *SegmentHealth {
-	if m != nil {
-		return m.Health
-	}
-	return nil
-}
+var xxx_messageInfo_RestoreTrashResponse proto.InternalMessageInfo
 
-func (m *SegmentHealthResponse) GetRedundancy() *RedundancyScheme {
+func (m *RestoreTrashResponse) GetRestoredCount() int64 {
 	if m != nil {
-		return m.Redundancy
+		return m.RestoredCount
 	}
-	return nil
+	return 0
 }
 
-type ObjectHealthRequest struct {
-	EncryptedPath        []byte   `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
-	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	ProjectId            []byte   `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	StartAfterSegment    int64    `protobuf:"varint,4,opt,name=start_after_segment,json=startAfterSegment,proto3" json:"start_after_segment,omitempty"`
-	EndBeforeSegment     int64    `protobuf:"varint,5,opt,name=end_before_segment,json=endBeforeSegment,proto3" json:"end_before_segment,omitempty"`
-	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+type GracefulExitSatelliteRequest struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
-func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
-func (*ObjectHealthRequest) ProtoMessage()    {}
-func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{29}
+func (m *GracefulExitSatelliteRequest) Reset()         { *m = GracefulExitSatelliteRequest{} }
+func (m *GracefulExitSatelliteRequest) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitSatelliteRequest) ProtoMessage()    {}
+func (*GracefulExitSatelliteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{32}
 }
-func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
+func (m *GracefulExitSatelliteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitSatelliteRequest.Unmarshal(m, b)
 }
-func (m *ObjectHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ObjectHealthRequest.Marshal(b, m, deterministic)
+func (m *GracefulExitSatelliteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitSatelliteRequest.Marshal(b, m, deterministic)
 }
-func (m *ObjectHealthRequest) XXX_Merge(src proto.Message) {
+func (m *GracefulExitSatelliteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitSatelliteRequest.Merge(m, src)
+}
+func (m *GracefulExitSatelliteRequest) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitSatelliteRequest.Size(m)
+}
+func (m *GracefulExitSatelliteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitSatelliteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitSatelliteRequest proto.InternalMessageInfo
+
+type GracefulExitSatelliteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GracefulExitSatelliteResponse) Reset()         { *m = GracefulExitSatelliteResponse{} }
+func (m *GracefulExitSatelliteResponse) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitSatelliteResponse) ProtoMessage()    {}
+func (*GracefulExitSatelliteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{33}
+}
+func (m *GracefulExitSatelliteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitSatelliteResponse.Unmarshal(m, b)
+}
+func (m *GracefulExitSatelliteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitSatelliteResponse.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitSatelliteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitSatelliteResponse.Merge(m, src)
+}
+func (m *GracefulExitSatelliteResponse) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitSatelliteResponse.Size(m)
+}
+func (m *GracefulExitSatelliteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitSatelliteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitSatelliteResponse proto.InternalMessageInfo
+
+type GracefulExitStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GracefulExitStatusRequest) Reset()         { *m = GracefulExitStatusRequest{} }
+func (m *GracefulExitStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitStatusRequest) ProtoMessage()    {}
+func (*GracefulExitStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{34}
+}
+func (m *GracefulExitStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitStatusRequest.Unmarshal(m, b)
+}
+func (m *GracefulExitStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitStatusRequest.Merge(m, src)
+}
+func (m *GracefulExitStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitStatusRequest.Size(m)
+}
+func (m *GracefulExitStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitStatusRequest proto.InternalMessageInfo
+
+type GracefulExitStatusResponse struct {
+	Progress             []*GracefulExitProgress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GracefulExitStatusResponse) Reset()         { *m = GracefulExitStatusResponse{} }
+func (m *GracefulExitStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitStatusResponse) ProtoMessage()    {}
+func (*GracefulExitStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{35}
+}
+func (m *GracefulExitStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitStatusResponse.Unmarshal(m, b)
+}
+func (m *GracefulExitStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitStatusResponse.Merge(m, src)
+}
+func (m *GracefulExitStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitStatusResponse.Size(m)
+}
+func (m *GracefulExitStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitStatusResponse proto.InternalMessageInfo
+
+func (m *GracefulExitStatusResponse) GetProgress() []*GracefulExitProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type GracefulExitProgress struct {
+	SatelliteId          NodeID     `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	InitiatedAt          time.Time  `protobuf:"bytes,2,opt,name=initiated_at,json=initiatedAt,proto3,stdtime" json:"initiated_at"`
+	FinishedAt           *time.Time `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at,omitempty"`
+	PiecesTransferred    int64      `protobuf:"varint,4,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed         int64      `protobuf:"varint,5,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	BytesTransferred     int64      `protobuf:"varint,6,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	Successful           bool       `protobuf:"varint,7,opt,name=successful,proto3" json:"successful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GracefulExitProgress) Reset()         { *m = GracefulExitProgress{} }
+func (m *GracefulExitProgress) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitProgress) ProtoMessage()    {}
+func (*GracefulExitProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{36}
+}
+func (m *GracefulExitProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitProgress.Unmarshal(m, b)
+}
+func (m *GracefulExitProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitProgress.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitProgress.Merge(m, src)
+}
+func (m *GracefulExitProgress) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitProgress.Size(m)
+}
+func (m *GracefulExitProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitProgress proto.InternalMessageInfo
+
+func (m *GracefulExitProgress) GetInitiatedAt() time.Time {
+	if m != nil {
+		return m.InitiatedAt
+	}
+	return time.Time{}
+}
+
+func (m *GracefulExitProgress) GetFinishedAt() *time.Time {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+func (m *GracefulExitProgress) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *GracefulExitProgress) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+func (m *GracefulExitProgress) GetBytesTransferred() int64 {
+	if m != nil {
+		return m.BytesTransferred
+	}
+	return 0
+}
+
+func (m *GracefulExitProgress) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+type SegmentHealthRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	SegmentIndex         int64    `protobuf:"varint,3,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	ProjectId            []byte   `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
+func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentHealthRequest) ProtoMessage()    {}
+func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{37}
+}
+func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
+}
+func (m *SegmentHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentHealthRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentHealthRequest.Merge(m, src)
+}
+func (m *SegmentHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentHealthRequest.Size(m)
+}
+func (m *SegmentHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentHealthRequest proto.InternalMessageInfo
+
+func (m *SegmentHealthRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentHealthRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *SegmentHealthRequest) GetSegmentIndex() int64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *SegmentHealthRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+type SegmentHealth struct {
+	HealthyIds           []NodeID `protobuf:"bytes,1,rep,name=healthy_ids,json=healthyIds,proto3,customtype=NodeID" json:"healthy_ids,omitempty"`
+	UnhealthyIds         []NodeID `protobuf:"bytes,2,rep,name=unhealthy_ids,json=unhealthyIds,proto3,customtype=NodeID" json:"unhealthy_ids,omitempty"`
+	OfflineIds           []NodeID `protobuf:"bytes,3,rep,name=offline_ids,json=offlineIds,proto3,customtype=NodeID" json:"offline_ids,omitempty"`
+	Segment              []byte   `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
+func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
+func (*SegmentHealth) ProtoMessage()    {}
+func (*SegmentHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{38}
+}
+func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
+}
+func (m *SegmentHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentHealth.Marshal(b, m, deterministic)
+}
+func (m *SegmentHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentHealth.Merge(m, src)
+}
+func (m *SegmentHealth) XXX_Size() int {
+	return xxx_messageInfo_SegmentHealth.Size(m)
+}
+func (m *SegmentHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentHealth proto.InternalMessageInfo
+
+func (m *SegmentHealth) GetSegment() []byte {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+type SegmentHealthResponse struct {
+	Health               *SegmentHealth    `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
+	Redundancy           *RedundancyScheme `protobuf:"bytes,2,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
+func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentHealthResponse) ProtoMessage()    {}
+func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{39}
+}
+func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
+}
+func (m *SegmentHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentHealthResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentHealthResponse.Merge(m, src)
+}
+func (m *SegmentHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentHealthResponse.Size(m)
+}
+func (m *SegmentHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentHealthResponse proto.InternalMessageInfo
+
+func (m *SegmentHealthResponse) GetHealth() *SegmentHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+func (m *SegmentHealthResponse) GetRedundancy() *RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
+	}
+	return nil
+}
+
+type ObjectHealthRequest struct {
+	EncryptedPath        []byte   `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	ProjectId            []byte   `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	StartAfterSegment    int64    `protobuf:"varint,4,opt,name=start_after_segment,json=startAfterSegment,proto3" json:"start_after_segment,omitempty"`
+	EndBeforeSegment     int64    `protobuf:"varint,5,opt,name=end_before_segment,json=endBeforeSegment,proto3" json:"end_before_segment,omitempty"`
+	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
+func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectHealthRequest) ProtoMessage()    {}
+func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{40}
+}
+func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
+}
+func (m *ObjectHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectHealthRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectHealthRequest) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_ObjectHealthRequest.Merge(m, src)
 }
 func (m *ObjectHealthRequest) XXX_Size() int {
@@ -1388,94 +1910,565 @@ var xxx_messageInfo_ObjectHealthRequest proto.InternalMessageInfo
 
 func (m *ObjectHealthRequest) GetEncryptedPath() []byte {
 	if m != nil {
-		return m.EncryptedPath
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectHealthRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectHealthRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *ObjectHealthRequest) GetStartAfterSegment() int64 {
+	if m != nil {
+		return m.StartAfterSegment
+	}
+	return 0
+}
+
+func (m *ObjectHealthRequest) GetEndBeforeSegment() int64 {
+	if m != nil {
+		return m.EndBeforeSegment
+	}
+	return 0
+}
+
+func (m *ObjectHealthRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ObjectHealthResponse struct {
+	Segments             []*SegmentHealth  `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	Redundancy           *RedundancyScheme `protobuf:"bytes,2,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
+func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectHealthResponse) ProtoMessage()    {}
+func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{41}
+}
+func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
+}
+func (m *ObjectHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectHealthResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectHealthResponse.Merge(m, src)
+}
+func (m *ObjectHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectHealthResponse.Size(m)
+}
+func (m *ObjectHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectHealthResponse proto.InternalMessageInfo
+
+func (m *ObjectHealthResponse) GetSegments() []*SegmentHealth {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ObjectHealthResponse) GetRedundancy() *RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
+	}
+	return nil
+}
+
+type ScrubStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScrubStatusRequest) Reset()         { *m = ScrubStatusRequest{} }
+func (m *ScrubStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ScrubStatusRequest) ProtoMessage()    {}
+func (*ScrubStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{42}
+}
+func (m *ScrubStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScrubStatusRequest.Unmarshal(m, b)
+}
+func (m *ScrubStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScrubStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *ScrubStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrubStatusRequest.Merge(m, src)
+}
+func (m *ScrubStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_ScrubStatusRequest.Size(m)
+}
+func (m *ScrubStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrubStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScrubStatusRequest proto.InternalMessageInfo
+
+type ScrubStatusResponse struct {
+	LastStarted          *time.Time            `protobuf:"bytes,1,opt,name=last_started,json=lastStarted,proto3,stdtime" json:"last_started,omitempty"`
+	LastFinished         *time.Time            `protobuf:"bytes,2,opt,name=last_finished,json=lastFinished,proto3,stdtime" json:"last_finished,omitempty"`
+	PiecesChecked        int64                 `protobuf:"varint,3,opt,name=pieces_checked,json=piecesChecked,proto3" json:"pieces_checked,omitempty"`
+	Damaged              []*DamagedPieceStatus `protobuf:"bytes,4,rep,name=damaged,proto3" json:"damaged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ScrubStatusResponse) Reset()         { *m = ScrubStatusResponse{} }
+func (m *ScrubStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ScrubStatusResponse) ProtoMessage()    {}
+func (*ScrubStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{43}
+}
+func (m *ScrubStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScrubStatusResponse.Unmarshal(m, b)
+}
+func (m *ScrubStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScrubStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *ScrubStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrubStatusResponse.Merge(m, src)
+}
+func (m *ScrubStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_ScrubStatusResponse.Size(m)
+}
+func (m *ScrubStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrubStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScrubStatusResponse proto.InternalMessageInfo
+
+func (m *ScrubStatusResponse) GetLastStarted() *time.Time {
+	if m != nil {
+		return m.LastStarted
+	}
+	return nil
+}
+
+func (m *ScrubStatusResponse) GetLastFinished() *time.Time {
+	if m != nil {
+		return m.LastFinished
+	}
+	return nil
+}
+
+func (m *ScrubStatusResponse) GetPiecesChecked() int64 {
+	if m != nil {
+		return m.PiecesChecked
+	}
+	return 0
+}
+
+func (m *ScrubStatusResponse) GetDamaged() []*DamagedPieceStatus {
+	if m != nil {
+		return m.Damaged
+	}
+	return nil
+}
+
+type DamagedPieceStatus struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	PieceId              PieceID   `protobuf:"bytes,2,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	Reason               string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	DetectedAt           time.Time `protobuf:"bytes,4,opt,name=detected_at,json=detectedAt,proto3,stdtime" json:"detected_at"`
+	Reported             bool      `protobuf:"varint,5,opt,name=reported,proto3" json:"reported,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DamagedPieceStatus) Reset()         { *m = DamagedPieceStatus{} }
+func (m *DamagedPieceStatus) String() string { return proto.CompactTextString(m) }
+func (*DamagedPieceStatus) ProtoMessage()    {}
+func (*DamagedPieceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{44}
+}
+func (m *DamagedPieceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DamagedPieceStatus.Unmarshal(m, b)
+}
+func (m *DamagedPieceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DamagedPieceStatus.Marshal(b, m, deterministic)
+}
+func (m *DamagedPieceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DamagedPieceStatus.Merge(m, src)
+}
+func (m *DamagedPieceStatus) XXX_Size() int {
+	return xxx_messageInfo_DamagedPieceStatus.Size(m)
+}
+func (m *DamagedPieceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DamagedPieceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DamagedPieceStatus proto.InternalMessageInfo
+
+func (m *DamagedPieceStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DamagedPieceStatus) GetDetectedAt() time.Time {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return time.Time{}
+}
+
+func (m *DamagedPieceStatus) GetReported() bool {
+	if m != nil {
+		return m.Reported
+	}
+	return false
+}
+
+type ExportOrdersRequest struct {
+	ArchivedAfter        time.Time `protobuf:"bytes,1,opt,name=archived_after,json=archivedAfter,proto3,stdtime" json:"archived_after"`
+	Limit                int32     `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64     `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Unsent               bool      `protobuf:"varint,4,opt,name=unsent,proto3" json:"unsent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ExportOrdersRequest) Reset()         { *m = ExportOrdersRequest{} }
+func (m *ExportOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportOrdersRequest) ProtoMessage()    {}
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{45}
+}
+func (m *ExportOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportOrdersRequest.Unmarshal(m, b)
+}
+func (m *ExportOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportOrdersRequest.Merge(m, src)
+}
+func (m *ExportOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportOrdersRequest.Size(m)
+}
+func (m *ExportOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportOrdersRequest proto.InternalMessageInfo
+
+func (m *ExportOrdersRequest) GetArchivedAfter() time.Time {
+	if m != nil {
+		return m.ArchivedAfter
+	}
+	return time.Time{}
+}
+
+func (m *ExportOrdersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ExportOrdersRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ExportOrdersRequest) GetUnsent() bool {
+	if m != nil {
+		return m.Unsent
+	}
+	return false
+}
+
+type ExportOrdersResponse struct {
+	Orders               []*ExportedOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportOrdersResponse) Reset()         { *m = ExportOrdersResponse{} }
+func (m *ExportOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportOrdersResponse) ProtoMessage()    {}
+func (*ExportOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{46}
+}
+func (m *ExportOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportOrdersResponse.Unmarshal(m, b)
+}
+func (m *ExportOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportOrdersResponse.Merge(m, src)
+}
+func (m *ExportOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportOrdersResponse.Size(m)
+}
+func (m *ExportOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportOrdersResponse proto.InternalMessageInfo
+
+func (m *ExportOrdersResponse) GetOrders() []*ExportedOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type ExportedOrder struct {
+	SatelliteId          NodeID       `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	SerialNumber         SerialNumber `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3,customtype=SerialNumber" json:"serial_number"`
+	PieceId              PieceID      `protobuf:"bytes,3,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	Action               string       `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Limit                int64        `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Amount               int64        `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	OrderCreation        time.Time    `protobuf:"bytes,7,opt,name=order_creation,json=orderCreation,proto3,stdtime" json:"order_creation"`
+	Status               string       `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ArchivedAt           *time.Time   `protobuf:"bytes,9,opt,name=archived_at,json=archivedAt,proto3,stdtime" json:"archived_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ExportedOrder) Reset()         { *m = ExportedOrder{} }
+func (m *ExportedOrder) String() string { return proto.CompactTextString(m) }
+func (*ExportedOrder) ProtoMessage()    {}
+func (*ExportedOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{47}
+}
+func (m *ExportedOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportedOrder.Unmarshal(m, b)
+}
+func (m *ExportedOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportedOrder.Marshal(b, m, deterministic)
+}
+func (m *ExportedOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedOrder.Merge(m, src)
+}
+func (m *ExportedOrder) XXX_Size() int {
+	return xxx_messageInfo_ExportedOrder.Size(m)
+}
+func (m *ExportedOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportedOrder proto.InternalMessageInfo
+
+func (m *ExportedOrder) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	return nil
+	return ""
 }
 
-func (m *ObjectHealthRequest) GetBucket() []byte {
+func (m *ExportedOrder) GetLimit() int64 {
 	if m != nil {
-		return m.Bucket
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *ObjectHealthRequest) GetProjectId() []byte {
+func (m *ExportedOrder) GetAmount() int64 {
 	if m != nil {
-		return m.ProjectId
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-func (m *ObjectHealthRequest) GetStartAfterSegment() int64 {
+func (m *ExportedOrder) GetOrderCreation() time.Time {
 	if m != nil {
-		return m.StartAfterSegment
+		return m.OrderCreation
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *ObjectHealthRequest) GetEndBeforeSegment() int64 {
+func (m *ExportedOrder) GetStatus() string {
 	if m != nil {
-		return m.EndBeforeSegment
+		return m.Status
 	}
-	return 0
+	return ""
 }
 
-func (m *ObjectHealthRequest) GetLimit() int32 {
+func (m *ExportedOrder) GetArchivedAt() *time.Time {
 	if m != nil {
-		return m.Limit
+		return m.ArchivedAt
 	}
-	return 0
+	return nil
 }
 
-type ObjectHealthResponse struct {
-	Segments             []*SegmentHealth  `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
-	Redundancy           *RedundancyScheme `protobuf:"bytes,2,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+type ArchiveSummariesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveSummariesRequest) Reset()         { *m = ArchiveSummariesRequest{} }
+func (m *ArchiveSummariesRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveSummariesRequest) ProtoMessage()    {}
+func (*ArchiveSummariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{48}
+}
+func (m *ArchiveSummariesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveSummariesRequest.Unmarshal(m, b)
+}
+func (m *ArchiveSummariesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveSummariesRequest.Marshal(b, m, deterministic)
+}
+func (m *ArchiveSummariesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveSummariesRequest.Merge(m, src)
+}
+func (m *ArchiveSummariesRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveSummariesRequest.Size(m)
+}
+func (m *ArchiveSummariesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveSummariesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveSummariesRequest proto.InternalMessageInfo
+
+type ArchiveSummariesResponse struct {
+	Summaries            []*ArchiveSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
-func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
-func (*ObjectHealthResponse) ProtoMessage()    {}
-func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{30}
+func (m *ArchiveSummariesResponse) Reset()         { *m = ArchiveSummariesResponse{} }
+func (m *ArchiveSummariesResponse) String() string { return proto.CompactTextString(m) }
+func (*ArchiveSummariesResponse) ProtoMessage()    {}
+func (*ArchiveSummariesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{49}
 }
-func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
+func (m *ArchiveSummariesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveSummariesResponse.Unmarshal(m, b)
 }
-func (m *ObjectHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ObjectHealthResponse.Marshal(b, m, deterministic)
+func (m *ArchiveSummariesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveSummariesResponse.Marshal(b, m, deterministic)
 }
-func (m *ObjectHealthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectHealthResponse.Merge(m, src)
+func (m *ArchiveSummariesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveSummariesResponse.Merge(m, src)
 }
-func (m *ObjectHealthResponse) XXX_Size() int {
-	return xxx_messageInfo_ObjectHealthResponse.Size(m)
+func (m *ArchiveSummariesResponse) XXX_Size() int {
+	return xxx_messageInfo_ArchiveSummariesResponse.Size(m)
 }
-func (m *ObjectHealthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectHealthResponse.DiscardUnknown(m)
+func (m *ArchiveSummariesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveSummariesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ObjectHealthResponse proto.InternalMessageInfo
+var xxx_messageInfo_ArchiveSummariesResponse proto.InternalMessageInfo
 
-func (m *ObjectHealthResponse) GetSegments() []*SegmentHealth {
+func (m *ArchiveSummariesResponse) GetSummaries() []*ArchiveSummary {
 	if m != nil {
-		return m.Segments
+		return m.Summaries
 	}
 	return nil
 }
 
-func (m *ObjectHealthResponse) GetRedundancy() *RedundancyScheme {
+type ArchiveSummary struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	Day                  time.Time `protobuf:"bytes,2,opt,name=day,proto3,stdtime" json:"day"`
+	Action               string    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Status               string    `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	OrderCount           int64     `protobuf:"varint,5,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	TotalAmount          int64     `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ArchiveSummary) Reset()         { *m = ArchiveSummary{} }
+func (m *ArchiveSummary) String() string { return proto.CompactTextString(m) }
+func (*ArchiveSummary) ProtoMessage()    {}
+func (*ArchiveSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{50}
+}
+func (m *ArchiveSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveSummary.Unmarshal(m, b)
+}
+func (m *ArchiveSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveSummary.Marshal(b, m, deterministic)
+}
+func (m *ArchiveSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveSummary.Merge(m, src)
+}
+func (m *ArchiveSummary) XXX_Size() int {
+	return xxx_messageInfo_ArchiveSummary.Size(m)
+}
+func (m *ArchiveSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveSummary proto.InternalMessageInfo
+
+func (m *ArchiveSummary) GetDay() time.Time {
 	if m != nil {
-		return m.Redundancy
+		return m.Day
 	}
-	return nil
+	return time.Time{}
+}
+
+func (m *ArchiveSummary) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ArchiveSummary) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ArchiveSummary) GetOrderCount() int64 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+func (m *ArchiveSummary) GetTotalAmount() int64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
 }
 
 func init() {
 	proto.RegisterType((*ListIrreparableSegmentsRequest)(nil), "inspector.ListIrreparableSegmentsRequest")
+	proto.RegisterType((*AuditQueuesRequest)(nil), "inspector.AuditQueuesRequest")
+	proto.RegisterType((*AuditQueuesResponse)(nil), "inspector.AuditQueuesResponse")
+	proto.RegisterType((*AuditQueue)(nil), "inspector.AuditQueue")
 	proto.RegisterType((*IrreparableSegment)(nil), "inspector.IrreparableSegment")
 	proto.RegisterType((*ListIrreparableSegmentsResponse)(nil), "inspector.ListIrreparableSegmentsResponse")
 	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
@@ -1502,121 +2495,205 @@ func init() {
 	proto.RegisterType((*StatSummaryResponse)(nil), "inspector.StatSummaryResponse")
 	proto.RegisterType((*DashboardRequest)(nil), "inspector.DashboardRequest")
 	proto.RegisterType((*DashboardResponse)(nil), "inspector.DashboardResponse")
+	proto.RegisterType((*SatelliteUsage)(nil), "inspector.SatelliteUsage")
+	proto.RegisterType((*RestoreTrashRequest)(nil), "inspector.RestoreTrashRequest")
+	proto.RegisterType((*RestoreTrashResponse)(nil), "inspector.RestoreTrashResponse")
+	proto.RegisterType((*GracefulExitSatelliteRequest)(nil), "inspector.GracefulExitSatelliteRequest")
+	proto.RegisterType((*GracefulExitSatelliteResponse)(nil), "inspector.GracefulExitSatelliteResponse")
+	proto.RegisterType((*GracefulExitStatusRequest)(nil), "inspector.GracefulExitStatusRequest")
+	proto.RegisterType((*GracefulExitStatusResponse)(nil), "inspector.GracefulExitStatusResponse")
+	proto.RegisterType((*GracefulExitProgress)(nil), "inspector.GracefulExitProgress")
 	proto.RegisterType((*SegmentHealthRequest)(nil), "inspector.SegmentHealthRequest")
 	proto.RegisterType((*SegmentHealth)(nil), "inspector.SegmentHealth")
 	proto.RegisterType((*SegmentHealthResponse)(nil), "inspector.SegmentHealthResponse")
 	proto.RegisterType((*ObjectHealthRequest)(nil), "inspector.ObjectHealthRequest")
 	proto.RegisterType((*ObjectHealthResponse)(nil), "inspector.ObjectHealthResponse")
+	proto.RegisterType((*ScrubStatusRequest)(nil), "inspector.ScrubStatusRequest")
+	proto.RegisterType((*ScrubStatusResponse)(nil), "inspector.ScrubStatusResponse")
+	proto.RegisterType((*DamagedPieceStatus)(nil), "inspector.DamagedPieceStatus")
+	proto.RegisterType((*ExportOrdersRequest)(nil), "inspector.ExportOrdersRequest")
+	proto.RegisterType((*ExportOrdersResponse)(nil), "inspector.ExportOrdersResponse")
+	proto.RegisterType((*ExportedOrder)(nil), "inspector.ExportedOrder")
+	proto.RegisterType((*ArchiveSummariesRequest)(nil), "inspector.ArchiveSummariesRequest")
+	proto.RegisterType((*ArchiveSummariesResponse)(nil), "inspector.ArchiveSummariesResponse")
+	proto.RegisterType((*ArchiveSummary)(nil), "inspector.ArchiveSummary")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x39, 0x73, 0x1b, 0x47,
-	0x16, 0xe6, 0xe0, 0x22, 0xf0, 0x00, 0xe2, 0x68, 0x52, 0x12, 0x16, 0x92, 0x08, 0xee, 0xec, 0xa1,
-	0x83, 0xbb, 0xa0, 0x16, 0xab, 0x0d, 0x54, 0x2a, 0x05, 0x3c, 0x56, 0x2b, 0x94, 0xb4, 0x12, 0x35,
-	0x94, 0x1d, 0xb8, 0x54, 0x46, 0x35, 0xd0, 0x0d, 0x70, 0x4c, 0x60, 0x7a, 0x34, 0xd3, 0x90, 0x85,
-	0x3f, 0xe0, 0xb2, 0x23, 0x47, 0x0e, 0x1c, 0xbb, 0xfc, 0x0f, 0x1c, 0x39, 0x75, 0xe2, 0xdf, 0xe0,
-	0x40, 0xa1, 0x9d, 0x3b, 0x73, 0x95, 0x03, 0x57, 0x1f, 0x73, 0x61, 0x00, 0x93, 0x55, 0xb6, 0x33,
-	0xf4, 0xfb, 0xbe, 0x7e, 0xfd, 0xde, 0xeb, 0xe3, 0x7d, 0x03, 0xa8, 0xd9, 0x8e, 0xef, 0xd2, 0x21,
-	0x67, 0x5e, 0xc7, 0xf5, 0x18, 0x67, 0xa8, 0x14, 0x1a, 0x5a, 0x30, 0x66, 0x63, 0xa6, 0xcc, 0x2d,
-	0x70, 0x18, 0xa1, 0xfa, 0x77, 0xcd, 0x65, 0xb6, 0xc3, 0xa9, 0x47, 0x06, 0xda, 0xb0, 0x3d, 0x66,
-	0x6c, 0x3c, 0xa1, 0x7b, 0x72, 0x34, 0x98, 0x8d, 0xf6, 0xc8, 0xcc, 0xc3, 0xdc, 0x66, 0x8e, 0xc6,
-	0xdb, 0x8b, 0x38, 0xb7, 0xa7, 0xd4, 0xe7, 0x78, 0xea, 0x2a, 0x82, 0xf9, 0x14, 0xb6, 0x9f, 0xd8,
-	0x3e, 0xef, 0x79, 0x1e, 0x75, 0xb1, 0x87, 0x07, 0x13, 0x7a, 0x42, 0xc7, 0x53, 0xea, 0x70, 0xdf,
-	0xa2, 0xaf, 0x66, 0xd4, 0xe7, 0x68, 0x0b, 0xf2, 0x13, 0x7b, 0x6a, 0xf3, 0xa6, 0xb1, 0x63, 0xdc,
-	0xcc, 0x5b, 0x6a, 0x80, 0x2e, 0x43, 0x81, 0x8d, 0x46, 0x3e, 0xe5, 0xcd, 0x8c, 0x34, 0xeb, 0x91,
-	0xf9, 0xbd, 0x01, 0x28, 0xed, 0x0c, 0x21, 0xc8, 0xb9, 0x98, 0x9f, 0x4a, 0x1f, 0x15, 0x4b, 0xfe,
-	0x46, 0xf7, 0xa0, 0xea, 0x2b, 0xb8, 0x4f, 0x28, 0xc7, 0xf6, 0x44, 0xba, 0x2a, 0x77, 0x51, 0x27,
-	0xca, 0xf2, 0x58, 0xfd, 0xb2, 0x36, 0x34, 0xf3, 0x48, 0x12, 0x51, 0x1b, 0xca, 0x13, 0xe6, 0xf3,
-	0xbe, 0x6b, 0xd3, 0x21, 0xf5, 0x9b, 0x59, 0x19, 0x02, 0x08, 0xd3, 0xb1, 0xb4, 0xa0, 0x0e, 0x6c,
-	0x4e, 0xb0, 0xcf, 0xfb, 0x22, 0x10, 0xdb, 0xeb, 0x63, 0xce, 0xe9, 0xd4, 0xe5, 0xcd, 0xdc, 0x8e,
-	0x71, 0x33, 0x6b, 0x35, 0x04, 0x64, 0x49, 0x64, 0x5f, 0x01, 0xe8, 0x0e, 0x6c, 0x25, 0xa9, 0xfd,
-	0x21, 0x9b, 0x39, 0xbc, 0x99, 0x97, 0x13, 0x90, 0x17, 0x27, 0x1f, 0x0a, 0xc4, 0x7c, 0x09, 0xed,
-	0x95, 0x85, 0xf3, 0x5d, 0xe6, 0xf8, 0x14, 0xdd, 0x83, 0xa2, 0x0e, 0xdb, 0x6f, 0x1a, 0x3b, 0xd9,
-	0x9b, 0xe5, 0xee, 0xf5, 0x4e, 0xb4, 0xe9, 0xe9, 0x99, 0x56, 0x48, 0x37, 0x6f, 0x03, 0x92, 0xcb,
-	0x3c, 0x65, 0x84, 0x46, 0x0e, 0xb7, 0x20, 0xaf, 0xc2, 0x32, 0x64, 0x58, 0x6a, 0x60, 0x6e, 0x42,
-	0x23, 0xce, 0x95, 0xbb, 0x66, 0x5e, 0x86, 0xad, 0xff, 0x51, 0x7e, 0x30, 0x1b, 0x9e, 0x51, 0x2e,
-	0xe2, 0x0c, 0xec, 0x3f, 0x1a, 0x70, 0x69, 0x01, 0xd0, 0xce, 0xf7, 0x61, 0x7d, 0x20, 0xad, 0x41,
-	0xb0, 0x37, 0x62, 0xc1, 0x2e, 0x9d, 0xd2, 0x51, 0x26, 0x2b, 0x98, 0xd7, 0xfa, 0xcc, 0x80, 0x82,
-	0xb2, 0xa1, 0x5d, 0x28, 0x29, 0x6b, 0xdf, 0x26, 0x6a, 0xd7, 0x0f, 0xaa, 0xdf, 0xbe, 0x6d, 0xaf,
-	0x7d, 0xf7, 0xb6, 0x5d, 0x10, 0x81, 0xf6, 0x8e, 0xac, 0xa2, 0x22, 0xf4, 0x08, 0xda, 0x83, 0x0d,
-	0x8f, 0xcd, 0xb8, 0xed, 0x8c, 0xfb, 0xe2, 0xb0, 0xfb, 0xcd, 0x8c, 0x0c, 0x00, 0x3a, 0xf2, 0xe8,
-	0x0b, 0xba, 0x55, 0xd1, 0x04, 0x99, 0x24, 0xfa, 0x27, 0x54, 0x86, 0x78, 0x78, 0x4a, 0x89, 0xe6,
-	0x67, 0x53, 0xfc, 0xb2, 0xc2, 0x25, 0x5d, 0x54, 0x28, 0x4c, 0x20, 0xac, 0xd0, 0x23, 0x40, 0x71,
-	0x63, 0x54, 0x62, 0xce, 0x38, 0x9e, 0x04, 0x25, 0x96, 0x03, 0x74, 0x0d, 0xb2, 0x36, 0x51, 0x61,
-	0x55, 0x0e, 0x20, 0x96, 0x83, 0x30, 0x9b, 0x5d, 0xa8, 0x87, 0x9e, 0x82, 0x5b, 0xb3, 0x0d, 0x99,
-	0x95, 0x89, 0x67, 0x6c, 0x62, 0xbe, 0x13, 0x0b, 0x29, 0x5c, 0xfc, 0x9c, 0x49, 0x68, 0x07, 0xf2,
-	0xab, 0xea, 0xa3, 0x00, 0xb3, 0x03, 0x10, 0xed, 0x53, 0xc4, 0x37, 0x56, 0xf1, 0x1f, 0x43, 0xed,
-	0x58, 0x57, 0xf5, 0x82, 0x91, 0xa3, 0x26, 0xac, 0x63, 0x42, 0x3c, 0xea, 0xfb, 0xf2, 0xbe, 0x96,
-	0xac, 0x60, 0x68, 0x9a, 0x50, 0x8f, 0x9c, 0xe9, 0x94, 0xaa, 0x90, 0x61, 0x67, 0xd2, 0x5b, 0xd1,
-	0xca, 0xb0, 0x33, 0xf3, 0x01, 0x34, 0x9e, 0x30, 0x76, 0x36, 0x73, 0xe3, 0x4b, 0x56, 0xc3, 0x25,
-	0x4b, 0xe7, 0x2c, 0xf1, 0x12, 0x50, 0x7c, 0x7a, 0x58, 0xb7, 0x9c, 0x48, 0x47, 0x7a, 0x48, 0xa6,
-	0x29, 0xed, 0xe8, 0xef, 0x90, 0x9b, 0x52, 0x8e, 0xc3, 0xf7, 0x25, 0xc4, 0xff, 0x4f, 0x39, 0x26,
-	0x98, 0x63, 0x4b, 0xe2, 0xe6, 0xfb, 0x50, 0x93, 0x89, 0x3a, 0x23, 0x76, 0xd1, 0x6a, 0xec, 0x26,
-	0x43, 0x2d, 0x77, 0x1b, 0x91, 0xf7, 0x7d, 0x05, 0x44, 0xd1, 0x7f, 0x63, 0x40, 0x3d, 0x5a, 0x40,
-	0x07, 0x6f, 0x42, 0x8e, 0xcf, 0x5d, 0x15, 0x7c, 0xb5, 0x5b, 0x8d, 0xa6, 0xbf, 0x98, 0xbb, 0xd4,
-	0x92, 0x18, 0xea, 0x40, 0x91, 0xb9, 0xd4, 0xc3, 0x9c, 0x79, 0xe9, 0x24, 0x9e, 0x69, 0xc4, 0x0a,
-	0x39, 0x82, 0x3f, 0xc4, 0x2e, 0x1e, 0xda, 0x7c, 0x2e, 0x1f, 0xc7, 0x04, 0xff, 0x50, 0x23, 0x56,
-	0xc8, 0x11, 0x59, 0xbc, 0xa6, 0x9e, 0x6f, 0x33, 0x47, 0x3e, 0x91, 0x89, 0x2c, 0xde, 0x55, 0x80,
-	0x15, 0x30, 0xcc, 0x29, 0xd4, 0x1e, 0xda, 0x0e, 0x79, 0x4a, 0xb1, 0x77, 0xd1, 0x2a, 0xfd, 0x15,
-	0xf2, 0x3e, 0xc7, 0x9e, 0x6a, 0x16, 0x69, 0x8a, 0x02, 0xa3, 0x4e, 0x93, 0x55, 0x77, 0x4f, 0x0e,
-	0xcc, 0xbb, 0x50, 0x8f, 0x96, 0xd3, 0x35, 0x3b, 0xff, 0x22, 0x20, 0xa8, 0x1f, 0xcd, 0xa6, 0x6e,
-	0xe2, 0x4d, 0xfc, 0x0f, 0x34, 0x62, 0xb6, 0x45, 0x57, 0x2b, 0xef, 0x48, 0x15, 0x2a, 0x27, 0x1c,
-	0x47, 0x0f, 0xc7, 0x4f, 0x06, 0x6c, 0x0a, 0xc3, 0xc9, 0x6c, 0x3a, 0xc5, 0xde, 0x3c, 0xf4, 0x74,
-	0x1d, 0x60, 0xe6, 0x53, 0xd2, 0xf7, 0x5d, 0x3c, 0xa4, 0xfa, 0xfd, 0x28, 0x09, 0xcb, 0x89, 0x30,
-	0xa0, 0x1b, 0x50, 0xc3, 0xaf, 0xb1, 0x3d, 0x11, 0x0f, 0xbe, 0xe6, 0x64, 0x24, 0xa7, 0x1a, 0x9a,
-	0x15, 0xf1, 0xcf, 0x50, 0x91, 0x7e, 0x6c, 0x67, 0x2c, 0xcf, 0x95, 0xaa, 0x46, 0x59, 0xd8, 0x7a,
-	0xca, 0x24, 0xfa, 0x9f, 0xa4, 0x50, 0xc5, 0x50, 0x6d, 0x4d, 0xae, 0xfe, 0x5f, 0x45, 0xf8, 0x1b,
-	0x54, 0x25, 0x61, 0x80, 0x1d, 0xf2, 0xa1, 0x4d, 0xf8, 0xa9, 0xee, 0x64, 0x1b, 0xc2, 0x7a, 0x10,
-	0x18, 0xd1, 0x1e, 0x6c, 0x46, 0x31, 0x45, 0xdc, 0x82, 0xea, 0x7a, 0x21, 0x14, 0x4e, 0x90, 0x65,
-	0xc5, 0xfe, 0xe9, 0x80, 0x61, 0x8f, 0x04, 0xf5, 0xf8, 0x39, 0x0b, 0x8d, 0x98, 0x51, 0x57, 0xe3,
-	0x06, 0xac, 0x8b, 0xf2, 0xad, 0x7e, 0xfe, 0x0b, 0x02, 0xee, 0x11, 0x74, 0x0b, 0xea, 0x92, 0x38,
-	0x64, 0x8e, 0x43, 0x87, 0x42, 0xbb, 0xf8, 0xba, 0x30, 0x35, 0x61, 0x3f, 0x8c, 0xcc, 0x68, 0x17,
-	0x1a, 0x03, 0xc6, 0xb8, 0xcf, 0x3d, 0xec, 0xf6, 0x83, 0x6b, 0x97, 0x95, 0x2f, 0x44, 0x3d, 0x04,
-	0xf4, 0xad, 0x13, 0x7e, 0xa5, 0x76, 0x70, 0xf0, 0x24, 0xe4, 0xe6, 0x24, 0xb7, 0x16, 0xd8, 0x63,
-	0x54, 0xfa, 0x66, 0x81, 0x9a, 0x57, 0xd4, 0xc0, 0x1e, 0x50, 0x77, 0xa1, 0x41, 0x82, 0x5c, 0x43,
-	0x6e, 0x41, 0x85, 0x10, 0x02, 0x01, 0xf9, 0xae, 0x3c, 0xf6, 0xdc, 0x6f, 0xae, 0xcb, 0x4b, 0xb5,
-	0x1d, 0x6b, 0xa8, 0x4b, 0x0e, 0x90, 0xa5, 0xc8, 0xe8, 0x5f, 0x50, 0x98, 0xb9, 0x42, 0xa7, 0x35,
-	0x8b, 0x72, 0xda, 0x9f, 0x3a, 0x4a, 0xc4, 0x75, 0x02, 0x11, 0xd7, 0x39, 0xd2, 0x22, 0xcf, 0xd2,
-	0x44, 0x74, 0x1f, 0xca, 0x52, 0xee, 0xb8, 0xb6, 0x33, 0xa6, 0xa4, 0x59, 0x92, 0xf3, 0x5a, 0xa9,
-	0x79, 0x2f, 0x02, 0xf1, 0x67, 0x81, 0xa0, 0x1f, 0x4b, 0x36, 0x7a, 0x00, 0x15, 0x39, 0xf9, 0xd5,
-	0x8c, 0x7a, 0x36, 0x25, 0x4d, 0x38, 0x77, 0xb6, 0x5c, 0xec, 0xb9, 0xa2, 0x9b, 0x9f, 0x1b, 0xb0,
-	0xa5, 0x05, 0xcc, 0x23, 0x8a, 0x27, 0xfc, 0x34, 0x78, 0x14, 0x2e, 0x43, 0x41, 0x75, 0x78, 0xad,
-	0xfa, 0xf4, 0x48, 0x9c, 0x4d, 0xea, 0x0c, 0xbd, 0xb9, 0xcb, 0x29, 0xe9, 0x4b, 0x55, 0x28, 0x5f,
-	0x05, 0x6b, 0x23, 0xb4, 0x1e, 0x0b, 0x79, 0xf8, 0x17, 0x08, 0x44, 0x5f, 0xdf, 0x76, 0x08, 0x7d,
-	0xa3, 0xef, 0x41, 0x45, 0x1b, 0x7b, 0xc2, 0x26, 0xee, 0x9c, 0xeb, 0xb1, 0x0f, 0xe8, 0x50, 0xea,
-	0x8c, 0x9c, 0xf4, 0x53, 0xd2, 0x96, 0x1e, 0x31, 0xbf, 0x32, 0x60, 0x23, 0x11, 0x1b, 0xda, 0x85,
-	0xf2, 0xa9, 0xfc, 0x35, 0xef, 0x8b, 0x8e, 0x6e, 0xa4, 0x3a, 0x3a, 0x68, 0xb8, 0x47, 0x7c, 0xa1,
-	0x4b, 0x66, 0x4e, 0x9c, 0x9e, 0x16, 0x00, 0x95, 0x90, 0x20, 0x26, 0xec, 0x42, 0x99, 0x8d, 0x46,
-	0x13, 0xdb, 0xa1, 0x92, 0x9e, 0x4d, 0x7b, 0xd7, 0xb0, 0x20, 0x37, 0x61, 0x5d, 0xe7, 0xa2, 0x03,
-	0x0f, 0x86, 0xe6, 0x47, 0x06, 0x5c, 0x5a, 0x28, 0xa9, 0xbe, 0x55, 0x77, 0xa0, 0xa0, 0x96, 0xd3,
-	0xbd, 0xae, 0x19, 0x3f, 0x52, 0x89, 0x19, 0x9a, 0x87, 0xee, 0x03, 0x78, 0x94, 0xcc, 0x1c, 0x82,
-	0x9d, 0xe1, 0x5c, 0x37, 0x8f, 0xab, 0x31, 0x85, 0x6d, 0x85, 0xe0, 0xc9, 0xf0, 0x94, 0x4e, 0xa9,
-	0x15, 0xa3, 0x9b, 0x3f, 0x18, 0xb0, 0xf9, 0x6c, 0x20, 0x8a, 0x99, 0xdc, 0xda, 0xf4, 0x16, 0x1a,
-	0xcb, 0xb6, 0x30, 0x3a, 0x01, 0x99, 0xc4, 0x09, 0x48, 0xee, 0x5a, 0x76, 0x61, 0xd7, 0x84, 0x78,
-	0x97, 0x0d, 0xa1, 0x8f, 0x47, 0x9c, 0x7a, 0xfd, 0x78, 0x91, 0xb2, 0x56, 0x43, 0x42, 0xfb, 0x02,
-	0x09, 0x3e, 0x2e, 0xfe, 0x01, 0x88, 0x3a, 0xa4, 0x3f, 0xa0, 0x23, 0xe6, 0xd1, 0x90, 0xae, 0x1e,
-	0xbc, 0x3a, 0x75, 0xc8, 0x81, 0x04, 0x02, 0x76, 0xd8, 0x65, 0x0a, 0xb1, 0xef, 0x19, 0xf3, 0x13,
-	0x03, 0xb6, 0x92, 0x99, 0xea, 0x8a, 0xdf, 0x4d, 0x89, 0xf8, 0xd5, 0x35, 0x0f, 0x99, 0xbf, 0xa9,
-	0xea, 0xdd, 0x4f, 0x73, 0x50, 0x79, 0x8c, 0x49, 0x2f, 0x58, 0x05, 0xf5, 0x00, 0x22, 0x85, 0x8f,
-	0xae, 0xc5, 0xd6, 0x4f, 0x09, 0xff, 0xd6, 0xf5, 0x15, 0xa8, 0x4e, 0xe7, 0x10, 0x8a, 0x81, 0x46,
-	0x43, 0xad, 0x18, 0x75, 0x41, 0x05, 0xb6, 0xae, 0x2e, 0xc5, 0xb4, 0x93, 0x1e, 0x40, 0xa4, 0xc2,
-	0x12, 0xf1, 0xa4, 0xb4, 0x5d, 0x22, 0x9e, 0x25, 0xd2, 0xed, 0x10, 0x8a, 0x81, 0x22, 0x4a, 0xc4,
-	0xb3, 0xa0, 0xc3, 0x12, 0xf1, 0xa4, 0x24, 0xd4, 0x21, 0x14, 0x03, 0x89, 0x90, 0x70, 0xb2, 0x20,
-	0x53, 0x12, 0x4e, 0x52, 0x9a, 0xe2, 0x21, 0x94, 0x42, 0x75, 0x80, 0xe2, 0xcc, 0x45, 0x1d, 0xd1,
-	0xba, 0xb6, 0x1c, 0xd4, 0x7e, 0x2c, 0xd8, 0x48, 0x7c, 0x2d, 0xa1, 0xf6, 0xea, 0xef, 0x28, 0xe5,
-	0x6f, 0xe7, 0xbc, 0x0f, 0xad, 0xee, 0x97, 0x06, 0xd4, 0x9f, 0xbd, 0xa6, 0xde, 0x04, 0xcf, 0xff,
-	0x90, 0x53, 0xf1, 0x3b, 0xe5, 0xde, 0xfd, 0xc2, 0x80, 0x4d, 0xf9, 0x05, 0x7e, 0xc2, 0x99, 0x47,
-	0xa3, 0x50, 0x0f, 0x20, 0x2f, 0x25, 0x14, 0xba, 0xb2, 0xd0, 0x02, 0x43, 0xbf, 0xe7, 0xf4, 0x46,
-	0x73, 0x0d, 0x3d, 0x82, 0x52, 0xa8, 0x32, 0x92, 0x31, 0x2e, 0x08, 0x92, 0x64, 0x8c, 0x8b, 0xc2,
-	0xc4, 0x5c, 0xeb, 0x7e, 0x6c, 0xc0, 0x56, 0xec, 0xeb, 0x3b, 0x0a, 0xd3, 0x85, 0x2b, 0x2b, 0xbe,
-	0xe9, 0xd1, 0xad, 0xf8, 0x31, 0xfe, 0xd5, 0x3f, 0x4c, 0x5a, 0xb7, 0x2f, 0x42, 0xd5, 0x05, 0xfb,
-	0xda, 0x80, 0x9a, 0x7a, 0x3c, 0xa2, 0x28, 0x9e, 0x43, 0x25, 0xfe, 0x12, 0xa1, 0x78, 0x69, 0x96,
-	0x3c, 0xc6, 0xad, 0xf6, 0x4a, 0x3c, 0xac, 0xdd, 0x8b, 0xc5, 0x36, 0xd8, 0x5e, 0xf9, 0x86, 0x2d,
-	0x39, 0x93, 0x4b, 0x5b, 0x91, 0xb9, 0x76, 0x90, 0x7b, 0x2f, 0xe3, 0x0e, 0x06, 0x05, 0x29, 0x10,
-	0xfe, 0xfd, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x4f, 0xb8, 0xc4, 0xd0, 0x12, 0x00, 0x00,
+	// 2723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0x4f, 0xcf, 0xd7, 0xce, 0xbc, 0xf9, 0xd8, 0xd9, 0xda, 0x71, 0xd2, 0x1e, 0xdb, 0x3b, 0x9b,
+	0x4e, 0x82, 0x9d, 0x98, 0x8c, 0x93, 0x25, 0x10, 0x59, 0x51, 0x0e, 0xb3, 0x5e, 0x3b, 0x1e, 0x25,
+	0xf8, 0xa3, 0xd7, 0x41, 0x02, 0x85, 0x8c, 0x6a, 0xba, 0x6a, 0x76, 0x1b, 0xcf, 0x74, 0xb7, 0xbb,
+	0xab, 0x9d, 0x9d, 0x23, 0x07, 0x10, 0x9c, 0x40, 0x42, 0x08, 0xf1, 0x07, 0x20, 0xf1, 0x07, 0xc0,
+	0x85, 0x2b, 0x17, 0x24, 0x8e, 0xdc, 0x38, 0x84, 0x1b, 0xdc, 0x40, 0xe2, 0x80, 0xc4, 0x0d, 0xd5,
+	0x47, 0x57, 0x77, 0xcf, 0x87, 0xf7, 0x03, 0xb8, 0x75, 0xbd, 0xf7, 0x7b, 0xaf, 0x5e, 0xbd, 0x7a,
+	0x55, 0xf5, 0xde, 0x6b, 0xd8, 0x74, 0xbd, 0x28, 0xa0, 0x0e, 0xf3, 0xc3, 0x7e, 0x10, 0xfa, 0xcc,
+	0x47, 0x35, 0x4d, 0xe8, 0xc2, 0x91, 0x7f, 0xe4, 0x4b, 0x72, 0x17, 0x3c, 0x9f, 0x50, 0xf5, 0xbd,
+	0x19, 0xf8, 0xae, 0xc7, 0x68, 0x48, 0xc6, 0x8a, 0xb0, 0x73, 0xe4, 0xfb, 0x47, 0x53, 0x7a, 0x4b,
+	0x8c, 0xc6, 0xf1, 0xe4, 0x16, 0x89, 0x43, 0xcc, 0x5c, 0xdf, 0x53, 0xfc, 0xde, 0x22, 0x9f, 0xb9,
+	0x33, 0x1a, 0x31, 0x3c, 0x0b, 0x24, 0xc0, 0x7a, 0x00, 0x3b, 0x9f, 0xb8, 0x11, 0x1b, 0x86, 0x21,
+	0x0d, 0x70, 0x88, 0xc7, 0x53, 0x7a, 0x48, 0x8f, 0x66, 0xd4, 0x63, 0x91, 0x4d, 0x9f, 0xc5, 0x34,
+	0x62, 0xa8, 0x03, 0xe5, 0xa9, 0x3b, 0x73, 0x99, 0x69, 0xec, 0x1a, 0x37, 0xca, 0xb6, 0x1c, 0xa0,
+	0x97, 0xa1, 0xe2, 0x4f, 0x26, 0x11, 0x65, 0x66, 0x41, 0x90, 0xd5, 0xc8, 0xea, 0x00, 0x1a, 0xc4,
+	0xc4, 0x65, 0x8f, 0x63, 0x1a, 0xd3, 0x44, 0x87, 0x75, 0x00, 0xdb, 0x39, 0x6a, 0x14, 0xf8, 0x5e,
+	0x44, 0xd1, 0xdb, 0x50, 0x79, 0x26, 0x28, 0xa6, 0xb1, 0x5b, 0xbc, 0x51, 0xdf, 0xbb, 0xd4, 0x4f,
+	0x7d, 0x92, 0xe2, 0x6d, 0x05, 0xb2, 0x7e, 0x50, 0x00, 0x48, 0xc9, 0xe8, 0x3a, 0x6c, 0x70, 0xd7,
+	0x8c, 0x5c, 0x22, 0x4c, 0x6b, 0xec, 0xb7, 0xfe, 0xf0, 0x65, 0xef, 0xa5, 0x3f, 0x7f, 0xd9, 0xab,
+	0x3c, 0xf0, 0x09, 0x1d, 0x1e, 0xd8, 0x15, 0xce, 0x1e, 0x12, 0xd4, 0x85, 0x6a, 0x10, 0xba, 0x7e,
+	0xe8, 0xb2, 0xb9, 0xb0, 0xb6, 0x66, 0xeb, 0x31, 0x6a, 0x43, 0xd1, 0xa3, 0x5f, 0x98, 0xc5, 0x5d,
+	0xe3, 0x46, 0xd5, 0xe6, 0x9f, 0x7c, 0x65, 0x62, 0x3e, 0x62, 0x96, 0x76, 0x8d, 0x1b, 0x45, 0x35,
+	0x3b, 0x41, 0x77, 0xa0, 0x31, 0xc5, 0x11, 0x1b, 0x61, 0x6e, 0x01, 0x25, 0x66, 0x79, 0xd7, 0xb8,
+	0x51, 0xdf, 0xeb, 0xf6, 0xa5, 0x87, 0xfb, 0x89, 0x87, 0xfb, 0x4f, 0x12, 0x0f, 0xef, 0x97, 0x7e,
+	0xfa, 0x97, 0x9e, 0x61, 0xd7, 0xb9, 0xd4, 0x40, 0x0a, 0x69, 0x25, 0x13, 0xec, 0x4e, 0xe3, 0x90,
+	0x9a, 0x95, 0xf3, 0x28, 0xb9, 0x27, 0x85, 0xac, 0xbf, 0x1a, 0x80, 0x96, 0x37, 0x0c, 0x21, 0x28,
+	0x05, 0x98, 0x1d, 0x4b, 0x67, 0xd8, 0xe2, 0x1b, 0xdd, 0x86, 0x56, 0x24, 0xd9, 0x23, 0x42, 0x19,
+	0x76, 0xa7, 0xc2, 0x01, 0xf5, 0x3d, 0xd4, 0x4f, 0x23, 0xe9, 0x91, 0xfc, 0xb2, 0x9b, 0x0a, 0x79,
+	0x20, 0x80, 0xa8, 0x07, 0xf5, 0xa9, 0x1f, 0xb1, 0x51, 0xe0, 0x52, 0x87, 0x46, 0xc2, 0x43, 0x65,
+	0x1b, 0x38, 0xe9, 0x91, 0xa0, 0xa0, 0x3e, 0x6c, 0x8b, 0xb5, 0x70, 0x43, 0xdc, 0x70, 0x84, 0x19,
+	0xa3, 0xb3, 0x80, 0x29, 0xaf, 0x6d, 0x71, 0x96, 0x2d, 0x38, 0x03, 0xc9, 0x40, 0xef, 0x40, 0x27,
+	0x0f, 0x1d, 0x39, 0x7e, 0xec, 0x31, 0xe1, 0xc8, 0xa2, 0x8d, 0xc2, 0x2c, 0xf8, 0x0e, 0xe7, 0x58,
+	0x9f, 0x41, 0x6f, 0x6d, 0x70, 0xaa, 0x10, 0xba, 0x0d, 0x55, 0x65, 0x76, 0x12, 0x44, 0xd7, 0x32,
+	0x41, 0xb4, 0x2c, 0x69, 0x6b, 0xb8, 0xf5, 0x16, 0x20, 0x31, 0x0d, 0x8f, 0x96, 0x54, 0x61, 0x07,
+	0xca, 0xd2, 0x2c, 0x43, 0x98, 0x25, 0x07, 0xd6, 0x36, 0x6c, 0x65, 0xb1, 0x32, 0xaa, 0x5f, 0x86,
+	0xce, 0x47, 0x94, 0xed, 0xc7, 0xce, 0x53, 0xca, 0xb8, 0x9d, 0x09, 0xfd, 0x9f, 0x06, 0x5c, 0x5a,
+	0x60, 0x28, 0xe5, 0x03, 0xd8, 0x18, 0x0b, 0x6a, 0x62, 0xec, 0xf5, 0x8c, 0xb1, 0x2b, 0x45, 0xfa,
+	0x92, 0x64, 0x27, 0x72, 0xdd, 0x9f, 0x1b, 0x50, 0x91, 0x34, 0x74, 0x13, 0x6a, 0x92, 0xba, 0xfe,
+	0x08, 0x54, 0x25, 0x60, 0x48, 0xd0, 0x2d, 0x68, 0x86, 0x7e, 0xcc, 0x5c, 0xef, 0x68, 0xc4, 0x8f,
+	0x45, 0x64, 0x16, 0x84, 0x01, 0xd0, 0xe7, 0xa3, 0x3e, 0x87, 0xdb, 0x0d, 0x05, 0xe0, 0x83, 0x08,
+	0xbd, 0x0d, 0x0d, 0x07, 0x3b, 0xc7, 0x94, 0x28, 0x7c, 0x71, 0x09, 0x5f, 0x97, 0x7c, 0x01, 0xe7,
+	0x1e, 0xd2, 0x0b, 0xd0, 0x1e, 0xba, 0x0f, 0x28, 0x4b, 0x4c, 0x5d, 0xcc, 0x7c, 0x86, 0xa7, 0x89,
+	0x8b, 0xc5, 0x00, 0x5d, 0x85, 0xa2, 0x4b, 0xa4, 0x59, 0x8d, 0x7d, 0xc8, 0xac, 0x81, 0x93, 0xad,
+	0x3d, 0x68, 0x6b, 0x4d, 0xc9, 0xcd, 0xb4, 0x03, 0x85, 0xb5, 0x0b, 0x2f, 0xb8, 0xc4, 0xfa, 0x34,
+	0x63, 0x92, 0x9e, 0xfc, 0x14, 0x21, 0xb4, 0x0b, 0xe5, 0x75, 0xfe, 0x91, 0x0c, 0xab, 0x0f, 0x90,
+	0xee, 0x53, 0x8a, 0x37, 0xd6, 0xe1, 0x3f, 0x86, 0xcd, 0x47, 0xca, 0xab, 0x67, 0xb4, 0x1c, 0x99,
+	0xb0, 0x81, 0x09, 0x09, 0x69, 0x14, 0xa9, 0x0b, 0x2b, 0x19, 0x5a, 0x16, 0xb4, 0x53, 0x65, 0x6a,
+	0x49, 0x2d, 0x28, 0xf8, 0x4f, 0x85, 0xb6, 0xaa, 0x5d, 0xf0, 0x9f, 0x5a, 0x1f, 0xc2, 0xd6, 0x27,
+	0xbe, 0xff, 0x34, 0x0e, 0xb2, 0x53, 0xb6, 0xf4, 0x94, 0xb5, 0x53, 0xa6, 0xf8, 0x0c, 0x50, 0x56,
+	0x5c, 0xfb, 0xad, 0xc4, 0x97, 0x23, 0x34, 0xe4, 0x97, 0x29, 0xe8, 0xe8, 0x2b, 0x50, 0x9a, 0x51,
+	0x86, 0xf5, 0xfd, 0xa2, 0xf9, 0xdf, 0xa4, 0x0c, 0x13, 0xcc, 0xb0, 0x2d, 0xf8, 0xd6, 0xe7, 0xb0,
+	0x29, 0x16, 0xea, 0x4d, 0xfc, 0xb3, 0x7a, 0xe3, 0x66, 0xde, 0xd4, 0xfa, 0xde, 0x56, 0xaa, 0x7d,
+	0x20, 0x19, 0xa9, 0xf5, 0xbf, 0x37, 0xa0, 0x9d, 0x4e, 0xa0, 0x8c, 0xb7, 0xa0, 0xc4, 0xe6, 0x81,
+	0x34, 0xbe, 0xb5, 0xd7, 0x4a, 0xc5, 0x9f, 0xcc, 0x03, 0x6a, 0x0b, 0x1e, 0xea, 0x43, 0xd5, 0x0f,
+	0x68, 0x88, 0x99, 0x1f, 0x2e, 0x2f, 0xe2, 0xa1, 0xe2, 0xd8, 0x1a, 0xc3, 0xf1, 0x0e, 0x0e, 0xb0,
+	0xc3, 0x5f, 0x95, 0xe2, 0x22, 0xfe, 0x8e, 0xe2, 0xd8, 0x1a, 0xc3, 0x57, 0xf1, 0x9c, 0x86, 0x91,
+	0xeb, 0x7b, 0x66, 0x69, 0x71, 0x15, 0xdf, 0x92, 0x0c, 0x3b, 0x41, 0x58, 0x33, 0xd8, 0xbc, 0xe7,
+	0x7a, 0xe4, 0x01, 0xc5, 0xe1, 0x59, 0xbd, 0xf4, 0x3a, 0x94, 0x23, 0x86, 0x43, 0xf9, 0x20, 0x2f,
+	0x43, 0x24, 0x33, 0x7d, 0xcd, 0x8b, 0xf2, 0xec, 0x89, 0x81, 0xf5, 0x1e, 0xb4, 0xd3, 0xe9, 0x94,
+	0xcf, 0x4e, 0x3f, 0x08, 0x08, 0xda, 0x07, 0xf1, 0x2c, 0xc8, 0xdd, 0x89, 0x5f, 0x87, 0xad, 0x0c,
+	0x6d, 0x51, 0xd5, 0xda, 0x33, 0xd2, 0x82, 0xc6, 0x21, 0xc3, 0xe9, 0xc5, 0xf1, 0x6f, 0x03, 0xb6,
+	0x39, 0xe1, 0x30, 0x9e, 0xcd, 0x70, 0x38, 0xd7, 0x9a, 0xae, 0x01, 0xc4, 0x11, 0x25, 0xa3, 0x28,
+	0xc0, 0x0e, 0x55, 0xf7, 0x47, 0x8d, 0x53, 0x0e, 0x39, 0x01, 0x5d, 0x87, 0x4d, 0xfc, 0x1c, 0xbb,
+	0x53, 0x7e, 0xe1, 0x2b, 0x4c, 0x41, 0x60, 0x5a, 0x9a, 0x2c, 0x81, 0xaf, 0x42, 0x43, 0xe8, 0x71,
+	0xbd, 0x23, 0x11, 0x57, 0xd2, 0x1b, 0x75, 0x4e, 0x1b, 0x4a, 0x12, 0x7f, 0xff, 0x04, 0x84, 0x4a,
+	0x84, 0x7c, 0xd6, 0xc4, 0xec, 0x77, 0x25, 0xe0, 0x0d, 0x68, 0x09, 0xc0, 0x18, 0x7b, 0xe4, 0x0b,
+	0x97, 0xb0, 0x63, 0xf5, 0x92, 0x35, 0x39, 0x75, 0x3f, 0x21, 0xa2, 0x5b, 0xb0, 0x9d, 0xda, 0x94,
+	0x62, 0x2b, 0xf2, 0xd5, 0xd3, 0x2c, 0x2d, 0x20, 0xdc, 0x8a, 0xa3, 0xe3, 0xb1, 0x8f, 0x43, 0x92,
+	0xf8, 0xe3, 0xb7, 0x65, 0xd8, 0xca, 0x10, 0x95, 0x37, 0xce, 0x9c, 0x01, 0xbd, 0x09, 0x6d, 0x01,
+	0x74, 0x7c, 0xcf, 0xa3, 0x0e, 0xcf, 0x0f, 0x23, 0xe5, 0x98, 0x4d, 0x4e, 0xbf, 0x93, 0x92, 0xd1,
+	0x4d, 0xd8, 0x1a, 0xfb, 0x3e, 0x8b, 0x58, 0x88, 0x83, 0x51, 0x72, 0xec, 0x8a, 0xe2, 0x86, 0x68,
+	0x6b, 0x86, 0x3a, 0x75, 0x5c, 0xaf, 0xc8, 0x1d, 0x3c, 0x3c, 0xd5, 0xd8, 0x92, 0xc0, 0x6e, 0x26,
+	0xf4, 0x0c, 0x94, 0x9e, 0x2c, 0x40, 0xcb, 0x12, 0x4a, 0x4f, 0xf2, 0xd0, 0x9b, 0xb0, 0x45, 0x92,
+	0xb5, 0x6a, 0x6c, 0x45, 0x9a, 0xa0, 0x19, 0x09, 0xf8, 0x3d, 0x11, 0xf6, 0x2c, 0x32, 0x37, 0xc4,
+	0xa1, 0xda, 0xc9, 0x3c, 0xa8, 0x2b, 0x02, 0xc8, 0x96, 0x60, 0xf4, 0x2e, 0x54, 0xe2, 0x80, 0xe7,
+	0xc2, 0x66, 0x55, 0x88, 0x5d, 0x5e, 0xca, 0xc0, 0x0e, 0x54, 0x22, 0x6d, 0x2b, 0x20, 0xfa, 0x00,
+	0x44, 0x12, 0x36, 0x0a, 0x5c, 0xef, 0x88, 0x12, 0xb3, 0x76, 0x5a, 0xe6, 0x66, 0x03, 0x87, 0x3f,
+	0x12, 0x68, 0xf4, 0xa1, 0xca, 0xfb, 0x9e, 0xc5, 0x34, 0x74, 0x29, 0x31, 0xe1, 0x54, 0x69, 0x31,
+	0xd9, 0x63, 0x09, 0xe7, 0xa1, 0x46, 0xf0, 0x0c, 0x1f, 0x51, 0x92, 0xa4, 0x63, 0x75, 0x19, 0x6a,
+	0x8a, 0xaa, 0x32, 0xb2, 0xdb, 0x00, 0x11, 0x66, 0x74, 0x3a, 0x75, 0x19, 0x8d, 0xcc, 0x86, 0x38,
+	0x6c, 0x97, 0xb3, 0x0e, 0x49, 0x98, 0x9f, 0x46, 0xf8, 0x88, 0xda, 0x19, 0x30, 0x9f, 0x81, 0x9e,
+	0x04, 0x6e, 0x48, 0xc9, 0xc8, 0x0f, 0x09, 0x0d, 0x23, 0xb3, 0x29, 0x67, 0x50, 0xd4, 0x87, 0x82,
+	0xc8, 0x73, 0xbe, 0x1c, 0x6c, 0x34, 0x9e, 0xf3, 0xa9, 0x5a, 0x32, 0xe7, 0xcb, 0x62, 0xf7, 0x39,
+	0xc3, 0xfa, 0x93, 0x01, 0xad, 0xfc, 0xac, 0xe8, 0x5d, 0x68, 0xe8, 0x79, 0xd7, 0x47, 0x6e, 0x5d,
+	0x63, 0x86, 0x84, 0x9f, 0x7a, 0x71, 0x98, 0x47, 0xfc, 0x64, 0xa9, 0xc0, 0xad, 0x09, 0xca, 0xa7,
+	0x11, 0x25, 0xfc, 0xa4, 0x4a, 0xf6, 0xb3, 0xd8, 0x67, 0x58, 0x9d, 0x65, 0x29, 0xf1, 0x98, 0x53,
+	0xf8, 0xe2, 0xf4, 0xc1, 0x93, 0x3a, 0xe4, 0x69, 0x6e, 0x6a, 0xaa, 0xd0, 0x73, 0x1d, 0x36, 0x53,
+	0x98, 0xd4, 0x25, 0x4f, 0x74, 0x2a, 0x2d, 0xf4, 0x59, 0x3f, 0x33, 0x60, 0xdb, 0xa6, 0x11, 0xf3,
+	0x43, 0xfa, 0x24, 0xc4, 0xd1, 0x71, 0x72, 0x45, 0x5f, 0x60, 0x69, 0x43, 0x68, 0x32, 0xae, 0x82,
+	0x92, 0x11, 0x9e, 0x30, 0x9a, 0x3c, 0x3d, 0x2f, 0xaa, 0x08, 0xaa, 0x5c, 0x9f, 0xa8, 0x0a, 0x1a,
+	0x4a, 0x74, 0xc0, 0x25, 0xad, 0x0f, 0xa1, 0x93, 0x37, 0x4a, 0xdd, 0x12, 0x6f, 0x40, 0x2b, 0x94,
+	0x74, 0x32, 0xca, 0xa6, 0xb6, 0xcd, 0x84, 0x2a, 0x93, 0xed, 0xc7, 0x70, 0xf5, 0xa3, 0x10, 0x3b,
+	0x74, 0x12, 0x4f, 0xef, 0x9e, 0xb8, 0x4c, 0xef, 0xda, 0xc5, 0x17, 0x67, 0xf5, 0xe0, 0xda, 0x1a,
+	0x95, 0xd2, 0x34, 0xeb, 0x0a, 0x5c, 0xce, 0x01, 0x18, 0x66, 0xb1, 0x7e, 0x03, 0xbe, 0x0d, 0xdd,
+	0x55, 0x4c, 0xb5, 0xaa, 0x0f, 0x78, 0x51, 0xe7, 0xcb, 0xbb, 0x59, 0x3e, 0x2b, 0xbd, 0x6c, 0x2e,
+	0x9d, 0x11, 0x7c, 0xa4, 0x60, 0xb6, 0x16, 0xb0, 0xfe, 0x55, 0x80, 0xce, 0x2a, 0xc8, 0x45, 0x76,
+	0xf0, 0x23, 0x68, 0xb8, 0x9e, 0xcb, 0x5c, 0xcc, 0xf8, 0x1e, 0xb2, 0x73, 0x6d, 0x60, 0x5d, 0x4b,
+	0x0e, 0x18, 0x1a, 0x40, 0x7d, 0xe2, 0x7a, 0xae, 0x8c, 0x05, 0x66, 0x16, 0x4f, 0xd5, 0x23, 0x4b,
+	0x43, 0x48, 0x84, 0x06, 0x0c, 0xbd, 0x0d, 0x48, 0xde, 0x0f, 0x23, 0x16, 0x62, 0x2f, 0x9a, 0xd0,
+	0x30, 0xd4, 0xc1, 0xbe, 0x25, 0x39, 0x4f, 0x52, 0x06, 0x7a, 0x0d, 0x9a, 0x0a, 0xce, 0xeb, 0x51,
+	0x55, 0xd3, 0x16, 0xed, 0x86, 0x24, 0xde, 0x13, 0x34, 0xf1, 0x20, 0xcc, 0xd9, 0x82, 0x4a, 0xf9,
+	0x7a, 0xb5, 0x05, 0x23, 0xab, 0x71, 0x07, 0x20, 0x8a, 0x1d, 0x87, 0x46, 0xd1, 0x24, 0x9e, 0x8a,
+	0x2b, 0xb9, 0x6a, 0x67, 0x28, 0xd6, 0x2f, 0x0d, 0xe8, 0xa8, 0x4a, 0xec, 0x3e, 0xc5, 0x53, 0xa6,
+	0x8f, 0xce, 0xcb, 0x50, 0x91, 0xa5, 0x8a, 0x2a, 0x5f, 0xd5, 0x48, 0xdc, 0x4b, 0x9e, 0x13, 0xce,
+	0x03, 0xee, 0x5d, 0x51, 0xde, 0x8a, 0xf4, 0xc6, 0x6e, 0x6a, 0xea, 0x23, 0x5e, 0xe7, 0xbe, 0x06,
+	0x49, 0xf5, 0x3a, 0x72, 0x3d, 0x42, 0x4f, 0xd4, 0x25, 0xd0, 0x50, 0xc4, 0x21, 0xa7, 0xf1, 0x6b,
+	0x24, 0x08, 0xfd, 0xef, 0x51, 0x47, 0x14, 0x4c, 0x25, 0xa1, 0xa7, 0xa6, 0x28, 0x43, 0x62, 0xfd,
+	0xc6, 0x80, 0x66, 0xce, 0x36, 0x74, 0x13, 0xea, 0xc7, 0xe2, 0x6b, 0x3e, 0x72, 0x89, 0x0c, 0xb3,
+	0x7c, 0x69, 0x02, 0x8a, 0x3d, 0x24, 0x11, 0x2f, 0xb0, 0x62, 0x2f, 0x0b, 0x5f, 0xae, 0x64, 0x1a,
+	0xb1, 0x97, 0x11, 0xb8, 0x09, 0x75, 0x7f, 0x32, 0x99, 0xba, 0x1e, 0x15, 0xf0, 0xe2, 0xb2, 0x76,
+	0xc5, 0xe6, 0x60, 0x13, 0x36, 0xd4, 0x5a, 0x94, 0xe1, 0xc9, 0xd0, 0xfa, 0xa1, 0x01, 0x97, 0x16,
+	0x5c, 0xaa, 0x8e, 0xc8, 0x3b, 0x50, 0x91, 0xd3, 0xa9, 0xa4, 0xdd, 0xcc, 0x3e, 0x05, 0x39, 0x09,
+	0x85, 0x43, 0x1f, 0x00, 0x84, 0x94, 0xc4, 0x1e, 0xc1, 0x9e, 0x33, 0x57, 0x91, 0x7c, 0x25, 0xd3,
+	0x2a, 0xb0, 0x35, 0xf3, 0xd0, 0x39, 0xa6, 0x33, 0x6a, 0x67, 0xe0, 0xd6, 0xdf, 0x0c, 0xd8, 0x7e,
+	0x38, 0xe6, 0xce, 0xcc, 0x6f, 0xed, 0xf2, 0x16, 0x1a, 0xab, 0xb6, 0x30, 0x8d, 0x80, 0x42, 0x2e,
+	0x02, 0xf2, 0xbb, 0x56, 0x5c, 0xd8, 0x35, 0xfe, 0x22, 0x89, 0xcc, 0x56, 0x5e, 0x9f, 0xa3, 0xac,
+	0x93, 0x8a, 0xf6, 0x96, 0x60, 0x89, 0xeb, 0x31, 0xe9, 0x92, 0x7c, 0x15, 0x10, 0xf5, 0xc8, 0x68,
+	0x4c, 0x27, 0x7e, 0x48, 0x35, 0x5c, 0x06, 0x7e, 0x9b, 0x7a, 0x64, 0x5f, 0x30, 0x12, 0xb4, 0x4e,
+	0x97, 0x2b, 0x99, 0xe6, 0x97, 0xf5, 0x63, 0x03, 0x3a, 0xf9, 0x95, 0x2a, 0x8f, 0xbf, 0xb7, 0xd4,
+	0x8d, 0x58, 0xef, 0x73, 0x8d, 0xfc, 0xef, 0xbc, 0xde, 0x01, 0x74, 0xe8, 0x84, 0xf1, 0x38, 0x7f,
+	0x77, 0x7e, 0xbf, 0x00, 0xdb, 0x39, 0xb2, 0x32, 0x30, 0xe9, 0x3f, 0x09, 0xbf, 0x50, 0x62, 0x1a,
+	0x67, 0xbc, 0x64, 0x44, 0x36, 0x72, 0x28, 0x85, 0xd0, 0x5d, 0x68, 0xca, 0x26, 0x96, 0xba, 0x78,
+	0xcc, 0xc2, 0x19, 0xb5, 0x88, 0xb9, 0xef, 0x29, 0x29, 0x1e, 0x17, 0xea, 0xf6, 0x71, 0x8e, 0xa9,
+	0xf3, 0x94, 0x12, 0x75, 0x68, 0xd5, 0x9d, 0x74, 0x47, 0x12, 0xd1, 0xfb, 0xb0, 0xa1, 0xb2, 0x1c,
+	0xb3, 0xb4, 0xd4, 0xe0, 0x39, 0xc8, 0xe4, 0x3f, 0x6a, 0xa9, 0x09, 0xda, 0xfa, 0xbb, 0x01, 0x68,
+	0x99, 0x7f, 0x91, 0x2b, 0xfe, 0x2d, 0xa8, 0x0a, 0x9b, 0x46, 0xae, 0x5c, 0x6b, 0x63, 0x7f, 0x53,
+	0xc1, 0x37, 0x84, 0xe6, 0xe1, 0x81, 0xbd, 0x21, 0x00, 0x43, 0xc2, 0xc3, 0x38, 0xa4, 0x38, 0xf2,
+	0x3d, 0x95, 0x34, 0xab, 0x11, 0xba, 0x0b, 0x75, 0x42, 0x19, 0x75, 0xd4, 0x2b, 0x51, 0x3a, 0xc7,
+	0x2b, 0x01, 0x89, 0xe0, 0x80, 0xf1, 0x5e, 0x66, 0x48, 0x03, 0x3f, 0x4c, 0x3a, 0x90, 0x55, 0x5b,
+	0x8f, 0xad, 0x5f, 0x1b, 0xb0, 0x7d, 0xf7, 0x84, 0x0f, 0x64, 0xb6, 0x96, 0x1c, 0xc0, 0x8f, 0xa1,
+	0x85, 0x43, 0xe7, 0xd8, 0x7d, 0xae, 0x93, 0x0c, 0xe3, 0x1c, 0xb3, 0x37, 0x13, 0x59, 0x71, 0x8c,
+	0xd2, 0x13, 0x51, 0x58, 0xdd, 0x0e, 0x96, 0x7b, 0xa8, 0x46, 0x9c, 0x1e, 0x7b, 0x51, 0x72, 0x20,
+	0xab, 0xb6, 0x1a, 0x59, 0xf7, 0xa1, 0x93, 0xb7, 0x34, 0xbd, 0xb2, 0x54, 0xfa, 0xb9, 0x7c, 0x7c,
+	0xa4, 0x80, 0x4a, 0x2f, 0x6d, 0x85, 0xb3, 0x7e, 0x51, 0x84, 0x66, 0x8e, 0x73, 0x91, 0x0d, 0xbe,
+	0xcd, 0x9f, 0x8f, 0xd0, 0xc5, 0xd3, 0x91, 0x17, 0xcf, 0xc6, 0x2a, 0x0b, 0x6b, 0xec, 0x77, 0x94,
+	0x4c, 0xe3, 0x50, 0x30, 0x1f, 0x08, 0x1e, 0x7f, 0x54, 0xd2, 0x51, 0x2e, 0x36, 0x8a, 0xa7, 0xc7,
+	0x06, 0x16, 0x65, 0x96, 0x2a, 0x92, 0xd4, 0x28, 0xf5, 0x69, 0x39, 0x53, 0x94, 0x0b, 0xf4, 0x4c,
+	0xe4, 0x6b, 0xf2, 0xb5, 0x55, 0x23, 0xbe, 0x9d, 0x32, 0xf7, 0x76, 0x42, 0x2a, 0x4a, 0x14, 0x73,
+	0xe3, 0x3c, 0xdb, 0x29, 0x64, 0xef, 0x28, 0x51, 0x3e, 0x49, 0x24, 0xce, 0x85, 0x28, 0x84, 0x6a,
+	0xb6, 0x1a, 0xf1, 0x64, 0x24, 0x8d, 0x19, 0x66, 0xd6, 0xce, 0x78, 0xc2, 0x41, 0x07, 0x0b, 0xb3,
+	0x2e, 0xc3, 0x2b, 0x03, 0x39, 0x92, 0x45, 0x98, 0x9b, 0x76, 0x09, 0x0e, 0xc1, 0x5c, 0x66, 0xa9,
+	0x10, 0x78, 0x1f, 0x6a, 0x51, 0x42, 0x34, 0x8d, 0xa5, 0x1a, 0x26, 0x27, 0x37, 0xb7, 0x53, 0xac,
+	0xf5, 0x0f, 0x03, 0x5a, 0x79, 0xee, 0x45, 0x42, 0xe1, 0x1b, 0x50, 0x24, 0x78, 0x7e, 0xae, 0x2c,
+	0x8e, 0x0b, 0x64, 0xf6, 0xb6, 0x98, 0xdb, 0xdb, 0xd4, 0xc1, 0xa5, 0x9c, 0x83, 0x7b, 0x50, 0x57,
+	0xbb, 0x98, 0x69, 0x82, 0x83, 0xdc, 0x1c, 0xb1, 0xcd, 0xaf, 0x42, 0x43, 0x34, 0x46, 0x47, 0xb9,
+	0x20, 0xa8, 0x0b, 0xda, 0x40, 0x90, 0xf6, 0x7e, 0x52, 0x82, 0xc6, 0xc7, 0x98, 0x0c, 0x13, 0xe7,
+	0xa0, 0x21, 0x40, 0xda, 0xa6, 0x46, 0x57, 0x33, 0x6e, 0x5b, 0xea, 0x5e, 0x77, 0xaf, 0xad, 0xe1,
+	0xea, 0x97, 0xa2, 0x9a, 0x34, 0x1a, 0x51, 0x37, 0x03, 0x5d, 0x68, 0x65, 0x76, 0xaf, 0xac, 0xe4,
+	0x29, 0x25, 0x43, 0x80, 0xb4, 0x95, 0x98, 0xb3, 0x67, 0xa9, 0x41, 0xd9, 0xbd, 0xb6, 0x86, 0x9b,
+	0xda, 0x93, 0xb4, 0xf5, 0x72, 0xf6, 0x2c, 0x34, 0x13, 0xbb, 0x57, 0x56, 0xf2, 0x52, 0x25, 0x49,
+	0x9f, 0x2b, 0xa7, 0x64, 0xa1, 0xd7, 0xd6, 0xbd, 0xb2, 0x92, 0xa7, 0x94, 0xdc, 0x83, 0x9a, 0x6e,
+	0x71, 0xa1, 0x2c, 0x72, 0xb1, 0x19, 0xd6, 0xbd, 0xba, 0x9a, 0xa9, 0xf4, 0xd8, 0xd0, 0xcc, 0xb5,
+	0xfc, 0x51, 0x6f, 0xfd, 0xcf, 0x00, 0xa9, 0x6f, 0xf7, 0xb4, 0xbf, 0x05, 0x7b, 0xbf, 0x32, 0xa0,
+	0xfd, 0xf0, 0x39, 0x0d, 0xa7, 0x78, 0xfe, 0x7f, 0x89, 0x8a, 0xff, 0xd1, 0xda, 0xf7, 0xfe, 0x58,
+	0x86, 0x6d, 0xf5, 0x28, 0xfb, 0x21, 0x4d, 0x4d, 0xdd, 0x87, 0xb2, 0xe8, 0x03, 0xa2, 0x57, 0x16,
+	0xfa, 0x38, 0x5a, 0xef, 0x29, 0x0d, 0x1e, 0xeb, 0x25, 0x74, 0x1f, 0x6a, 0xba, 0x55, 0x96, 0xb7,
+	0x71, 0xa1, 0xab, 0xd6, 0xbd, 0xba, 0x9a, 0xa9, 0x35, 0x3d, 0x86, 0x46, 0xb6, 0xa2, 0x46, 0xd9,
+	0xb9, 0x57, 0xd4, 0xff, 0xdd, 0xde, 0x5a, 0xbe, 0x56, 0x39, 0x85, 0x4b, 0x2b, 0x4b, 0x62, 0x74,
+	0x7d, 0x4d, 0xf5, 0xba, 0x58, 0x87, 0x77, 0x6f, 0x9c, 0x0e, 0xd4, 0xb3, 0x39, 0x80, 0x96, 0x4b,
+	0x68, 0xf4, 0xfa, 0x3a, 0x0d, 0xd9, 0x14, 0xb2, 0xfb, 0xc6, 0x29, 0x28, 0x3d, 0xc9, 0x03, 0xa8,
+	0x67, 0x52, 0x4d, 0x94, 0x8d, 0xa0, 0xe5, 0xcc, 0xb4, 0xbb, 0xb3, 0x8e, 0x9d, 0xf5, 0x7a, 0x36,
+	0x37, 0xc8, 0x79, 0x7d, 0x45, 0x7a, 0xd3, 0xed, 0xad, 0xe5, 0x6b, 0x95, 0xdf, 0x85, 0xf6, 0xe2,
+	0x7b, 0x83, 0xac, 0x75, 0x8f, 0x4a, 0xfa, 0x4e, 0x75, 0x5f, 0x7b, 0x21, 0x26, 0x51, 0xbf, 0xf7,
+	0x23, 0x03, 0x3a, 0x99, 0x5f, 0x8d, 0x69, 0x38, 0x07, 0xf0, 0xca, 0x9a, 0x1f, 0x98, 0xe8, 0xcd,
+	0xec, 0x75, 0xf7, 0xc2, 0x3f, 0xf0, 0xdd, 0xb7, 0xce, 0x02, 0x55, 0x07, 0xeb, 0x73, 0x68, 0x89,
+	0x7f, 0xcd, 0xa9, 0x0d, 0x9f, 0x40, 0x3d, 0xf3, 0xef, 0x3d, 0xb7, 0x3d, 0xcb, 0x7f, 0xea, 0xbb,
+	0x3b, 0xeb, 0xd8, 0x4a, 0xff, 0xef, 0x0c, 0xd8, 0x94, 0x05, 0x4c, 0x3a, 0xc3, 0x63, 0x68, 0x64,
+	0xab, 0xa1, 0xdc, 0x86, 0xad, 0x28, 0x08, 0xbb, 0xbd, 0xb5, 0x7c, 0xbd, 0x61, 0x4f, 0x16, 0x4b,
+	0xf1, 0xde, 0xda, 0x3a, 0x6a, 0xc5, 0xdd, 0xb8, 0xb2, 0x1c, 0xb6, 0x5e, 0xda, 0x2f, 0x7d, 0xa7,
+	0x10, 0x8c, 0xc7, 0x15, 0xf1, 0x98, 0x7f, 0xed, 0x3f, 0x03, 0x00, 0xe3, 0x20, 0x39, 0xa2, 0x81,
+	0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2012,6 +3089,18 @@ type PieceStoreInspectorClient interface {
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error)
+	// RestoreTrash restores all pieces of a satellite, which were trashed after the specified time
+	RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error)
+	// GracefulExitSatellite initiates the graceful exit from a satellite
+	GracefulExitSatellite(ctx context.Context, in *GracefulExitSatelliteRequest, opts ...grpc.CallOption) (*GracefulExitSatelliteResponse, error)
+	// GracefulExitStatus returns the progress of all graceful exits
+	GracefulExitStatus(ctx context.Context, in *GracefulExitStatusRequest, opts ...grpc.CallOption) (*GracefulExitStatusResponse, error)
+	// ScrubStatus returns the results of the piece scrubber
+	ScrubStatus(ctx context.Context, in *ScrubStatusRequest, opts ...grpc.CallOption) (*ScrubStatusResponse, error)
+	// ExportOrders returns archived or unsent orders for reconciliation
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (*ExportOrdersResponse, error)
+	// ArchiveSummaries returns the totals of archived orders removed by the retention policy
+	ArchiveSummaries(ctx context.Context, in *ArchiveSummariesRequest, opts ...grpc.CallOption) (*ArchiveSummariesResponse, error)
 }
 
 type pieceStoreInspectorClient struct {
@@ -2040,12 +3129,78 @@ func (c *pieceStoreInspectorClient) Dashboard(ctx context.Context, in *Dashboard
 	return out, nil
 }
 
+func (c *pieceStoreInspectorClient) RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error) {
+	out := new(RestoreTrashResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/RestoreTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreInspectorClient) GracefulExitSatellite(ctx context.Context, in *GracefulExitSatelliteRequest, opts ...grpc.CallOption) (*GracefulExitSatelliteResponse, error) {
+	out := new(GracefulExitSatelliteResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/GracefulExitSatellite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreInspectorClient) GracefulExitStatus(ctx context.Context, in *GracefulExitStatusRequest, opts ...grpc.CallOption) (*GracefulExitStatusResponse, error) {
+	out := new(GracefulExitStatusResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/GracefulExitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreInspectorClient) ScrubStatus(ctx context.Context, in *ScrubStatusRequest, opts ...grpc.CallOption) (*ScrubStatusResponse, error) {
+	out := new(ScrubStatusResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/ScrubStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreInspectorClient) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (*ExportOrdersResponse, error) {
+	out := new(ExportOrdersResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/ExportOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreInspectorClient) ArchiveSummaries(ctx context.Context, in *ArchiveSummariesRequest, opts ...grpc.CallOption) (*ArchiveSummariesResponse, error) {
+	out := new(ArchiveSummariesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/ArchiveSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreInspectorServer is the server API for PieceStoreInspector service.
 type PieceStoreInspectorServer interface {
 	// Stats return space and bandwidth stats for a storagenode
 	Stats(context.Context, *StatsRequest) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(context.Context, *DashboardRequest) (*DashboardResponse, error)
+	// RestoreTrash restores all pieces of a satellite, which were trashed after the specified time
+	RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error)
+	// GracefulExitSatellite initiates the graceful exit from a satellite
+	GracefulExitSatellite(context.Context, *GracefulExitSatelliteRequest) (*GracefulExitSatelliteResponse, error)
+	// GracefulExitStatus returns the progress of all graceful exits
+	GracefulExitStatus(context.Context, *GracefulExitStatusRequest) (*GracefulExitStatusResponse, error)
+	// ScrubStatus returns the results of the piece scrubber
+	ScrubStatus(context.Context, *ScrubStatusRequest) (*ScrubStatusResponse, error)
+	// ExportOrders returns archived or unsent orders for reconciliation
+	ExportOrders(context.Context, *ExportOrdersRequest) (*ExportOrdersResponse, error)
+	// ArchiveSummaries returns the totals of archived orders removed by the retention policy
+	ArchiveSummaries(context.Context, *ArchiveSummariesRequest) (*ArchiveSummariesResponse, error)
 }
 
 func RegisterPieceStoreInspectorServer(s *grpc.Server, srv PieceStoreInspectorServer) {
@@ -2088,6 +3243,114 @@ func _PieceStoreInspector_Dashboard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PieceStoreInspector_RestoreTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).RestoreTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/RestoreTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).RestoreTrash(ctx, req.(*RestoreTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreInspector_GracefulExitSatellite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GracefulExitSatelliteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).GracefulExitSatellite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/GracefulExitSatellite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).GracefulExitSatellite(ctx, req.(*GracefulExitSatelliteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreInspector_GracefulExitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GracefulExitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).GracefulExitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/GracefulExitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).GracefulExitStatus(ctx, req.(*GracefulExitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreInspector_ScrubStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrubStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).ScrubStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/ScrubStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).ScrubStatus(ctx, req.(*ScrubStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreInspector_ExportOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).ExportOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/ExportOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).ExportOrders(ctx, req.(*ExportOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreInspector_ArchiveSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).ArchiveSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/ArchiveSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).ArchiveSummaries(ctx, req.(*ArchiveSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.PieceStoreInspector",
 	HandlerType: (*PieceStoreInspectorServer)(nil),
@@ -2100,6 +3363,30 @@ var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Dashboard",
 			Handler:    _PieceStoreInspector_Dashboard_Handler,
 		},
+		{
+			MethodName: "RestoreTrash",
+			Handler:    _PieceStoreInspector_RestoreTrash_Handler,
+		},
+		{
+			MethodName: "GracefulExitSatellite",
+			Handler:    _PieceStoreInspector_GracefulExitSatellite_Handler,
+		},
+		{
+			MethodName: "GracefulExitStatus",
+			Handler:    _PieceStoreInspector_GracefulExitStatus_Handler,
+		},
+		{
+			MethodName: "ScrubStatus",
+			Handler:    _PieceStoreInspector_ScrubStatus_Handler,
+		},
+		{
+			MethodName: "ExportOrders",
+			Handler:    _PieceStoreInspector_ExportOrders_Handler,
+		},
+		{
+			MethodName: "ArchiveSummaries",
+			Handler:    _PieceStoreInspector_ArchiveSummaries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -2171,6 +3458,72 @@ var _IrreparableInspector_serviceDesc = grpc.ServiceDesc{
 	Metadata: "inspector.proto",
 }
 
+// AuditInspectorClient is the client API for AuditInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditInspectorClient interface {
+	// AuditQueues returns the state of the audit queues of nodes
+	AuditQueues(ctx context.Context, in *AuditQueuesRequest, opts ...grpc.CallOption) (*AuditQueuesResponse, error)
+}
+
+type auditInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditInspectorClient(cc *grpc.ClientConn) AuditInspectorClient {
+	return &auditInspectorClient{cc}
+}
+
+func (c *auditInspectorClient) AuditQueues(ctx context.Context, in *AuditQueuesRequest, opts ...grpc.CallOption) (*AuditQueuesResponse, error) {
+	out := new(AuditQueuesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.AuditInspector/AuditQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditInspectorServer is the server API for AuditInspector service.
+type AuditInspectorServer interface {
+	// AuditQueues returns the state of the audit queues of nodes
+	AuditQueues(context.Context, *AuditQueuesRequest) (*AuditQueuesResponse, error)
+}
+
+func RegisterAuditInspectorServer(s *grpc.Server, srv AuditInspectorServer) {
+	s.RegisterService(&_AuditInspector_serviceDesc, srv)
+}
+
+func _AuditInspector_AuditQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditInspectorServer).AuditQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.AuditInspector/AuditQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditInspectorServer).AuditQueues(ctx, req.(*AuditQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.AuditInspector",
+	HandlerType: (*AuditInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AuditQueues",
+			Handler:    _AuditInspector_AuditQueues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
 // HealthInspectorClient is the client API for HealthInspector service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
@@ -1327,6 +1327,1059 @@ func (m *ListSegmentsResponse_Item) GetIsPrefix() bool {
 	return false
 }
 
+type ObjectMoveSegment struct {
+	Segment              int64    `protobuf:"varint,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	EncryptedMetadata    []byte   `protobuf:"bytes,2,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectMoveSegment) Reset()         { *m = ObjectMoveSegment{} }
+func (m *ObjectMoveSegment) String() string { return proto.CompactTextString(m) }
+func (*ObjectMoveSegment) ProtoMessage()    {}
+func (*ObjectMoveSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{25}
+}
+func (m *ObjectMoveSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectMoveSegment.Unmarshal(m, b)
+}
+func (m *ObjectMoveSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectMoveSegment.Marshal(b, m, deterministic)
+}
+func (m *ObjectMoveSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectMoveSegment.Merge(m, src)
+}
+func (m *ObjectMoveSegment) XXX_Size() int {
+	return xxx_messageInfo_ObjectMoveSegment.Size(m)
+}
+func (m *ObjectMoveSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectMoveSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectMoveSegment proto.InternalMessageInfo
+
+func (m *ObjectMoveSegment) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *ObjectMoveSegment) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+type ObjectBeginMoveRequest struct {
+	Bucket           []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath    []byte `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	NewBucket        []byte `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedPath []byte `protobuf:"bytes,4,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	// keep_replaced requests the object at the new path, which is moved to
+	// another path instead of being deleted
+	KeepReplaced         bool     `protobuf:"varint,5,opt,name=keep_replaced,json=keepReplaced,proto3" json:"keep_replaced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectBeginMoveRequest) Reset()         { *m = ObjectBeginMoveRequest{} }
+func (m *ObjectBeginMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginMoveRequest) ProtoMessage()    {}
+func (*ObjectBeginMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{26}
+}
+func (m *ObjectBeginMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginMoveRequest.Merge(m, src)
+}
+func (m *ObjectBeginMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Size(m)
+}
+func (m *ObjectBeginMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetKeepReplaced() bool {
+	if m != nil {
+		return m.KeepReplaced
+	}
+	return false
+}
+
+type ObjectBeginMoveResponse struct {
+	Segments []*ObjectMoveSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	// revision identifies the state of the object, which has to be moved
+	Revision []byte `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// the object at the new path, if it's kept. Its revision is empty, if
+	// there's no object at the new path.
+	ReplacedSegments     []*ObjectMoveSegment `protobuf:"bytes,3,rep,name=replaced_segments,json=replacedSegments,proto3" json:"replaced_segments,omitempty"`
+	ReplacedRevision     []byte               `protobuf:"bytes,4,opt,name=replaced_revision,json=replacedRevision,proto3" json:"replaced_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ObjectBeginMoveResponse) Reset()         { *m = ObjectBeginMoveResponse{} }
+func (m *ObjectBeginMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginMoveResponse) ProtoMessage()    {}
+func (*ObjectBeginMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{27}
+}
+func (m *ObjectBeginMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginMoveResponse.Merge(m, src)
+}
+func (m *ObjectBeginMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Size(m)
+}
+func (m *ObjectBeginMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginMoveResponse proto.InternalMessageInfo
+
+func (m *ObjectBeginMoveResponse) GetSegments() []*ObjectMoveSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetRevision() []byte {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetReplacedSegments() []*ObjectMoveSegment {
+	if m != nil {
+		return m.ReplacedSegments
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetReplacedRevision() []byte {
+	if m != nil {
+		return m.ReplacedRevision
+	}
+	return nil
+}
+
+type ObjectFinishMoveRequest struct {
+	Bucket           []byte               `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath    []byte               `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	NewBucket        []byte               `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedPath []byte               `protobuf:"bytes,4,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	Segments         []*ObjectMoveSegment `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	Revision         []byte               `protobuf:"bytes,6,opt,name=revision,proto3" json:"revision,omitempty"`
+	// keep_replaced moves the object at the new path to replaced_encrypted_path
+	// in the new bucket in the same operation
+	KeepReplaced          bool                 `protobuf:"varint,7,opt,name=keep_replaced,json=keepReplaced,proto3" json:"keep_replaced,omitempty"`
+	ReplacedEncryptedPath []byte               `protobuf:"bytes,8,opt,name=replaced_encrypted_path,json=replacedEncryptedPath,proto3" json:"replaced_encrypted_path,omitempty"`
+	ReplacedSegments      []*ObjectMoveSegment `protobuf:"bytes,9,rep,name=replaced_segments,json=replacedSegments,proto3" json:"replaced_segments,omitempty"`
+	ReplacedRevision      []byte               `protobuf:"bytes,10,opt,name=replaced_revision,json=replacedRevision,proto3" json:"replaced_revision,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
+	XXX_unrecognized      []byte               `json:"-"`
+	XXX_sizecache         int32                `json:"-"`
+}
+
+func (m *ObjectFinishMoveRequest) Reset()         { *m = ObjectFinishMoveRequest{} }
+func (m *ObjectFinishMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishMoveRequest) ProtoMessage()    {}
+func (*ObjectFinishMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{28}
+}
+func (m *ObjectFinishMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishMoveRequest.Merge(m, src)
+}
+func (m *ObjectFinishMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Size(m)
+}
+func (m *ObjectFinishMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectFinishMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetSegments() []*ObjectMoveSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetRevision() []byte {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetKeepReplaced() bool {
+	if m != nil {
+		return m.KeepReplaced
+	}
+	return false
+}
+
+func (m *ObjectFinishMoveRequest) GetReplacedEncryptedPath() []byte {
+	if m != nil {
+		return m.ReplacedEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetReplacedSegments() []*ObjectMoveSegment {
+	if m != nil {
+		return m.ReplacedSegments
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetReplacedRevision() []byte {
+	if m != nil {
+		return m.ReplacedRevision
+	}
+	return nil
+}
+
+type ObjectFinishMoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectFinishMoveResponse) Reset()         { *m = ObjectFinishMoveResponse{} }
+func (m *ObjectFinishMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishMoveResponse) ProtoMessage()    {}
+func (*ObjectFinishMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{29}
+}
+func (m *ObjectFinishMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectFinishMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishMoveResponse.Merge(m, src)
+}
+func (m *ObjectFinishMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Size(m)
+}
+func (m *ObjectFinishMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishMoveResponse proto.InternalMessageInfo
+
+type ObjectConcatSource struct {
+	EncryptedPath []byte               `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	Segments      []*ObjectMoveSegment `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	// revision identifies the state of the object, which has to be concatenated
+	Revision             []byte   `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectConcatSource) Reset()         { *m = ObjectConcatSource{} }
+func (m *ObjectConcatSource) String() string { return proto.CompactTextString(m) }
+func (*ObjectConcatSource) ProtoMessage()    {}
+func (*ObjectConcatSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{30}
+}
+func (m *ObjectConcatSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectConcatSource.Unmarshal(m, b)
+}
+func (m *ObjectConcatSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectConcatSource.Marshal(b, m, deterministic)
+}
+func (m *ObjectConcatSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectConcatSource.Merge(m, src)
+}
+func (m *ObjectConcatSource) XXX_Size() int {
+	return xxx_messageInfo_ObjectConcatSource.Size(m)
+}
+func (m *ObjectConcatSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectConcatSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectConcatSource proto.InternalMessageInfo
+
+func (m *ObjectConcatSource) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectConcatSource) GetSegments() []*ObjectMoveSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ObjectConcatSource) GetRevision() []byte {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+type ObjectBeginConcatRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPaths       [][]byte `protobuf:"bytes,2,rep,name=encrypted_paths,json=encryptedPaths,proto3" json:"encrypted_paths,omitempty"`
+	NewEncryptedPath     []byte   `protobuf:"bytes,3,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectBeginConcatRequest) Reset()         { *m = ObjectBeginConcatRequest{} }
+func (m *ObjectBeginConcatRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginConcatRequest) ProtoMessage()    {}
+func (*ObjectBeginConcatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{31}
+}
+func (m *ObjectBeginConcatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginConcatRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginConcatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginConcatRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginConcatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginConcatRequest.Merge(m, src)
+}
+func (m *ObjectBeginConcatRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginConcatRequest.Size(m)
+}
+func (m *ObjectBeginConcatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginConcatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginConcatRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginConcatRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginConcatRequest) GetEncryptedPaths() [][]byte {
+	if m != nil {
+		return m.EncryptedPaths
+	}
+	return nil
+}
+
+func (m *ObjectBeginConcatRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+type ObjectBeginConcatResponse struct {
+	Sources              []*ObjectConcatSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ObjectBeginConcatResponse) Reset()         { *m = ObjectBeginConcatResponse{} }
+func (m *ObjectBeginConcatResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginConcatResponse) ProtoMessage()    {}
+func (*ObjectBeginConcatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{32}
+}
+func (m *ObjectBeginConcatResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginConcatResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginConcatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginConcatResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginConcatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginConcatResponse.Merge(m, src)
+}
+func (m *ObjectBeginConcatResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginConcatResponse.Size(m)
+}
+func (m *ObjectBeginConcatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginConcatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginConcatResponse proto.InternalMessageInfo
+
+func (m *ObjectBeginConcatResponse) GetSources() []*ObjectConcatSource {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+type ObjectFinishConcatRequest struct {
+	Bucket           []byte                `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	NewEncryptedPath []byte                `protobuf:"bytes,2,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	Sources          []*ObjectConcatSource `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	// the last segment of the new object is stored inline and only holds its stream info
+	EncryptedInlineSegment []byte               `protobuf:"bytes,4,opt,name=encrypted_inline_segment,json=encryptedInlineSegment,proto3" json:"encrypted_inline_segment,omitempty"`
+	EncryptedMetadata      []byte               `protobuf:"bytes,5,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	ExpirationDate         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
+}
+
+func (m *ObjectFinishConcatRequest) Reset()         { *m = ObjectFinishConcatRequest{} }
+func (m *ObjectFinishConcatRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishConcatRequest) ProtoMessage()    {}
+func (*ObjectFinishConcatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{33}
+}
+func (m *ObjectFinishConcatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishConcatRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishConcatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishConcatRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishConcatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishConcatRequest.Merge(m, src)
+}
+func (m *ObjectFinishConcatRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishConcatRequest.Size(m)
+}
+func (m *ObjectFinishConcatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishConcatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishConcatRequest proto.InternalMessageInfo
+
+func (m *ObjectFinishConcatRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectFinishConcatRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectFinishConcatRequest) GetSources() []*ObjectConcatSource {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *ObjectFinishConcatRequest) GetEncryptedInlineSegment() []byte {
+	if m != nil {
+		return m.EncryptedInlineSegment
+	}
+	return nil
+}
+
+func (m *ObjectFinishConcatRequest) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+func (m *ObjectFinishConcatRequest) GetExpirationDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return nil
+}
+
+type ObjectFinishConcatResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectFinishConcatResponse) Reset()         { *m = ObjectFinishConcatResponse{} }
+func (m *ObjectFinishConcatResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishConcatResponse) ProtoMessage()    {}
+func (*ObjectFinishConcatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{34}
+}
+func (m *ObjectFinishConcatResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishConcatResponse.Unmarshal(m, b)
+}
+func (m *ObjectFinishConcatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishConcatResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishConcatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishConcatResponse.Merge(m, src)
+}
+func (m *ObjectFinishConcatResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishConcatResponse.Size(m)
+}
+func (m *ObjectFinishConcatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishConcatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishConcatResponse proto.InternalMessageInfo
+
+type LifecycleRule struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// encrypted_prefix selects the objects the rule applies to
+	EncryptedPrefix      []byte   `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	ExpirationDays       int32    `protobuf:"varint,3,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{35}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetExpirationDays() int32 {
+	if m != nil {
+		return m.ExpirationDays
+	}
+	return 0
+}
+
+type BucketLifecycle struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketLifecycle) Reset()         { *m = BucketLifecycle{} }
+func (m *BucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycle) ProtoMessage()    {}
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{36}
+}
+func (m *BucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLifecycle.Unmarshal(m, b)
+}
+func (m *BucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLifecycle.Marshal(b, m, deterministic)
+}
+func (m *BucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycle.Merge(m, src)
+}
+func (m *BucketLifecycle) XXX_Size() int {
+	return xxx_messageInfo_BucketLifecycle.Size(m)
+}
+func (m *BucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycle proto.InternalMessageInfo
+
+func (m *BucketLifecycle) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetBucketLifecycleRequest struct {
+	Bucket               []byte           `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Rules                []*LifecycleRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetBucketLifecycleRequest) Reset()         { *m = SetBucketLifecycleRequest{} }
+func (m *SetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleRequest) ProtoMessage()    {}
+func (*SetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{37}
+}
+func (m *SetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *SetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Size(m)
+}
+func (m *SetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *SetBucketLifecycleRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleRequest) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetBucketLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketLifecycleResponse) Reset()         { *m = SetBucketLifecycleResponse{} }
+func (m *SetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleResponse) ProtoMessage()    {}
+func (*SetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{38}
+}
+func (m *SetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *SetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Size(m)
+}
+func (m *SetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleResponse proto.InternalMessageInfo
+
+type GetBucketLifecycleRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketLifecycleRequest) Reset()         { *m = GetBucketLifecycleRequest{} }
+func (m *GetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleRequest) ProtoMessage()    {}
+func (*GetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{39}
+}
+func (m *GetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *GetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Size(m)
+}
+func (m *GetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketLifecycleResponse struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetBucketLifecycleResponse) Reset()         { *m = GetBucketLifecycleResponse{} }
+func (m *GetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleResponse) ProtoMessage()    {}
+func (*GetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{40}
+}
+func (m *GetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *GetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Size(m)
+}
+func (m *GetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleResponse proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleResponse) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// PlacementPolicy restricts the storage nodes selected for the segments of a bucket
+type PlacementPolicy struct {
+	// allowed_countries are ISO 3166-1 alpha-2 country codes, nodes outside them aren't selected
+	AllowedCountries []string `protobuf:"bytes,1,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	DeniedCountries  []string `protobuf:"bytes,2,rep,name=denied_countries,json=deniedCountries,proto3" json:"denied_countries,omitempty"`
+	// denied_networks are CIDR networks, nodes within them aren't selected
+	DeniedNetworks []string `protobuf:"bytes,3,rep,name=denied_networks,json=deniedNetworks,proto3" json:"denied_networks,omitempty"`
+	// required_tags have to be declared by the node operator
+	RequiredTags []string `protobuf:"bytes,4,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	// distinct_subnets selects at most one node per /24 subnet
+	DistinctSubnets      bool     `protobuf:"varint,5,opt,name=distinct_subnets,json=distinctSubnets,proto3" json:"distinct_subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlacementPolicy) Reset()         { *m = PlacementPolicy{} }
+func (m *PlacementPolicy) String() string { return proto.CompactTextString(m) }
+func (*PlacementPolicy) ProtoMessage()    {}
+func (*PlacementPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{41}
+}
+func (m *PlacementPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlacementPolicy.Unmarshal(m, b)
+}
+func (m *PlacementPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlacementPolicy.Marshal(b, m, deterministic)
+}
+func (m *PlacementPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementPolicy.Merge(m, src)
+}
+func (m *PlacementPolicy) XXX_Size() int {
+	return xxx_messageInfo_PlacementPolicy.Size(m)
+}
+func (m *PlacementPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementPolicy proto.InternalMessageInfo
+
+func (m *PlacementPolicy) GetAllowedCountries() []string {
+	if m != nil {
+		return m.AllowedCountries
+	}
+	return nil
+}
+
+func (m *PlacementPolicy) GetDeniedCountries() []string {
+	if m != nil {
+		return m.DeniedCountries
+	}
+	return nil
+}
+
+func (m *PlacementPolicy) GetDeniedNetworks() []string {
+	if m != nil {
+		return m.DeniedNetworks
+	}
+	return nil
+}
+
+func (m *PlacementPolicy) GetRequiredTags() []string {
+	if m != nil {
+		return m.RequiredTags
+	}
+	return nil
+}
+
+func (m *PlacementPolicy) GetDistinctSubnets() bool {
+	if m != nil {
+		return m.DistinctSubnets
+	}
+	return false
+}
+
+type SetBucketPlacementRequest struct {
+	Bucket               []byte           `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Policy               *PlacementPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetBucketPlacementRequest) Reset()         { *m = SetBucketPlacementRequest{} }
+func (m *SetBucketPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementRequest) ProtoMessage()    {}
+func (*SetBucketPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{42}
+}
+func (m *SetBucketPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementRequest.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementRequest.Merge(m, src)
+}
+func (m *SetBucketPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementRequest.Size(m)
+}
+func (m *SetBucketPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementRequest proto.InternalMessageInfo
+
+func (m *SetBucketPlacementRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketPlacementRequest) GetPolicy() *PlacementPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type SetBucketPlacementResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketPlacementResponse) Reset()         { *m = SetBucketPlacementResponse{} }
+func (m *SetBucketPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementResponse) ProtoMessage()    {}
+func (*SetBucketPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{43}
+}
+func (m *SetBucketPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementResponse.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementResponse.Merge(m, src)
+}
+func (m *SetBucketPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementResponse.Size(m)
+}
+func (m *SetBucketPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementResponse proto.InternalMessageInfo
+
+type GetBucketPlacementRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketPlacementRequest) Reset()         { *m = GetBucketPlacementRequest{} }
+func (m *GetBucketPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketPlacementRequest) ProtoMessage()    {}
+func (*GetBucketPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{44}
+}
+func (m *GetBucketPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketPlacementRequest.Unmarshal(m, b)
+}
+func (m *GetBucketPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketPlacementRequest.Merge(m, src)
+}
+func (m *GetBucketPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketPlacementRequest.Size(m)
+}
+func (m *GetBucketPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketPlacementRequest proto.InternalMessageInfo
+
+func (m *GetBucketPlacementRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketPlacementResponse struct {
+	Policy               *PlacementPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetBucketPlacementResponse) Reset()         { *m = GetBucketPlacementResponse{} }
+func (m *GetBucketPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketPlacementResponse) ProtoMessage()    {}
+func (*GetBucketPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{45}
+}
+func (m *GetBucketPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketPlacementResponse.Unmarshal(m, b)
+}
+func (m *GetBucketPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketPlacementResponse.Merge(m, src)
+}
+func (m *GetBucketPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketPlacementResponse.Size(m)
+}
+func (m *GetBucketPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketPlacementResponse proto.InternalMessageInfo
+
+func (m *GetBucketPlacementResponse) GetPolicy() *PlacementPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
 type SetAttributionRequest struct {
 	BucketName           []byte   `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 	PartnerId            []byte   `protobuf:"bytes,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
@@ -1339,7 +2392,7 @@ func (m *SetAttributionRequest) Reset()         { *m = SetAttributionRequest{} }
 func (m *SetAttributionRequest) String() string { return proto.CompactTextString(m) }
 func (*SetAttributionRequest) ProtoMessage()    {}
 func (*SetAttributionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{25}
+	return fileDescriptor_631e2f30a93cd64e, []int{46}
 }
 func (m *SetAttributionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAttributionRequest.Unmarshal(m, b)
@@ -1383,7 +2436,7 @@ func (m *SetAttributionResponse) Reset()         { *m = SetAttributionResponse{}
 func (m *SetAttributionResponse) String() string { return proto.CompactTextString(m) }
 func (*SetAttributionResponse) ProtoMessage()    {}
 func (*SetAttributionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{26}
+	return fileDescriptor_631e2f30a93cd64e, []int{47}
 }
 func (m *SetAttributionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAttributionResponse.Unmarshal(m, b)
@@ -1413,7 +2466,7 @@ func (m *ProjectInfoRequest) Reset()         { *m = ProjectInfoRequest{} }
 func (m *ProjectInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoRequest) ProtoMessage()    {}
 func (*ProjectInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{27}
+	return fileDescriptor_631e2f30a93cd64e, []int{48}
 }
 func (m *ProjectInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoRequest.Unmarshal(m, b)
@@ -1444,7 +2497,7 @@ func (m *ProjectInfoResponse) Reset()         { *m = ProjectInfoResponse{} }
 func (m *ProjectInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoResponse) ProtoMessage()    {}
 func (*ProjectInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{28}
+	return fileDescriptor_631e2f30a93cd64e, []int{49}
 }
 func (m *ProjectInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoResponse.Unmarshal(m, b)
@@ -1498,6 +2551,27 @@ func init() {
 	proto.RegisterType((*ListSegmentsRequest)(nil), "metainfo.ListSegmentsRequest")
 	proto.RegisterType((*ListSegmentsResponse)(nil), "metainfo.ListSegmentsResponse")
 	proto.RegisterType((*ListSegmentsResponse_Item)(nil), "metainfo.ListSegmentsResponse.Item")
+	proto.RegisterType((*ObjectMoveSegment)(nil), "metainfo.ObjectMoveSegment")
+	proto.RegisterType((*ObjectBeginMoveRequest)(nil), "metainfo.ObjectBeginMoveRequest")
+	proto.RegisterType((*ObjectBeginMoveResponse)(nil), "metainfo.ObjectBeginMoveResponse")
+	proto.RegisterType((*ObjectFinishMoveRequest)(nil), "metainfo.ObjectFinishMoveRequest")
+	proto.RegisterType((*ObjectFinishMoveResponse)(nil), "metainfo.ObjectFinishMoveResponse")
+	proto.RegisterType((*ObjectConcatSource)(nil), "metainfo.ObjectConcatSource")
+	proto.RegisterType((*ObjectBeginConcatRequest)(nil), "metainfo.ObjectBeginConcatRequest")
+	proto.RegisterType((*ObjectBeginConcatResponse)(nil), "metainfo.ObjectBeginConcatResponse")
+	proto.RegisterType((*ObjectFinishConcatRequest)(nil), "metainfo.ObjectFinishConcatRequest")
+	proto.RegisterType((*ObjectFinishConcatResponse)(nil), "metainfo.ObjectFinishConcatResponse")
+	proto.RegisterType((*LifecycleRule)(nil), "metainfo.LifecycleRule")
+	proto.RegisterType((*BucketLifecycle)(nil), "metainfo.BucketLifecycle")
+	proto.RegisterType((*SetBucketLifecycleRequest)(nil), "metainfo.SetBucketLifecycleRequest")
+	proto.RegisterType((*SetBucketLifecycleResponse)(nil), "metainfo.SetBucketLifecycleResponse")
+	proto.RegisterType((*GetBucketLifecycleRequest)(nil), "metainfo.GetBucketLifecycleRequest")
+	proto.RegisterType((*GetBucketLifecycleResponse)(nil), "metainfo.GetBucketLifecycleResponse")
+	proto.RegisterType((*PlacementPolicy)(nil), "metainfo.PlacementPolicy")
+	proto.RegisterType((*SetBucketPlacementRequest)(nil), "metainfo.SetBucketPlacementRequest")
+	proto.RegisterType((*SetBucketPlacementResponse)(nil), "metainfo.SetBucketPlacementResponse")
+	proto.RegisterType((*GetBucketPlacementRequest)(nil), "metainfo.GetBucketPlacementRequest")
+	proto.RegisterType((*GetBucketPlacementResponse)(nil), "metainfo.GetBucketPlacementResponse")
 	proto.RegisterType((*SetAttributionRequest)(nil), "metainfo.SetAttributionRequest")
 	proto.RegisterType((*SetAttributionResponse)(nil), "metainfo.SetAttributionResponse")
 	proto.RegisterType((*ProjectInfoRequest)(nil), "metainfo.ProjectInfoRequest")
@@ -1507,93 +2581,143 @@ func init() {
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xdd, 0x6e, 0x1b, 0x55,
-	0x10, 0x66, 0x9d, 0xc4, 0xb1, 0xc7, 0xf9, 0xeb, 0x89, 0x49, 0xcc, 0x26, 0xa9, 0xdd, 0x45, 0xad,
-	0x82, 0x84, 0x5c, 0x94, 0xde, 0x14, 0x2a, 0x24, 0xf2, 0x53, 0x4a, 0x50, 0x5b, 0xa2, 0x35, 0xa2,
-	0x55, 0x85, 0x58, 0x1d, 0x7b, 0x8f, 0xdd, 0x05, 0xef, 0x0f, 0x67, 0x8f, 0x21, 0xed, 0x35, 0x0f,
-	0x40, 0x2f, 0x78, 0x06, 0x1e, 0x80, 0x87, 0x80, 0x0b, 0x1e, 0x00, 0x71, 0x51, 0x1e, 0x84, 0x1b,
-	0x74, 0xfe, 0xbc, 0x67, 0xed, 0x75, 0x42, 0x2b, 0x23, 0xee, 0x7c, 0x66, 0x66, 0xe7, 0xcc, 0xcc,
-	0xf7, 0xcd, 0xcc, 0x31, 0xac, 0x85, 0x84, 0xe1, 0x20, 0xea, 0xc7, 0xed, 0x84, 0xc6, 0x2c, 0x46,
-	0x15, 0x7d, 0xb6, 0x37, 0x48, 0xd4, 0xa3, 0xcf, 0x12, 0x16, 0xc4, 0x91, 0xd4, 0xd9, 0x30, 0x88,
-	0x07, 0xca, 0xce, 0x6e, 0x0e, 0xe2, 0x78, 0x30, 0x24, 0x37, 0xc5, 0xa9, 0x3b, 0xea, 0xdf, 0x64,
-	0x41, 0x48, 0x52, 0x86, 0xc3, 0x44, 0x1b, 0x47, 0xb1, 0x4f, 0xd4, 0xef, 0xf5, 0x24, 0x0e, 0x22,
-	0x46, 0xa8, 0xdf, 0x55, 0x82, 0x95, 0x98, 0xfa, 0x84, 0xa6, 0xf2, 0xe4, 0xfc, 0xb2, 0x00, 0xe5,
-	0xa3, 0x51, 0xef, 0x1b, 0xc2, 0x10, 0x82, 0xc5, 0x08, 0x87, 0xa4, 0x61, 0xb5, 0xac, 0xfd, 0x15,
-	0x57, 0xfc, 0x46, 0xb7, 0xa1, 0x96, 0x60, 0xf6, 0xd4, 0xeb, 0x05, 0xc9, 0x53, 0x42, 0x1b, 0xa5,
-	0x96, 0xb5, 0x5f, 0x3b, 0xd8, 0x6e, 0x1b, 0xe1, 0x1d, 0x0b, 0x4d, 0x67, 0x14, 0x30, 0xe2, 0x02,
-	0xb7, 0x95, 0x02, 0x74, 0x1d, 0xd6, 0x30, 0x63, 0x34, 0xe8, 0x8e, 0xb8, 0x99, 0x17, 0xf8, 0x8d,
-	0x05, 0xe1, 0x77, 0xd5, 0x90, 0x9e, 0xfa, 0xe8, 0x18, 0xa0, 0x47, 0x09, 0x66, 0xc4, 0xf7, 0x30,
-	0x6b, 0x2c, 0x0a, 0xff, 0x76, 0x5b, 0x26, 0xd8, 0xd6, 0x09, 0xb6, 0x3f, 0xd7, 0x09, 0x1e, 0x55,
-	0x7e, 0x7b, 0xd9, 0x7c, 0xe3, 0xc7, 0xbf, 0x9a, 0x96, 0x5b, 0x55, 0xdf, 0x1d, 0x32, 0xf4, 0x1e,
-	0xd4, 0x7d, 0xd2, 0xc7, 0xa3, 0x21, 0xf3, 0x52, 0x32, 0x08, 0x49, 0xc4, 0xbc, 0x34, 0x78, 0x4e,
-	0x1a, 0x4b, 0x2d, 0x6b, 0x7f, 0xc1, 0x45, 0x4a, 0xd7, 0x91, 0xaa, 0x4e, 0xf0, 0x9c, 0xa0, 0x47,
-	0xf0, 0x96, 0xfe, 0x82, 0x12, 0x7f, 0x14, 0xf9, 0x38, 0xea, 0x3d, 0xf3, 0xd2, 0xde, 0x53, 0x12,
-	0x92, 0x46, 0x59, 0x44, 0xb1, 0xd3, 0xce, 0x2a, 0xe7, 0x8e, 0x6d, 0x3a, 0xc2, 0xc4, 0xdd, 0x56,
-	0x5f, 0x4f, 0x2a, 0x90, 0x0f, 0x7b, 0xda, 0x71, 0x56, 0x24, 0x2f, 0xc1, 0x14, 0x87, 0x84, 0x11,
-	0x9a, 0x36, 0x96, 0x85, 0xf3, 0x96, 0x59, 0xc2, 0xbb, 0xe3, 0x9f, 0x67, 0x63, 0x3b, 0x77, 0x47,
-	0xb9, 0x29, 0x52, 0x3a, 0x01, 0xac, 0x49, 0xd0, 0xee, 0x07, 0x29, 0x3b, 0x65, 0x24, 0x2c, 0x04,
-	0x2f, 0x5f, 0xdb, 0xd2, 0x6b, 0xd5, 0xd6, 0xf9, 0xbb, 0x04, 0x9b, 0xf2, 0xae, 0x63, 0x21, 0x73,
-	0xc9, 0xb7, 0x23, 0x92, 0xfe, 0x4f, 0x6c, 0x99, 0x05, 0xf4, 0xe2, 0xeb, 0x01, 0xbd, 0xf4, 0x5f,
-	0x02, 0x5d, 0x9e, 0x07, 0xd0, 0x1f, 0x41, 0x3d, 0x5f, 0xfc, 0x34, 0x89, 0xa3, 0x94, 0xa0, 0x7d,
-	0x28, 0x77, 0x85, 0x5c, 0xd4, 0xbf, 0x76, 0xb0, 0xd1, 0x1e, 0xcf, 0x12, 0x69, 0xef, 0x2a, 0xbd,
-	0x73, 0x03, 0x36, 0xa4, 0xe4, 0x1e, 0x61, 0x17, 0x60, 0xe7, 0x7c, 0x08, 0x57, 0x0c, 0xbb, 0x57,
-	0xbe, 0xe6, 0x1d, 0xcd, 0x92, 0x13, 0x32, 0x24, 0x17, 0xb2, 0xc4, 0xd9, 0xd2, 0x39, 0x69, 0x53,
-	0x79, 0x99, 0x73, 0xa8, 0x23, 0xe0, 0xa4, 0xd6, 0x0e, 0xb6, 0xa0, 0xdc, 0x1b, 0xd1, 0x34, 0xa6,
-	0xca, 0x85, 0x3a, 0xa1, 0x3a, 0x2c, 0x0d, 0x83, 0x30, 0x90, 0xb4, 0x5e, 0x72, 0xe5, 0xc1, 0x79,
-	0x0c, 0xc8, 0x74, 0xa1, 0xb2, 0x68, 0xc3, 0x52, 0xc0, 0x48, 0x98, 0x36, 0xac, 0xd6, 0xc2, 0x7e,
-	0xed, 0xa0, 0x31, 0x99, 0x84, 0x6e, 0x22, 0x57, 0x9a, 0xf1, 0xa0, 0xc3, 0x98, 0x12, 0xe1, 0xba,
-	0xe2, 0x8a, 0xdf, 0xce, 0x63, 0xd8, 0x91, 0xc6, 0x1d, 0xc2, 0x0e, 0x33, 0x4e, 0x5e, 0xd4, 0x0d,
-	0xd3, 0x9c, 0x2e, 0x15, 0x70, 0xda, 0xb9, 0x0a, 0xbb, 0xc5, 0x9e, 0x55, 0x59, 0x7e, 0xb0, 0x60,
-	0xf3, 0xd0, 0xf7, 0x29, 0x49, 0x53, 0xe2, 0x7f, 0xc6, 0x67, 0xf7, 0x7d, 0x9e, 0x2b, 0xda, 0xd7,
-	0x15, 0x90, 0xd0, 0xa0, 0xb6, 0x9a, 0xeb, 0x99, 0x89, 0xaa, 0x0a, 0x3a, 0x86, 0x7a, 0xca, 0x62,
-	0x8a, 0x07, 0xc4, 0xe3, 0x8b, 0xc1, 0xc3, 0xd2, 0x9b, 0xea, 0xcf, 0x2b, 0x6d, 0xb1, 0x2d, 0x1e,
-	0xc6, 0x3e, 0x51, 0xd7, 0xb8, 0x48, 0x99, 0x1b, 0x32, 0xe7, 0x45, 0x09, 0x36, 0x55, 0x63, 0x3d,
-	0xa2, 0x41, 0x86, 0xf0, 0x56, 0x8e, 0x22, 0x2b, 0x9a, 0x10, 0xbc, 0x22, 0xbc, 0xbf, 0x55, 0xce,
-	0xe2, 0x37, 0x6a, 0xc0, 0xb2, 0x6a, 0x5b, 0xd1, 0xde, 0x0b, 0xae, 0x3e, 0xa2, 0x3b, 0x00, 0x59,
-	0x7b, 0xaa, 0x35, 0x70, 0x61, 0x5f, 0x1a, 0xe6, 0xe8, 0x0e, 0xd8, 0x21, 0x3e, 0xd7, 0x6d, 0x48,
-	0xfc, 0xa2, 0x25, 0xb0, 0x1d, 0xe2, 0xf3, 0xbb, 0xda, 0xc0, 0x1c, 0x10, 0x1f, 0x00, 0x90, 0xf3,
-	0x24, 0xa0, 0x98, 0x17, 0x5d, 0x35, 0xed, 0x05, 0x43, 0xd2, 0x35, 0xac, 0x9d, 0x9f, 0x2c, 0xa8,
-	0xe7, 0x6b, 0xa2, 0x18, 0xf7, 0x09, 0x6c, 0x60, 0x0d, 0x99, 0x27, 0x40, 0xd0, 0xe4, 0xdb, 0xcb,
-	0xc8, 0x57, 0x00, 0xaa, 0xbb, 0x3e, 0xfe, 0x4c, 0x9c, 0x53, 0x74, 0x0b, 0x56, 0x69, 0x1c, 0x33,
-	0x2f, 0x09, 0x48, 0x8f, 0x8c, 0x39, 0x74, 0xb4, 0xce, 0x47, 0xf5, 0x9f, 0x2f, 0x9b, 0xcb, 0x67,
-	0x5c, 0x7e, 0x7a, 0xe2, 0xd6, 0xb8, 0x95, 0x3c, 0xf8, 0xce, 0xaf, 0x59, 0x5c, 0xc7, 0x71, 0xc8,
-	0xfd, 0xce, 0x15, 0xac, 0x77, 0x61, 0x59, 0x21, 0xa3, 0x90, 0x42, 0x06, 0x52, 0x67, 0xf2, 0x97,
-	0xab, 0x4d, 0xd0, 0x1d, 0x58, 0x8f, 0x69, 0x30, 0x08, 0x22, 0x3c, 0xd4, 0xa5, 0x58, 0x12, 0xa5,
-	0x28, 0x62, 0xec, 0x9a, 0x36, 0x95, 0xe9, 0x3b, 0x77, 0xe1, 0xcd, 0x89, 0x44, 0x54, 0x85, 0x8d,
-	0x18, 0xac, 0x4b, 0x63, 0x70, 0xbe, 0x82, 0x2d, 0xe5, 0xe6, 0x24, 0xfe, 0x3e, 0x1a, 0xc6, 0xd8,
-	0x9f, 0x6b, 0x45, 0x9c, 0x17, 0x16, 0x6c, 0x4f, 0x5d, 0x30, 0x77, 0x2e, 0x18, 0x39, 0x97, 0x2e,
-	0xcf, 0xf9, 0x09, 0x20, 0x15, 0xd2, 0x69, 0xd4, 0x8f, 0xe7, 0x9b, 0xef, 0xf1, 0x78, 0x16, 0x48,
-	0xdf, 0xd3, 0xa0, 0xfc, 0x8b, 0x00, 0xbf, 0x1c, 0x93, 0x34, 0xbf, 0x33, 0xe6, 0x13, 0x22, 0x1e,
-	0x33, 0x27, 0xbf, 0x66, 0xe6, 0x87, 0x87, 0xf3, 0x87, 0x05, 0x9b, 0x7c, 0x77, 0xa8, 0x7b, 0xd2,
-	0xcb, 0x12, 0xd8, 0x82, 0x72, 0x42, 0x49, 0x3f, 0x38, 0x57, 0x29, 0xa8, 0x13, 0x6a, 0x42, 0x2d,
-	0x65, 0x98, 0x32, 0x0f, 0xf7, 0x79, 0xe9, 0xe4, 0xcb, 0x07, 0x84, 0xe8, 0x90, 0x4b, 0xd0, 0x1e,
-	0x00, 0x89, 0x7c, 0xaf, 0x4b, 0xfa, 0x7c, 0x2d, 0x2d, 0x0a, 0x7d, 0x95, 0x44, 0xfe, 0x91, 0x10,
-	0xa0, 0x5d, 0xa8, 0x52, 0xc2, 0xf7, 0x62, 0xf0, 0x9d, 0x1c, 0x77, 0x15, 0x37, 0x13, 0x64, 0x9b,
-	0xb2, 0x6c, 0x6c, 0x4a, 0xee, 0x92, 0x27, 0xeb, 0xf5, 0x87, 0x78, 0x20, 0x1f, 0xa5, 0xcb, 0x6e,
-	0x95, 0x4b, 0x3e, 0xe6, 0x02, 0xe7, 0x77, 0x0b, 0xea, 0xf9, 0xd4, 0x54, 0xf5, 0xde, 0xcf, 0xef,
-	0xd2, 0xb7, 0xb3, 0x92, 0x15, 0x99, 0xb7, 0x2f, 0x59, 0xab, 0x36, 0x81, 0x45, 0xfd, 0x7c, 0x15,
-	0xd8, 0x5a, 0x06, 0xb6, 0xaf, 0xc4, 0x26, 0xb4, 0x03, 0xd5, 0x20, 0xf5, 0x54, 0x7d, 0x17, 0xc4,
-	0x15, 0x95, 0x20, 0x3d, 0x13, 0x67, 0xe7, 0x11, 0x27, 0x43, 0xd1, 0xde, 0x6e, 0x42, 0x4d, 0x82,
-	0xe3, 0x19, 0xeb, 0x1b, 0xa4, 0xe8, 0x21, 0x5f, 0xe2, 0x7b, 0x00, 0x09, 0xa6, 0x2c, 0x22, 0x34,
-	0x5b, 0xe0, 0x55, 0x25, 0x39, 0xf5, 0x9d, 0x06, 0x1f, 0x2c, 0x85, 0x6b, 0xbb, 0x0e, 0xe8, 0x8c,
-	0xc6, 0x5f, 0x93, 0x9e, 0xd9, 0x7e, 0xce, 0x6d, 0xd8, 0xcc, 0x49, 0x55, 0x55, 0xaf, 0xc1, 0x4a,
-	0x22, 0xc5, 0x5e, 0x8a, 0x87, 0x9a, 0x37, 0x35, 0x25, 0xeb, 0xe0, 0x21, 0x3b, 0xf8, 0xb9, 0x02,
-	0x95, 0x07, 0xaa, 0xd6, 0xe8, 0x01, 0xac, 0xc8, 0x07, 0xa1, 0xfa, 0xeb, 0xb6, 0x37, 0xf9, 0xa4,
-	0xc9, 0xbd, 0xd5, 0xed, 0xab, 0xb3, 0xd4, 0xea, 0xfa, 0x13, 0xa8, 0xde, 0x23, 0x4c, 0xf9, 0xb2,
-	0x27, 0x8d, 0xb3, 0x87, 0xa3, 0xbd, 0x53, 0xa8, 0x53, 0x5e, 0x1e, 0xc0, 0x8a, 0x6c, 0xb5, 0x59,
-	0x41, 0xe5, 0xda, 0x7c, 0x3a, 0xa8, 0xa9, 0x3e, 0xad, 0x71, 0x4a, 0x49, 0x5d, 0x8a, 0x76, 0x8a,
-	0x5e, 0x6d, 0xda, 0xd7, 0x6e, 0xb1, 0x52, 0x79, 0x22, 0x7c, 0xd0, 0x28, 0x47, 0x06, 0x54, 0xe8,
-	0xfa, 0xe4, 0x57, 0x85, 0x1c, 0xb1, 0x6f, 0x5c, 0x66, 0xa6, 0xae, 0x79, 0x08, 0xab, 0xb2, 0xae,
-	0xaa, 0x0b, 0xcc, 0x02, 0x14, 0xbc, 0x9c, 0xcc, 0x02, 0x14, 0x3e, 0x22, 0xce, 0x60, 0x55, 0x2e,
-	0x3d, 0xed, 0x6f, 0xfa, 0x83, 0xdc, 0x76, 0xb7, 0x9b, 0x33, 0xf5, 0xca, 0xe3, 0xa7, 0x50, 0x33,
-	0xc6, 0x36, 0xda, 0x9d, 0xb2, 0x37, 0xa8, 0x6a, 0xef, 0xcd, 0xd0, 0x2a, 0x5f, 0x5f, 0xc0, 0xba,
-	0x5e, 0x75, 0x3a, 0xbe, 0xd6, 0xd4, 0x17, 0x13, 0xdb, 0xd6, 0xbe, 0x76, 0x81, 0x45, 0x96, 0xb5,
-	0x24, 0xc2, 0xec, 0xac, 0xf3, 0x3c, 0x6a, 0xce, 0xd4, 0x67, 0xbc, 0x34, 0x67, 0x93, 0x09, 0x4b,
-	0xc1, 0xf4, 0x36, 0x61, 0x29, 0x9c, 0x80, 0x1d, 0x58, 0xcb, 0x13, 0x00, 0xe5, 0x22, 0x28, 0x62,
-	0x50, 0x6b, 0xb6, 0x41, 0x86, 0x8c, 0x31, 0x17, 0x4c, 0x64, 0xa6, 0x87, 0x88, 0x89, 0x4c, 0xc1,
-	0x30, 0x39, 0x5a, 0x7c, 0x52, 0x4a, 0xba, 0xdd, 0xb2, 0x78, 0xbb, 0xde, 0xfa, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xed, 0x01, 0x50, 0xbb, 0x65, 0x12, 0x00, 0x00,
+	// 2164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
+	0x15, 0x2f, 0x25, 0x4b, 0xb6, 0x9e, 0x6c, 0x49, 0x1e, 0x7b, 0x6d, 0x99, 0xfe, 0x1b, 0x7a, 0x37,
+	0x71, 0xd0, 0x44, 0x69, 0x77, 0x81, 0x74, 0xdb, 0x45, 0x81, 0xda, 0xf2, 0xc6, 0x71, 0xbb, 0xde,
+	0x15, 0xa8, 0xa0, 0x9b, 0x06, 0x69, 0x59, 0x4a, 0x1c, 0x69, 0xa7, 0x2b, 0x91, 0x0a, 0x39, 0x5a,
+	0xaf, 0x73, 0xee, 0xa5, 0xb7, 0xe4, 0xd0, 0x73, 0x3f, 0x44, 0x3f, 0x44, 0x5a, 0xa0, 0xe8, 0xb9,
+	0xe8, 0x21, 0x05, 0x0a, 0xf4, 0x53, 0xf4, 0x52, 0x0c, 0x67, 0x86, 0x1c, 0x4a, 0xa4, 0x64, 0x2f,
+	0x5c, 0xb4, 0x37, 0xf2, 0xbd, 0x37, 0xef, 0xdf, 0xef, 0x37, 0x7f, 0xa1, 0x32, 0xc4, 0xd4, 0x26,
+	0x6e, 0xcf, 0x6b, 0x8c, 0x7c, 0x8f, 0x7a, 0x68, 0x49, 0xfe, 0xeb, 0x35, 0xec, 0x76, 0xfd, 0xab,
+	0x11, 0x25, 0x9e, 0xcb, 0x75, 0x3a, 0xf4, 0xbd, 0xbe, 0xb0, 0xd3, 0xf7, 0xfb, 0x9e, 0xd7, 0x1f,
+	0xe0, 0x0f, 0xc2, 0xbf, 0xce, 0xb8, 0xf7, 0x01, 0x25, 0x43, 0x1c, 0x50, 0x7b, 0x38, 0x92, 0xc6,
+	0xae, 0xe7, 0x60, 0xf1, 0x5d, 0x1d, 0x79, 0xc4, 0xa5, 0xd8, 0x77, 0x3a, 0x42, 0xb0, 0xec, 0xf9,
+	0x0e, 0xf6, 0x03, 0xfe, 0x67, 0xfc, 0x31, 0x0f, 0xc5, 0x93, 0x71, 0xf7, 0x25, 0xa6, 0x08, 0xc1,
+	0x82, 0x6b, 0x0f, 0x71, 0x5d, 0x3b, 0xd0, 0x8e, 0x96, 0xcd, 0xf0, 0x1b, 0x3d, 0x84, 0xf2, 0xc8,
+	0xa6, 0x2f, 0xac, 0x2e, 0x19, 0xbd, 0xc0, 0x7e, 0x3d, 0x77, 0xa0, 0x1d, 0x95, 0xef, 0x6f, 0x36,
+	0x94, 0xf4, 0x9a, 0xa1, 0xa6, 0x3d, 0x26, 0x14, 0x9b, 0xc0, 0x6c, 0xb9, 0x00, 0xdd, 0x83, 0x8a,
+	0x4d, 0xa9, 0x4f, 0x3a, 0x63, 0x66, 0x66, 0x11, 0xa7, 0x9e, 0x0f, 0xfd, 0xae, 0x28, 0xd2, 0x73,
+	0x07, 0x35, 0x01, 0xba, 0x3e, 0xb6, 0x29, 0x76, 0x2c, 0x9b, 0xd6, 0x17, 0x42, 0xff, 0x7a, 0x83,
+	0x17, 0xd8, 0x90, 0x05, 0x36, 0x3e, 0x91, 0x05, 0x9e, 0x2c, 0xfd, 0xe9, 0xdb, 0xfd, 0xef, 0x7c,
+	0xf5, 0x8f, 0x7d, 0xcd, 0x2c, 0x89, 0x71, 0xc7, 0x14, 0x7d, 0x0f, 0xd6, 0x1d, 0xdc, 0xb3, 0xc7,
+	0x03, 0x6a, 0x05, 0xb8, 0x3f, 0xc4, 0x2e, 0xb5, 0x02, 0xf2, 0x25, 0xae, 0x17, 0x0e, 0xb4, 0xa3,
+	0xbc, 0x89, 0x84, 0xae, 0xcd, 0x55, 0x6d, 0xf2, 0x25, 0x46, 0xcf, 0x61, 0x4b, 0x8e, 0xf0, 0xb1,
+	0x33, 0x76, 0x1d, 0xdb, 0xed, 0x5e, 0x59, 0x41, 0xf7, 0x05, 0x1e, 0xe2, 0x7a, 0x31, 0xcc, 0x62,
+	0xbb, 0x11, 0x77, 0xce, 0x8c, 0x6c, 0xda, 0xa1, 0x89, 0xb9, 0x29, 0x46, 0x4f, 0x2a, 0x90, 0x03,
+	0xbb, 0xd2, 0x71, 0xdc, 0x24, 0x6b, 0x64, 0xfb, 0xf6, 0x10, 0x53, 0xec, 0x07, 0xf5, 0xc5, 0xd0,
+	0xf9, 0x81, 0xda, 0xc2, 0xc7, 0xd1, 0x67, 0x2b, 0xb2, 0x33, 0xb7, 0x85, 0x9b, 0x34, 0xa5, 0x41,
+	0xa0, 0xc2, 0x41, 0x7b, 0x42, 0x02, 0x7a, 0x4e, 0xf1, 0x30, 0x15, 0xbc, 0x64, 0x6f, 0x73, 0x6f,
+	0xd4, 0x5b, 0xe3, 0xdf, 0x39, 0x58, 0xe3, 0xb1, 0x9a, 0xa1, 0xcc, 0xc4, 0x5f, 0x8c, 0x71, 0xf0,
+	0x3f, 0x62, 0x4b, 0x16, 0xd0, 0x0b, 0x6f, 0x06, 0x74, 0xe1, 0xbf, 0x09, 0x74, 0xf1, 0x36, 0x80,
+	0xfe, 0x09, 0xac, 0x27, 0x9b, 0x1f, 0x8c, 0x3c, 0x37, 0xc0, 0xe8, 0x08, 0x8a, 0x9d, 0x50, 0x1e,
+	0xf6, 0xbf, 0x7c, 0xbf, 0xd6, 0x88, 0xd6, 0x12, 0x6e, 0x6f, 0x0a, 0xbd, 0xf1, 0x36, 0xd4, 0xb8,
+	0xe4, 0x0c, 0xd3, 0x19, 0xd8, 0x19, 0x3f, 0x86, 0x55, 0xc5, 0xee, 0xc6, 0x61, 0xde, 0x95, 0x2c,
+	0x39, 0xc5, 0x03, 0x3c, 0x93, 0x25, 0xc6, 0x06, 0xac, 0x27, 0x4d, 0x79, 0x30, 0xe3, 0x18, 0x56,
+	0x63, 0x52, 0x4b, 0x07, 0x1b, 0x50, 0xec, 0x8e, 0xfd, 0xc0, 0xf3, 0x85, 0x0b, 0xf1, 0x87, 0xd6,
+	0xa1, 0x30, 0x20, 0x43, 0xc2, 0x69, 0x5d, 0x30, 0xf9, 0x8f, 0xf1, 0x29, 0x20, 0xd5, 0x85, 0xa8,
+	0xa2, 0x01, 0x05, 0x42, 0xf1, 0x30, 0xa8, 0x6b, 0x07, 0xf9, 0xa3, 0xf2, 0xfd, 0xfa, 0x64, 0x11,
+	0x72, 0x12, 0x99, 0xdc, 0x8c, 0x25, 0x3d, 0xf4, 0x7c, 0x1c, 0xba, 0x5e, 0x32, 0xc3, 0x6f, 0xe3,
+	0x53, 0xd8, 0xe6, 0xc6, 0x6d, 0x4c, 0x8f, 0x63, 0x4e, 0xce, 0x9a, 0x0d, 0xd3, 0x9c, 0xce, 0xa5,
+	0x70, 0xda, 0xd8, 0x83, 0x9d, 0x74, 0xcf, 0xa2, 0x2d, 0xbf, 0xd5, 0x60, 0xed, 0xd8, 0x71, 0x7c,
+	0x1c, 0x04, 0xd8, 0x79, 0xc6, 0xd6, 0xee, 0x27, 0xac, 0x56, 0x74, 0x24, 0x3b, 0xc0, 0xa1, 0x41,
+	0x0d, 0xb1, 0xae, 0xc7, 0x26, 0xa2, 0x2b, 0xa8, 0x09, 0xeb, 0x01, 0xf5, 0x7c, 0xbb, 0x8f, 0x2d,
+	0xb6, 0x31, 0x58, 0x36, 0xf7, 0x26, 0xe6, 0xe7, 0x6a, 0x83, 0x09, 0x1b, 0x4f, 0x3d, 0x07, 0x8b,
+	0x30, 0x26, 0x12, 0xe6, 0x8a, 0xcc, 0xf8, 0x3a, 0x07, 0x6b, 0x62, 0x62, 0x3d, 0xf7, 0x49, 0x8c,
+	0xf0, 0x46, 0x82, 0x22, 0xcb, 0x92, 0x10, 0xac, 0x23, 0x6c, 0x7e, 0x8b, 0x9a, 0xc3, 0x6f, 0x54,
+	0x87, 0x45, 0x31, 0x6d, 0xc3, 0xe9, 0x9d, 0x37, 0xe5, 0x2f, 0x7a, 0x04, 0x10, 0x4f, 0xcf, 0xfa,
+	0xc2, 0xfc, 0x79, 0xa9, 0x98, 0xa3, 0x47, 0xa0, 0x0f, 0xed, 0xd7, 0x72, 0x1a, 0x62, 0x27, 0x6d,
+	0x13, 0xd8, 0x1c, 0xda, 0xaf, 0x1f, 0x4b, 0x03, 0x75, 0x81, 0xf8, 0x11, 0x00, 0x7e, 0x3d, 0x22,
+	0xbe, 0xcd, 0x9a, 0x5e, 0x2f, 0xce, 0x5b, 0x24, 0x4d, 0xc5, 0xda, 0xf8, 0xbd, 0x06, 0xeb, 0xc9,
+	0x9e, 0x08, 0xc6, 0x7d, 0x0c, 0x35, 0x5b, 0x42, 0x66, 0x85, 0x20, 0x48, 0xf2, 0xed, 0xc6, 0xe4,
+	0x4b, 0x01, 0xd5, 0xac, 0x46, 0xc3, 0xc2, 0xff, 0x00, 0x3d, 0x80, 0x15, 0xdf, 0xf3, 0xa8, 0x35,
+	0x22, 0xb8, 0x8b, 0x23, 0x0e, 0x9d, 0x54, 0xd9, 0x52, 0xfd, 0xf7, 0x6f, 0xf7, 0x17, 0x5b, 0x4c,
+	0x7e, 0x7e, 0x6a, 0x96, 0x99, 0x15, 0xff, 0x71, 0x8c, 0x6f, 0xe2, 0xbc, 0x9a, 0xde, 0x90, 0xf9,
+	0xbd, 0x55, 0xb0, 0xde, 0x83, 0x45, 0x81, 0x8c, 0x40, 0x0a, 0x29, 0x48, 0xb5, 0xf8, 0x97, 0x29,
+	0x4d, 0xd0, 0x23, 0xa8, 0x7a, 0x3e, 0xe9, 0x13, 0xd7, 0x1e, 0xc8, 0x56, 0x14, 0x0e, 0xf2, 0x19,
+	0x8c, 0xad, 0x48, 0x53, 0x5e, 0xbe, 0xf1, 0x18, 0xee, 0x4c, 0x14, 0x22, 0x3a, 0xac, 0xe4, 0xa0,
+	0xcd, 0xcd, 0xc1, 0xf8, 0x15, 0x6c, 0x08, 0x37, 0xa7, 0xde, 0xa5, 0x3b, 0xf0, 0x6c, 0xe7, 0x56,
+	0x3b, 0x62, 0x7c, 0xad, 0xc1, 0xe6, 0x54, 0x80, 0x5b, 0xe7, 0x82, 0x52, 0x73, 0x6e, 0x7e, 0xcd,
+	0x9f, 0x01, 0x12, 0x29, 0x9d, 0xbb, 0x3d, 0xef, 0x76, 0xeb, 0x6d, 0xc2, 0x5a, 0xc2, 0xf7, 0x34,
+	0x28, 0xd7, 0x48, 0xf0, 0xf3, 0x88, 0xa4, 0xc9, 0x3d, 0xe3, 0x76, 0x52, 0xb4, 0xe1, 0xce, 0x84,
+	0xf7, 0xdb, 0xc6, 0xc3, 0xf8, 0x9b, 0x06, 0x6b, 0x6c, 0xef, 0x10, 0x71, 0x82, 0x79, 0x05, 0x6c,
+	0x40, 0x71, 0xe4, 0xe3, 0x1e, 0x79, 0x2d, 0x4a, 0x10, 0x7f, 0x68, 0x1f, 0xca, 0x01, 0xb5, 0x7d,
+	0x6a, 0xd9, 0x3d, 0xd6, 0x3a, 0x7e, 0xf2, 0x81, 0x50, 0x74, 0xcc, 0x24, 0x68, 0x17, 0x00, 0xbb,
+	0x8e, 0xd5, 0xc1, 0x3d, 0xb6, 0x2d, 0x2d, 0x84, 0xfa, 0x12, 0x76, 0x9d, 0x93, 0x50, 0x80, 0x76,
+	0xa0, 0xe4, 0x63, 0xb6, 0x2f, 0x92, 0x57, 0x7c, 0xb9, 0x5b, 0x32, 0x63, 0x41, 0xbc, 0x53, 0x16,
+	0x95, 0x9d, 0x92, 0xb9, 0x64, 0xc5, 0x5a, 0xbd, 0x81, 0xdd, 0xe7, 0x87, 0xd2, 0x45, 0xb3, 0xc4,
+	0x24, 0x1f, 0x31, 0x81, 0xf1, 0x17, 0x0d, 0xd6, 0x93, 0xa5, 0x89, 0xee, 0xfd, 0x30, 0xb9, 0x97,
+	0x1e, 0xc6, 0x2d, 0x4b, 0x33, 0x6f, 0xcc, 0xd9, 0x56, 0x75, 0x0c, 0x0b, 0xf2, 0xf8, 0x1a, 0x62,
+	0xab, 0x29, 0xd8, 0xde, 0x88, 0x4d, 0x68, 0x1b, 0x4a, 0x24, 0xb0, 0x44, 0x7f, 0xf3, 0x61, 0x88,
+	0x25, 0x12, 0xb4, 0xc2, 0x7f, 0xe3, 0x73, 0x58, 0x7d, 0xd6, 0xf9, 0x0d, 0xee, 0xd2, 0x0b, 0xef,
+	0x15, 0x16, 0x49, 0xaa, 0xdc, 0xd1, 0x92, 0x0b, 0xdc, 0xfb, 0x80, 0xe2, 0xcd, 0x84, 0x15, 0xe8,
+	0xd8, 0xd4, 0x16, 0xa0, 0xad, 0x46, 0x9a, 0x0b, 0xa1, 0x30, 0xfe, 0xac, 0xc1, 0x06, 0x77, 0x7f,
+	0x82, 0xfb, 0xc4, 0x65, 0x31, 0xe6, 0x51, 0xe1, 0x1e, 0x54, 0xe2, 0x08, 0x0a, 0xab, 0x57, 0x22,
+	0x69, 0x8b, 0xb5, 0x60, 0x17, 0xc0, 0xc5, 0x97, 0x96, 0x70, 0xc1, 0x89, 0x51, 0x72, 0xf1, 0xa5,
+	0xb8, 0xb1, 0xbd, 0x07, 0x88, 0xa9, 0x27, 0x3c, 0x71, 0x7e, 0xd4, 0x5c, 0x7c, 0xf9, 0x38, 0xe1,
+	0xec, 0x10, 0x56, 0x5e, 0x62, 0x3c, 0xb2, 0x7c, 0x3c, 0x1a, 0xd8, 0x5d, 0xec, 0x08, 0xaa, 0x2c,
+	0x33, 0xa1, 0x29, 0x64, 0xc6, 0xbf, 0x34, 0xd8, 0x9c, 0xaa, 0x45, 0x60, 0xff, 0x03, 0x58, 0x12,
+	0x1d, 0x92, 0xf0, 0x6f, 0xc7, 0xf0, 0x4f, 0xf5, 0xd7, 0x8c, 0x8c, 0x91, 0x0e, 0x4b, 0x3e, 0x7e,
+	0x45, 0x02, 0xb6, 0xc3, 0xf2, 0x3a, 0xa3, 0x7f, 0xf4, 0x31, 0xac, 0xca, 0x84, 0xac, 0xc8, 0x7b,
+	0x7e, 0xbe, 0xf7, 0x9a, 0x1c, 0xd5, 0x96, 0x51, 0xbe, 0xab, 0x78, 0x8a, 0xc2, 0x89, 0x66, 0x48,
+	0x85, 0x29, 0xe4, 0xc6, 0x5f, 0xf3, 0xb2, 0xce, 0x8f, 0x88, 0x4b, 0x82, 0x17, 0xff, 0xaf, 0xa0,
+	0xa9, 0x3d, 0x2f, 0xbc, 0x69, 0xcf, 0x8b, 0x13, 0x3d, 0x9f, 0x62, 0xc2, 0xe2, 0x34, 0x13, 0xd0,
+	0x87, 0xb0, 0x19, 0xb5, 0x73, 0x22, 0xd9, 0xa5, 0xd0, 0xdf, 0x1d, 0xa9, 0x4e, 0x66, 0x9c, 0x0a,
+	0x68, 0xe9, 0xd6, 0x00, 0x85, 0x0c, 0x40, 0x75, 0xa8, 0x4f, 0xe3, 0x29, 0x8e, 0xd0, 0x5f, 0x69,
+	0x80, 0xb8, 0xb2, 0xe9, 0xb9, 0x5d, 0x9b, 0xb6, 0xbd, 0xb1, 0xdf, 0xc5, 0x29, 0x78, 0x6a, 0x69,
+	0x78, 0xaa, 0x10, 0xe4, 0xde, 0x14, 0x82, 0x7c, 0x12, 0x02, 0xe3, 0x77, 0x1a, 0xd4, 0x95, 0x79,
+	0xc6, 0xf3, 0x9a, 0x47, 0xc0, 0x77, 0xa0, 0x9a, 0x4c, 0x98, 0x27, 0xb4, 0x6c, 0x56, 0x12, 0x19,
+	0x07, 0x19, 0x1c, 0xcb, 0xa7, 0x73, 0xcc, 0x68, 0xc3, 0x56, 0x4a, 0x2a, 0x62, 0xd2, 0x7f, 0x08,
+	0x8b, 0x41, 0xd8, 0x2e, 0x39, 0xe7, 0x77, 0x26, 0x8b, 0x57, 0x7b, 0x6a, 0x4a, 0x63, 0xe3, 0x9b,
+	0x1c, 0x6c, 0xa9, 0x80, 0x5c, 0xaf, 0xc2, 0xf4, 0xc4, 0x73, 0x19, 0x93, 0x43, 0xc9, 0x2d, 0x7f,
+	0x83, 0xdc, 0xd0, 0x43, 0xa8, 0xc7, 0x11, 0x88, 0x3b, 0x20, 0x2e, 0x96, 0x54, 0x15, 0x13, 0x71,
+	0x23, 0xd2, 0x9f, 0x87, 0xea, 0xf6, 0xcc, 0x9d, 0xa1, 0x90, 0xb1, 0x33, 0xa0, 0x26, 0x54, 0xe3,
+	0xeb, 0x82, 0xe5, 0xd8, 0x14, 0x5f, 0xe3, 0x86, 0x51, 0x89, 0x87, 0x9c, 0xda, 0x14, 0x1b, 0x3b,
+	0xa0, 0xa7, 0x35, 0x52, 0x70, 0x3b, 0x80, 0x95, 0x27, 0xa4, 0x87, 0xbb, 0x57, 0xdd, 0x01, 0x36,
+	0xc7, 0x03, 0x8c, 0x2a, 0x90, 0x23, 0x4e, 0xd8, 0xd6, 0x92, 0x99, 0x23, 0x0e, 0x7a, 0x17, 0x6a,
+	0x4a, 0x3b, 0xd5, 0xf3, 0x47, 0x4c, 0x26, 0xbe, 0x4d, 0x86, 0xfc, 0x52, 0xd3, 0xbd, 0x0a, 0x42,
+	0xce, 0x14, 0x92, 0x29, 0x5d, 0xb1, 0x67, 0x89, 0xaa, 0xbc, 0x3a, 0x8b, 0xd0, 0xe8, 0x7d, 0x28,
+	0xf8, 0xe3, 0x41, 0xc4, 0x92, 0x4d, 0xf5, 0x60, 0xa0, 0xa4, 0x67, 0x72, 0x2b, 0xa3, 0x03, 0x5b,
+	0x6d, 0x4c, 0x27, 0x9c, 0xcc, 0x63, 0x47, 0x14, 0x23, 0x77, 0xad, 0x18, 0x3b, 0xa0, 0xa7, 0xc5,
+	0x10, 0x8d, 0x7b, 0x00, 0x5b, 0x67, 0x37, 0xcd, 0xc0, 0xf8, 0x19, 0xe8, 0x67, 0x99, 0x2e, 0x6f,
+	0xda, 0x83, 0x7f, 0x6a, 0x50, 0x6d, 0xb1, 0x55, 0x8c, 0x51, 0xab, 0xe5, 0x0d, 0x48, 0xf7, 0x8a,
+	0xad, 0x79, 0xf6, 0x60, 0xe0, 0x5d, 0x62, 0xc7, 0xea, 0x7a, 0x63, 0x97, 0xfa, 0x44, 0xb8, 0x2b,
+	0x99, 0x35, 0xa1, 0x68, 0x4a, 0x39, 0x83, 0xd6, 0xc1, 0x2e, 0x49, 0xd8, 0xe6, 0x42, 0xdb, 0x2a,
+	0x97, 0xc7, 0xa6, 0xef, 0x80, 0x10, 0x59, 0x2e, 0xa6, 0x97, 0x9e, 0xff, 0x92, 0x4f, 0x99, 0x92,
+	0x59, 0xe1, 0xe2, 0xa7, 0x42, 0xca, 0xf6, 0x06, 0x1f, 0x7f, 0x31, 0x26, 0x3e, 0x76, 0x2c, 0xca,
+	0xce, 0x86, 0x0b, 0xa1, 0xd9, 0xb2, 0x14, 0x7e, 0x62, 0xf7, 0x79, 0x60, 0x12, 0x50, 0xe2, 0x76,
+	0xa9, 0x15, 0x8c, 0x3b, 0x2e, 0x0e, 0x77, 0x27, 0xb6, 0x87, 0x54, 0xa5, 0xbc, 0xcd, 0xc5, 0x46,
+	0x4f, 0x01, 0x3a, 0x2a, 0x76, 0x1e, 0xd0, 0xdf, 0x87, 0xe2, 0x28, 0xec, 0x87, 0x38, 0xf9, 0x6d,
+	0xc5, 0x9d, 0x9c, 0x68, 0x98, 0x29, 0x0c, 0x13, 0x60, 0x2b, 0x71, 0x52, 0xc0, 0xbe, 0x6e, 0x16,
+	0xc6, 0x33, 0xd0, 0xd3, 0x06, 0x09, 0xb0, 0xe3, 0x1c, 0xb5, 0xeb, 0xe6, 0xf8, 0x1c, 0xee, 0x4c,
+	0x3e, 0xf2, 0xf0, 0x0c, 0xf6, 0xa1, 0xcc, 0x63, 0x5a, 0xca, 0x2b, 0x12, 0x70, 0xd1, 0x53, 0x7b,
+	0x88, 0xd9, 0x99, 0x62, 0x64, 0xfb, 0xd4, 0xc5, 0x7e, 0xfc, 0x8e, 0x54, 0x12, 0x92, 0x73, 0xc7,
+	0xa8, 0xc3, 0xc6, 0xa4, 0x63, 0x51, 0xf8, 0x3a, 0xa0, 0x96, 0xef, 0xb1, 0xd5, 0x43, 0xb9, 0x05,
+	0x1a, 0x0f, 0x61, 0x2d, 0x21, 0x15, 0x25, 0xbd, 0x05, 0xcb, 0x23, 0x2e, 0xb6, 0x02, 0x7b, 0x20,
+	0xdb, 0x51, 0x16, 0xb2, 0xb6, 0x3d, 0xa0, 0xf7, 0xff, 0x50, 0x81, 0xa5, 0x0b, 0x51, 0x27, 0xba,
+	0x80, 0x65, 0xfe, 0x2e, 0x29, 0x8e, 0x36, 0xbb, 0x93, 0x2f, 0x6b, 0x89, 0x27, 0x63, 0x7d, 0x2f,
+	0x4b, 0x2d, 0xc2, 0x9f, 0x42, 0x29, 0xea, 0x37, 0xd2, 0x27, 0x8d, 0xe3, 0xf7, 0x4b, 0x7d, 0x3b,
+	0x55, 0x27, 0xbc, 0x5c, 0xc0, 0x32, 0xbf, 0xf1, 0x65, 0x25, 0x95, 0xb8, 0x6d, 0xea, 0x7b, 0x59,
+	0xea, 0xe8, 0xba, 0x58, 0x66, 0x37, 0x1b, 0xae, 0x0b, 0xd0, 0x76, 0xda, 0xe3, 0xa1, 0xf4, 0xb5,
+	0x93, 0xae, 0x14, 0x9e, 0x30, 0xbb, 0xef, 0x0a, 0x47, 0x0a, 0x54, 0xe8, 0xde, 0xe4, 0xa8, 0x54,
+	0x8e, 0xe8, 0x6f, 0xcf, 0x33, 0x13, 0x61, 0x9e, 0xc2, 0x0a, 0xef, 0xab, 0xdc, 0xb3, 0x94, 0x06,
+	0xa4, 0x3c, 0xe0, 0xe9, 0x7b, 0x59, 0x6a, 0xe1, 0xaf, 0x05, 0x2b, 0xfc, 0xed, 0x45, 0xfa, 0x9b,
+	0x1e, 0x90, 0x78, 0x64, 0xd2, 0xf7, 0x33, 0xf5, 0xc2, 0xe3, 0x4f, 0xa1, 0xac, 0xbc, 0x1e, 0xa0,
+	0x9d, 0x29, 0x7b, 0x85, 0xaa, 0xfa, 0x6e, 0x86, 0x56, 0xf8, 0xfa, 0x39, 0x54, 0xe5, 0x8b, 0x8b,
+	0xcc, 0xef, 0x60, 0x6a, 0xc4, 0xc4, 0xa3, 0x8f, 0xfe, 0xd6, 0x0c, 0x8b, 0xb8, 0x6a, 0x4e, 0x84,
+	0xec, 0xaa, 0x93, 0x3c, 0xda, 0xcf, 0xd4, 0xc7, 0xbc, 0x54, 0xaf, 0xc8, 0x2a, 0x2c, 0x29, 0x8f,
+	0x08, 0xfa, 0x5e, 0x96, 0x3a, 0x2e, 0x3c, 0xba, 0xa1, 0xf1, 0xe3, 0x81, 0x5a, 0x78, 0xfa, 0x75,
+	0x54, 0x7f, 0x6b, 0x86, 0x85, 0xf0, 0xfb, 0x0b, 0xa8, 0xc5, 0x27, 0x68, 0xe1, 0x78, 0x6a, 0xd8,
+	0xd4, 0x9d, 0x49, 0x37, 0x66, 0x99, 0x08, 0xd7, 0xbf, 0x04, 0xa4, 0x9c, 0x30, 0xb9, 0x59, 0x80,
+	0x8c, 0xd4, 0x9c, 0x12, 0xc7, 0x45, 0xfd, 0x70, 0xa6, 0x8d, 0x70, 0xff, 0x6b, 0x58, 0x53, 0x4f,
+	0x48, 0xd2, 0xff, 0x61, 0x7a, 0x66, 0xc9, 0x00, 0x77, 0x67, 0x1b, 0x89, 0x08, 0x16, 0xa0, 0x68,
+	0x06, 0xc7, 0x27, 0x9f, 0x43, 0x15, 0xf9, 0x8c, 0x03, 0x85, 0x7e, 0x77, 0xb6, 0x51, 0x1c, 0xe0,
+	0x6c, 0x66, 0x80, 0xb3, 0xeb, 0x04, 0x38, 0x9b, 0x19, 0x60, 0x7a, 0x97, 0x4c, 0xad, 0x60, 0x72,
+	0x97, 0xd4, 0xef, 0xce, 0x36, 0x4a, 0xa9, 0x20, 0x35, 0xc0, 0xd9, 0x75, 0x02, 0xcc, 0xd8, 0x76,
+	0xdb, 0x50, 0x49, 0x2e, 0x7c, 0x28, 0x31, 0xf3, 0xd2, 0x56, 0xce, 0x83, 0x6c, 0x83, 0x78, 0x45,
+	0x52, 0xf6, 0x43, 0x75, 0x45, 0x9a, 0xde, 0x3c, 0xf5, 0xdd, 0x0c, 0x2d, 0xf7, 0x75, 0xb2, 0xf0,
+	0x59, 0x6e, 0xd4, 0xe9, 0x14, 0xc3, 0x83, 0xfd, 0x83, 0xff, 0x0c, 0x00, 0x7c, 0xb2, 0x97, 0x4c,
+	0xe4, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1619,6 +2743,14 @@ type MetainfoClient interface {
 	DownloadSegment(ctx context.Context, in *SegmentDownloadRequest, opts ...grpc.CallOption) (*SegmentDownloadResponse, error)
 	DeleteSegment(ctx context.Context, in *SegmentDeleteRequest, opts ...grpc.CallOption) (*SegmentDeleteResponse, error)
 	ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*ListSegmentsResponse, error)
+	BeginMoveObject(ctx context.Context, in *ObjectBeginMoveRequest, opts ...grpc.CallOption) (*ObjectBeginMoveResponse, error)
+	FinishMoveObject(ctx context.Context, in *ObjectFinishMoveRequest, opts ...grpc.CallOption) (*ObjectFinishMoveResponse, error)
+	BeginConcatObjects(ctx context.Context, in *ObjectBeginConcatRequest, opts ...grpc.CallOption) (*ObjectBeginConcatResponse, error)
+	FinishConcatObjects(ctx context.Context, in *ObjectFinishConcatRequest, opts ...grpc.CallOption) (*ObjectFinishConcatResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error)
+	GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest, opts ...grpc.CallOption) (*GetBucketLifecycleResponse, error)
+	SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest, opts ...grpc.CallOption) (*SetBucketPlacementResponse, error)
+	GetBucketPlacement(ctx context.Context, in *GetBucketPlacementRequest, opts ...grpc.CallOption) (*GetBucketPlacementResponse, error)
 	SetAttribution(ctx context.Context, in *SetAttributionRequest, opts ...grpc.CallOption) (*SetAttributionResponse, error)
 	ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error)
 }
@@ -1730,6 +2862,78 @@ func (c *metainfoClient) ListSegments(ctx context.Context, in *ListSegmentsReque
 	return out, nil
 }
 
+func (c *metainfoClient) BeginMoveObject(ctx context.Context, in *ObjectBeginMoveRequest, opts ...grpc.CallOption) (*ObjectBeginMoveResponse, error) {
+	out := new(ObjectBeginMoveResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/BeginMoveObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) FinishMoveObject(ctx context.Context, in *ObjectFinishMoveRequest, opts ...grpc.CallOption) (*ObjectFinishMoveResponse, error) {
+	out := new(ObjectFinishMoveResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/FinishMoveObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) BeginConcatObjects(ctx context.Context, in *ObjectBeginConcatRequest, opts ...grpc.CallOption) (*ObjectBeginConcatResponse, error) {
+	out := new(ObjectBeginConcatResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/BeginConcatObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) FinishConcatObjects(ctx context.Context, in *ObjectFinishConcatRequest, opts ...grpc.CallOption) (*ObjectFinishConcatResponse, error) {
+	out := new(ObjectFinishConcatResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/FinishConcatObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error) {
+	out := new(SetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest, opts ...grpc.CallOption) (*GetBucketLifecycleResponse, error) {
+	out := new(GetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/GetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest, opts ...grpc.CallOption) (*SetBucketPlacementResponse, error) {
+	out := new(SetBucketPlacementResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) GetBucketPlacement(ctx context.Context, in *GetBucketPlacementRequest, opts ...grpc.CallOption) (*GetBucketPlacementResponse, error) {
+	out := new(GetBucketPlacementResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/GetBucketPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metainfoClient) SetAttribution(ctx context.Context, in *SetAttributionRequest, opts ...grpc.CallOption) (*SetAttributionResponse, error) {
 	out := new(SetAttributionResponse)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetAttribution", in, out, opts...)
@@ -1761,6 +2965,14 @@ type MetainfoServer interface {
 	DownloadSegment(context.Context, *SegmentDownloadRequest) (*SegmentDownloadResponse, error)
 	DeleteSegment(context.Context, *SegmentDeleteRequest) (*SegmentDeleteResponse, error)
 	ListSegments(context.Context, *ListSegmentsRequest) (*ListSegmentsResponse, error)
+	BeginMoveObject(context.Context, *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error)
+	FinishMoveObject(context.Context, *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error)
+	BeginConcatObjects(context.Context, *ObjectBeginConcatRequest) (*ObjectBeginConcatResponse, error)
+	FinishConcatObjects(context.Context, *ObjectFinishConcatRequest) (*ObjectFinishConcatResponse, error)
+	SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error)
+	SetBucketPlacement(context.Context, *SetBucketPlacementRequest) (*SetBucketPlacementResponse, error)
+	GetBucketPlacement(context.Context, *GetBucketPlacementRequest) (*GetBucketPlacementResponse, error)
 	SetAttribution(context.Context, *SetAttributionRequest) (*SetAttributionResponse, error)
 	ProjectInfo(context.Context, *ProjectInfoRequest) (*ProjectInfoResponse, error)
 }
@@ -1967,6 +3179,150 @@ func _Metainfo_ListSegments_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_BeginMoveObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectBeginMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).BeginMoveObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/BeginMoveObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).BeginMoveObject(ctx, req.(*ObjectBeginMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_FinishMoveObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectFinishMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).FinishMoveObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/FinishMoveObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).FinishMoveObject(ctx, req.(*ObjectFinishMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_BeginConcatObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectBeginConcatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).BeginConcatObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/BeginConcatObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).BeginConcatObjects(ctx, req.(*ObjectBeginConcatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_FinishConcatObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectFinishConcatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).FinishConcatObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/FinishConcatObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).FinishConcatObjects(ctx, req.(*ObjectFinishConcatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, req.(*SetBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_GetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).GetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/GetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).GetBucketLifecycle(ctx, req.(*GetBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_SetBucketPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, req.(*SetBucketPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_GetBucketPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).GetBucketPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/GetBucketPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).GetBucketPlacement(ctx, req.(*GetBucketPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metainfo_SetAttribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetAttributionRequest)
 	if err := dec(in); err != nil {
@@ -2051,6 +3407,38 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListSegments",
 			Handler:    _Metainfo_ListSegments_Handler,
 		},
+		{
+			MethodName: "BeginMoveObject",
+			Handler:    _Metainfo_BeginMoveObject_Handler,
+		},
+		{
+			MethodName: "FinishMoveObject",
+			Handler:    _Metainfo_FinishMoveObject_Handler,
+		},
+		{
+			MethodName: "BeginConcatObjects",
+			Handler:    _Metainfo_BeginConcatObjects_Handler,
+		},
+		{
+			MethodName: "FinishConcatObjects",
+			Handler:    _Metainfo_FinishConcatObjects_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _Metainfo_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "GetBucketLifecycle",
+			Handler:    _Metainfo_GetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "SetBucketPlacement",
+			Handler:    _Metainfo_SetBucketPlacement_Handler,
+		},
+		{
+			MethodName: "GetBucketPlacement",
+			Handler:    _Metainfo_GetBucketPlacement_Handler,
+		},
 		{
 			MethodName: "SetAttribution",
 			Handler:    _Metainfo_SetAttribution_Handler,
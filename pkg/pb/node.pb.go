@@ -84,12 +84,14 @@ func (NodeTransport) EnumDescriptor() ([]byte, []int) {
 // Node represents a node in the overlay network
 // Node is info for a updating a single storagenode, used in the Update rpc calls
 type Node struct {
-	Id                   NodeID       `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
-	Address              *NodeAddress `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	LastIp               string       `protobuf:"bytes,14,opt,name=last_ip,json=lastIp,proto3" json:"last_ip,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Id      NodeID       `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Address *NodeAddress `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// last_ip is the network of the node, last_ip_address its IP address
+	LastIp               string   `protobuf:"bytes,14,opt,name=last_ip,json=lastIp,proto3" json:"last_ip,omitempty"`
+	LastIpAddress        string   `protobuf:"bytes,15,opt,name=last_ip_address,json=lastIpAddress,proto3" json:"last_ip_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
@@ -130,6 +132,13 @@ func (m *Node) GetLastIp() string {
 	return ""
 }
 
+func (m *Node) GetLastIpAddress() string {
+	if m != nil {
+		return m.LastIpAddress
+	}
+	return ""
+}
+
 // NodeAddress contains the information needed to communicate with a node on the network
 type NodeAddress struct {
 	Transport            NodeTransport `protobuf:"varint,1,opt,name=transport,proto3,enum=node.NodeTransport" json:"transport,omitempty"`
@@ -181,6 +190,7 @@ func (m *NodeAddress) GetAddress() string {
 type NodeOperator struct {
 	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Wallet               string   `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -224,6 +234,13 @@ func (m *NodeOperator) GetWallet() string {
 	return ""
 }
 
+func (m *NodeOperator) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 // NodeCapacity contains all relevant data about a nodes ability to store data
 type NodeCapacity struct {
 	FreeBandwidth        int64    `protobuf:"varint,1,opt,name=free_bandwidth,json=freeBandwidth,proto3" json:"free_bandwidth,omitempty"`
@@ -443,42 +460,43 @@ func init() {
 func init() { proto.RegisterFile("node.proto", fileDescriptor_0c843d59d2d938e7) }
 
 var fileDescriptor_0c843d59d2d938e7 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcb, 0x6e, 0x13, 0x31,
-	0x14, 0xed, 0x24, 0xd3, 0x24, 0x73, 0xf3, 0xd0, 0x60, 0x2a, 0x88, 0x8a, 0x44, 0x42, 0x24, 0xa4,
-	0xa8, 0x48, 0xa9, 0x28, 0x1b, 0x16, 0x6c, 0x92, 0xb6, 0x2a, 0x81, 0x90, 0x44, 0xce, 0xd0, 0x45,
-	0x37, 0x23, 0x27, 0x76, 0x13, 0xab, 0x93, 0xb1, 0x65, 0x7b, 0xa8, 0xf2, 0x2f, 0x7c, 0x10, 0xdf,
-	0xc0, 0xa2, 0x9f, 0x82, 0x90, 0xe7, 0xd1, 0xc7, 0x12, 0x89, 0xdd, 0x9c, 0x73, 0xcf, 0xb5, 0xcf,
-	0x9c, 0x7b, 0x0d, 0x10, 0x0b, 0xca, 0x06, 0x52, 0x09, 0x23, 0x90, 0x6b, 0xbf, 0x0f, 0x61, 0x2d,
-	0xd6, 0x22, 0x63, 0x0e, 0x3b, 0x6b, 0x21, 0xd6, 0x11, 0x3b, 0x4e, 0xd1, 0x32, 0xb9, 0x3e, 0x36,
-	0x7c, 0xcb, 0xb4, 0x21, 0x5b, 0x99, 0x09, 0x7a, 0x7f, 0x1c, 0x70, 0xa7, 0x82, 0x32, 0xf4, 0x1a,
-	0x4a, 0x9c, 0xb6, 0x9d, 0xae, 0xd3, 0x6f, 0x8c, 0x5a, 0xbf, 0xee, 0x3a, 0x7b, 0xbf, 0xef, 0x3a,
-	0x15, 0x5b, 0x19, 0x9f, 0xe1, 0x12, 0xa7, 0xe8, 0x1d, 0x54, 0x09, 0xa5, 0x8a, 0x69, 0xdd, 0x2e,
-	0x75, 0x9d, 0x7e, 0xfd, 0xe4, 0xd9, 0x20, 0xbd, 0xd9, 0x4a, 0x86, 0x59, 0x01, 0x17, 0x0a, 0xf4,
-	0x12, 0xaa, 0x11, 0xd1, 0x26, 0xe4, 0xb2, 0xdd, 0xea, 0x3a, 0x7d, 0x0f, 0x57, 0x2c, 0x1c, 0xcb,
-	0x2f, 0x6e, 0xad, 0xec, 0xb7, 0xb0, 0x6b, 0x76, 0x92, 0xe1, 0x86, 0x62, 0xda, 0x28, 0xbe, 0x32,
-	0x5c, 0xc4, 0x1a, 0x83, 0x62, 0x32, 0x31, 0xc4, 0x02, 0x5c, 0xdb, 0x32, 0x43, 0x28, 0x31, 0x04,
-	0x37, 0x22, 0x62, 0x58, 0xbc, 0xda, 0x85, 0x11, 0xd7, 0x06, 0x37, 0x49, 0x42, 0xb9, 0x09, 0x75,
-	0xb2, 0x5a, 0xd9, 0xeb, 0xf6, 0xb9, 0x0e, 0x13, 0x89, 0x5b, 0x89, 0xa4, 0xc4, 0xb0, 0x30, 0x97,
-	0xe2, 0x83, 0x1c, 0x3f, 0x15, 0x37, 0x73, 0x36, 0x91, 0x36, 0x02, 0x5c, 0xfd, 0xc1, 0x94, 0xe6,
-	0x22, 0xee, 0x5d, 0x41, 0xfd, 0xd1, 0x2f, 0xa0, 0xf7, 0xe0, 0x19, 0x45, 0x62, 0x2d, 0x85, 0x32,
-	0x69, 0x1a, 0xad, 0x93, 0xe7, 0x0f, 0x3f, 0x1a, 0x14, 0x25, 0xfc, 0xa0, 0x42, 0xed, 0xa7, 0xc9,
-	0x78, 0xf7, 0x31, 0xf4, 0x3e, 0x41, 0xc3, 0x76, 0xcd, 0x24, 0x53, 0xc4, 0x08, 0x85, 0x0e, 0x60,
-	0x9f, 0x6d, 0x09, 0x8f, 0xd2, 0x83, 0x3d, 0x9c, 0x01, 0xf4, 0x02, 0x2a, 0xb7, 0x24, 0x8a, 0x98,
-	0xc9, 0xdb, 0x73, 0xd4, 0xc3, 0x59, 0xf7, 0x29, 0x91, 0x64, 0xc5, 0xcd, 0x0e, 0xbd, 0x85, 0xd6,
-	0xb5, 0x62, 0x2c, 0x5c, 0x92, 0x98, 0xde, 0x72, 0x6a, 0x36, 0xe9, 0x31, 0x65, 0xdc, 0xb4, 0xec,
-	0xa8, 0x20, 0xd1, 0x2b, 0xf0, 0x52, 0x19, 0xe5, 0xfa, 0x26, 0x3d, 0xb1, 0x8c, 0x6b, 0x96, 0x38,
-	0xe3, 0xfa, 0xa6, 0x70, 0xf4, 0x2d, 0xcf, 0xf7, 0x1f, 0x1d, 0x5d, 0x82, 0x6f, 0xbb, 0xf1, 0xa3,
-	0xb9, 0xfd, 0x17, 0x57, 0x3f, 0x9d, 0x6c, 0x08, 0x97, 0xd9, 0x4c, 0x6c, 0xa2, 0xf9, 0x78, 0x72,
-	0x5f, 0x05, 0x44, 0x1d, 0xa8, 0xaf, 0xc4, 0x76, 0xcb, 0x4d, 0xb8, 0x21, 0x7a, 0x93, 0xdb, 0x83,
-	0x8c, 0xfa, 0x4c, 0xf4, 0x06, 0x7d, 0x04, 0xef, 0x7e, 0xc5, 0xdb, 0xe5, 0x74, 0x51, 0x0f, 0x07,
-	0xd9, 0x23, 0x18, 0x14, 0x8f, 0x60, 0x10, 0x14, 0x0a, 0xfc, 0x20, 0xb6, 0x97, 0x2a, 0x16, 0x31,
-	0xa2, 0x59, 0xdb, 0xed, 0x3a, 0xfd, 0x1a, 0x2e, 0xe0, 0xd1, 0x14, 0x6a, 0xe9, 0xf0, 0x77, 0x92,
-	0xa1, 0x3a, 0x54, 0xc7, 0xd3, 0xcb, 0xe1, 0x64, 0x7c, 0xe6, 0xef, 0xa1, 0x26, 0x78, 0x8b, 0x61,
-	0x70, 0x3e, 0x99, 0x8c, 0x83, 0x73, 0xdf, 0xb1, 0xb5, 0x45, 0x30, 0xc3, 0xc3, 0x8b, 0x73, 0xbf,
-	0x84, 0x00, 0x2a, 0xdf, 0xe7, 0x93, 0xf1, 0xf4, 0xab, 0x5f, 0xb6, 0xba, 0xd1, 0x6c, 0x16, 0x2c,
-	0x02, 0x3c, 0x9c, 0xfb, 0xee, 0xd1, 0x1b, 0x68, 0x3e, 0x59, 0x26, 0xe4, 0x43, 0x23, 0x38, 0x9d,
-	0x87, 0xc1, 0x64, 0x11, 0x5e, 0xe0, 0xf9, 0xa9, 0xbf, 0x37, 0x72, 0xaf, 0x4a, 0x72, 0xb9, 0xac,
-	0xa4, 0x8e, 0x3f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xa6, 0x6e, 0x66, 0xe4, 0x03, 0x00,
-	0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xda, 0x4e,
+	0x10, 0xc7, 0x63, 0xec, 0x00, 0x1e, 0xfe, 0xc4, 0xbf, 0xfd, 0x45, 0xad, 0x95, 0x4a, 0x85, 0x22,
+	0xb5, 0x42, 0xa9, 0x44, 0xd4, 0xf4, 0xd2, 0x43, 0x2f, 0x90, 0x44, 0x29, 0x2d, 0x05, 0xb4, 0xb8,
+	0x39, 0xe4, 0x62, 0x2d, 0x78, 0x03, 0xab, 0x18, 0x76, 0xe5, 0x5d, 0x37, 0xe2, 0x5d, 0x7a, 0xee,
+	0xb3, 0xf4, 0x19, 0x7a, 0xc8, 0xb3, 0x54, 0xbb, 0xb6, 0x93, 0x70, 0xac, 0xd4, 0x9b, 0xbf, 0x33,
+	0x9f, 0x9d, 0x1d, 0x7f, 0x67, 0x07, 0x60, 0xc3, 0x23, 0xda, 0x13, 0x09, 0x57, 0x1c, 0x39, 0xfa,
+	0xfb, 0x08, 0x96, 0x7c, 0xc9, 0xb3, 0xc8, 0x51, 0x6b, 0xc9, 0xf9, 0x32, 0xa6, 0x27, 0x46, 0xcd,
+	0xd3, 0x9b, 0x13, 0xc5, 0xd6, 0x54, 0x2a, 0xb2, 0x16, 0x19, 0xd0, 0xf9, 0x59, 0x02, 0x67, 0xcc,
+	0x23, 0x8a, 0x5e, 0x42, 0x89, 0x45, 0xbe, 0xd5, 0xb6, 0xba, 0xf5, 0x41, 0xf3, 0xd7, 0x7d, 0x6b,
+	0xef, 0xf7, 0x7d, 0xab, 0xac, 0x33, 0xc3, 0x73, 0x5c, 0x62, 0x11, 0x7a, 0x0b, 0x15, 0x12, 0x45,
+	0x09, 0x95, 0xd2, 0x2f, 0xb5, 0xad, 0x6e, 0xed, 0xf4, 0xbf, 0x9e, 0xb9, 0x59, 0x23, 0xfd, 0x2c,
+	0x81, 0x0b, 0x02, 0x3d, 0x87, 0x4a, 0x4c, 0xa4, 0x0a, 0x99, 0xf0, 0x9b, 0x6d, 0xab, 0xeb, 0xe2,
+	0xb2, 0x96, 0x43, 0x81, 0xde, 0xc0, 0x41, 0x9e, 0x08, 0x8b, 0x6a, 0x07, 0x06, 0x68, 0x64, 0x40,
+	0x5e, 0xe9, 0xb3, 0x53, 0xb5, 0xbd, 0x26, 0x76, 0xd4, 0x56, 0x50, 0x5c, 0x4f, 0xa8, 0x54, 0x09,
+	0x5b, 0x28, 0xc6, 0x37, 0x12, 0x43, 0x42, 0x45, 0xaa, 0x88, 0x16, 0xb8, 0xba, 0xa6, 0x8a, 0x44,
+	0x44, 0x11, 0x5c, 0x8f, 0x89, 0xa2, 0x9b, 0xc5, 0x36, 0x8c, 0x99, 0x54, 0xb8, 0x41, 0xd2, 0x88,
+	0xa9, 0x50, 0xa6, 0x8b, 0x85, 0x6e, 0x6b, 0x9f, 0xc9, 0x30, 0x15, 0xb8, 0x99, 0x8a, 0x88, 0x28,
+	0x1a, 0xe6, 0x28, 0x3e, 0xcc, 0xf5, 0x2e, 0xdc, 0xc8, 0xa3, 0xa9, 0xd0, 0x56, 0xe1, 0xca, 0x77,
+	0x9a, 0x48, 0xc6, 0x37, 0x9d, 0x6b, 0xa8, 0x3d, 0xf9, 0x55, 0xf4, 0x0e, 0x5c, 0x95, 0x90, 0x8d,
+	0x14, 0x3c, 0x51, 0xc6, 0xb5, 0xe6, 0xe9, 0xff, 0x8f, 0x86, 0x04, 0x45, 0x0a, 0x3f, 0x52, 0xc8,
+	0xdf, 0x75, 0xd0, 0x7d, 0xb0, 0xab, 0x33, 0x85, 0xba, 0x3e, 0x35, 0x11, 0x34, 0x21, 0x8a, 0x27,
+	0xe8, 0x10, 0xf6, 0xe9, 0x9a, 0xb0, 0xd8, 0x14, 0x76, 0x71, 0x26, 0xd0, 0x33, 0x28, 0xdf, 0x91,
+	0x38, 0xa6, 0x2a, 0x3f, 0x9e, 0x2b, 0x84, 0xc0, 0x51, 0x64, 0x29, 0x7d, 0xbb, 0x6d, 0x77, 0x5d,
+	0x6c, 0xbe, 0x3b, 0x38, 0xab, 0x78, 0x46, 0x04, 0x59, 0x30, 0xb5, 0x45, 0xaf, 0xa1, 0x79, 0x93,
+	0x50, 0x1a, 0xce, 0xc9, 0x26, 0xba, 0x63, 0x91, 0x5a, 0x99, 0xd2, 0x36, 0x6e, 0xe8, 0xe8, 0xa0,
+	0x08, 0xa2, 0x17, 0xe0, 0x1a, 0x2c, 0x62, 0xf2, 0xd6, 0xdc, 0x62, 0xe3, 0xaa, 0x0e, 0x9c, 0x33,
+	0x79, 0xdb, 0xf9, 0x98, 0xd5, 0xfc, 0x9a, 0x7b, 0xfe, 0x77, 0x5d, 0x76, 0xae, 0xc0, 0xd3, 0xa7,
+	0xf1, 0x93, 0x59, 0xfe, 0x93, 0xae, 0x7e, 0x58, 0xd9, 0x60, 0xae, 0xb2, 0x39, 0x69, 0x97, 0xf3,
+	0x91, 0xe5, 0x7d, 0x15, 0x12, 0xb5, 0xa0, 0xb6, 0xe0, 0xeb, 0x35, 0x53, 0xe1, 0x8a, 0xc8, 0x55,
+	0xde, 0x1e, 0x64, 0xa1, 0x4f, 0x44, 0xae, 0xd0, 0x07, 0x70, 0x1f, 0xd6, 0xc3, 0xb7, 0xcd, 0x23,
+	0x3f, 0xea, 0x65, 0x0b, 0xd4, 0x2b, 0x16, 0xa8, 0x17, 0x14, 0x04, 0x7e, 0x84, 0xf5, 0xa5, 0x09,
+	0x8d, 0x29, 0x91, 0xd4, 0x77, 0xda, 0x56, 0xb7, 0x8a, 0x0b, 0x79, 0x3c, 0x86, 0xaa, 0x79, 0x10,
+	0x5b, 0x41, 0x51, 0x0d, 0x2a, 0xc3, 0xf1, 0x55, 0x7f, 0x34, 0x3c, 0xf7, 0xf6, 0x50, 0x03, 0xdc,
+	0x59, 0x3f, 0xb8, 0x18, 0x8d, 0x86, 0xc1, 0x85, 0x67, 0xe9, 0xdc, 0x2c, 0x98, 0xe0, 0xfe, 0xe5,
+	0x85, 0x57, 0x42, 0x00, 0xe5, 0x6f, 0xd3, 0xd1, 0x70, 0xfc, 0xc5, 0xb3, 0x35, 0x37, 0x98, 0x4c,
+	0x82, 0x59, 0x80, 0xfb, 0x53, 0xcf, 0x39, 0x7e, 0x05, 0x8d, 0x9d, 0x07, 0x86, 0x3c, 0xa8, 0x07,
+	0x67, 0xd3, 0x30, 0x18, 0xcd, 0xc2, 0x4b, 0x3c, 0x3d, 0xf3, 0xf6, 0x06, 0xce, 0x75, 0x49, 0xcc,
+	0xe7, 0x65, 0xd3, 0xf1, 0xfb, 0x3f, 0x03, 0x00, 0x61, 0x00, 0xc6, 0x09, 0x20, 0x04, 0x00, 0x00,
 }
@@ -67,13 +67,17 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 }
 
 type StreamInfo struct {
-	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
-	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
-	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
-	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NumberOfSegments int64  `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize     int64  `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize  int64  `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	Metadata         []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// parts are the streams a concatenated stream consists of. Their segments
+	// keep the sizes and the encryption they were uploaded with. The last
+	// segment of a concatenated stream is empty.
+	Parts                []*StreamPart `protobuf:"bytes,5,rep,name=parts,proto3" json:"parts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
@@ -128,21 +132,85 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetParts() []*StreamPart {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+// StreamPart is a stream, which is part of a concatenated stream.
+type StreamPart struct {
+	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamPart) Reset()         { *m = StreamPart{} }
+func (m *StreamPart) String() string { return proto.CompactTextString(m) }
+func (*StreamPart) ProtoMessage()    {}
+func (*StreamPart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6bbf8af0ec331d6, []int{2}
+}
+func (m *StreamPart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPart.Unmarshal(m, b)
+}
+func (m *StreamPart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPart.Marshal(b, m, deterministic)
+}
+func (m *StreamPart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPart.Merge(m, src)
+}
+func (m *StreamPart) XXX_Size() int {
+	return xxx_messageInfo_StreamPart.Size(m)
+}
+func (m *StreamPart) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPart proto.InternalMessageInfo
+
+func (m *StreamPart) GetNumberOfSegments() int64 {
+	if m != nil {
+		return m.NumberOfSegments
+	}
+	return 0
+}
+
+func (m *StreamPart) GetSegmentsSize() int64 {
+	if m != nil {
+		return m.SegmentsSize
+	}
+	return 0
+}
+
+func (m *StreamPart) GetLastSegmentSize() int64 {
+	if m != nil {
+		return m.LastSegmentSize
+	}
+	return 0
+}
+
 type StreamMeta struct {
-	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
-	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
-	EncryptionBlockSize  int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
-	LastSegmentMeta      *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	EncryptedStreamInfo []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
+	EncryptionType      int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
+	EncryptionBlockSize int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
+	LastSegmentMeta     *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
+	// version_id identifies the latest version of an object in a versioned bucket
+	VersionId            string   `protobuf:"bytes,5,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamMeta) Reset()         { *m = StreamMeta{} }
 func (m *StreamMeta) String() string { return proto.CompactTextString(m) }
 func (*StreamMeta) ProtoMessage()    {}
 func (*StreamMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6bbf8af0ec331d6, []int{2}
+	return fileDescriptor_c6bbf8af0ec331d6, []int{3}
 }
 func (m *StreamMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeta.Unmarshal(m, b)
@@ -190,33 +258,166 @@ func (m *StreamMeta) GetLastSegmentMeta() *SegmentMeta {
 	return nil
 }
 
+func (m *StreamMeta) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+// ResumableUpload is the state of an unfinished upload of a stream, which
+// allows to continue the upload from another process.
+type ResumableUpload struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	VersionId            string   `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	ExpirationUnixNano   int64    `protobuf:"varint,4,opt,name=expiration_unix_nano,json=expirationUnixNano,proto3" json:"expiration_unix_nano,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SegmentsSize         int64    `protobuf:"varint,6,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	EncryptionType       int32    `protobuf:"varint,7,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
+	EncryptionBlockSize  int32    `protobuf:"varint,8,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
+	CommittedSegments    int64    `protobuf:"varint,9,opt,name=committed_segments,json=committedSegments,proto3" json:"committed_segments,omitempty"`
+	CommittedSize        int64    `protobuf:"varint,10,opt,name=committed_size,json=committedSize,proto3" json:"committed_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumableUpload) Reset()         { *m = ResumableUpload{} }
+func (m *ResumableUpload) String() string { return proto.CompactTextString(m) }
+func (*ResumableUpload) ProtoMessage()    {}
+func (*ResumableUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6bbf8af0ec331d6, []int{4}
+}
+func (m *ResumableUpload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumableUpload.Unmarshal(m, b)
+}
+func (m *ResumableUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumableUpload.Marshal(b, m, deterministic)
+}
+func (m *ResumableUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumableUpload.Merge(m, src)
+}
+func (m *ResumableUpload) XXX_Size() int {
+	return xxx_messageInfo_ResumableUpload.Size(m)
+}
+func (m *ResumableUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumableUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumableUpload proto.InternalMessageInfo
+
+func (m *ResumableUpload) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *ResumableUpload) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ResumableUpload) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *ResumableUpload) GetExpirationUnixNano() int64 {
+	if m != nil {
+		return m.ExpirationUnixNano
+	}
+	return 0
+}
+
+func (m *ResumableUpload) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ResumableUpload) GetSegmentsSize() int64 {
+	if m != nil {
+		return m.SegmentsSize
+	}
+	return 0
+}
+
+func (m *ResumableUpload) GetEncryptionType() int32 {
+	if m != nil {
+		return m.EncryptionType
+	}
+	return 0
+}
+
+func (m *ResumableUpload) GetEncryptionBlockSize() int32 {
+	if m != nil {
+		return m.EncryptionBlockSize
+	}
+	return 0
+}
+
+func (m *ResumableUpload) GetCommittedSegments() int64 {
+	if m != nil {
+		return m.CommittedSegments
+	}
+	return 0
+}
+
+func (m *ResumableUpload) GetCommittedSize() int64 {
+	if m != nil {
+		return m.CommittedSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SegmentMeta)(nil), "streams.SegmentMeta")
 	proto.RegisterType((*StreamInfo)(nil), "streams.StreamInfo")
+	proto.RegisterType((*StreamPart)(nil), "streams.StreamPart")
 	proto.RegisterType((*StreamMeta)(nil), "streams.StreamMeta")
+	proto.RegisterType((*ResumableUpload)(nil), "streams.ResumableUpload")
 }
 
 func init() { proto.RegisterFile("streams.proto", fileDescriptor_c6bbf8af0ec331d6) }
 
 var fileDescriptor_c6bbf8af0ec331d6 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x55, 0xea, 0x38, 0x8d, 0x27, 0x49, 0xf3, 0xeb, 0xb6, 0x3f, 0x64, 0x81, 0x90, 0x22, 0x23,
+	0x44, 0x40, 0x50, 0xa1, 0xf0, 0x05, 0x50, 0x6f, 0x15, 0xa2, 0x45, 0x1b, 0x7a, 0xe1, 0x62, 0xad,
+	0xed, 0x09, 0xac, 0x1c, 0xef, 0x5a, 0xde, 0x0d, 0x8a, 0x73, 0xe4, 0xca, 0xb7, 0xe3, 0x03, 0x21,
+	0xe4, 0x5d, 0xff, 0x49, 0x43, 0x39, 0x70, 0xe2, 0xe6, 0x99, 0x79, 0x7a, 0xfb, 0xde, 0xbc, 0x91,
+	0x61, 0xa2, 0x74, 0x81, 0x2c, 0x53, 0x17, 0x79, 0x21, 0xb5, 0x24, 0xc7, 0x75, 0x19, 0xdc, 0xc0,
+	0x68, 0x89, 0x9f, 0x33, 0x14, 0xfa, 0x3d, 0x6a, 0x46, 0x9e, 0xc0, 0x04, 0x45, 0x5c, 0x94, 0xb9,
+	0xc6, 0x24, 0x4c, 0xb1, 0xf4, 0x7b, 0xb3, 0xde, 0x7c, 0x4c, 0xc7, 0x6d, 0xf3, 0x1d, 0x96, 0xe4,
+	0x11, 0x78, 0x29, 0x96, 0xa1, 0x90, 0x22, 0x46, 0xff, 0xc8, 0x00, 0x86, 0x29, 0x96, 0xd7, 0x55,
+	0x1d, 0xfc, 0xe8, 0x01, 0x2c, 0x0d, 0xf9, 0x95, 0x58, 0x49, 0xf2, 0x12, 0x88, 0xd8, 0x64, 0x11,
+	0x16, 0xa1, 0x5c, 0x85, 0xca, 0xbe, 0xa4, 0x0c, 0xab, 0x43, 0xff, 0xb3, 0x93, 0x9b, 0x55, 0xad,
+	0x40, 0x55, 0xcf, 0x37, 0x98, 0x50, 0xf1, 0x9d, 0x65, 0x77, 0xe8, 0xb8, 0x69, 0x2e, 0xf9, 0x0e,
+	0xc9, 0x0b, 0x38, 0x5d, 0x33, 0xa5, 0x1b, 0x36, 0x0b, 0x74, 0x0c, 0x70, 0x5a, 0x0d, 0x6a, 0x36,
+	0x83, 0x7d, 0x08, 0xc3, 0x0c, 0x35, 0x4b, 0x98, 0x66, 0x7e, 0xdf, 0x2a, 0x6d, 0x6a, 0xf2, 0x1c,
+	0xdc, 0x9c, 0x15, 0x5a, 0xf9, 0xee, 0xcc, 0x99, 0x8f, 0x16, 0x67, 0x17, 0xcd, 0x8a, 0xac, 0xfc,
+	0x0f, 0xac, 0xd0, 0xd4, 0x22, 0x82, 0xef, 0xad, 0xa9, 0xaa, 0xfb, 0x8f, 0x4d, 0x05, 0x3f, 0x5b,
+	0x35, 0x26, 0xb3, 0x05, 0xfc, 0xdf, 0x65, 0x66, 0x3d, 0x84, 0x5c, 0xac, 0x64, 0x9d, 0xdd, 0x59,
+	0x3b, 0xdc, 0x8b, 0xe5, 0x19, 0x4c, 0xeb, 0x36, 0x97, 0x22, 0xd4, 0x65, 0x6e, 0x55, 0xb9, 0xf4,
+	0xa4, 0x6b, 0x7f, 0x2c, 0x73, 0xdc, 0x23, 0xaf, 0x80, 0xd1, 0x5a, 0xc6, 0x69, 0xa7, 0xcd, 0x6d,
+	0xc9, 0xb9, 0x14, 0x97, 0xd5, 0xcc, 0x78, 0x79, 0x7b, 0xe0, 0x25, 0xc3, 0x7a, 0xfb, 0xa3, 0xc5,
+	0x79, 0xb7, 0xe4, 0xee, 0xea, 0xee, 0x38, 0x34, 0x96, 0x1e, 0x03, 0x7c, 0xc5, 0x42, 0x55, 0x4f,
+	0xf2, 0xc4, 0x77, 0x67, 0xbd, 0xb9, 0x47, 0xbd, 0xba, 0x73, 0x95, 0x04, 0xdf, 0x1c, 0x98, 0x52,
+	0x54, 0x9b, 0x8c, 0x45, 0x6b, 0xbc, 0xcd, 0xd7, 0x92, 0x25, 0xe4, 0x01, 0x0c, 0xa2, 0x4d, 0x9c,
+	0xa2, 0x36, 0xb6, 0x3d, 0x5a, 0x57, 0x84, 0x40, 0x3f, 0x67, 0xfa, 0x8b, 0xb1, 0xe7, 0x51, 0xf3,
+	0x7d, 0x40, 0xef, 0x1c, 0xd0, 0x93, 0xd7, 0x70, 0x8e, 0xdb, 0x9c, 0x17, 0xcc, 0x78, 0xde, 0x08,
+	0xbe, 0x0d, 0x05, 0x13, 0xd2, 0x58, 0x70, 0x28, 0xe9, 0x66, 0xb7, 0x82, 0x6f, 0xaf, 0x99, 0x90,
+	0x77, 0xce, 0xcc, 0x3d, 0x38, 0xb3, 0xdf, 0xe2, 0x1f, 0xdc, 0x13, 0xff, 0x3d, 0x79, 0x1c, 0xff,
+	0x5d, 0x1e, 0xc3, 0x3f, 0xe7, 0xf1, 0x0a, 0x48, 0x2c, 0xb3, 0x8c, 0x6b, 0x73, 0x20, 0xcd, 0xb9,
+	0x7a, 0x46, 0xc6, 0x69, 0x3b, 0x69, 0xef, 0xf5, 0x29, 0x9c, 0xec, 0xc1, 0x2b, 0x6e, 0x30, 0xd0,
+	0x49, 0x07, 0xe5, 0x3b, 0xbc, 0xec, 0x7f, 0x3a, 0xca, 0xa3, 0x68, 0x60, 0xfe, 0x27, 0x6f, 0x7e,
+	0x0d, 0x00, 0x37, 0xb1, 0xb2, 0x95, 0x60, 0x04, 0x00, 0x00,
 }
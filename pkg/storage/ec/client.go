@@ -34,8 +34,13 @@ type Client interface {
 	Get(ctx context.Context, limits []*pb.AddressedOrderLimit, es eestream.ErasureScheme, size int64) (ranger.Ranger, error)
 	Delete(ctx context.Context, limits []*pb.AddressedOrderLimit) error
 	WithForceErrorDetection(force bool) Client
+	WithTransferObserver(observer TransferObserver) Client
 }
 
+// TransferObserver is called with the amount of data of every completed piece
+// transfer and how long the transfer took.
+type TransferObserver func(ctx context.Context, limit *pb.OrderLimit, bytes int64, duration time.Duration)
+
 type dialPiecestoreFunc func(context.Context, *pb.Node) (*piecestore.Client, error)
 
 type ecClient struct {
@@ -43,6 +48,7 @@ type ecClient struct {
 	transport           transport.Client
 	memoryLimit         int
 	forceErrorDetection bool
+	transferObserver    TransferObserver
 }
 
 // NewClient from the given identity and max buffer memory
@@ -59,6 +65,11 @@ func (ec *ecClient) WithForceErrorDetection(force bool) Client {
 	return ec
 }
 
+func (ec *ecClient) WithTransferObserver(observer TransferObserver) Client {
+	ec.transferObserver = observer
+	return ec
+}
+
 func (ec *ecClient) dialPiecestore(ctx context.Context, n *pb.Node) (*piecestore.Client, error) {
 	logger := ec.log.Named(n.Id.String())
 	signer := signing.SignerFromFullIdentity(ec.transport.Identity())
@@ -266,6 +277,7 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, limit *pb.AddressedOrd
 		return nil, nil
 	}
 
+	start := time.Now()
 	storageNodeID := limit.GetLimit().StorageNodeId
 	pieceID := limit.GetLimit().PieceId
 	ps, err := ec.dialPiecestore(ctx, &pb.Node{
@@ -283,6 +295,7 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, limit *pb.AddressedOrd
 		ec.log.Sugar().Debugf("Failed requesting upload of piece %s to node %s: %v", pieceID, storageNodeID, err)
 		return nil, err
 	}
+	var written int64
 	defer func() {
 		if ctx.Err() != nil || err != nil {
 			hash = nil
@@ -292,9 +305,12 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, limit *pb.AddressedOrd
 		h, closeErr := upload.Commit(ctx)
 		hash = h
 		err = errs.Combine(err, closeErr)
+		if err == nil && ec.transferObserver != nil {
+			ec.transferObserver(parent, limit.GetLimit(), written, time.Since(start))
+		}
 	}()
 
-	_, err = sync2.Copy(ctx, upload, data)
+	written, err = sync2.Copy(ctx, upload, data)
 	// Canceled context means the piece upload was interrupted by user or due
 	// to slow connection. No error logging for this case.
 	if ctx.Err() == context.Canceled {
@@ -337,6 +353,7 @@ func (ec *ecClient) Get(ctx context.Context, limits []*pb.AddressedOrderLimit, e
 
 		rrs[i] = &lazyPieceRanger{
 			dialPiecestore: ec.dialPiecestore,
+			observer:       ec.transferObserver,
 			limit:          addressedLimit,
 			size:           pieceSize,
 		}
@@ -432,6 +449,7 @@ func calcPadded(size int64, blockSize int) int64 {
 
 type lazyPieceRanger struct {
 	dialPiecestore dialPiecestoreFunc
+	observer       TransferObserver
 	limit          *pb.AddressedOrderLimit
 	size           int64
 }
@@ -444,6 +462,7 @@ func (lr *lazyPieceRanger) Size() int64 {
 // Range implements Ranger.Range to be lazily connected
 func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
+	start := time.Now()
 	ps, err := lr.dialPiecestore(ctx, &pb.Node{
 		Id:      lr.limit.GetLimit().StorageNodeId,
 		Address: lr.limit.GetStorageNodeAddress(),
@@ -456,7 +475,17 @@ func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (_ i
 	if err != nil {
 		return nil, errs.Combine(err, ps.Close())
 	}
-	return &clientCloser{download, ps}, nil
+	if lr.observer == nil {
+		return &clientCloser{download, ps}, nil
+	}
+	return &observedDownload{
+		clientCloser: clientCloser{download, ps},
+		ctx:          ctx,
+		observer:     lr.observer,
+		limit:        lr.limit.GetLimit(),
+		start:        start,
+		length:       length,
+	}, nil
 }
 
 type clientCloser struct {
@@ -471,6 +500,32 @@ func (client *clientCloser) Close() error {
 	)
 }
 
+// observedDownload reports the download to the observer when all of the
+// requested data was read.
+type observedDownload struct {
+	clientCloser
+	ctx      context.Context
+	observer TransferObserver
+	limit    *pb.OrderLimit
+	start    time.Time
+	length   int64
+	read     int64
+}
+
+func (download *observedDownload) Read(p []byte) (n int, err error) {
+	n, err = download.clientCloser.Read(p)
+	download.read += int64(n)
+	return n, err
+}
+
+func (download *observedDownload) Close() error {
+	err := download.clientCloser.Close()
+	if err == nil && download.read == download.length {
+		download.observer(download.ctx, download.limit, download.read, time.Since(download.start))
+	}
+	return err
+}
+
 func nonNilCount(limits []*pb.AddressedOrderLimit) int {
 	total := 0
 	for _, limit := range limits {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
@@ -16,32 +17,53 @@ import (
 	"storj.io/storj/pkg/pb"
 	ecclient "storj.io/storj/pkg/storage/ec"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/damagedpieces"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/placement"
 )
 
 // Repairer for segments
 type Repairer struct {
-	log      *zap.Logger
-	metainfo *metainfo.Service
-	orders   *orders.Service
-	cache    *overlay.Cache
-	ec       ecclient.Client
-	identity *identity.FullIdentity
-	timeout  time.Duration
+	log        *zap.Logger
+	metainfo   *metainfo.Service
+	orders     *orders.Service
+	cache      *overlay.Cache
+	recorder   *overlay.PerformanceRecorder
+	placements placement.DB
+	damaged    damagedpieces.DB
+	ec         ecclient.Client
+	identity   *identity.FullIdentity
+	timeout    time.Duration
 }
 
 // NewSegmentRepairer creates a new instance of SegmentRepairer
-func NewSegmentRepairer(log *zap.Logger, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache, ec ecclient.Client, identity *identity.FullIdentity, timeout time.Duration) *Repairer {
-	return &Repairer{
-		log:      log,
-		metainfo: metainfo,
-		orders:   orders,
-		cache:    cache,
-		ec:       ec.WithForceErrorDetection(true),
-		identity: identity,
-		timeout:  timeout,
+func NewSegmentRepairer(log *zap.Logger, metainfo *metainfo.Service, orders *orders.Service, cache *overlay.Cache, recorder *overlay.PerformanceRecorder, placements placement.DB, damaged damagedpieces.DB, ec ecclient.Client, identity *identity.FullIdentity, timeout time.Duration) *Repairer {
+	repairer := &Repairer{
+		log:        log,
+		metainfo:   metainfo,
+		orders:     orders,
+		cache:      cache,
+		recorder:   recorder,
+		placements: placements,
+		damaged:    damaged,
+		identity:   identity,
+		timeout:    timeout,
 	}
+	repairer.ec = ec.WithForceErrorDetection(true).WithTransferObserver(repairer.updatePerformance)
+	return repairer
+}
+
+// updatePerformance records the throughput of the node with the duration of a
+// piece transfer measured while repairing. The samples are written in batches
+// by the recorder.
+func (repairer *Repairer) updatePerformance(ctx context.Context, limit *pb.OrderLimit, bytes int64, duration time.Duration) {
+	repairer.recorder.Record(overlay.PerformanceSample{
+		NodeID:   limit.StorageNodeId,
+		Action:   limit.Action,
+		Bytes:    bytes,
+		Duration: duration,
+	})
 }
 
 // Repair retrieves an at-risk segment and repairs and stores lost pieces on new nodes
@@ -78,6 +100,19 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 		return Error.New("error getting missing pieces %s", err)
 	}
 
+	// pieces reported as damaged by their storage nodes are repaired like lost ones
+	damagedPieces, err := repairer.findDamagedPieces(ctx, pointer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	lostPiecesSet := sliceToSet(missingPieces)
+	for _, piece := range damagedPieces {
+		if _, ok := lostPiecesSet[piece.PieceNum]; !ok {
+			missingPieces = append(missingPieces, piece.PieceNum)
+		}
+	}
+	damagedSet := sliceToSet(pieceNums(damagedPieces))
+
 	numHealthy := len(pieces) - len(missingPieces)
 	// irreparable piece, we need k+1 to detect corrupted pieces
 	if int32(numHealthy) < pointer.Remote.Redundancy.MinReq+1 {
@@ -86,7 +121,7 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	}
 
 	// repair not needed
-	if int32(numHealthy) > pointer.Remote.Redundancy.RepairThreshold {
+	if int32(numHealthy) > pointer.Remote.Redundancy.RepairThreshold && len(damagedPieces) == 0 {
 		mon.Meter("repair_unnecessary").Mark(1)
 		repairer.log.Sugar().Debugf("segment %v with %d pieces above repair threshold %d", path, numHealthy, pointer.Remote.Redundancy.RepairThreshold)
 		return nil
@@ -98,7 +133,7 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	}
 	mon.FloatVal("healthy_ratio_before_repair").Observe(healthyRatioBeforeRepair)
 
-	lostPiecesSet := sliceToSet(missingPieces)
+	lostPiecesSet = sliceToSet(missingPieces)
 
 	// Populate healthyPieces with all pieces from the pointer except those correlating to indices in lostPieces
 	for _, piece := range pieces {
@@ -122,12 +157,19 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 		return Error.Wrap(err)
 	}
 
+	// The new nodes have to satisfy the placement policy of the bucket
+	policy, err := repairer.bucketPlacement(ctx, path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	// Request Overlay for n-h new storage nodes
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: redundancy.TotalCount() - len(healthyPieces),
 		FreeBandwidth:  pieceSize,
 		FreeDisk:       pieceSize,
 		ExcludedNodes:  excludeNodeIDs,
+		Placement:      policy,
 	}
 	newNodes, err := repairer.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -188,8 +230,12 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	mon.FloatVal("healthy_ratio_after_repair").Observe(healthyRatioAfterRepair)
 
 	// if partial repair, include "unhealthy" pieces that are not duplicates
+	// and weren't reported as damaged
 	if healthyLength < pointer.Remote.Redundancy.SuccessThreshold {
 		for _, p := range unhealthyPieces {
+			if _, ok := damagedSet[p.GetPieceNum()]; ok {
+				continue
+			}
 			if _, ok := healthyMap[p.GetPieceNum()]; !ok {
 				healthyPieces = append(healthyPieces, p)
 			}
@@ -200,7 +246,48 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	pointer.GetRemote().RemotePieces = healthyPieces
 
 	// Update the segment pointer in the metainfo
-	return repairer.metainfo.Put(ctx, path, pointer)
+	err = repairer.metainfo.Put(ctx, path, pointer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the damaged pieces aren't referenced anymore
+	for _, piece := range damagedPieces {
+		pieceID := pointer.GetRemote().RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+		err = errs.Combine(err, repairer.damaged.Delete(ctx, piece.NodeId, pieceID))
+	}
+	return Error.Wrap(err)
+}
+
+// findDamagedPieces returns the pieces of the pointer, which were reported as
+// damaged by the storage nodes storing them
+func (repairer *Repairer) findDamagedPieces(ctx context.Context, pointer *pb.Pointer) (damaged []*pb.RemotePiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if repairer.damaged == nil {
+		return nil, nil
+	}
+
+	remote := pointer.GetRemote()
+	for _, piece := range remote.GetRemotePieces() {
+		reported, err := repairer.damaged.Has(ctx, piece.NodeId, remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+		if err != nil {
+			return nil, err
+		}
+		if reported {
+			damaged = append(damaged, piece)
+		}
+	}
+	return damaged, nil
+}
+
+// pieceNums returns the piece numbers of the pieces
+func pieceNums(pieces []*pb.RemotePiece) []int32 {
+	nums := make([]int32, 0, len(pieces))
+	for _, piece := range pieces {
+		nums = append(nums, piece.PieceNum)
+	}
+	return nums
 }
 
 // sliceToSet converts the given slice to a set
@@ -212,6 +299,27 @@ func sliceToSet(slice []int32) map[int32]struct{} {
 	return set
 }
 
+// bucketPlacement returns the placement policy of the bucket containing the segment
+func (repairer *Repairer) bucketPlacement(ctx context.Context, path storj.Path) (_ *pb.PlacementPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if repairer.placements == nil {
+		return nil, nil
+	}
+
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return nil, Error.New("no bucket component in path: %s", path)
+	}
+
+	projectID, err := uuid.Parse(comps[0])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return repairer.placements.Get(ctx, *projectID, []byte(comps[2]))
+}
+
 func createBucketID(path storj.Path) ([]byte, error) {
 	comps := storj.SplitPath(path)
 	if len(comps) < 3 {
@@ -0,0 +1,184 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/ranger"
+)
+
+// prefetchRanger concatenates the rangers of the segments of a stream. Its
+// readers download up to prefetch segments ahead of the segment being read.
+type prefetchRanger struct {
+	rangers  []ranger.Ranger
+	prefetch int
+}
+
+// Size implements Ranger.Size
+func (rr *prefetchRanger) Size() (size int64) {
+	for _, segment := range rr.rangers {
+		size += segment.Size()
+	}
+	return size
+}
+
+// Range implements Ranger.Range
+func (rr *prefetchRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if offset < 0 {
+		return nil, ranger.Error.New("negative offset")
+	}
+	if length < 0 {
+		return nil, ranger.Error.New("negative length")
+	}
+	if offset+length > rr.Size() {
+		return nil, ranger.Error.New("range beyond end")
+	}
+
+	var parts []segmentRange
+	for _, segment := range rr.rangers {
+		size := segment.Size()
+		if length <= 0 {
+			break
+		}
+		if offset >= size {
+			offset -= size
+			continue
+		}
+
+		partLength := size - offset
+		if partLength > length {
+			partLength = length
+		}
+		parts = append(parts, segmentRange{ranger: segment, offset: offset, length: partLength})
+
+		offset = 0
+		length -= partLength
+	}
+
+	return newPrefetchReader(ctx, parts, rr.prefetch), nil
+}
+
+// segmentRange is a range of a single segment.
+type segmentRange struct {
+	ranger ranger.Ranger
+	offset int64
+	length int64
+}
+
+// prefetchedSegment is the data of a segmentRange downloaded in the background.
+type prefetchedSegment struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// prefetchReader reads segment ranges in order, while downloading the next
+// ones in the background.
+type prefetchReader struct {
+	cancel  func()
+	wg      sync.WaitGroup
+	limiter chan struct{}
+
+	segments []*prefetchedSegment
+	next     int
+	data     []byte
+}
+
+func newPrefetchReader(ctx context.Context, parts []segmentRange, prefetch int) *prefetchReader {
+	ctx, cancel := context.WithCancel(ctx)
+
+	reader := &prefetchReader{
+		cancel:   cancel,
+		limiter:  make(chan struct{}, prefetch),
+		segments: make([]*prefetchedSegment, len(parts)),
+	}
+	for i := range reader.segments {
+		reader.segments[i] = &prefetchedSegment{done: make(chan struct{})}
+	}
+
+	reader.wg.Add(1)
+	go reader.download(ctx, parts)
+
+	return reader
+}
+
+// download starts the downloads of the parts, keeping at most cap(limiter)
+// of them ahead of the reader.
+func (reader *prefetchReader) download(ctx context.Context, parts []segmentRange) {
+	defer reader.wg.Done()
+
+	for i, part := range parts {
+		segment := reader.segments[i]
+
+		select {
+		case reader.limiter <- struct{}{}:
+		case <-ctx.Done():
+			for _, segment := range reader.segments[i:] {
+				segment.err = ctx.Err()
+				close(segment.done)
+			}
+			return
+		}
+
+		reader.wg.Add(1)
+		go func(part segmentRange) {
+			defer reader.wg.Done()
+			defer close(segment.done)
+
+			segment.data, segment.err = readSegmentRange(ctx, part)
+		}(part)
+	}
+}
+
+func readSegmentRange(ctx context.Context, part segmentRange) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := part.ranger.Range(ctx, part.offset, part.length)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	return ioutil.ReadAll(reader)
+}
+
+// Read implements io.Reader
+func (reader *prefetchReader) Read(p []byte) (n int, err error) {
+	for len(reader.data) == 0 {
+		if reader.next >= len(reader.segments) {
+			return 0, io.EOF
+		}
+
+		segment := reader.segments[reader.next]
+		<-segment.done
+		if segment.err != nil {
+			return 0, segment.err
+		}
+
+		reader.data, segment.data = segment.data, nil
+		reader.next++
+
+		// the segment isn't downloading anymore, let the next one start
+		<-reader.limiter
+	}
+
+	n = copy(p, reader.data)
+	reader.data = reader.data[n:]
+	return n, nil
+}
+
+// Close cancels the downloads in progress and waits for them to finish.
+func (reader *prefetchReader) Close() error {
+	reader.cancel()
+	reader.wg.Wait()
+	reader.data = nil
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/ranger"
+)
+
+func TestPrefetchRanger(t *testing.T) {
+	rr := &prefetchRanger{
+		rangers: []ranger.Ranger{
+			ranger.ByteRanger("abcd"),
+			ranger.ByteRanger("efgh"),
+			ranger.ByteRanger("ijkl"),
+			ranger.ByteRanger("mn"),
+		},
+		prefetch: 2,
+	}
+	assert.EqualValues(t, 14, rr.Size())
+
+	for _, example := range []struct {
+		offset, length int64
+		substr         string
+		fail           bool
+	}{
+		{0, 0, "", false},
+		{0, 14, "abcdefghijklmn", false},
+		{2, 4, "cdef", false},
+		{4, 4, "efgh", false},
+		{3, 10, "defghijklm", false},
+		{12, 2, "mn", false},
+		{14, 0, "", false},
+		{0, 15, "", true},
+		{-1, 2, "", true},
+		{0, -1, "", true},
+	} {
+		reader, err := rr.Range(ctx, example.offset, example.length)
+		if example.fail {
+			assert.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, example.substr, string(data))
+		require.NoError(t, reader.Close())
+	}
+
+	// closing before reading everything stops the downloads
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	require.NoError(t, err)
+	buf := make([]byte, 2)
+	_, err = reader.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ab", string(buf))
+	require.NoError(t, reader.Close())
+}
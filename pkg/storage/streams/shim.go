@@ -19,8 +19,11 @@ type Store interface {
 	Meta(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	PutResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, state UploadState, committed func(UploadState)) (Meta, error)
+	DeleteResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, state UploadState) error
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	WithParallelism(parallelism Parallelism) Store
 }
 
 type shimStore struct {
@@ -57,6 +60,20 @@ func (s *shimStore) Put(ctx context.Context, path storj.Path, pathCipher storj.C
 	return s.store.Put(ctx, ParsePath(path), pathCipher, data, metadata, expiration)
 }
 
+// PutResumable parses the passed in path and dispatches to the typed store.
+func (s *shimStore) PutResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, state UploadState, committed func(UploadState)) (_ Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.PutResumable(ctx, ParsePath(path), pathCipher, data, metadata, expiration, state, committed)
+}
+
+// DeleteResumable parses the passed in path and dispatches to the typed store.
+func (s *shimStore) DeleteResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, state UploadState) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.DeleteResumable(ctx, ParsePath(path), pathCipher, state)
+}
+
 // Delete parses the passed in path and dispatches to the typed store.
 func (s *shimStore) Delete(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -70,3 +87,9 @@ func (s *shimStore) List(ctx context.Context, prefix storj.Path, startAfter stor
 
 	return s.store.List(ctx, ParsePath(prefix), startAfter, endBefore, pathCipher, recursive, limit, metaFlags)
 }
+
+// WithParallelism returns a copy of the store, which transfers segments with
+// the given parallelism.
+func (s *shimStore) WithParallelism(parallelism Parallelism) Store {
+	return &shimStore{store: s.store.WithParallelism(parallelism)}
+}
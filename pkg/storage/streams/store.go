@@ -4,12 +4,14 @@
 package streams
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/rand"
 	"io"
 	"io/ioutil"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -36,6 +38,7 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Data       []byte
+	VersionID  string
 }
 
 // convertMeta converts segment metadata to stream metadata
@@ -43,18 +46,75 @@ func convertMeta(lastSegmentMeta segments.Meta, stream pb.StreamInfo, streamMeta
 	return Meta{
 		Modified:   lastSegmentMeta.Modified,
 		Expiration: lastSegmentMeta.Expiration,
-		Size:       ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
+		Size:       StreamSize(stream),
 		Data:       stream.Metadata,
+		VersionID:  streamMeta.VersionId,
 	}
 }
 
+// StreamSize returns the size of the data stored in the stream
+func StreamSize(stream pb.StreamInfo) int64 {
+	if len(stream.Parts) == 0 {
+		return ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize
+	}
+
+	size := stream.LastSegmentSize
+	for _, part := range stream.Parts {
+		size += ((part.NumberOfSegments - 1) * part.SegmentsSize) + part.LastSegmentSize
+	}
+	return size
+}
+
+// segmentLayout is the size of a segment and the index of its content nonce
+type segmentLayout struct {
+	size  int64
+	nonce int64
+}
+
+// streamLayout returns the layout of all segments of the stream but the last
+// one. The segments of a concatenated stream keep the sizes and the nonces
+// of the parts they were uploaded with.
+func streamLayout(stream pb.StreamInfo) (layout []segmentLayout, err error) {
+	if len(stream.Parts) == 0 {
+		for i := int64(0); i < stream.NumberOfSegments-1; i++ {
+			layout = append(layout, segmentLayout{size: stream.SegmentsSize, nonce: i + 1})
+		}
+		return layout, nil
+	}
+
+	for _, part := range stream.Parts {
+		for i := int64(0); i < part.NumberOfSegments-1; i++ {
+			layout = append(layout, segmentLayout{size: part.SegmentsSize, nonce: i + 1})
+		}
+		layout = append(layout, segmentLayout{size: part.LastSegmentSize, nonce: part.NumberOfSegments})
+	}
+	if int64(len(layout)) != stream.NumberOfSegments-1 {
+		return nil, errs.New("parts have %d segments, expected %d", len(layout), stream.NumberOfSegments-1)
+	}
+	return layout, nil
+}
+
 // Store interface methods for streams to satisfy to be a store
 type typedStore interface {
 	Meta(ctx context.Context, path Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	PutResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, state UploadState, committed func(UploadState)) (Meta, error)
+	DeleteResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, state UploadState) error
 	Delete(ctx context.Context, path Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix Path, startAfter, endBefore string, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	WithParallelism(parallelism Parallelism) typedStore
+}
+
+// Parallelism determines how many segments of a stream are transferred
+// concurrently. Values lower than 2 transfer one segment at a time.
+type Parallelism struct {
+	// Upload is the number of segments encrypted and uploaded concurrently.
+	// Every segment in flight is buffered in memory.
+	Upload int
+	// Download is the number of segments downloaded ahead of the segment
+	// being read. Every prefetched segment is buffered in memory.
+	Download int
 }
 
 // streamStore is a store for streams. It implements typedStore as part of an ongoing migration
@@ -66,6 +126,7 @@ type streamStore struct {
 	encBlockSize    int
 	cipher          storj.CipherSuite
 	inlineThreshold int
+	parallelism     Parallelism
 }
 
 // newTypedStreamStore constructs a typedStore backed by a streamStore.
@@ -87,6 +148,14 @@ func newTypedStreamStore(segments segments.Store, segmentSize int64, encStore *e
 	}, nil
 }
 
+// WithParallelism returns a copy of the store, which transfers segments with
+// the given parallelism.
+func (s *streamStore) WithParallelism(parallelism Parallelism) typedStore {
+	clone := *s
+	clone.parallelism = parallelism
+	return &clone
+}
+
 // Put breaks up data as it comes in into s.segmentSize length pieces, then
 // store the first piece at s0/<path>, second piece at s1/<path>, and the
 // *last* piece at l/<path>. Store the given metadata, along with the number
@@ -102,7 +171,7 @@ func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return Meta{}, err
 	}
 
-	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
+	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration, UploadState{}, nil)
 	if err != nil {
 		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
 	}
@@ -110,14 +179,76 @@ func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.Ciphe
 	return m, err
 }
 
-func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, lastSegment int64, err error) {
+// UploadState is the progress of a resumable upload of a stream.
+type UploadState struct {
+	// Segments is the number of committed segments. The last segment of the
+	// stream is only committed when the upload finishes.
+	Segments int64
+	// Size is the size of the committed segments.
+	Size int64
+}
+
+// PutResumable continues the upload of a stream after the segments in state.
+// Unlike Put, it neither deletes an existing stream at path nor the committed
+// segments if the upload fails. committed is called after every segment
+// except the last one is committed, so the upload can be continued with the
+// returned state later. data has to start right after the committed segments.
+// With parallel uploads, committed is called from the uploading goroutines,
+// but never concurrently.
+func (s *streamStore) PutResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, state UploadState, committed func(UploadState)) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if committed == nil {
+		committed = func(UploadState) {}
+	}
+
+	m, _, err = s.upload(ctx, path, pathCipher, data, metadata, expiration, state, committed)
+	return m, err
+}
+
+// DeleteResumable deletes the committed segments of an unfinished resumable upload.
+func (s *streamStore) DeleteResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, state UploadState) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var currentSegment int64
-	var streamSize int64
+	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
+	if err != nil {
+		return err
+	}
+
+	var group errs.Group
+	for i := int64(0); i < state.Segments; i++ {
+		currentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
+		if err != nil {
+			return err
+		}
+
+		err = s.segments.Delete(ctx, currentPath)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			group.Add(err)
+		}
+	}
+
+	return group.Err()
+}
+
+// upload uploads data as the segments of the stream following the segments
+// in state. If committed isn't nil, it's called after every segment except
+// the last one and the segments aren't deleted when the context is canceled.
+func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, state UploadState, committed func(UploadState)) (m Meta, lastSegment int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.parallelism.Upload > 1 {
+		return s.uploadParallel(ctx, path, pathCipher, data, metadata, expiration, state, committed)
+	}
+
+	currentSegment := state.Segments
+	streamSize := state.Size
 	var putMeta segments.Meta
 
 	defer func() {
+		if committed != nil {
+			return
+		}
 		select {
 		case <-ctx.Done():
 			s.cancelHandler(context.Background(), currentSegment, path, pathCipher)
@@ -137,62 +268,17 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 	eofReader := NewEOFReader(data)
 
 	for !eofReader.isEOF() && !eofReader.hasError() {
-		// generate random key for encrypting the segment's content
-		var contentKey storj.Key
-		_, err = rand.Read(contentKey[:])
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
-
-		// Initialize the content nonce with the segment's index incremented by 1.
-		// The increment by 1 is to avoid nonce reuse with the metadata encryption,
-		// which is encrypted with the zero nonce.
-		var contentNonce storj.Nonce
-		_, err := encryption.Increment(&contentNonce, currentSegment+1)
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
-
-		encrypter, err := encryption.NewEncrypter(s.cipher, &contentKey, &contentNonce, s.encBlockSize)
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
-
-		// generate random nonce for encrypting the content key
-		var keyNonce storj.Nonce
-		_, err = rand.Read(keyNonce[:])
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
-
-		encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
+		enc, err := s.newSegmentEncryption(currentSegment, derivedKey)
 		if err != nil {
 			return Meta{}, currentSegment, err
 		}
 
 		sizeReader := NewSizeReader(eofReader)
 		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
-		peekReader := segments.NewPeekThresholdReader(segmentReader)
-		// If the data is larger than the inline threshold size, then it will be a remote segment
-		isRemote, err := peekReader.IsLargerThan(s.inlineThreshold)
+		transformedReader, err := s.encryptSegment(segmentReader, enc)
 		if err != nil {
 			return Meta{}, currentSegment, err
 		}
-		var transformedReader io.Reader
-		if isRemote {
-			paddedReader := eestream.PadReader(ioutil.NopCloser(peekReader), encrypter.InBlockSize())
-			transformedReader = encryption.TransformReader(paddedReader, encrypter, 0)
-		} else {
-			data, err := ioutil.ReadAll(peekReader)
-			if err != nil {
-				return Meta{}, currentSegment, err
-			}
-			cipherData, err := encryption.Encrypt(data, s.cipher, &contentKey, &contentNonce)
-			if err != nil {
-				return Meta{}, currentSegment, err
-			}
-			transformedReader = bytes.NewReader(cipherData)
-		}
 
 		putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
 			if !eofReader.isEOF() {
@@ -201,14 +287,7 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 					return "", nil, err
 				}
 
-				if s.cipher == storj.EncNull {
-					return segmentPath, nil, nil
-				}
-
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
+				segmentMeta, err := s.segmentMeta(enc)
 				if err != nil {
 					return "", nil, err
 				}
@@ -221,7 +300,7 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 				return "", nil, err
 			}
 
-			streamInfo, err := proto.Marshal(&pb.StreamInfo{
+			lastSegmentMeta, err := s.lastSegmentMeta(enc, &pb.StreamInfo{
 				NumberOfSegments: currentSegment + 1,
 				SegmentsSize:     s.segmentSize,
 				LastSegmentSize:  sizeReader.Size(),
@@ -231,30 +310,6 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 				return "", nil, err
 			}
 
-			// encrypt metadata with the content encryption key and zero nonce
-			encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, &contentKey, &storj.Nonce{})
-			if err != nil {
-				return "", nil, err
-			}
-
-			streamMeta := pb.StreamMeta{
-				EncryptedStreamInfo: encryptedStreamInfo,
-				EncryptionType:      int32(s.cipher),
-				EncryptionBlockSize: int32(s.encBlockSize),
-			}
-
-			if s.cipher != storj.EncNull {
-				streamMeta.LastSegmentMeta = &pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				}
-			}
-
-			lastSegmentMeta, err := proto.Marshal(&streamMeta)
-			if err != nil {
-				return "", nil, err
-			}
-
 			return lastSegmentPath, lastSegmentMeta, nil
 		})
 		if err != nil {
@@ -263,6 +318,10 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 
 		currentSegment++
 		streamSize += sizeReader.Size()
+
+		if committed != nil && !eofReader.isEOF() {
+			committed(UploadState{Segments: currentSegment, Size: streamSize})
+		}
 	}
 
 	if eofReader.hasError() {
@@ -279,6 +338,346 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 	return resultMeta, currentSegment, nil
 }
 
+// uploadParallel uploads data like upload, but up to s.parallelism.Upload
+// segments are encrypted and uploaded concurrently. Every segment is read
+// into memory before it's uploaded, so at most s.parallelism.Upload segments
+// are buffered at a time. The last segment is uploaded after all the others
+// are committed.
+func (s *streamStore) uploadParallel(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, state UploadState, committed func(UploadState)) (m Meta, lastSegment int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	currentSegment := state.Segments
+	streamSize := state.Size
+
+	derivedKey, err := encryption.DeriveContentKey(path.Bucket(), path.UnencryptedPath(), s.encStore)
+	if err != nil {
+		return Meta{}, currentSegment, err
+	}
+	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
+	if err != nil {
+		return Meta{}, currentSegment, err
+	}
+
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		limiter = make(chan struct{}, s.parallelism.Upload)
+
+		mu       sync.Mutex
+		failure  error
+		finished = map[int64]bool{}
+		progress = state
+	)
+
+	// commit marks the segment as uploaded and reports the segments, which
+	// are committed without gaps.
+	commit := func(index int64) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		finished[index] = true
+		advanced := false
+		for finished[progress.Segments] {
+			delete(finished, progress.Segments)
+			progress.Segments++
+			progress.Size += s.segmentSize
+			advanced = true
+		}
+		if advanced && committed != nil {
+			committed(progress)
+		}
+	}
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if failure == nil {
+			failure = err
+			cancel()
+		}
+	}
+
+	reader := bufio.NewReader(data)
+	var lastData []byte
+	var readErr error
+	for {
+		select {
+		case limiter <- struct{}{}:
+		case <-uploadCtx.Done():
+		}
+		if err := uploadCtx.Err(); err != nil {
+			readErr = err
+			break
+		}
+
+		segmentData, last, err := readSegment(reader, s.segmentSize)
+		if err != nil {
+			readErr = err
+			break
+		}
+		if last {
+			lastData = segmentData
+			break
+		}
+
+		wg.Add(1)
+		go func(index int64) {
+			defer wg.Done()
+			defer func() { <-limiter }()
+
+			err := s.putSegment(uploadCtx, index, segmentData, path.Bucket(), encPath, derivedKey, expiration)
+			if err != nil {
+				fail(err)
+				return
+			}
+			commit(index)
+		}(currentSegment)
+
+		currentSegment++
+		streamSize += int64(len(segmentData))
+	}
+
+	wg.Wait()
+
+	if failure != nil {
+		return Meta{}, currentSegment, failure
+	}
+	if readErr != nil {
+		return Meta{}, currentSegment, readErr
+	}
+
+	enc, err := s.newSegmentEncryption(currentSegment, derivedKey)
+	if err != nil {
+		return Meta{}, currentSegment, err
+	}
+
+	transformedReader, err := s.encryptSegment(bytes.NewReader(lastData), enc)
+	if err != nil {
+		return Meta{}, currentSegment, err
+	}
+
+	putMeta, err := s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
+		lastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
+		if err != nil {
+			return "", nil, err
+		}
+
+		lastSegmentMeta, err := s.lastSegmentMeta(enc, &pb.StreamInfo{
+			NumberOfSegments: currentSegment + 1,
+			SegmentsSize:     s.segmentSize,
+			LastSegmentSize:  int64(len(lastData)),
+			Metadata:         metadata,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+
+		return lastSegmentPath, lastSegmentMeta, nil
+	})
+	if err != nil {
+		return Meta{}, currentSegment, err
+	}
+
+	resultMeta := Meta{
+		Modified:   putMeta.Modified,
+		Expiration: expiration,
+		Size:       streamSize + int64(len(lastData)),
+		Data:       metadata,
+	}
+
+	return resultMeta, currentSegment + 1, nil
+}
+
+// readSegment reads the next segment of at most size bytes from reader.
+// last is true if there's no more data after the segment.
+func readSegment(reader *bufio.Reader, size int64) (data []byte, last bool, err error) {
+	data = make([]byte, size)
+	n, err := io.ReadFull(reader, data)
+	switch err {
+	case nil:
+		_, err = reader.Peek(1)
+		if err == io.EOF {
+			return data, true, nil
+		}
+		return data, false, err
+	case io.EOF, io.ErrUnexpectedEOF:
+		return data[:n], true, nil
+	default:
+		return nil, false, err
+	}
+}
+
+// putSegment encrypts and uploads data as the segment with the given index,
+// which isn't the last segment of the stream.
+func (s *streamStore) putSegment(ctx context.Context, index int64, data []byte, bucket string, encPath paths.Encrypted, derivedKey *storj.Key, expiration time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	enc, err := s.newSegmentEncryption(index, derivedKey)
+	if err != nil {
+		return err
+	}
+
+	transformedReader, err := s.encryptSegment(bytes.NewReader(data), enc)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
+		segmentPath, err := createSegmentPath(ctx, index, bucket, encPath)
+		if err != nil {
+			return "", nil, err
+		}
+
+		segmentMeta, err := s.segmentMeta(enc)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return segmentPath, segmentMeta, nil
+	})
+	return err
+}
+
+// segmentEncryption holds the keys for encrypting the content of a segment.
+type segmentEncryption struct {
+	contentKey   storj.Key
+	contentNonce storj.Nonce
+	encryptedKey storj.EncryptedPrivateKey
+	keyNonce     storj.Nonce
+}
+
+// newSegmentEncryption generates a random content key for the segment with
+// the given index and encrypts it with derivedKey.
+func (s *streamStore) newSegmentEncryption(index int64, derivedKey *storj.Key) (_ *segmentEncryption, err error) {
+	var enc segmentEncryption
+
+	// generate random key for encrypting the segment's content
+	_, err = rand.Read(enc.contentKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the content nonce with the segment's index incremented by 1.
+	// The increment by 1 is to avoid nonce reuse with the metadata encryption,
+	// which is encrypted with the zero nonce.
+	_, err = encryption.Increment(&enc.contentNonce, index+1)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate random nonce for encrypting the content key
+	_, err = rand.Read(enc.keyNonce[:])
+	if err != nil {
+		return nil, err
+	}
+
+	enc.encryptedKey, err = encryption.EncryptKey(&enc.contentKey, s.cipher, derivedKey, &enc.keyNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &enc, nil
+}
+
+// encryptSegment returns a reader of the encrypted data of a segment. Data,
+// which isn't larger than the inline threshold, is read and encrypted at once.
+func (s *streamStore) encryptSegment(data io.Reader, enc *segmentEncryption) (io.Reader, error) {
+	encrypter, err := encryption.NewEncrypter(s.cipher, &enc.contentKey, &enc.contentNonce, s.encBlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	peekReader := segments.NewPeekThresholdReader(data)
+	// If the data is larger than the inline threshold size, then it will be a remote segment
+	isRemote, err := peekReader.IsLargerThan(s.inlineThreshold)
+	if err != nil {
+		return nil, err
+	}
+	if isRemote {
+		paddedReader := eestream.PadReader(ioutil.NopCloser(peekReader), encrypter.InBlockSize())
+		return encryption.TransformReader(paddedReader, encrypter, 0), nil
+	}
+
+	plainData, err := ioutil.ReadAll(peekReader)
+	if err != nil {
+		return nil, err
+	}
+	cipherData, err := encryption.Encrypt(plainData, s.cipher, &enc.contentKey, &enc.contentNonce)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(cipherData), nil
+}
+
+// segmentMeta returns the metadata of a segment, which isn't the last one.
+func (s *streamStore) segmentMeta(enc *segmentEncryption) ([]byte, error) {
+	if s.cipher == storj.EncNull {
+		return nil, nil
+	}
+
+	return proto.Marshal(&pb.SegmentMeta{
+		EncryptedKey: enc.encryptedKey,
+		KeyNonce:     enc.keyNonce[:],
+	})
+}
+
+// lastSegmentMeta returns the metadata of the last segment, which holds the
+// stream info encrypted with the content key of the segment.
+func (s *streamStore) lastSegmentMeta(enc *segmentEncryption, info *pb.StreamInfo) ([]byte, error) {
+	streamInfo, err := proto.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+
+	// encrypt metadata with the content encryption key and zero nonce
+	encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, &enc.contentKey, &storj.Nonce{})
+	if err != nil {
+		return nil, err
+	}
+
+	streamMeta := pb.StreamMeta{
+		EncryptedStreamInfo: encryptedStreamInfo,
+		EncryptionType:      int32(s.cipher),
+		EncryptionBlockSize: int32(s.encBlockSize),
+	}
+
+	if s.cipher != storj.EncNull {
+		streamMeta.LastSegmentMeta = &pb.SegmentMeta{
+			EncryptedKey: enc.encryptedKey,
+			KeyNonce:     enc.keyNonce[:],
+		}
+	}
+
+	return proto.Marshal(&streamMeta)
+}
+
+// EmptyLastSegment returns the encrypted data and the metadata of an empty
+// last segment, which holds the stream info. The last segment of a
+// concatenated stream is such a segment.
+func EmptyLastSegment(info *pb.StreamInfo, cipher storj.CipherSuite, encBlockSize int, derivedKey *storj.Key) (data, metadata []byte, err error) {
+	s := &streamStore{cipher: cipher, encBlockSize: encBlockSize}
+
+	enc, err := s.newSegmentEncryption(info.NumberOfSegments-1, derivedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err = encryption.Encrypt([]byte{}, cipher, &enc.contentKey, &enc.contentNonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata, err = s.lastSegmentMeta(enc, info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, metadata, nil
+}
+
 // Get returns a ranger that knows what the overall size is (from l/<path>)
 // and then returns the appropriate data from segments s0/<path>, s1/<path>,
 // ..., l/<path>.
@@ -316,15 +715,20 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return nil, Meta{}, err
 	}
 
+	layout, err := streamLayout(stream)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
 	var rangers []ranger.Ranger
-	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
-		currentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
+	for i, segment := range layout {
+		currentPath, err := createSegmentPath(ctx, int64(i), path.Bucket(), encPath)
 		if err != nil {
 			return nil, Meta{}, err
 		}
 
 		var contentNonce storj.Nonce
-		_, err = encryption.Increment(&contentNonce, i+1)
+		_, err = encryption.Increment(&contentNonce, segment.nonce)
 		if err != nil {
 			return nil, Meta{}, err
 		}
@@ -332,7 +736,7 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		rangers = append(rangers, &lazySegmentRanger{
 			segments:      s.segments,
 			path:          currentPath,
-			size:          stream.SegmentsSize,
+			size:          segment.size,
 			derivedKey:    derivedKey,
 			startingNonce: &contentNonce,
 			encBlockSize:  int(streamMeta.EncryptionBlockSize),
@@ -363,9 +767,11 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 	}
 
 	rangers = append(rangers, decryptedLastSegmentRanger)
-	catRangers := ranger.Concat(rangers...)
 	meta = convertMeta(lastSegmentMeta, stream, streamMeta)
-	return catRangers, meta, nil
+	if s.parallelism.Download > 1 {
+		return &prefetchRanger{rangers: rangers, prefetch: s.parallelism.Download}, meta, nil
+	}
+	return ranger.Concat(rangers...), meta, nil
 }
 
 // Meta implements Store.Meta
@@ -393,3 +393,43 @@ func TestStreamStoreList(t *testing.T) {
 		assert.Equal(t, test.streamMore, more, errTag)
 	}
 }
+
+func TestStreamLayout(t *testing.T) {
+	for i, tt := range []struct {
+		stream pb.StreamInfo
+		layout []segmentLayout
+		size   int64
+	}{
+		{
+			stream: pb.StreamInfo{NumberOfSegments: 1, SegmentsSize: 10, LastSegmentSize: 5},
+			size:   5,
+		},
+		{
+			stream: pb.StreamInfo{NumberOfSegments: 3, SegmentsSize: 10, LastSegmentSize: 5},
+			layout: []segmentLayout{{size: 10, nonce: 1}, {size: 10, nonce: 2}},
+			size:   25,
+		},
+		{
+			stream: pb.StreamInfo{NumberOfSegments: 5, Parts: []*pb.StreamPart{
+				{NumberOfSegments: 3, SegmentsSize: 10, LastSegmentSize: 5},
+				{NumberOfSegments: 1, SegmentsSize: 20, LastSegmentSize: 7},
+			}},
+			layout: []segmentLayout{{size: 10, nonce: 1}, {size: 10, nonce: 2}, {size: 5, nonce: 3}, {size: 7, nonce: 1}},
+			size:   32,
+		},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		layout, err := streamLayout(tt.stream)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, tt.layout, layout, errTag)
+		assert.Equal(t, tt.size, StreamSize(tt.stream), errTag)
+	}
+
+	_, err := streamLayout(pb.StreamInfo{NumberOfSegments: 3, Parts: []*pb.StreamPart{
+		{NumberOfSegments: 1, SegmentsSize: 10, LastSegmentSize: 5},
+	}})
+	assert.Error(t, err)
+}
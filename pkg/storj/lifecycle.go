@@ -0,0 +1,17 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+// LifecycleRule expires the objects of a bucket, which are under a prefix,
+// after a number of days since they were uploaded.
+type LifecycleRule struct {
+	// ID identifies the rule within the bucket
+	ID string
+	// Prefix selects the objects the rule applies to. With encrypted paths,
+	// the prefix has to be empty or end with a slash. Previous versions of
+	// objects in versioned buckets are only expired by rules without a prefix.
+	Prefix Path
+	// ExpirationDays is the age in days after which the objects are deleted
+	ExpirationDays int
+}
@@ -22,18 +22,37 @@ type Metainfo interface {
 	GetBucket(ctx context.Context, bucket string) (Bucket, error)
 	// ListBuckets lists buckets starting from first
 	ListBuckets(ctx context.Context, options BucketListOptions) (BucketList, error)
+	// SetBucketLifecycle replaces the lifecycle rules of a bucket
+	SetBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error
+	// GetBucketLifecycle returns the lifecycle rules of a bucket
+	GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error)
+	// SetBucketPlacement replaces the placement policy of a bucket
+	SetBucketPlacement(ctx context.Context, bucket string, placement Placement) error
+	// GetBucketPlacement returns the placement policy of a bucket
+	GetBucketPlacement(ctx context.Context, bucket string) (Placement, error)
 
 	// GetObject returns information about an object
 	GetObject(ctx context.Context, bucket string, path Path) (Object, error)
 	// GetObjectStream returns interface for reading the object stream
 	GetObjectStream(ctx context.Context, bucket string, path Path) (ReadOnlyStream, error)
+	// GetObjectVersion returns information about a specific version of an object
+	GetObjectVersion(ctx context.Context, bucket string, path Path, versionID string) (Object, error)
+	// GetObjectVersionStream returns interface for reading the stream of a specific version of an object
+	GetObjectVersionStream(ctx context.Context, bucket string, path Path, versionID string) (ReadOnlyStream, error)
 
 	// CreateObject creates a mutable object for uploading stream info
 	CreateObject(ctx context.Context, bucket string, path Path, info *CreateObject) (MutableObject, error)
 	// ModifyObject creates a mutable object for updating a partially uploaded object
 	ModifyObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// DeleteObject deletes an object from database
+	// In versioned buckets it creates a delete marker instead
 	DeleteObject(ctx context.Context, bucket string, path Path) error
+	// DeleteObjectVersion permanently deletes a specific version of an object from database
+	DeleteObjectVersion(ctx context.Context, bucket string, path Path, versionID string) error
+	// MoveObject moves an object to a new path without re-uploading its data
+	MoveObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path) error
+	// ConcatObjects concatenates objects into a new object without re-uploading their data
+	ConcatObjects(ctx context.Context, bucket string, paths []Path, newPath Path, createInfo *CreateObject) error
 	// ListObjects lists objects in bucket based on the ListOptions
 	ListObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 
@@ -95,6 +114,12 @@ type ListOptions struct {
 	Recursive bool
 	Direction ListDirection
 	Limit     int
+
+	// Versions lists all versions of the objects, newest first.
+	// Only the Forward and After directions are supported.
+	Versions bool
+	// VersionCursor is the version of the object at Cursor to continue listing from
+	VersionCursor string
 }
 
 // ObjectList is a list of objects
@@ -123,12 +148,19 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 			Limit:     opts.Limit,
 		}
 	case After, Forward:
-		return ListOptions{
+		last := list.Items[len(list.Items)-1]
+		next := ListOptions{
 			Prefix:    opts.Prefix,
-			Cursor:    list.Items[len(list.Items)-1].Path,
+			Cursor:    last.Path,
 			Direction: After,
 			Limit:     opts.Limit,
 		}
+		if opts.Versions {
+			next.Recursive = opts.Recursive
+			next.Versions = true
+			next.VersionCursor = last.VersionID
+		}
+		return next
 	}
 
 	return ListOptions{}
@@ -32,6 +32,7 @@ type Bucket struct {
 	SegmentsSize         int64
 	RedundancyScheme     RedundancyScheme
 	EncryptionParameters EncryptionParameters
+	Versioning           bool
 }
 
 // Object contains information about a specific object
@@ -41,6 +42,13 @@ type Object struct {
 	Path     Path
 	IsPrefix bool
 
+	// VersionID identifies the version of the object in a versioned bucket
+	VersionID string
+	// IsLatest is set for the current version of the object
+	IsLatest bool
+	// IsDeleteMarker is set for versions, which record the deletion of the object
+	IsDeleteMarker bool
+
 	Metadata map[string]string
 
 	ContentType string
@@ -51,6 +59,22 @@ type Object struct {
 	Stream
 }
 
+// VersionsDir is the directory where the versions of objects in versioned buckets are stored
+const VersionsDir = ".storj-versions"
+
+// NullVersionID identifies the version of an object, which was stored without versioning
+const NullVersionID = "null"
+
+// StreamPath returns the path where the stream of the object is stored.
+// The latest version is stored at the path of the object, all previous
+// versions are stored in VersionsDir.
+func (object Object) StreamPath() Path {
+	if object.VersionID == "" || object.IsLatest {
+		return object.Path
+	}
+	return JoinPaths(VersionsDir, object.Path, object.VersionID)
+}
+
 // Stream is information about an object stream
 type Stream struct {
 	// Size is the total size of the stream in bytes
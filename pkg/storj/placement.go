@@ -0,0 +1,21 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+// Placement restricts the storage nodes, which store the segments uploaded to
+// a bucket. The same restrictions apply when the segments are repaired.
+type Placement struct {
+	// AllowedCountries are ISO 3166-1 alpha-2 country codes. When set, only
+	// nodes located in these countries are selected.
+	AllowedCountries []string
+	// DeniedCountries are country codes of countries where nodes aren't selected
+	DeniedCountries []string
+	// DeniedNetworks are networks in CIDR notation, e.g. "192.0.2.0/24",
+	// where nodes aren't selected
+	DeniedNetworks []string
+	// RequiredTags have to be declared by the operators of the selected nodes
+	RequiredTags []string
+	// DistinctSubnets selects at most one node per /24 subnet
+	DistinctSubnets bool
+}
@@ -49,7 +49,7 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 			return errs.Combine(err, reader.CloseWithError(err))
 		}
 
-		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
+		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.StreamPath()), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
 		if err != nil {
 			return errs.Combine(err, reader.CloseWithError(err))
 		}
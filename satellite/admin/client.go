@@ -0,0 +1,188 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/zeebo/errs"
+)
+
+// Client is a client of the admin api.
+type Client struct {
+	address string
+	token   string
+	http    http.Client
+}
+
+// NewClient creates a client of the admin api listening on address.
+func NewClient(address, token string) *Client {
+	return &Client{
+		address: address,
+		token:   token,
+	}
+}
+
+// GetUser returns the user with given email and the projects of the user.
+func (client *Client) GetUser(ctx context.Context, email string) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var user User
+	err = client.do(ctx, http.MethodGet, "/api/users/"+url.PathEscape(email), nil, nil, &user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// SuspendUser prevents the user from logging in to the console.
+// The api keys of the projects of the user keep working until they are revoked.
+func (client *Client) SuspendUser(ctx context.Context, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.do(ctx, http.MethodPut, "/api/users/"+url.PathEscape(email)+"/suspend", nil, nil, nil)
+}
+
+// UnsuspendUser lets a suspended user log in to the console again.
+func (client *Client) UnsuspendUser(ctx context.Context, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.do(ctx, http.MethodPut, "/api/users/"+url.PathEscape(email)+"/unsuspend", nil, nil, nil)
+}
+
+// DeleteUser deletes the user account.
+func (client *Client) DeleteUser(ctx context.Context, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.do(ctx, http.MethodDelete, "/api/users/"+url.PathEscape(email), nil, nil, nil)
+}
+
+// GetProject returns the project together with its limits and usage.
+func (client *Client) GetProject(ctx context.Context, projectID string) (_ *Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var project Project
+	err = client.do(ctx, http.MethodGet, "/api/projects/"+url.PathEscape(projectID), nil, nil, &project)
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// UpdateProjectLimits changes the usage limits of the project.
+func (client *Client) UpdateProjectLimits(ctx context.Context, projectID string, update LimitsUpdate) (_ *LimitsUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var limits LimitsUsage
+	err = client.do(ctx, http.MethodPut, "/api/projects/"+url.PathEscape(projectID)+"/limits", nil, update, &limits)
+	if err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+// ListAPIKeys returns the api keys of the project.
+func (client *Client) ListAPIKeys(ctx context.Context, projectID string) (_ []APIKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var keys []APIKey
+	err = client.do(ctx, http.MethodGet, "/api/projects/"+url.PathEscape(projectID)+"/apikeys", nil, nil, &keys)
+	return keys, err
+}
+
+// RevokeAPIKey deletes the api key.
+func (client *Client) RevokeAPIKey(ctx context.Context, keyID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.do(ctx, http.MethodDelete, "/api/apikeys/"+url.PathEscape(keyID), nil, nil, nil)
+}
+
+// GetNode returns the node information tracked by the overlay.
+func (client *Client) GetNode(ctx context.Context, nodeID string) (_ *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var node Node
+	err = client.do(ctx, http.MethodGet, "/api/nodes/"+url.PathEscape(nodeID), nil, nil, &node)
+	if err != nil {
+		return nil, err
+	}
+	return &node, nil
+}
+
+// DisqualifyNode disqualifies the node.
+func (client *Client) DisqualifyNode(ctx context.Context, nodeID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.do(ctx, http.MethodPut, "/api/nodes/"+url.PathEscape(nodeID)+"/disqualify", nil, nil, nil)
+}
+
+// ReinstateNode removes the disqualification of the node.
+func (client *Client) ReinstateNode(ctx context.Context, nodeID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.do(ctx, http.MethodPut, "/api/nodes/"+url.PathEscape(nodeID)+"/reinstate", nil, nil, nil)
+}
+
+// GetSegment returns the pointer of the segment at path and the health of its pieces.
+func (client *Client) GetSegment(ctx context.Context, path string) (_ *Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var segment Segment
+	err = client.do(ctx, http.MethodGet, "/api/segments", url.Values{"path": {path}}, nil, &segment)
+	if err != nil {
+		return nil, err
+	}
+	return &segment, nil
+}
+
+// RepairSegment adds the segment at path to the repair queue.
+func (client *Client) RepairSegment(ctx context.Context, path string) (_ *Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var segment Segment
+	err = client.do(ctx, http.MethodPost, "/api/segments/repair", url.Values{"path": {path}}, nil, &segment)
+	if err != nil {
+		return nil, err
+	}
+	return &segment, nil
+}
+
+// do sends the request with body encoded as json and decodes the response into result.
+func (client *Client) do(ctx context.Context, method, path string, query url.Values, body, result interface{}) (err error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	// path elements are already escaped by the callers
+	target := "http://" + client.address + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, target, reader)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set(authorization, client.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.http.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = Error.Wrap(errs.Combine(err, resp.Body.Close())) }()
+
+	if resp.StatusCode >= 400 {
+		var failure errorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&failure); err != nil || failure.Error == "" {
+			return Error.New("%s", resp.Status)
+		}
+		return Error.New("%s: %s", resp.Status, failure.Error)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
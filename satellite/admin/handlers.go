@@ -0,0 +1,652 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gorilla/mux"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+)
+
+// errorResponse is the body of failed requests.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// User describes a console user and the projects the user is a member of.
+type User struct {
+	ID         string    `json:"id"`
+	Email      string    `json:"email"`
+	FullName   string    `json:"fullName"`
+	ShortName  string    `json:"shortName"`
+	Status     string    `json:"status"`
+	MFAEnabled bool      `json:"mfaEnabled"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Projects   []Project `json:"projects"`
+}
+
+// Project describes a project and its usage limits.
+type Project struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	Limits      *LimitsUsage `json:"limits,omitempty"`
+}
+
+// Limits contains the usage limits of a project or how much of them is used.
+type Limits struct {
+	Storage   int64 `json:"storage"`
+	Bandwidth int64 `json:"bandwidth"`
+	Segments  int64 `json:"segments"`
+	Buckets   int64 `json:"buckets"`
+}
+
+// LimitsUsage contains the usage limits of a project together with the current usage.
+type LimitsUsage struct {
+	// Custom are the limits set for the project, zero values mean the satellite default is used.
+	Custom Limits `json:"custom"`
+	// Effective are the limits enforced for the project.
+	Effective Limits `json:"effective"`
+	Usage     Limits `json:"usage"`
+}
+
+// LimitsUpdate changes the usage limits of a project. Nil values are left unchanged,
+// zero values reset the limit to the satellite default.
+type LimitsUpdate struct {
+	Storage   *int64 `json:"storage,omitempty"`
+	Bandwidth *int64 `json:"bandwidth,omitempty"`
+	Segments  *int64 `json:"segments,omitempty"`
+	Buckets   *int64 `json:"buckets,omitempty"`
+}
+
+// APIKey describes an api key of a project.
+type APIKey struct {
+	ID        string    `json:"id"`
+	ProjectID string    `json:"projectId"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Node describes a storage node known to the satellite.
+type Node struct {
+	ID                 string     `json:"id"`
+	Address            string     `json:"address"`
+	Email              string     `json:"email"`
+	Wallet             string     `json:"wallet"`
+	Version            string     `json:"version"`
+	Contained          bool       `json:"contained"`
+	Disqualified       *time.Time `json:"disqualified"`
+	AuditCount         int64      `json:"auditCount"`
+	AuditSuccessCount  int64      `json:"auditSuccessCount"`
+	AuditReputation    float64    `json:"auditReputation"`
+	UptimeCount        int64      `json:"uptimeCount"`
+	UptimeSuccessCount int64      `json:"uptimeSuccessCount"`
+	UptimeReputation   float64    `json:"uptimeReputation"`
+	LastContactSuccess time.Time  `json:"lastContactSuccess"`
+	LastContactFailure time.Time  `json:"lastContactFailure"`
+}
+
+// Segment describes the pointer of a segment and the health of its pieces.
+type Segment struct {
+	Path           string     `json:"path"`
+	Type           string     `json:"type"`
+	SegmentSize    int64      `json:"segmentSize"`
+	CreationDate   time.Time  `json:"creationDate"`
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+
+	Redundancy    *Redundancy `json:"redundancy,omitempty"`
+	Pieces        []Piece     `json:"pieces,omitempty"`
+	HealthyPieces int         `json:"healthyPieces"`
+	// NeedsRepair is true when the checker would queue the segment for repair.
+	NeedsRepair bool `json:"needsRepair"`
+	// Irreparable is true when there aren't enough healthy pieces to recover the segment.
+	Irreparable bool `json:"irreparable"`
+}
+
+// Redundancy is the erasure coding scheme of a remote segment.
+type Redundancy struct {
+	MinReq           int32 `json:"minReq"`
+	RepairThreshold  int32 `json:"repairThreshold"`
+	SuccessThreshold int32 `json:"successThreshold"`
+	Total            int32 `json:"total"`
+	ShareSize        int32 `json:"shareSize"`
+}
+
+// Piece describes a piece of a remote segment.
+type Piece struct {
+	Number  int32  `json:"number"`
+	NodeID  string `json:"nodeId"`
+	Healthy bool   `json:"healthy"`
+}
+
+// getUser returns the user with given email and the projects of the user
+func (s *Server) getUser(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	user, projects, err := s.console.LookupUser(ctx, mux.Vars(req)["email"])
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	result := User{
+		ID:         user.ID.String(),
+		Email:      user.Email,
+		FullName:   user.FullName,
+		ShortName:  user.ShortName,
+		Status:     userStatus(user.Status),
+		MFAEnabled: user.MFAEnabled,
+		CreatedAt:  user.CreatedAt,
+	}
+	for _, project := range projects {
+		result.Projects = append(result.Projects, Project{
+			ID:          project.ID.String(),
+			Name:        project.Name,
+			Description: project.Description,
+			CreatedAt:   project.CreatedAt,
+		})
+	}
+
+	s.serveJSON(w, result)
+}
+
+// suspendUser prevents the user from logging in to the console, it doesn't revoke the api keys of the user
+func (s *Server) suspendUser(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	user, _, err := s.console.LookupUser(ctx, mux.Vars(req)["email"])
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	if err = s.console.SuspendUser(ctx, user.ID); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// unsuspendUser lets a suspended user log in to the console again
+func (s *Server) unsuspendUser(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	user, _, err := s.console.LookupUser(ctx, mux.Vars(req)["email"])
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	if err = s.console.UnsuspendUser(ctx, user.ID); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteUser deletes the user account
+func (s *Server) deleteUser(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	user, _, err := s.console.LookupUser(ctx, mux.Vars(req)["email"])
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	if err = s.console.DeleteUser(ctx, user.ID); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getProject returns the project together with its limits and usage
+func (s *Server) getProject(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, ok := s.parseUUID(w, mux.Vars(req)["project"])
+	if !ok {
+		return
+	}
+
+	project, err := s.console.LookupProject(ctx, projectID)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	limits, err := s.getLimitsUsage(req, projectID)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	s.serveJSON(w, Project{
+		ID:          project.ID.String(),
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt,
+		Limits:      limits,
+	})
+}
+
+// updateProjectLimits changes the usage limits of the project
+func (s *Server) updateProjectLimits(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, ok := s.parseUUID(w, mux.Vars(req)["project"])
+	if !ok {
+		return
+	}
+
+	var update LimitsUpdate
+	if err = json.NewDecoder(req.Body).Decode(&update); err != nil {
+		s.serveError(w, http.StatusBadRequest, errs.New("invalid limits: %v", err))
+		return
+	}
+
+	if _, err = s.console.LookupProject(ctx, projectID); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	limits, err := s.projectUsage.GetCustomProjectLimits(ctx, projectID)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	if update.Storage != nil {
+		limits.Storage = memory.Size(*update.Storage)
+	}
+	if update.Bandwidth != nil {
+		limits.Bandwidth = memory.Size(*update.Bandwidth)
+	}
+	if update.Segments != nil {
+		limits.Segments = *update.Segments
+	}
+	if update.Buckets != nil {
+		limits.Buckets = *update.Buckets
+	}
+
+	if limits.Storage < 0 || limits.Bandwidth < 0 || limits.Segments < 0 || limits.Buckets < 0 {
+		s.serveError(w, http.StatusBadRequest, errs.New("limits can not be negative"))
+		return
+	}
+
+	if err = s.projectUsage.SetProjectLimits(ctx, projectID, limits); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	s.log.Info("changed project limits", zap.Stringer("project", projectID))
+
+	result, err := s.getLimitsUsage(req, projectID)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	s.serveJSON(w, result)
+}
+
+// getLimitsUsage returns the custom and effective limits of the project together with its usage
+func (s *Server) getLimitsUsage(req *http.Request, projectID uuid.UUID) (_ *LimitsUsage, err error) {
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	custom, err := s.projectUsage.GetCustomProjectLimits(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.projectUsage.GetProjectLimitUsage(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LimitsUsage{
+		Custom:    toLimits(custom),
+		Effective: toLimits(usage.Limits),
+		Usage: Limits{
+			Storage:   usage.Storage.Int64(),
+			Bandwidth: usage.Bandwidth.Int64(),
+			Segments:  usage.Segments,
+			Buckets:   usage.Buckets,
+		},
+	}, nil
+}
+
+// listAPIKeys returns the api keys of the project
+func (s *Server) listAPIKeys(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, ok := s.parseUUID(w, mux.Vars(req)["project"])
+	if !ok {
+		return
+	}
+
+	keys, err := s.console.ListAPIKeys(ctx, projectID)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	result := []APIKey{}
+	for _, key := range keys {
+		result = append(result, APIKey{
+			ID:        key.ID.String(),
+			ProjectID: key.ProjectID.String(),
+			Name:      key.Name,
+			CreatedAt: key.CreatedAt,
+		})
+	}
+
+	s.serveJSON(w, result)
+}
+
+// revokeAPIKey deletes the api key
+func (s *Server) revokeAPIKey(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	keyID, ok := s.parseUUID(w, mux.Vars(req)["apikey"])
+	if !ok {
+		return
+	}
+
+	if err = s.console.RevokeAPIKey(ctx, keyID); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getNode returns the node information tracked by the overlay
+func (s *Server) getNode(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, ok := s.parseNodeID(w, mux.Vars(req)["node"])
+	if !ok {
+		return
+	}
+
+	node, err := s.overlay.Get(ctx, nodeID)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	stats := node.Reputation
+	s.serveJSON(w, Node{
+		ID:                 node.Id.String(),
+		Address:            node.Address.GetAddress(),
+		Email:              node.Operator.Email,
+		Wallet:             node.Operator.Wallet,
+		Version:            node.Version.Version,
+		Contained:          node.Contained,
+		Disqualified:       node.Disqualified,
+		AuditCount:         stats.AuditCount,
+		AuditSuccessCount:  stats.AuditSuccessCount,
+		AuditReputation:    reputation(stats.AuditReputationAlpha, stats.AuditReputationBeta),
+		UptimeCount:        stats.UptimeCount,
+		UptimeSuccessCount: stats.UptimeSuccessCount,
+		UptimeReputation:   reputation(stats.UptimeReputationAlpha, stats.UptimeReputationBeta),
+		LastContactSuccess: stats.LastContactSuccess,
+		LastContactFailure: stats.LastContactFailure,
+	})
+}
+
+// disqualifyNode disqualifies the node
+func (s *Server) disqualifyNode(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, ok := s.parseNodeID(w, mux.Vars(req)["node"])
+	if !ok {
+		return
+	}
+
+	if err = s.overlay.DisqualifyNode(ctx, nodeID); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	s.log.Info("disqualified node", zap.Stringer("node", nodeID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reinstateNode removes the disqualification of the node
+func (s *Server) reinstateNode(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, ok := s.parseNodeID(w, mux.Vars(req)["node"])
+	if !ok {
+		return
+	}
+
+	if err = s.overlay.ReinstateNode(ctx, nodeID); err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	s.log.Info("reinstated node", zap.Stringer("node", nodeID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getSegment returns the pointer of the segment and the health of its pieces
+func (s *Server) getSegment(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	path := mux.Vars(req)["path"]
+
+	segment, _, err := s.inspectSegment(req, path)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	s.serveJSON(w, segment)
+}
+
+// repairSegment adds the segment to the repair queue
+func (s *Server) repairSegment(w http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	path := mux.Vars(req)["path"]
+
+	segment, missing, err := s.inspectSegment(req, path)
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	if segment.Redundancy == nil {
+		s.serveError(w, http.StatusBadRequest, errs.New("inline segments can not be repaired"))
+		return
+	}
+
+	err = s.repairQueue.Insert(ctx, &pb.InjuredSegment{
+		Path:       path,
+		LostPieces: missing,
+	}, segment.HealthyPieces-int(segment.Redundancy.MinReq))
+	if err != nil {
+		s.serveServiceError(w, err)
+		return
+	}
+
+	s.log.Info("queued segment for repair", zap.String("path", path))
+	s.serveJSON(w, segment)
+}
+
+// inspectSegment looks up the pointer at path and checks which of its pieces are healthy
+func (s *Server) inspectSegment(req *http.Request, path string) (_ *Segment, missing []int32, err error) {
+	ctx := req.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := s.metainfo.Get(ctx, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	segment := &Segment{
+		Path:        path,
+		Type:        pointer.Type.String(),
+		SegmentSize: pointer.SegmentSize,
+	}
+	if pointer.CreationDate != nil {
+		segment.CreationDate, err = ptypes.Timestamp(pointer.CreationDate)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if pointer.ExpirationDate != nil {
+		expiration, err := ptypes.Timestamp(pointer.ExpirationDate)
+		if err != nil {
+			return nil, nil, err
+		}
+		segment.ExpirationDate = &expiration
+	}
+
+	remote := pointer.GetRemote()
+	if pointer.Type != pb.Pointer_REMOTE || remote == nil {
+		return segment, nil, nil
+	}
+
+	redundancy := remote.GetRedundancy()
+	segment.Redundancy = &Redundancy{
+		MinReq:           redundancy.GetMinReq(),
+		RepairThreshold:  redundancy.GetRepairThreshold(),
+		SuccessThreshold: redundancy.GetSuccessThreshold(),
+		Total:            redundancy.GetTotal(),
+		ShareSize:        redundancy.GetErasureShareSize(),
+	}
+
+	missing, err = s.overlay.GetMissingPieces(ctx, remote.GetRemotePieces())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missingSet := make(map[int32]bool, len(missing))
+	for _, number := range missing {
+		missingSet[number] = true
+	}
+
+	for _, piece := range remote.GetRemotePieces() {
+		healthy := !missingSet[piece.GetPieceNum()]
+		if healthy {
+			segment.HealthyPieces++
+		}
+		segment.Pieces = append(segment.Pieces, Piece{
+			Number:  piece.GetPieceNum(),
+			NodeID:  piece.NodeId.String(),
+			Healthy: healthy,
+		})
+	}
+
+	// same conditions as used by the checker
+	healthy := int32(segment.HealthyPieces)
+	segment.Irreparable = healthy < redundancy.GetMinReq()
+	segment.NeedsRepair = !segment.Irreparable &&
+		healthy <= redundancy.GetRepairThreshold() &&
+		healthy < redundancy.GetSuccessThreshold()
+
+	return segment, missing, nil
+}
+
+// parseUUID parses the id and writes an error response when it's invalid
+func (s *Server) parseUUID(w http.ResponseWriter, value string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		s.serveError(w, http.StatusBadRequest, errs.New("invalid id %q", value))
+		return uuid.UUID{}, false
+	}
+	return *id, true
+}
+
+// parseNodeID parses the node id and writes an error response when it's invalid
+func (s *Server) parseNodeID(w http.ResponseWriter, value string) (storj.NodeID, bool) {
+	nodeID, err := storj.NodeIDFromString(value)
+	if err != nil {
+		s.serveError(w, http.StatusBadRequest, errs.New("invalid node id %q", value))
+		return storj.NodeID{}, false
+	}
+	return nodeID, true
+}
+
+// toLimits converts project limits to their json representation
+func toLimits(limits accounting.ProjectLimits) Limits {
+	return Limits{
+		Storage:   limits.Storage.Int64(),
+		Bandwidth: limits.Bandwidth.Int64(),
+		Segments:  limits.Segments,
+		Buckets:   limits.Buckets,
+	}
+}
+
+// reputation calculates the reputation score from the beta distribution parameters
+func reputation(alpha, beta float64) float64 {
+	if alpha+beta == 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
+}
+
+// userStatus returns the name of the user status
+func userStatus(status console.UserStatus) string {
+	switch status {
+	case console.Inactive:
+		return "inactive"
+	case console.Active:
+		return "active"
+	case console.Deleted:
+		return "deleted"
+	case console.Suspended:
+		return "suspended"
+	default:
+		return "unknown"
+	}
+}
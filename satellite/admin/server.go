@@ -0,0 +1,172 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is satellite admin error type
+	Error = errs.Class("satellite admin error")
+)
+
+// authorization is the header containing the admin auth token
+const authorization = "Authorization"
+
+// Config contains configuration for the admin api server
+type Config struct {
+	Address   string `help:"server address of the admin api, the api is disabled when empty" default:""`
+	AuthToken string `help:"auth token needed for access to the admin api" default:""`
+}
+
+// Server serves the admin api, which lets satellite operators manage users, projects, nodes and segments.
+//
+// The server doesn't access the database directly, the requests are handled by
+// the same services that are used by the rest of the satellite.
+type Server struct {
+	log      *zap.Logger
+	config   Config
+	listener net.Listener
+	server   http.Server
+
+	console      *console.Service
+	projectUsage *accounting.ProjectUsage
+	overlay      *overlay.Cache
+	metainfo     *metainfo.Service
+	repairQueue  queue.RepairQueue
+}
+
+// NewServer creates new instance of admin api server
+func NewServer(log *zap.Logger, config Config, listener net.Listener, console *console.Service, projectUsage *accounting.ProjectUsage, overlay *overlay.Cache, metainfo *metainfo.Service, repairQueue queue.RepairQueue) (*Server, error) {
+	if config.AuthToken == "" {
+		return nil, Error.New("auth token is required")
+	}
+
+	s := &Server{
+		log:          log,
+		config:       config,
+		listener:     listener,
+		console:      console,
+		projectUsage: projectUsage,
+		overlay:      overlay,
+		metainfo:     metainfo,
+		repairQueue:  repairQueue,
+	}
+
+	log.Sugar().Debugf("Starting Admin API on %s...", listener.Addr().String())
+
+	router := mux.NewRouter()
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(s.authorize)
+
+	api.HandleFunc("/users/{email}", s.getUser).Methods(http.MethodGet)
+	api.HandleFunc("/users/{email}", s.deleteUser).Methods(http.MethodDelete)
+	api.HandleFunc("/users/{email}/suspend", s.suspendUser).Methods(http.MethodPut)
+	api.HandleFunc("/users/{email}/unsuspend", s.unsuspendUser).Methods(http.MethodPut)
+
+	api.HandleFunc("/projects/{project}", s.getProject).Methods(http.MethodGet)
+	api.HandleFunc("/projects/{project}/limits", s.updateProjectLimits).Methods(http.MethodPut)
+	api.HandleFunc("/projects/{project}/apikeys", s.listAPIKeys).Methods(http.MethodGet)
+	api.HandleFunc("/apikeys/{apikey}", s.revokeAPIKey).Methods(http.MethodDelete)
+
+	api.HandleFunc("/nodes/{node}", s.getNode).Methods(http.MethodGet)
+	api.HandleFunc("/nodes/{node}/disqualify", s.disqualifyNode).Methods(http.MethodPut)
+	api.HandleFunc("/nodes/{node}/reinstate", s.reinstateNode).Methods(http.MethodPut)
+
+	api.HandleFunc("/segments", s.getSegment).Methods(http.MethodGet).Queries("path", "{path}")
+	api.HandleFunc("/segments/repair", s.repairSegment).Methods(http.MethodPost).Queries("path", "{path}")
+
+	s.server.Handler = router
+
+	return s, nil
+}
+
+// Run starts the server that host admin api endpoints
+func (s *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return Error.Wrap(s.server.Shutdown(ctx))
+	})
+	group.Go(func() error {
+		defer cancel()
+		return Error.Wrap(s.server.Serve(s.listener))
+	})
+
+	return group.Wait()
+}
+
+// Close closes server and underlying listener
+func (s *Server) Close() error {
+	return Error.Wrap(s.server.Close())
+}
+
+// authorize rejects requests without the admin auth token
+// and adds the ip address of the admin to the request context for the audit log
+func (s *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := req.Header.Get(authorization)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.config.AuthToken)) != 1 {
+			s.serveError(w, http.StatusUnauthorized, errs.New("unauthorized"))
+			return
+		}
+
+		ip, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			ip = req.RemoteAddr
+		}
+		next.ServeHTTP(w, req.WithContext(console.WithClientIP(req.Context(), ip)))
+	})
+}
+
+// serveJSON writes v as the json response body
+func (s *Server) serveJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.log.Error("failed to write json response", zap.Error(err))
+	}
+}
+
+// serveError writes the error as json response with given status code
+func (s *Server) serveError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(errorResponse{Error: err.Error()}); err != nil {
+		s.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
+
+// serveServiceError writes the error returned by one of the satellite services
+func (s *Server) serveServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrNotFound.Has(err), overlay.ErrNodeNotFound.Has(err), storage.ErrKeyNotFound.Has(err):
+		s.serveError(w, http.StatusNotFound, err)
+	case console.ErrValidation.Has(err):
+		s.serveError(w, http.StatusBadRequest, err)
+	default:
+		s.log.Error("admin request failed", zap.Error(err))
+		s.serveError(w, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+const authToken = "very-secret-admin-token"
+
+func newClient(sat *satellite.Peer) *admin.Client {
+	return admin.NewClient(sat.Admin.Listener.Addr().String(), authToken)
+}
+
+func TestUnauthorized(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		nodeID := planet.StorageNodes[0].ID().String()
+
+		for _, token := range []string{"", "wrong-token"} {
+			client := admin.NewClient(sat.Admin.Listener.Addr().String(), token)
+			_, err := client.GetNode(ctx, nodeID)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "401")
+		}
+
+		node, err := newClient(sat).GetNode(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, nodeID, node.ID)
+	})
+}
+
+func TestUsers(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Console.Service
+		client := newClient(sat)
+
+		createUser := console.CreateUser{
+			UserInfo: console.UserInfo{
+				FullName:  "John Roll",
+				ShortName: "Roll",
+				Email:     "test@mail.test",
+			},
+			Password: "123a123",
+		}
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		user, err := service.CreateUser(ctx, createUser, regToken.Secret)
+		require.NoError(t, err)
+
+		activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+		require.NoError(t, err)
+		require.NoError(t, service.ActivateAccount(ctx, activationToken))
+
+		login := func() error {
+			_, err := service.Token(ctx, console.AuthUser{Email: createUser.Email, Password: createUser.Password})
+			return err
+		}
+
+		info, err := client.GetUser(ctx, createUser.Email)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID.String(), info.ID)
+		assert.Equal(t, "active", info.Status)
+		assert.Empty(t, info.Projects)
+
+		require.NoError(t, client.SuspendUser(ctx, createUser.Email))
+		require.True(t, console.ErrUnauthorized.Has(login()))
+
+		info, err = client.GetUser(ctx, createUser.Email)
+		require.NoError(t, err)
+		assert.Equal(t, "suspended", info.Status)
+
+		require.NoError(t, client.UnsuspendUser(ctx, createUser.Email))
+		require.NoError(t, login())
+
+		require.NoError(t, client.DeleteUser(ctx, createUser.Email))
+
+		_, err = client.GetUser(ctx, createUser.Email)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "404")
+
+		var actions []console.AuditAction
+		err = sat.DB.Console().AuditLogs().Iterate(ctx, time.Time{}, time.Now().Add(time.Hour), func(ctx context.Context, entry console.AuditLogEntry) error {
+			if entry.ActorID == console.AdminActorID {
+				assert.Equal(t, createUser.Email, entry.Target)
+				assert.NotEmpty(t, entry.IPAddress)
+				actions = append(actions, entry.Action)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []console.AuditAction{
+			console.AuditAdminUserSuspend,
+			console.AuditAdminUserUnsuspend,
+			console.AuditAdminUserDelete,
+		}, actions)
+	})
+}
+
+func TestProjects(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		client := newClient(sat)
+
+		projects, err := sat.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		projectID := projects[0].ID.String()
+
+		project, err := client.GetProject(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, projects[0].Name, project.Name)
+		require.NotNil(t, project.Limits)
+		assert.Equal(t, admin.Limits{}, project.Limits.Custom)
+
+		storageLimit, buckets := int64(5*memory.GB), int64(3)
+		limits, err := client.UpdateProjectLimits(ctx, projectID, admin.LimitsUpdate{
+			Storage: &storageLimit,
+			Buckets: &buckets,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, storageLimit, limits.Custom.Storage)
+		assert.Equal(t, buckets, limits.Custom.Buckets)
+		assert.Equal(t, storageLimit, limits.Effective.Storage)
+		assert.Equal(t, buckets, limits.Effective.Buckets)
+
+		negative := int64(-1)
+		_, err = client.UpdateProjectLimits(ctx, projectID, admin.LimitsUpdate{Segments: &negative})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "400")
+
+		// a partial update keeps the other custom limits
+		segments := int64(100)
+		limits, err = client.UpdateProjectLimits(ctx, projectID, admin.LimitsUpdate{Segments: &segments})
+		require.NoError(t, err)
+		assert.Equal(t, storageLimit, limits.Custom.Storage)
+		assert.Equal(t, segments, limits.Custom.Segments)
+
+		keys, err := client.ListAPIKeys(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "root", keys[0].Name)
+
+		require.NoError(t, client.RevokeAPIKey(ctx, keys[0].ID))
+
+		keys, err = client.ListAPIKeys(ctx, projectID)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+
+		auditLog, err := sat.DB.Console().AuditLogs().GetByProjectID(ctx, projects[0].ID, console.AuditLogCursor{Limit: 1, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, auditLog.Entries, 1)
+		assert.Equal(t, console.AuditAdminAPIKeyRevoke, auditLog.Entries[0].Action)
+		assert.Equal(t, console.AdminActorID, auditLog.Entries[0].ActorID)
+		assert.Equal(t, "root", auditLog.Entries[0].Target)
+
+		err = planet.Uplinks[0].Upload(ctx, sat, "testbucket", "test/path", testrand.Bytes(memory.KiB))
+		require.Error(t, err)
+
+		_, err = client.GetProject(ctx, testrand.UUID().String())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "404")
+	})
+}
+
+func TestNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		client := newClient(sat)
+		nodeID := planet.StorageNodes[0].ID()
+
+		require.NoError(t, client.DisqualifyNode(ctx, nodeID.String()))
+
+		node, err := client.GetNode(ctx, nodeID.String())
+		require.NoError(t, err)
+		assert.NotNil(t, node.Disqualified)
+
+		dossier, err := sat.Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.NotNil(t, dossier.Disqualified)
+
+		require.NoError(t, client.ReinstateNode(ctx, nodeID.String()))
+
+		dossier, err = sat.Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Nil(t, dossier.Disqualified)
+
+		err = client.DisqualifyNode(ctx, testrand.NodeID().String())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "404")
+	})
+}
+
+func TestSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		client := newClient(sat)
+
+		sat.Repair.Checker.Loop.Pause()
+		sat.Repair.Repairer.Loop.Pause()
+
+		err := planet.Uplinks[0].Upload(ctx, sat, "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		var path string
+		err = sat.Metainfo.Database.Iterate(ctx, storage.IterateOptions{Recurse: true},
+			func(ctx context.Context, it storage.Iterator) error {
+				// skip bucket metadata and find the last segment of the uploaded object
+				var item storage.ListItem
+				for it.Next(ctx, &item) {
+					parts := storj.SplitPath(item.Key.String())
+					if len(parts) >= 4 && parts[1] == "l" {
+						path = item.Key.String()
+					}
+				}
+				return nil
+			})
+		require.NoError(t, err)
+		require.NotEmpty(t, path)
+
+		segment, err := client.GetSegment(ctx, path)
+		require.NoError(t, err)
+		assert.Equal(t, path, segment.Path)
+		assert.Equal(t, "REMOTE", segment.Type)
+		require.NotNil(t, segment.Redundancy)
+		assert.Len(t, segment.Pieces, segment.HealthyPieces)
+		assert.False(t, segment.NeedsRepair)
+
+		_, err = client.RepairSegment(ctx, path)
+		require.NoError(t, err)
+
+		injured, err := sat.DB.RepairQueue().Select(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, path, injured.Path)
+
+		_, err = client.GetSegment(ctx, path+"/missing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "404")
+	})
+}
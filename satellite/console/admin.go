@@ -0,0 +1,162 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// ErrNotFound is error type of looking up an entity that doesn't exist.
+var ErrNotFound = errs.Class("not found")
+
+// The methods below don't check authorization and are meant for
+// trusted callers only, like the satellite admin API.
+
+// LookupUser returns the user with given email together with the projects the user is a member of.
+func (s *Service) LookupUser(ctx context.Context, email string) (_ *User, _ []Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.store.Users().GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		return nil, nil, ErrNotFound.New("user %s", email)
+	}
+
+	projects, err := s.store.Projects().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, projects, nil
+}
+
+// SuspendUser prevents the user from logging in and invalidates their existing sessions.
+// Suspension only blocks the console, the api keys of the projects of the user keep working
+// until they are revoked with RevokeAPIKey.
+func (s *Service) SuspendUser(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.setUserStatus(ctx, userID, Suspended, AuditAdminUserSuspend)
+}
+
+// UnsuspendUser lets a suspended user log in again.
+func (s *Service) UnsuspendUser(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.setUserStatus(ctx, userID, Active, AuditAdminUserUnsuspend)
+}
+
+// setUserStatus changes the status of the user and records the action in the audit log.
+func (s *Service) setUserStatus(ctx context.Context, userID uuid.UUID, status UserStatus, action AuditAction) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.store.Users().Get(ctx, userID)
+	if err != nil {
+		return ErrNotFound.New("user %s", userID)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = withTx(tx, func(tx DBTx) error {
+		user.Status = status
+		if err := tx.Users().Update(ctx, user); err != nil {
+			return err
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, AdminActorID, action, nil, user.Email))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("user status changed by admin", zap.Stringer("user", userID), zap.Int("status", int(status)))
+	return nil
+}
+
+// DeleteUser deletes the user account. Unlike DeleteAccount it doesn't require the password of the user.
+func (s *Service) DeleteUser(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.store.Users().Get(ctx, userID)
+	if err != nil {
+		return ErrNotFound.New("user %s", userID)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = withTx(tx, func(tx DBTx) error {
+		if err := tx.Users().Delete(ctx, userID); err != nil {
+			return err
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, AdminActorID, AuditAdminUserDelete, nil, user.Email))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("user deleted by admin", zap.Stringer("user", userID))
+	return nil
+}
+
+// LookupProject returns the project with given id.
+func (s *Service) LookupProject(ctx context.Context, projectID uuid.UUID) (_ *Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := s.store.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, ErrNotFound.New("project %s", projectID)
+	}
+	return project, nil
+}
+
+// ListAPIKeys returns the api keys of the project.
+func (s *Service) ListAPIKeys(ctx context.Context, projectID uuid.UUID) (_ []APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err = s.LookupProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	return s.store.APIKeys().GetByProjectID(ctx, projectID)
+}
+
+// RevokeAPIKey deletes the api key, the uplinks using it lose access immediately.
+func (s *Service) RevokeAPIKey(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := s.store.APIKeys().Get(ctx, id)
+	if err != nil {
+		return ErrNotFound.New("api key %s", id)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = withTx(tx, func(tx DBTx) error {
+		if err := tx.APIKeys().Delete(ctx, id); err != nil {
+			return err
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, AdminActorID, AuditAdminAPIKeyRevoke, &key.ProjectID, key.Name))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("api key revoked by admin", zap.Stringer("key", id), zap.Stringer("project", key.ProjectID))
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+)
+
+// AuditLogs exposes methods to manage the append-only audit log.
+type AuditLogs interface {
+	// Insert is a method for appending an entry to the audit log.
+	Insert(ctx context.Context, entry *AuditLogEntry) (*AuditLogEntry, error)
+	// GetByProjectID is a method for querying a page of audit log entries of a project, newest first.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID, cursor AuditLogCursor) (*AuditLogPage, error)
+	// Iterate calls fn for every audit log entry created in [since, before), oldest first.
+	Iterate(ctx context.Context, since, before time.Time, fn func(context.Context, AuditLogEntry) error) error
+}
+
+// AuditAction describes a mutation recorded in the audit log.
+type AuditAction string
+
+const (
+	// AuditUserCreate is recorded when a user account is created.
+	AuditUserCreate AuditAction = "user.create"
+	// AuditUserActivate is recorded when a user account is activated.
+	AuditUserActivate AuditAction = "user.activate"
+	// AuditUserUpdate is recorded when a user changes their account info.
+	AuditUserUpdate AuditAction = "user.update"
+	// AuditUserDelete is recorded when a user account is deleted.
+	AuditUserDelete AuditAction = "user.delete"
+	// AuditPasswordChange is recorded when a user changes their password.
+	AuditPasswordChange AuditAction = "user.password.change"
+	// AuditPasswordReset is recorded when a password is reset with a reset token.
+	AuditPasswordReset AuditAction = "user.password.reset"
+	// AuditMFAEnable is recorded when two-factor authentication is enabled.
+	AuditMFAEnable AuditAction = "user.mfa.enable"
+	// AuditMFADisable is recorded when two-factor authentication is disabled.
+	AuditMFADisable AuditAction = "user.mfa.disable"
+	// AuditMFARecoveryCodesReset is recorded when recovery codes are regenerated.
+	AuditMFARecoveryCodesReset AuditAction = "user.mfa.recovery_codes.reset"
+
+	// AuditProjectCreate is recorded when a project is created.
+	AuditProjectCreate AuditAction = "project.create"
+	// AuditProjectUpdate is recorded when a project description is changed.
+	AuditProjectUpdate AuditAction = "project.update"
+	// AuditProjectDelete is recorded when a project is deleted.
+	AuditProjectDelete AuditAction = "project.delete"
+	// AuditProjectOwnershipTransfer is recorded when a project changes owner.
+	AuditProjectOwnershipTransfer AuditAction = "project.ownership.transfer"
+
+	// AuditMemberInvite is recorded when a user is invited to a project.
+	AuditMemberInvite AuditAction = "member.invite"
+	// AuditMemberInvitationAccept is recorded when a user accepts an invitation.
+	AuditMemberInvitationAccept AuditAction = "member.invitation.accept"
+	// AuditMemberInvitationDecline is recorded when a user declines an invitation.
+	AuditMemberInvitationDecline AuditAction = "member.invitation.decline"
+	// AuditMemberRoleUpdate is recorded when the role of a member is changed.
+	AuditMemberRoleUpdate AuditAction = "member.role.update"
+	// AuditMemberRemove is recorded when a member is removed from a project.
+	AuditMemberRemove AuditAction = "member.remove"
+
+	// AuditAPIKeyCreate is recorded when an api key is created.
+	AuditAPIKeyCreate AuditAction = "apikey.create"
+	// AuditAPIKeyDelete is recorded when an api key is deleted.
+	AuditAPIKeyDelete AuditAction = "apikey.delete"
+
+	// AuditAdminUserSuspend is recorded when an admin suspends a user.
+	AuditAdminUserSuspend AuditAction = "admin.user.suspend"
+	// AuditAdminUserUnsuspend is recorded when an admin lifts the suspension of a user.
+	AuditAdminUserUnsuspend AuditAction = "admin.user.unsuspend"
+	// AuditAdminUserDelete is recorded when an admin deletes a user account.
+	AuditAdminUserDelete AuditAction = "admin.user.delete"
+	// AuditAdminAPIKeyRevoke is recorded when an admin revokes an api key.
+	AuditAdminAPIKeyRevoke AuditAction = "admin.apikey.revoke"
+)
+
+// AdminActorID is the actor of the actions performed through the satellite admin api.
+// It doesn't belong to any user.
+var AdminActorID = uuid.UUID{}
+
+// AuditLogEntry is a single record of the audit log.
+type AuditLogEntry struct {
+	ID uuid.UUID
+
+	// ActorID is the user who performed the action.
+	ActorID uuid.UUID
+	// ProjectID is the project affected by the action, nil for account actions.
+	ProjectID *uuid.UUID
+
+	Action    AuditAction
+	Target    string
+	IPAddress string
+
+	CreatedAt time.Time
+}
+
+// AuditLogCursor holds info for audit log
+// cursor pagination
+type AuditLogCursor struct {
+	Limit uint
+	Page  uint
+}
+
+// AuditLogPage represents audit log page result
+type AuditLogPage struct {
+	Entries []AuditLogEntry
+
+	Limit  uint
+	Offset uint64
+
+	PageCount   uint
+	CurrentPage uint
+	TotalCount  uint64
+}
+
+// clientIPKey is context key for the client ip address
+const clientIPKey key = 1
+
+// WithClientIP creates new context with the ip address of the client performing the request.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+// getClientIP gets the client ip address from context.
+func getClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
+
+// GetAuditLog returns a page of the audit log of given project, newest entries first.
+// Only the project owner can view the audit log.
+func (s *Service) GetAuditLog(ctx context.Context, projectID uuid.UUID, cursor AuditLogCursor) (_ *AuditLogPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.hasProjectRole(ctx, auth.User.ID, projectID, RoleOwner); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if cursor.Limit > maxLimit {
+		cursor.Limit = maxLimit
+	}
+
+	page, err := s.store.AuditLogs().GetByProjectID(ctx, projectID, cursor)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return page, nil
+}
+
+// ExportAuditLogs calls fn for every audit log entry created in [since, before), oldest first.
+// It doesn't check authorization and is meant for trusted callers only.
+func (s *Service) ExportAuditLogs(ctx context.Context, since, before time.Time, fn func(context.Context, AuditLogEntry) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.store.AuditLogs().Iterate(ctx, since, before, fn)
+}
+
+// newAuditLogEntry creates audit log entry performed by the client of the request in ctx.
+func newAuditLogEntry(ctx context.Context, actorID uuid.UUID, action AuditAction, projectID *uuid.UUID, target string) *AuditLogEntry {
+	return &AuditLogEntry{
+		ActorID:   actorID,
+		ProjectID: projectID,
+		Action:    action,
+		Target:    target,
+		IPAddress: getClientIP(ctx),
+	}
+}
+
+// roleTarget formats the target of member actions that assign a role.
+func roleTarget(email string, role ProjectMemberRole) string {
+	return email + " (" + role.String() + ")"
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditLogsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		logs := db.Console().AuditLogs()
+
+		createdUsers, createdProjects := prepareUsersAndProjects(ctx, t, db.Console().Users(), db.Console().Projects())
+		actor, project := createdUsers[0], createdProjects[0]
+
+		start := time.Now().Add(-time.Minute)
+
+		t.Run("Insert", func(t *testing.T) {
+			for _, target := range []string{"key1", "key2", "key3"} {
+				inserted, err := logs.Insert(ctx, &console.AuditLogEntry{
+					ActorID:   actor.ID,
+					ProjectID: &project.ID,
+					Action:    console.AuditAPIKeyCreate,
+					Target:    target,
+					IPAddress: "127.0.0.1",
+				})
+				require.NoError(t, err)
+				assert.False(t, inserted.CreatedAt.IsZero())
+			}
+
+			_, err := logs.Insert(ctx, &console.AuditLogEntry{
+				ActorID: actor.ID,
+				Action:  console.AuditPasswordChange,
+				Target:  actor.Email,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("Get by project id", func(t *testing.T) {
+			page, err := logs.GetByProjectID(ctx, project.ID, console.AuditLogCursor{Limit: 2, Page: 1})
+			require.NoError(t, err)
+			assert.Equal(t, uint64(3), page.TotalCount)
+			assert.Equal(t, uint(2), page.PageCount)
+			require.Len(t, page.Entries, 2)
+			assert.Equal(t, actor.ID, page.Entries[0].ActorID)
+			assert.Equal(t, console.AuditAPIKeyCreate, page.Entries[0].Action)
+			assert.Equal(t, "127.0.0.1", page.Entries[0].IPAddress)
+
+			page, err = logs.GetByProjectID(ctx, project.ID, console.AuditLogCursor{Limit: 2, Page: 2})
+			require.NoError(t, err)
+			require.Len(t, page.Entries, 1)
+
+			page, err = logs.GetByProjectID(ctx, createdProjects[1].ID, console.AuditLogCursor{Limit: 2, Page: 1})
+			require.NoError(t, err)
+			assert.Equal(t, uint64(0), page.TotalCount)
+			assert.Empty(t, page.Entries)
+		})
+
+		t.Run("Iterate", func(t *testing.T) {
+			var entries []console.AuditLogEntry
+			err := logs.Iterate(ctx, start, time.Now().Add(time.Minute), func(ctx context.Context, entry console.AuditLogEntry) error {
+				entries = append(entries, entry)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, entries, 4)
+			assert.Equal(t, "key1", entries[0].Target)
+			assert.Nil(t, entries[3].ProjectID)
+			assert.Equal(t, console.AuditPasswordChange, entries[3].Action)
+
+			entries = nil
+			err = logs.Iterate(ctx, start.Add(-time.Hour), start, func(ctx context.Context, entry console.AuditLogEntry) error {
+				entries = append(entries, entry)
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	})
+}
@@ -18,6 +18,8 @@ type Claims struct {
 	ID         uuid.UUID `json:"id"`
 	Email      string    `json:"email,omitempty"`
 	Expiration time.Time `json:"expires,omitempty"`
+	// MFAVerified is set when the session passed the second authentication factor.
+	MFAVerified bool `json:"mfaVerified,omitempty"`
 }
 
 // JSON returns json representation of Claims
@@ -16,9 +16,10 @@ func TestClaims(t *testing.T) {
 	id := testrand.UUID()
 
 	claims := Claims{
-		ID:         id,
-		Email:      "alice@mail.test",
-		Expiration: time.Now(),
+		ID:          id,
+		Email:       "alice@mail.test",
+		Expiration:  time.Now(),
+		MFAVerified: true,
 	}
 
 	claimsBytes, err := claims.JSON()
@@ -31,6 +32,7 @@ func TestClaims(t *testing.T) {
 
 	assert.Equal(t, parsedClaims.Email, claims.Email)
 	assert.Equal(t, parsedClaims.ID, claims.ID)
+	assert.Equal(t, parsedClaims.MFAVerified, claims.MFAVerified)
 	assert.Equal(t, parsedClaims.Expiration.Year(), claims.Expiration.Year())
 	assert.Equal(t, parsedClaims.Expiration.Month(), claims.Expiration.Month())
 	assert.Equal(t, parsedClaims.Expiration.Day(), claims.Expiration.Day())
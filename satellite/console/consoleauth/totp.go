@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // nolint: gosec, TOTP is defined over HMAC-SHA1
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// TOTPPeriod is the duration for which a single passcode is valid.
+	TOTPPeriod = 30 * time.Second
+	// TOTPDigits is the number of digits of a passcode.
+	TOTPDigits = 6
+	// totpSkew is the number of periods before and after the current one,
+	// whose passcodes are accepted to tolerate clock drift.
+	totpSkew = 1
+	// totpSecretSize is the size of the shared secret in bytes.
+	totpSecretSize = 20
+)
+
+// ErrTOTP is error type of time-based one-time password operations.
+var ErrTOTP = errs.Class("totp error")
+
+// totpEncoding is the encoding of shared secrets expected by authenticator apps.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generates a new random base32 encoded TOTP shared secret.
+func NewTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", ErrTOTP.Wrap(err)
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPURL returns the otpauth URL of the secret, which authenticator apps
+// can scan from a QR code.
+func TOTPURL(issuer, account, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("digits", fmt.Sprint(TOTPDigits))
+	values.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+// TOTPStep returns the time step, which the passcode at the given time is generated for.
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// TOTPPasscode returns the passcode of the secret at the given time.
+func TOTPPasscode(secret string, t time.Time) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", ErrTOTP.Wrap(err)
+	}
+	return hotp(key, uint64(TOTPStep(t))), nil
+}
+
+// ValidateTOTP checks whether the passcode is valid for the secret at the given time.
+// It returns the time step of the passcode, so that callers can reject passcodes used before.
+func ValidateTOTP(secret, passcode string, t time.Time) (step int64, valid bool, err error) {
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != TOTPDigits {
+		return 0, false, nil
+	}
+
+	for skew := -totpSkew; skew <= totpSkew; skew++ {
+		at := t.Add(time.Duration(skew) * TOTPPeriod)
+		expected, err := TOTPPasscode(secret, at)
+		if err != nil {
+			return 0, false, err
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(passcode)) == 1 {
+			return TOTPStep(at), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// hotp returns the HMAC-based one-time password of the counter, see RFC 4226.
+func hotp(key []byte, counter uint64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTPDigits, code%modulo)
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPPasscode(t *testing.T) {
+	// test vectors from RFC 6238, appendix B, truncated to six digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for _, test := range []struct {
+		unix     int64
+		passcode string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	} {
+		passcode, err := TOTPPasscode(secret, time.Unix(test.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, test.passcode, passcode, test.unix)
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	passcode, err := TOTPPasscode(secret, now)
+	require.NoError(t, err)
+
+	step, valid, err := ValidateTOTP(secret, passcode, now)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, TOTPStep(now), step)
+
+	step, valid, err = ValidateTOTP(secret, passcode, now.Add(TOTPPeriod))
+	require.NoError(t, err)
+	assert.True(t, valid, "passcode should be accepted within clock skew")
+	assert.Equal(t, TOTPStep(now), step, "step should be the one of the passcode")
+
+	_, valid, err = ValidateTOTP(secret, passcode, now.Add(3*TOTPPeriod))
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, valid, err = ValidateTOTP(secret, "12345", now)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, _, err = ValidateTOTP("not base32!", "123456", now)
+	assert.Error(t, err)
+}
+
+func TestTOTPURL(t *testing.T) {
+	parsed, err := url.Parse(TOTPURL("Storj", "alice@mail.test", "SECRET"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/Storj:alice@mail.test", parsed.Path)
+	assert.Equal(t, "SECRET", parsed.Query().Get("secret"))
+	assert.Equal(t, "Storj", parsed.Query().Get("issuer"))
+}
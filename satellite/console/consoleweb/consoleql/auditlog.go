@@ -0,0 +1,119 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// AuditLogEntryType is a graphql type name for audit log entry
+	AuditLogEntryType = "auditLogEntry"
+	// AuditLogPageType is a graphql type name for audit log page
+	AuditLogPageType = "auditLogPage"
+	// AuditLogCursorInputType is a graphql input
+	// type name for audit log cursor
+	AuditLogCursorInputType = "auditLogCursor"
+	// FieldAuditLog is a field name for audit log
+	FieldAuditLog = "auditLog"
+	// FieldEntries is a field name for page entries
+	FieldEntries = "entries"
+	// FieldActorID is a field name for the id of the user who performed an action
+	FieldActorID = "actorId"
+	// FieldAction is a field name for action
+	FieldAction = "action"
+	// FieldTarget is a field name for the target of an action
+	FieldTarget = "target"
+	// FieldIPAddress is a field name for ip address
+	FieldIPAddress = "ipAddress"
+)
+
+// graphqlAuditLogEntry creates audit log entry graphql type
+func graphqlAuditLogEntry() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: AuditLogEntryType,
+		Fields: graphql.Fields{
+			FieldID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldActorID: &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					entry, _ := p.Source.(console.AuditLogEntry)
+					return entry.ActorID.String(), nil
+				},
+			},
+			FieldAction: &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					entry, _ := p.Source.(console.AuditLogEntry)
+					return string(entry.Action), nil
+				},
+			},
+			FieldTarget: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldIPAddress: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
+
+// graphqlAuditLogPage creates audit log page graphql object
+func graphqlAuditLogPage(types *TypeCreator) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: AuditLogPageType,
+		Fields: graphql.Fields{
+			FieldEntries: &graphql.Field{
+				Type: graphql.NewList(types.auditLogEntry),
+			},
+			LimitArg: &graphql.Field{
+				Type: graphql.Int,
+			},
+			OffsetArg: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldPageCount: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldCurrentPage: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldTotalCount: &graphql.Field{
+				Type: graphql.Int,
+			},
+		},
+	})
+}
+
+// graphqlAuditLogCursor creates audit log cursor graphql input type
+func graphqlAuditLogCursor() *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: AuditLogCursorInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			LimitArg: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			PageArg: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+		},
+	})
+}
+
+// fromMapAuditLogCursor creates console.AuditLogCursor from input args
+func fromMapAuditLogCursor(args map[string]interface{}) (cursor console.AuditLogCursor) {
+	limit, _ := args[LimitArg].(int)
+	page, _ := args[PageArg].(int)
+
+	cursor.Limit = uint(limit)
+	cursor.Page = uint(page)
+	return
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	// MFASecretKeyType is a graphql type name for two-factor authentication secret key
+	MFASecretKeyType = "mfaSecretKey"
+	// FieldMFAEnabled is a field name for two-factor authentication status
+	FieldMFAEnabled = "mfaEnabled"
+	// FieldMFAPasscode is a field name for two-factor authentication passcode
+	FieldMFAPasscode = "passcode"
+	// FieldMFARecoveryCode is a field name for two-factor authentication recovery code
+	FieldMFARecoveryCode = "recoveryCode"
+	// FieldURL is a field name for url
+	FieldURL = "url"
+)
+
+// graphqlMFASecretKey creates mfaSecretKey type
+func graphqlMFASecretKey() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: MFASecretKeyType,
+		Fields: graphql.Fields{
+			FieldKey: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldURL: &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+}
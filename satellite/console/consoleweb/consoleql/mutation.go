@@ -36,12 +36,29 @@ const (
 	AddProjectMembersMutation = "addProjectMembers"
 	// DeleteProjectMembersMutation is a mutation name for deleting project members
 	DeleteProjectMembersMutation = "deleteProjectMembers"
+	// UpdateProjectMemberRoleMutation is a mutation name for changing the role of project member
+	UpdateProjectMemberRoleMutation = "updateProjectMemberRole"
+	// TransferProjectOwnershipMutation is a mutation name for transferring project ownership
+	TransferProjectOwnershipMutation = "transferProjectOwnership"
+	// AcceptProjectInvitationMutation is a mutation name for accepting project invitation
+	AcceptProjectInvitationMutation = "acceptProjectInvitation"
+	// DeclineProjectInvitationMutation is a mutation name for declining project invitation
+	DeclineProjectInvitationMutation = "declineProjectInvitation"
 
 	// CreateAPIKeyMutation is a mutation name for api key creation
 	CreateAPIKeyMutation = "createAPIKey"
 	// DeleteAPIKeysMutation is a mutation name for api key deleting
 	DeleteAPIKeysMutation = "deleteAPIKeys"
 
+	// GenerateMFASecretKeyMutation is a mutation name for generating two-factor authentication secret key
+	GenerateMFASecretKeyMutation = "generateMFASecretKey"
+	// EnableUserMFAMutation is a mutation name for enabling two-factor authentication
+	EnableUserMFAMutation = "enableUserMFA"
+	// DisableUserMFAMutation is a mutation name for disabling two-factor authentication
+	DisableUserMFAMutation = "disableUserMFA"
+	// ResetMFARecoveryCodesMutation is a mutation name for replacing two-factor authentication recovery codes
+	ResetMFARecoveryCodesMutation = "resetMFARecoveryCodes"
+
 	// InputArg is argument name for all input types
 	InputArg = "input"
 	// FieldProjectID is field name for projectID
@@ -196,6 +213,57 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return auth.User, nil
 				},
 			},
+			// generates two-factor authentication secret key for current user
+			GenerateMFASecretKeyMutation: &graphql.Field{
+				Type: types.mfaSecretKey,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return service.GenerateMFASecretKey(p.Context)
+				},
+			},
+			// enables two-factor authentication for current user and returns recovery codes
+			EnableUserMFAMutation: &graphql.Field{
+				Type: graphql.NewList(graphql.String),
+				Args: graphql.FieldConfigArgument{
+					FieldMFAPasscode: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					passcode, _ := p.Args[FieldMFAPasscode].(string)
+
+					return service.EnableUserMFA(p.Context, passcode)
+				},
+			},
+			// disables two-factor authentication for current user
+			DisableUserMFAMutation: &graphql.Field{
+				Type: graphql.Boolean,
+				Args: graphql.FieldConfigArgument{
+					FieldMFAPasscode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					FieldMFARecoveryCode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					passcode, _ := p.Args[FieldMFAPasscode].(string)
+					recoveryCode, _ := p.Args[FieldMFARecoveryCode].(string)
+
+					err := service.DisableUserMFA(p.Context, passcode, recoveryCode)
+					if err != nil {
+						return false, err
+					}
+
+					return true, nil
+				},
+			},
+			// replaces two-factor authentication recovery codes of current user
+			ResetMFARecoveryCodesMutation: &graphql.Field{
+				Type: graphql.NewList(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return service.ResetMFARecoveryCodes(p.Context)
+				},
+			},
 			// creates project from input params
 			CreateProjectMutation: &graphql.Field{
 				Type: types.project,
@@ -260,7 +328,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return service.UpdateProject(p.Context, *projectID, description)
 				},
 			},
-			// add user as member of given project
+			// invites users to join given project, by default as developers
 			AddProjectMembersMutation: &graphql.Field{
 				Type: types.project,
 				Args: graphql.FieldConfigArgument{
@@ -270,16 +338,26 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					FieldEmail: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
 					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type:         graphql.String,
+						DefaultValue: console.RoleDeveloper.String(),
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					pID, _ := p.Args[FieldProjectID].(string)
 					emails, _ := p.Args[FieldEmail].([]interface{})
+					roleName, _ := p.Args[FieldRole].(string)
 
 					projectID, err := uuid.Parse(pID)
 					if err != nil {
 						return nil, err
 					}
 
+					role, err := console.ParseProjectMemberRole(roleName)
+					if err != nil {
+						return nil, err
+					}
+
 					var userEmails []string
 					for _, email := range emails {
 						userEmails = append(userEmails, email.(string))
@@ -290,7 +368,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return nil, err
 					}
 
-					users, err := service.AddProjectMembers(p.Context, *projectID, userEmails)
+					users, err := service.AddProjectMembers(p.Context, *projectID, userEmails, role)
 					if err != nil {
 						return nil, err
 					}
@@ -353,6 +431,119 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return service.GetProject(p.Context, *projectID)
 				},
 			},
+			// changes the role of project member
+			UpdateProjectMemberRoleMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+					email, _ := p.Args[FieldEmail].(string)
+					roleName, _ := p.Args[FieldRole].(string)
+
+					projectID, err := uuid.Parse(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					role, err := console.ParseProjectMemberRole(roleName)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.UpdateProjectMemberRole(p.Context, *projectID, email, role)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, *projectID)
+				},
+			},
+			// makes project member the owner of given project
+			TransferProjectOwnershipMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+					email, _ := p.Args[FieldEmail].(string)
+
+					projectID, err := uuid.Parse(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.TransferProjectOwnership(p.Context, *projectID, email)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, *projectID)
+				},
+			},
+			// accepts invitation to given project
+			AcceptProjectInvitationMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+
+					projectID, err := uuid.Parse(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					_, err = service.AcceptProjectInvitation(p.Context, *projectID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, *projectID)
+				},
+			},
+			// declines invitation to given project
+			DeclineProjectInvitationMutation: &graphql.Field{
+				Type: graphql.NewList(types.projectInvite),
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+
+					projectID, err := uuid.Parse(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.DeclineProjectInvitation(p.Context, *projectID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProjectInvitations(p.Context)
+				},
+			},
 			// creates new api key
 			CreateAPIKeyMutation: &graphql.Field{
 				Type: types.createAPIKey,
@@ -54,6 +54,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			db.Console(),
 			db.Rewards(),
 			localpayments.NewService(nil),
+			nil,
 			console.TestPasswordCost,
 		)
 		require.NoError(t, err)
@@ -91,7 +92,7 @@ func TestGrapqhlMutation(t *testing.T) {
 		err = service.ActivateAccount(ctx, activationToken)
 		require.NoError(t, err)
 
-		token, err := service.Token(ctx, createUser.Email, createUser.Password)
+		token, err := service.Token(ctx, console.AuthUser{Email: createUser.Email, Password: createUser.Password})
 		require.NoError(t, err)
 
 		sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
@@ -147,10 +148,10 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, newUser.ShortName, user.ShortName)
 		})
 
-		testQuery := func(t *testing.T, query string) interface{} {
+		testQueryAs := func(t *testing.T, ctx context.Context, query string) interface{} {
 			result := graphql.Do(graphql.Params{
 				Schema:        schema,
-				Context:       authCtx,
+				Context:       ctx,
 				RequestString: query,
 				RootObject:    rootObject,
 			})
@@ -163,6 +164,10 @@ func TestGrapqhlMutation(t *testing.T) {
 			return result.Data
 		}
 
+		testQuery := func(t *testing.T, query string) interface{} {
+			return testQueryAs(t, authCtx, query)
+		}
+
 		t.Run("Update account mutation email only", func(t *testing.T) {
 			email := "new@mail.test"
 			query := fmt.Sprintf(
@@ -281,7 +286,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			createUser.Password = newPassword
 		})
 
-		token, err = service.Token(ctx, rootUser.Email, createUser.Password)
+		token, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password})
 		require.NoError(t, err)
 
 		sauth, err = service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
@@ -403,7 +408,105 @@ func TestGrapqhlMutation(t *testing.T) {
 
 			assert.Equal(t, project.ID.String(), proj[consoleql.FieldID])
 			assert.Equal(t, project.Name, proj[consoleql.FieldName])
-			assert.Equal(t, 3, len(proj[consoleql.FieldMembers].([]interface{})))
+			// invited users become members only after accepting the invitation
+			assert.Equal(t, 1, len(proj[consoleql.FieldMembers].([]interface{})))
+		})
+
+		user1Ctx := console.WithAuth(ctx, console.Authorization{User: *user1})
+		user2Ctx := console.WithAuth(ctx, console.Authorization{User: *user2})
+
+		t.Run("Accept project invitation mutation", func(t *testing.T) {
+			result := testQueryAs(t, user1Ctx, "query {projectInvitations{project{id},inviter{email},role}}")
+
+			data := result.(map[string]interface{})
+			invitations := data[consoleql.ProjectInvitationsQuery].([]interface{})
+			require.Equal(t, 1, len(invitations))
+
+			invitation := invitations[0].(map[string]interface{})
+			assert.Equal(t, project.ID.String(), invitation[consoleql.ProjectType].(map[string]interface{})[consoleql.FieldID])
+			assert.Equal(t, rootUser.Email, invitation[consoleql.FieldInviter].(map[string]interface{})[consoleql.FieldEmail])
+			assert.Equal(t, console.RoleDeveloper.String(), invitation[consoleql.FieldRole])
+
+			query := fmt.Sprintf(
+				"mutation {acceptProjectInvitation(projectID:\"%s\"){id,members(limit:50,offset:0){role}}}",
+				project.ID.String(),
+			)
+
+			result = testQueryAs(t, user1Ctx, query)
+
+			data = result.(map[string]interface{})
+			proj := data[consoleql.AcceptProjectInvitationMutation].(map[string]interface{})
+			assert.Equal(t, 2, len(proj[consoleql.FieldMembers].([]interface{})))
+		})
+
+		t.Run("Decline project invitation mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {declineProjectInvitation(projectID:\"%s\"){role}}",
+				project.ID.String(),
+			)
+
+			result := testQueryAs(t, user2Ctx, query)
+
+			data := result.(map[string]interface{})
+			assert.Equal(t, 0, len(data[consoleql.DeclineProjectInvitationMutation].([]interface{})))
+
+			_, err := service.AcceptProjectInvitation(user2Ctx, project.ID)
+			assert.Error(t, err)
+		})
+
+		t.Run("Update project member role mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {updateProjectMemberRole(projectID:\"%s\",email:\"%s\",role:\"%s\"){members(limit:50,offset:0){user{email},role}}}",
+				project.ID.String(),
+				user1.Email,
+				console.RoleViewer.String(),
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			proj := data[consoleql.UpdateProjectMemberRoleMutation].(map[string]interface{})
+
+			roles := map[string]string{}
+			for _, entry := range proj[consoleql.FieldMembers].([]interface{}) {
+				member := entry.(map[string]interface{})
+				email := member[consoleql.UserType].(map[string]interface{})[consoleql.FieldEmail].(string)
+				roles[email] = member[consoleql.FieldRole].(string)
+			}
+
+			assert.Equal(t, console.RoleOwner.String(), roles[rootUser.Email])
+			assert.Equal(t, console.RoleViewer.String(), roles[user1.Email])
+
+			// viewers can't create api keys
+			_, _, err := service.CreateAPIKey(user1Ctx, project.ID, "viewer key")
+			assert.True(t, console.ErrUnauthorized.Has(err))
+		})
+
+		t.Run("Transfer project ownership mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {transferProjectOwnership(projectID:\"%s\",email:\"%s\"){id}}",
+				project.ID.String(),
+				user1.Email,
+			)
+
+			testQuery(t, query)
+
+			// the previous owner becomes an admin and can't delete the project
+			err := service.DeleteProject(authCtx, project.ID)
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			err = service.TransferProjectOwnership(user1Ctx, project.ID, rootUser.Email)
+			require.NoError(t, err)
+
+			members, err := service.GetProjectMembers(authCtx, project.ID, console.Pagination{Limit: 50})
+			require.NoError(t, err)
+			for _, member := range members {
+				if member.MemberID == user1.ID {
+					assert.Equal(t, console.RoleAdmin, member.Role)
+				} else {
+					assert.Equal(t, console.RoleOwner, member.Role)
+				}
+			}
 		})
 
 		t.Run("Delete project members mutation", func(t *testing.T) {
@@ -478,6 +581,120 @@ func TestGrapqhlMutation(t *testing.T) {
 			}
 		})
 
+		t.Run("Audit log query", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"query {project(id:\"%s\"){auditLog(cursor:{limit:2,page:1}){entries{actorId,action,target},pageCount,totalCount}}}",
+				project.ID.String(),
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			proj := data[consoleql.ProjectQuery].(map[string]interface{})
+			auditLog := proj[consoleql.FieldAuditLog].(map[string]interface{})
+			entries := auditLog[consoleql.FieldEntries].([]interface{})
+			require.Len(t, entries, 2)
+
+			// newest entries come first
+			latest := entries[0].(map[string]interface{})
+			assert.Equal(t, rootUser.ID.String(), latest[consoleql.FieldActorID])
+			assert.Equal(t, string(console.AuditAPIKeyDelete), latest[consoleql.FieldAction])
+			assert.Equal(t, "key1", latest[consoleql.FieldTarget])
+
+			previous := entries[1].(map[string]interface{})
+			assert.Equal(t, string(console.AuditAPIKeyCreate), previous[consoleql.FieldAction])
+
+			// only the owner can view the audit log
+			_, err := service.GetAuditLog(user1Ctx, project.ID, console.AuditLogCursor{Limit: 2, Page: 1})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+		})
+
+		t.Run("Two-factor authentication mutations", func(t *testing.T) {
+			result := testQuery(t, "mutation {generateMFASecretKey{key,url}}")
+
+			data := result.(map[string]interface{})
+			secretKey := data[consoleql.GenerateMFASecretKeyMutation].(map[string]interface{})
+			key := secretKey[consoleql.FieldKey].(string)
+			assert.Contains(t, secretKey[consoleql.FieldURL], key)
+
+			passcode, err := consoleauth.TOTPPasscode(key, time.Now())
+			require.NoError(t, err)
+
+			result = testQuery(t, fmt.Sprintf("mutation {enableUserMFA(passcode:\"%s\")}", passcode))
+
+			data = result.(map[string]interface{})
+			recoveryCodes := data[consoleql.EnableUserMFAMutation].([]interface{})
+			require.NotEmpty(t, recoveryCodes)
+
+			// signing in requires the second factor
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password})
+			assert.True(t, console.ErrMFAMissing.Has(err))
+
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFAPasscode: "000000x"})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			// sessions without the second factor can't create api keys
+			sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+			assert.True(t, sauth.User.MFAEnabled)
+			assert.False(t, sauth.Claims.MFAVerified)
+
+			_, _, err = service.CreateAPIKey(console.WithAuth(ctx, sauth), project.ID, "unverified key")
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			// the passcode used to enable two-factor authentication can't be used again
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFAPasscode: passcode})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			passcode, err = consoleauth.TOTPPasscode(key, time.Now().Add(consoleauth.TOTPPeriod))
+			require.NoError(t, err)
+
+			mfaToken, err := service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFAPasscode: passcode})
+			require.NoError(t, err)
+
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFAPasscode: passcode})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			sauth, err = service.Authorize(auth.WithAPIKey(ctx, []byte(mfaToken)))
+			require.NoError(t, err)
+			assert.True(t, sauth.Claims.MFAVerified)
+
+			_, _, err = service.CreateAPIKey(console.WithAuth(ctx, sauth), project.ID, "verified key")
+			require.NoError(t, err)
+
+			// passcodes are rejected after too many failed attempts, recovery codes are not
+			for i := 0; i < 4; i++ {
+				_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFAPasscode: "000000x"})
+				assert.True(t, console.ErrUnauthorized.Has(err))
+			}
+
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFAPasscode: "000000x"})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "Too many invalid two-factor authentication passcodes")
+
+			// recovery codes can be used only once
+			recoveryCode := recoveryCodes[0].(string)
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFARecoveryCode: recoveryCode})
+			require.NoError(t, err)
+
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password, MFARecoveryCode: recoveryCode})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			sauth, err = service.Authorize(auth.WithAPIKey(ctx, []byte(mfaToken)))
+			require.NoError(t, err)
+
+			result = testQueryAs(t, console.WithAuth(ctx, sauth), fmt.Sprintf(
+				"mutation {disableUserMFA(recoveryCode:\"%s\")}",
+				recoveryCodes[1].(string),
+			))
+
+			data = result.(map[string]interface{})
+			assert.Equal(t, true, data[consoleql.DisableUserMFAMutation])
+
+			_, err = service.Token(ctx, console.AuthUser{Email: rootUser.Email, Password: createUser.Password})
+			require.NoError(t, err)
+		})
+
 		t.Run("Delete project mutation", func(t *testing.T) {
 			query := fmt.Sprintf(
 				"mutation {deleteProject(id:\"%s\"){id,name}}",
@@ -8,6 +8,7 @@ import (
 
 	"github.com/graphql-go/graphql"
 
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/satellite/console"
 )
 
@@ -18,6 +19,8 @@ const (
 	ProjectInputType = "projectInput"
 	// ProjectUsageType is a graphql type name for project usage
 	ProjectUsageType = "projectUsage"
+	// ProjectLimitsType is a graphql type name for project limits
+	ProjectLimitsType = "projectLimits"
 	// BucketUsageCursorInputType is a graphql input
 	// type name for bucket usage cursor
 	BucketUsageCursorInputType = "bucketUsageCursor"
@@ -37,6 +40,24 @@ const (
 	FieldAPIKeys = "apiKeys"
 	// FieldUsage is a field name for usage rollup
 	FieldUsage = "usage"
+	// FieldLimits is a field name for project limits
+	FieldLimits = "limits"
+	// FieldStorageLimit is a field name for storage limit
+	FieldStorageLimit = "storageLimit"
+	// FieldStorageUsed is a field name for used storage
+	FieldStorageUsed = "storageUsed"
+	// FieldBandwidthLimit is a field name for monthly bandwidth limit
+	FieldBandwidthLimit = "bandwidthLimit"
+	// FieldBandwidthUsed is a field name for bandwidth used during the past month
+	FieldBandwidthUsed = "bandwidthUsed"
+	// FieldSegmentLimit is a field name for segment limit
+	FieldSegmentLimit = "segmentLimit"
+	// FieldSegmentsUsed is a field name for stored segments count
+	FieldSegmentsUsed = "segmentsUsed"
+	// FieldBucketLimit is a field name for bucket limit
+	FieldBucketLimit = "bucketLimit"
+	// FieldBucketsUsed is a field name for buckets count
+	FieldBucketsUsed = "bucketsUsed"
 	// FieldBucketUsages is a field name for bucket usages
 	FieldBucketUsages = "bucketUsages"
 	// FieldStorage is a field name for storage total
@@ -137,6 +158,7 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 						users = append(users, projectMember{
 							User:     user,
 							JoinedAt: member.CreatedAt,
+							Role:     member.Role.String(),
 						})
 					}
 
@@ -170,6 +192,14 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 					return service.GetProjectUsage(p.Context, project.ID, since, before)
 				},
 			},
+			FieldLimits: &graphql.Field{
+				Type: types.projectLimits,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectLimits(p.Context, project.ID)
+				},
+			},
 			FieldBucketUsages: &graphql.Field{
 				Type: types.bucketUsagePage,
 				Args: graphql.FieldConfigArgument{
@@ -189,6 +219,21 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 					return service.GetBucketTotals(p.Context, project.ID, cursor, before)
 				},
 			},
+			FieldAuditLog: &graphql.Field{
+				Type: types.auditLogPage,
+				Args: graphql.FieldConfigArgument{
+					CursorArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(types.auditLogCursor),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					cursor := fromMapAuditLogCursor(p.Args[CursorArg].(map[string]interface{}))
+
+					return service.GetAuditLog(p.Context, project.ID, cursor)
+				},
+			},
 		},
 	})
 }
@@ -307,6 +352,71 @@ func graphqlProjectUsage() *graphql.Object {
 	})
 }
 
+// graphqlProjectLimits creates project limits graphql object
+func graphqlProjectLimits() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectLimitsType,
+		Fields: graphql.Fields{
+			FieldStorageLimit: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Limits.Storage.Int64(), nil
+				},
+			},
+			FieldStorageUsed: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Storage.Int64(), nil
+				},
+			},
+			FieldBandwidthLimit: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Limits.Bandwidth.Int64(), nil
+				},
+			},
+			FieldBandwidthUsed: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Bandwidth.Int64(), nil
+				},
+			},
+			FieldSegmentLimit: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Limits.Segments, nil
+				},
+			},
+			FieldSegmentsUsed: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Segments, nil
+				},
+			},
+			FieldBucketLimit: &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Limits.Buckets, nil
+				},
+			},
+			FieldBucketsUsed: &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*accounting.ProjectLimitUsage)
+					return limits.Buckets, nil
+				},
+			},
+		},
+	})
+}
+
 // fromMapProjectInfo creates console.ProjectInfo from input args
 func fromMapProjectInfo(args map[string]interface{}) (project console.ProjectInfo) {
 	project.Name, _ = args[FieldName].(string)
@@ -0,0 +1,50 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// ProjectInvitationType is a graphql type name for project invitation
+	ProjectInvitationType = "projectInvitation"
+	// FieldInviter is a field name for the user who sent project invitation
+	FieldInviter = "inviter"
+)
+
+// graphqlProjectInvitation creates projectInvitation type
+func graphqlProjectInvitation(service *console.Service, types *TypeCreator) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectInvitationType,
+		Fields: graphql.Fields{
+			ProjectType: &graphql.Field{
+				Type: types.project,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					invitation, _ := p.Source.(console.ProjectInvitation)
+					return service.GetProject(p.Context, invitation.ProjectID)
+				},
+			},
+			FieldInviter: &graphql.Field{
+				Type: types.user,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					invitation, _ := p.Source.(console.ProjectInvitation)
+					return service.GetUser(p.Context, invitation.InviterID)
+				},
+			},
+			FieldRole: &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					invitation, _ := p.Source.(console.ProjectInvitation)
+					return invitation.Role.String(), nil
+				},
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
@@ -16,6 +16,8 @@ const (
 	ProjectMemberType = "projectMember"
 	// FieldJoinedAt is a field name for joined at timestamp
 	FieldJoinedAt = "joinedAt"
+	// FieldRole is a field name for project member role
+	FieldRole = "role"
 )
 
 // graphqlProjectMember creates projectMember type
@@ -34,12 +36,16 @@ func graphqlProjectMember(service *console.Service, types *TypeCreator) *graphql
 			FieldJoinedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
+			FieldRole: &graphql.Field{
+				Type: graphql.String,
+			},
 		},
 	})
 }
 
-// projectMember encapsulates User and joinedAt
+// projectMember encapsulates User, joinedAt and role
 type projectMember struct {
 	User     *console.User
 	JoinedAt time.Time
+	Role     string
 }
@@ -25,6 +25,8 @@ const (
 	ProjectQuery = "project"
 	// MyProjectsQuery is a query name for projects related to account
 	MyProjectsQuery = "myProjects"
+	// ProjectInvitationsQuery is a query name for pending project invitations of account
+	ProjectInvitationsQuery = "projectInvitations"
 	// ActiveRewardQuery is a query name for current active reward offer
 	ActiveRewardQuery = "activeReward"
 	// CreditUsageQuery is a query name for credit usage related to an user
@@ -86,6 +88,12 @@ func rootQuery(service *console.Service, mailService *mailservice.Service, types
 					return service.GetUsersProjects(p.Context)
 				},
 			},
+			ProjectInvitationsQuery: &graphql.Field{
+				Type: graphql.NewList(types.projectInvite),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return service.GetProjectInvitations(p.Context)
+				},
+			},
 			ActiveRewardQuery: &graphql.Field{
 				Type: types.reward,
 				Args: graphql.FieldConfigArgument{
@@ -114,12 +122,25 @@ func rootQuery(service *console.Service, mailService *mailservice.Service, types
 					FieldPassword: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
 					},
+					FieldMFAPasscode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					FieldMFARecoveryCode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					email, _ := p.Args[FieldEmail].(string)
 					pass, _ := p.Args[FieldPassword].(string)
-
-					token, err := service.Token(p.Context, email, pass)
+					passcode, _ := p.Args[FieldMFAPasscode].(string)
+					recoveryCode, _ := p.Args[FieldMFARecoveryCode].(string)
+
+					token, err := service.Token(p.Context, console.AuthUser{
+						Email:           email,
+						Password:        pass,
+						MFAPasscode:     passcode,
+						MFARecoveryCode: recoveryCode,
+					})
 					if err != nil {
 						return nil, err
 					}
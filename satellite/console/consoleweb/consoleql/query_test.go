@@ -13,7 +13,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
@@ -31,12 +34,23 @@ func TestGraphqlQuery(t *testing.T) {
 
 		log := zaptest.NewLogger(t)
 
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
+		projectUsage := accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, nil, accounting.ProjectLimitConfig{
+			DefaultMaxStorage:   25 * memory.GB,
+			DefaultMaxBandwidth: 25 * memory.GB,
+			DefaultMaxSegments:  1000,
+			DefaultMaxBuckets:   10,
+		})
+
 		service, err := console.NewService(
 			log,
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			db.Console(),
 			db.Rewards(),
 			localpayments.NewService(nil),
+			projectUsage,
 			console.TestPasswordCost,
 		)
 		require.NoError(t, err)
@@ -86,7 +100,7 @@ func TestGraphqlQuery(t *testing.T) {
 			rootUser.Email = "mtest@mail.test"
 		})
 
-		token, err := service.Token(ctx, createUser.Email, createUser.Password)
+		token, err := service.Token(ctx, console.AuthUser{Email: createUser.Email, Password: createUser.Password})
 		require.NoError(t, err)
 
 		sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
@@ -178,6 +192,33 @@ func TestGraphqlQuery(t *testing.T) {
 			assert.True(t, createdProject.CreatedAt.Equal(createdAt))
 		})
 
+		t.Run("Project query limits", func(t *testing.T) {
+			err := projectUsage.SetProjectLimits(ctx, createdProject.ID, accounting.ProjectLimits{
+				Storage: 5 * memory.GB,
+				Buckets: 3,
+			})
+			require.NoError(t, err)
+
+			query := fmt.Sprintf(
+				"query {project(id:\"%s\"){limits{storageLimit,storageUsed,bandwidthLimit,segmentLimit,segmentsUsed,bucketLimit,bucketsUsed}}}",
+				createdProject.ID.String(),
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			project := data[consoleql.ProjectQuery].(map[string]interface{})
+			limits := project[consoleql.FieldLimits].(map[string]interface{})
+
+			assert.Equal(t, float64(5*memory.GB), limits[consoleql.FieldStorageLimit])
+			assert.Equal(t, float64(0), limits[consoleql.FieldStorageUsed])
+			assert.Equal(t, float64(25*memory.GB), limits[consoleql.FieldBandwidthLimit])
+			assert.Equal(t, float64(1000), limits[consoleql.FieldSegmentLimit])
+			assert.Equal(t, float64(0), limits[consoleql.FieldSegmentsUsed])
+			assert.Equal(t, 3, limits[consoleql.FieldBucketLimit])
+			assert.Equal(t, 0, limits[consoleql.FieldBucketsUsed])
+		})
+
 		regTokenUser1, err := service.CreateRegToken(ctx, 2)
 		require.NoError(t, err)
 
@@ -232,10 +273,15 @@ func TestGraphqlQuery(t *testing.T) {
 		users, err := service.AddProjectMembers(authCtx, createdProject.ID, []string{
 			user1.Email,
 			user2.Email,
-		})
+		}, console.RoleDeveloper)
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(users))
 
+		for _, user := range users {
+			_, err = service.AcceptProjectInvitation(console.WithAuth(ctx, console.Authorization{User: *user}), createdProject.ID)
+			require.NoError(t, err)
+		}
+
 		t.Run("Project query team members", func(t *testing.T) {
 			query := fmt.Sprintf(
 				"query {project(id:\"%s\"){members(offset:0, limit:50){user{id,fullName,shortName,email,createdAt}}}}",
@@ -23,15 +23,21 @@ type TypeCreator struct {
 	creditUsage     *graphql.Object
 	project         *graphql.Object
 	projectUsage    *graphql.Object
+	projectLimits   *graphql.Object
 	bucketUsage     *graphql.Object
 	bucketUsagePage *graphql.Object
+	auditLogEntry   *graphql.Object
+	auditLogPage    *graphql.Object
 	projectMember   *graphql.Object
+	projectInvite   *graphql.Object
 	apiKeyInfo      *graphql.Object
 	createAPIKey    *graphql.Object
+	mfaSecretKey    *graphql.Object
 
 	userInput         *graphql.InputObject
 	projectInput      *graphql.InputObject
 	bucketUsageCursor *graphql.InputObject
+	auditLogCursor    *graphql.InputObject
 }
 
 // Create create types and check for error
@@ -52,6 +58,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.auditLogCursor = graphqlAuditLogCursor()
+	if err := c.auditLogCursor.Error(); err != nil {
+		return err
+	}
+
 	// entities
 	c.user = graphqlUser()
 	if err := c.user.Error(); err != nil {
@@ -78,11 +89,26 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.projectLimits = graphqlProjectLimits()
+	if err := c.projectLimits.Error(); err != nil {
+		return err
+	}
+
 	c.bucketUsagePage = graphqlBucketUsagePage(c)
 	if err := c.bucketUsagePage.Error(); err != nil {
 		return err
 	}
 
+	c.auditLogEntry = graphqlAuditLogEntry()
+	if err := c.auditLogEntry.Error(); err != nil {
+		return err
+	}
+
+	c.auditLogPage = graphqlAuditLogPage(c)
+	if err := c.auditLogPage.Error(); err != nil {
+		return err
+	}
+
 	c.apiKeyInfo = graphqlAPIKeyInfo()
 	if err := c.apiKeyInfo.Error(); err != nil {
 		return err
@@ -93,6 +119,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.mfaSecretKey = graphqlMFASecretKey()
+	if err := c.mfaSecretKey.Error(); err != nil {
+		return err
+	}
+
 	c.projectMember = graphqlProjectMember(service, c)
 	if err := c.projectMember.Error(); err != nil {
 		return err
@@ -103,6 +134,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.projectInvite = graphqlProjectInvitation(service, c)
+	if err := c.projectInvite.Error(); err != nil {
+		return err
+	}
+
 	c.token = graphqlToken(service, c)
 	if err := c.user.Error(); err != nil {
 		return err
@@ -48,6 +48,9 @@ func baseUserConfig() graphql.ObjectConfig {
 			FieldCreatedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
+			FieldMFAEnabled: &graphql.Field{
+				Type: graphql.Boolean,
+			},
 		},
 	}
 }
@@ -5,6 +5,7 @@ package consoleweb
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"html/template"
 	"net"
@@ -36,6 +37,9 @@ const (
 
 	applicationJSON    = "application/json"
 	applicationGraphql = "application/graphql"
+	applicationNDJSON  = "application/x-ndjson"
+
+	xForwardedFor = "X-Forwarded-For"
 )
 
 var (
@@ -57,6 +61,9 @@ type Config struct {
 	AuthTokenSecret string `help:"secret used to sign auth tokens" releaseDefault:"" devDefault:"my-suppa-secret-key"`
 
 	PasswordCost int `internal:"true" help:"password hashing cost (0=automatic)" default:"0"`
+
+	AuditLogExportToken string `help:"auth token needed for access to audit log export endpoint, the endpoint is disabled when empty" default:""`
+	TrustForwardedFor   bool   `help:"use X-Forwarded-For header as client address in audit log, enable only behind a trusted proxy" default:"false"`
 }
 
 // Server represents console web server
@@ -98,6 +105,10 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 
 	mux.Handle("/api/graphql/v0", http.HandlerFunc(server.grapqlHandler))
 
+	if server.config.AuditLogExportToken != "" {
+		mux.Handle("/api/v0/audit-log/export", http.HandlerFunc(server.auditLogExportHandler))
+	}
+
 	if server.config.StaticDir != "" {
 		mux.Handle("/activation/", http.HandlerFunc(server.accountActivationHandler))
 		mux.Handle("/password-recovery/", http.HandlerFunc(server.passwordRecoveryHandler))
@@ -234,9 +245,50 @@ func (s *Server) createRegistrationTokenHandler(w http.ResponseWriter, req *http
 	response.Secret = token.Secret.String()
 }
 
+// auditLogExportHandler streams audit log entries created in the requested period
+// as newline delimited json, since and before are unix timestamps
+func (s *Server) auditLogExportHandler(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	authToken := req.Header.Get(authorization)
+	if subtle.ConstantTimeCompare([]byte(authToken), []byte(s.config.AuditLogExportToken)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(req.URL.Query().Get("since"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid since", http.StatusBadRequest)
+		return
+	}
+
+	before := time.Now()
+	if beforeInput := req.URL.Query().Get("before"); beforeInput != "" {
+		beforeStamp, err := strconv.ParseInt(beforeInput, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid before", http.StatusBadRequest)
+			return
+		}
+		before = time.Unix(beforeStamp, 0)
+	}
+
+	w.Header().Set(contentType, applicationNDJSON)
+
+	encoder := json.NewEncoder(w)
+	err = s.service.ExportAuditLogs(ctx, time.Unix(sinceStamp, 0), before,
+		func(ctx context.Context, entry console.AuditLogEntry) error {
+			return encoder.Encode(newAuditLogRecord(entry))
+		})
+	if err != nil {
+		s.log.Error("audit log export error", zap.Error(err))
+	}
+}
+
 // accountActivationHandler is web app http handler function
 func (s *Server) accountActivationHandler(w http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+	ctx := s.withClientIP(req)
 	defer mon.Task()(&ctx)(nil)
 	activationToken := req.URL.Query().Get("token")
 
@@ -254,7 +306,7 @@ func (s *Server) accountActivationHandler(w http.ResponseWriter, req *http.Reque
 }
 
 func (s *Server) passwordRecoveryHandler(w http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+	ctx := s.withClientIP(req)
 	defer mon.Task()(&ctx)(nil)
 	recoveryToken := req.URL.Query().Get("token")
 	if len(recoveryToken) == 0 {
@@ -311,6 +363,11 @@ func (s *Server) cancelPasswordRecoveryHandler(w http.ResponseWriter, req *http.
 	http.Redirect(w, req, "https://storjlabs.atlassian.net/servicedesk/customer/portals", http.StatusSeeOther)
 }
 
+// withClientIP returns request context with the ip address of the client
+func (s *Server) withClientIP(req *http.Request) context.Context {
+	return console.WithClientIP(req.Context(), getClientIP(req, s.config.TrustForwardedFor))
+}
+
 func (s *Server) serveError(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
 	http.ServeFile(w, req, filepath.Join(s.config.StaticDir, "static", "errors", "404.html"))
@@ -318,7 +375,7 @@ func (s *Server) serveError(w http.ResponseWriter, req *http.Request) {
 
 // grapqlHandler is graphql endpoint http handler function
 func (s *Server) grapqlHandler(w http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+	ctx := s.withClientIP(req)
 	defer mon.Task()(&ctx)(nil)
 	w.Header().Set(contentType, applicationJSON)
 
@@ -6,11 +6,14 @@ package consoleweb
 import (
 	"encoding/json"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 )
 
@@ -62,3 +65,46 @@ func queryPOST(req *http.Request) (query graphqlJSON, err error) {
 		return query, errs.New("can't parse request body of type %s", typ)
 	}
 }
+
+// getClientIP retrieves the ip address of the client from request,
+// the X-Forwarded-For header is used only when it is trusted
+func getClientIP(req *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if forwarded := req.Header.Get(xForwardedFor); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// auditLogRecord is the json representation of exported audit log entry
+type auditLogRecord struct {
+	ID        string    `json:"id"`
+	ActorID   string    `json:"actorId"`
+	ProjectID string    `json:"projectId,omitempty"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	IPAddress string    `json:"ipAddress"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// newAuditLogRecord creates auditLogRecord from console.AuditLogEntry
+func newAuditLogRecord(entry console.AuditLogEntry) auditLogRecord {
+	record := auditLogRecord{
+		ID:        entry.ID.String(),
+		ActorID:   entry.ActorID.String(),
+		Action:    string(entry.Action),
+		Target:    entry.Target,
+		IPAddress: entry.IPAddress,
+		CreatedAt: entry.CreatedAt,
+	}
+	if entry.ProjectID != nil {
+		record.ProjectID = entry.ProjectID.String()
+	}
+	return record
+}
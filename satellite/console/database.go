@@ -17,8 +17,12 @@ type DB interface {
 	Projects() Projects
 	// ProjectMembers is a getter for ProjectMembers repository
 	ProjectMembers() ProjectMembers
+	// ProjectInvitations is a getter for ProjectInvitations repository
+	ProjectInvitations() ProjectInvitations
 	// APIKeys is a getter for APIKeys repository
 	APIKeys() APIKeys
+	// AuditLogs is a getter for AuditLogs repository
+	AuditLogs() AuditLogs
 	// BucketUsage is a getter for accounting.BucketUsage repository
 	BucketUsage() accounting.BucketUsage
 	// RegistrationTokens is a getter for RegistrationTokens repository
@@ -0,0 +1,337 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+const (
+	// mfaIssuer is the issuer shown by authenticator apps.
+	mfaIssuer = "Storj"
+	// mfaRecoveryCodeCount is the number of recovery codes generated for a user.
+	mfaRecoveryCodeCount = 10
+	// mfaRecoveryCodeLength is the number of characters of a recovery code.
+	mfaRecoveryCodeLength = 10
+	// mfaRecoveryCodeAlphabet excludes characters that are easy to confuse.
+	mfaRecoveryCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// mfaMaxFailedAttempts is the number of invalid passcodes, after which passcodes
+	// of the user are rejected until mfaLockout passes since the last failed attempt.
+	// Recovery codes are too long to be guessed and aren't throttled.
+	mfaMaxFailedAttempts = 5
+	// mfaLockout is the duration for which passcodes are rejected after too many failed attempts.
+	mfaLockout = 15 * time.Minute
+)
+
+// Error messages of two-factor authentication
+const (
+	mfaRequiredErrMsg     = "A two-factor authentication passcode or recovery code is required"
+	mfaPasscodeErrMsg     = "The two-factor authentication passcode is invalid"
+	mfaThrottledErrMsg    = "Too many invalid two-factor authentication passcodes, try again later or use a recovery code"
+	mfaRecoveryCodeErrMsg = "The two-factor authentication recovery code is invalid"
+	mfaEnabledErrMsg      = "Two-factor authentication is already enabled"
+	mfaDisabledErrMsg     = "Two-factor authentication is not enabled"
+	mfaSecretKeyErrMsg    = "Generate a two-factor authentication secret key first"
+	mfaSessionErrMsg      = "This action requires signing in with two-factor authentication"
+)
+
+// ErrMFAMissing is error type of signing in without the second factor
+// when the user has two-factor authentication enabled.
+var ErrMFAMissing = errs.Class("MFA credentials missing")
+
+// AuthUser holds the credentials of a user signing in.
+type AuthUser struct {
+	Email    string
+	Password string
+	// MFAPasscode or MFARecoveryCode is required when the user has two-factor authentication enabled.
+	MFAPasscode     string
+	MFARecoveryCode string
+}
+
+// MFASecretKey is a TOTP shared secret of a user being enrolled to two-factor authentication.
+type MFASecretKey struct {
+	Key string
+	// URL is the otpauth URL of the key, which authenticator apps can scan from a QR code.
+	URL string
+}
+
+// GenerateMFASecretKey generates a new TOTP shared secret for the current user.
+// Two-factor authentication is enabled only after confirming the secret with EnableUserMFA.
+func (s *Service) GenerateMFASecretKey(ctx context.Context) (_ *MFASecretKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	if user.MFAEnabled {
+		return nil, errs.New(mfaEnabledErrMsg)
+	}
+
+	key, err := consoleauth.NewTOTPSecret()
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	user.MFASecretKey = key
+	err = s.store.Users().UpdateMFA(ctx, user)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return &MFASecretKey{
+		Key: key,
+		URL: consoleauth.TOTPURL(mfaIssuer, user.Email, key),
+	}, nil
+}
+
+// EnableUserMFA enables two-factor authentication of the current user after
+// verifying the passcode generated from the secret key. It returns the recovery codes,
+// which are shown to the user only once.
+func (s *Service) EnableUserMFA(ctx context.Context, passcode string) (recoveryCodes []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	if user.MFAEnabled {
+		return nil, errs.New(mfaEnabledErrMsg)
+	}
+	if user.MFASecretKey == "" {
+		return nil, errs.New(mfaSecretKeyErrMsg)
+	}
+
+	if err = s.useMFAPasscode(ctx, user, passcode); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err = generateMFARecoveryCodes()
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	user.MFAEnabled = true
+	user.MFARecoveryCodes = hashMFARecoveryCodes(recoveryCodes)
+	if err = s.updateMFA(ctx, user, AuditMFAEnable); err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableUserMFA disables two-factor authentication of the current user,
+// it requires either a valid passcode or an unused recovery code.
+func (s *Service) DisableUserMFA(ctx context.Context, passcode, recoveryCode string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	if !user.MFAEnabled {
+		return errs.New(mfaDisabledErrMsg)
+	}
+
+	if err = s.verifyMFA(ctx, user, passcode, recoveryCode); err != nil {
+		return err
+	}
+
+	user.MFAEnabled = false
+	user.MFASecretKey = ""
+	user.MFARecoveryCodes = nil
+	if err = s.updateMFA(ctx, user, AuditMFADisable); err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	return nil
+}
+
+// ResetMFARecoveryCodes replaces the recovery codes of the current user.
+// The session has to be verified with two-factor authentication.
+func (s *Service) ResetMFARecoveryCodes(ctx context.Context) (recoveryCodes []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	if !user.MFAEnabled {
+		return nil, errs.New(mfaDisabledErrMsg)
+	}
+	if !auth.Claims.MFAVerified {
+		return nil, ErrUnauthorized.New(mfaSessionErrMsg)
+	}
+
+	recoveryCodes, err = generateMFARecoveryCodes()
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	user.MFARecoveryCodes = hashMFARecoveryCodes(recoveryCodes)
+	if err = s.updateMFA(ctx, user, AuditMFARecoveryCodesReset); err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return recoveryCodes, nil
+}
+
+// updateMFA stores the two-factor authentication settings of the user and records the action in the audit log.
+func (s *Service) updateMFA(ctx context.Context, user *User, action AuditAction) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.Users().UpdateMFA(ctx, user); err != nil {
+			return err
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, user.ID, action, nil, user.Email))
+		return err
+	})
+}
+
+// verifyMFA checks the passcode, or when it's empty the recovery code, of the user.
+// A passcode and a recovery code can be used only once.
+func (s *Service) verifyMFA(ctx context.Context, user *User, passcode, recoveryCode string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case passcode != "":
+		return s.useMFAPasscode(ctx, user, passcode)
+	case recoveryCode != "":
+		hash := hashMFARecoveryCode(recoveryCode)
+		for i, code := range user.MFARecoveryCodes {
+			if subtle.ConstantTimeCompare([]byte(code), []byte(hash)) != 1 {
+				continue
+			}
+
+			// the codes are replaced only if no concurrent request used one of them meanwhile
+			remaining := append(user.MFARecoveryCodes[:i:i], user.MFARecoveryCodes[i+1:]...)
+			updated, err := s.store.Users().UpdateMFARecoveryCodes(ctx, user.ID, user.MFARecoveryCodes, remaining)
+			if err != nil {
+				return errs.New(internalErrMsg)
+			}
+			if !updated {
+				return ErrUnauthorized.New(mfaRecoveryCodeErrMsg)
+			}
+
+			user.MFARecoveryCodes = remaining
+			return nil
+		}
+		return ErrUnauthorized.New(mfaRecoveryCodeErrMsg)
+	default:
+		return ErrMFAMissing.New(mfaRequiredErrMsg)
+	}
+}
+
+// useMFAPasscode checks the passcode against the secret key of the user.
+// A passcode is rejected when a passcode of the same or a later time step was accepted before,
+// and all passcodes are rejected for a while after too many failed attempts.
+func (s *Service) useMFAPasscode(ctx context.Context, user *User, passcode string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	if user.MFAFailedAttempts >= mfaMaxFailedAttempts && now.Sub(user.MFAFailedAt) < mfaLockout {
+		return ErrUnauthorized.New(mfaThrottledErrMsg)
+	}
+
+	step, valid, err := consoleauth.ValidateTOTP(user.MFASecretKey, passcode, now)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	if valid {
+		valid, err = s.store.Users().UseMFAStep(ctx, user.ID, step)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+	}
+
+	if !valid {
+		if err := s.store.Users().AddMFAFailure(ctx, user.ID, now); err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return ErrUnauthorized.New(mfaPasscodeErrMsg)
+	}
+
+	return nil
+}
+
+// requireMFA checks that the session passed two-factor authentication,
+// when the user has it enabled.
+func requireMFA(auth Authorization) error {
+	if auth.User.MFAEnabled && !auth.Claims.MFAVerified {
+		return ErrUnauthorized.New(mfaSessionErrMsg)
+	}
+	return nil
+}
+
+// generateMFARecoveryCodes generates new random recovery codes.
+func generateMFARecoveryCodes() ([]string, error) {
+	codes := make([]string, mfaRecoveryCodeCount)
+	for i := range codes {
+		random := make([]byte, mfaRecoveryCodeLength)
+		if _, err := rand.Read(random); err != nil {
+			return nil, err
+		}
+
+		code := make([]byte, mfaRecoveryCodeLength)
+		for k, b := range random {
+			// the alphabet has 32 characters, so there is no modulo bias
+			code[k] = mfaRecoveryCodeAlphabet[int(b)%len(mfaRecoveryCodeAlphabet)]
+		}
+		codes[i] = string(code)
+	}
+	return codes, nil
+}
+
+// hashMFARecoveryCodes hashes the recovery codes for storing them.
+func hashMFARecoveryCodes(codes []string) []string {
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = hashMFARecoveryCode(code)
+	}
+	return hashes
+}
+
+// hashMFARecoveryCode hashes a single recovery code, ignoring case and surrounding whitespace.
+func hashMFARecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToUpper(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectInvitations exposes methods to manage pending project invitations.
+type ProjectInvitations interface {
+	// Insert is a method for inserting or replacing project invitation.
+	Insert(ctx context.Context, invitation *ProjectInvitation) (*ProjectInvitation, error)
+	// Get is a method for querying project invitation of a user.
+	Get(ctx context.Context, projectID, memberID uuid.UUID) (*ProjectInvitation, error)
+	// GetByMemberID is a method for querying all pending project invitations of a user.
+	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]ProjectInvitation, error)
+	// GetByProjectID is a method for querying all pending invitations to a project.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]ProjectInvitation, error)
+	// Delete is a method for deleting project invitation.
+	Delete(ctx context.Context, projectID, memberID uuid.UUID) error
+}
+
+// ProjectInvitation is a pending invitation of a user to join a project.
+// The user becomes a member with the given role after accepting it.
+type ProjectInvitation struct {
+	ProjectID uuid.UUID
+	MemberID  uuid.UUID
+	InviterID uuid.UUID
+	Role      ProjectMemberRole
+
+	CreatedAt time.Time
+}
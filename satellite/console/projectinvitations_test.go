@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProjectInvitationsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		invitations := db.Console().ProjectInvitations()
+
+		createdUsers, createdProjects := prepareUsersAndProjects(ctx, t, db.Console().Users(), db.Console().Projects())
+		inviter, invitee := createdUsers[0], createdUsers[1]
+
+		t.Run("Insert and get", func(t *testing.T) {
+			inserted, err := invitations.Insert(ctx, &console.ProjectInvitation{
+				ProjectID: createdProjects[0].ID,
+				MemberID:  invitee.ID,
+				InviterID: inviter.ID,
+				Role:      console.RoleViewer,
+			})
+			require.NoError(t, err)
+			assert.False(t, inserted.CreatedAt.IsZero())
+
+			invitation, err := invitations.Get(ctx, createdProjects[0].ID, invitee.ID)
+			require.NoError(t, err)
+			assert.Equal(t, inviter.ID, invitation.InviterID)
+			assert.Equal(t, console.RoleViewer, invitation.Role)
+		})
+
+		t.Run("Insert replaces pending invitation", func(t *testing.T) {
+			_, err := invitations.Insert(ctx, &console.ProjectInvitation{
+				ProjectID: createdProjects[0].ID,
+				MemberID:  invitee.ID,
+				InviterID: inviter.ID,
+				Role:      console.RoleAdmin,
+			})
+			require.NoError(t, err)
+
+			_, err = invitations.Insert(ctx, &console.ProjectInvitation{
+				ProjectID: createdProjects[1].ID,
+				MemberID:  invitee.ID,
+				InviterID: inviter.ID,
+				Role:      console.RoleDeveloper,
+			})
+			require.NoError(t, err)
+
+			pending, err := invitations.GetByMemberID(ctx, invitee.ID)
+			require.NoError(t, err)
+			require.Len(t, pending, 2)
+			assert.Equal(t, console.RoleAdmin, pending[0].Role)
+
+			pending, err = invitations.GetByProjectID(ctx, createdProjects[0].ID)
+			require.NoError(t, err)
+			require.Len(t, pending, 1)
+			assert.Equal(t, invitee.ID, pending[0].MemberID)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			err := invitations.Delete(ctx, createdProjects[0].ID, invitee.ID)
+			require.NoError(t, err)
+
+			_, err = invitations.Get(ctx, createdProjects[0].ID, invitee.ID)
+			assert.Error(t, err)
+
+			pending, err := invitations.GetByMemberID(ctx, invitee.ID)
+			require.NoError(t, err)
+			assert.Len(t, pending, 1)
+		})
+	})
+}
@@ -5,9 +5,12 @@ package console
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
 )
 
 // ProjectMembers exposes methods to manage ProjectMembers table in database.
@@ -16,8 +19,10 @@ type ProjectMembers interface {
 	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]ProjectMember, error)
 	// GetByProjectID is a method for querying project members from the database by projectID, offset and limit.
 	GetByProjectID(ctx context.Context, projectID uuid.UUID, pagination Pagination) ([]ProjectMember, error)
-	// Insert is a method for inserting project member into the database.
-	Insert(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectMember, error)
+	// Insert is a method for inserting project member with the given role into the database.
+	Insert(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) (*ProjectMember, error)
+	// UpdateRole is a method for changing the role of project member.
+	UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) error
 	// Delete is a method for deleting project member by memberID and projectID from the database.
 	Delete(ctx context.Context, memberID, projectID uuid.UUID) error
 }
@@ -28,10 +33,52 @@ type ProjectMember struct {
 	MemberID uuid.UUID
 	// FK on Projects table.
 	ProjectID uuid.UUID
+	Role      ProjectMemberRole
 
 	CreatedAt time.Time
 }
 
+// ProjectMemberRole determines what a project member is allowed to do.
+// Every role is allowed to do everything the lower roles are allowed to do.
+type ProjectMemberRole int
+
+const (
+	// RoleViewer can view the project, its members, API keys, usage and billing.
+	RoleViewer ProjectMemberRole = 1
+	// RoleDeveloper can create and delete API keys.
+	RoleDeveloper ProjectMemberRole = 2
+	// RoleAdmin can update the project and invite, remove and change the roles of members.
+	RoleAdmin ProjectMemberRole = 3
+	// RoleOwner can delete the project and transfer its ownership. Every project has a single owner.
+	RoleOwner ProjectMemberRole = 4
+)
+
+// String returns the name of the role.
+func (role ProjectMemberRole) String() string {
+	switch role {
+	case RoleViewer:
+		return "viewer"
+	case RoleDeveloper:
+		return "developer"
+	case RoleAdmin:
+		return "admin"
+	case RoleOwner:
+		return "owner"
+	default:
+		return fmt.Sprintf("role(%d)", int(role))
+	}
+}
+
+// ParseProjectMemberRole parses the name of a role.
+func ParseProjectMemberRole(name string) (ProjectMemberRole, error) {
+	for _, role := range []ProjectMemberRole{RoleViewer, RoleDeveloper, RoleAdmin, RoleOwner} {
+		if role.String() == strings.ToLower(name) {
+			return role, nil
+		}
+	}
+	return 0, errs.New("unknown project member role %q", name)
+}
+
 // Pagination defines pagination, filtering and sorting rules
 type Pagination struct {
 	Limit  int
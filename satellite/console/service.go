@@ -15,6 +15,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -49,6 +50,13 @@ const (
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
 
+	projectRoleErrMsg        = "Your role in this project does not allow this action"
+	invitationRoleErrMsg     = "Members can be invited as viewers, developers or admins"
+	invitationNotFoundErrMsg = "There is no pending invitation to this project"
+	ownerRoleErrMsg          = "The role of the project owner can only be changed by transferring the ownership"
+	transferOwnershipErrMsg  = "The ownership can only be transferred to another member of the project"
+	suspendedErrMsg          = "Your account is suspended"
+
 	// TODO: remove after vanguard release
 	usedRegTokenVanguardErrMsg = "This registration token has already been used"
 	projLimitVanguardErrMsg    = "Sorry, during the Vanguard release you have a limited number of projects"
@@ -58,16 +66,17 @@ const (
 type Service struct {
 	Signer
 
-	log     *zap.Logger
-	pm      payments.Service
-	store   DB
-	rewards rewards.DB
+	log          *zap.Logger
+	pm           payments.Service
+	store        DB
+	rewards      rewards.DB
+	projectUsage *accounting.ProjectUsage
 
 	passwordCost int
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, projectUsage *accounting.ProjectUsage, passwordCost int) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -87,6 +96,7 @@ func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm
 		store:        store,
 		rewards:      rewards,
 		pm:           pm,
+		projectUsage: projectUsage,
 		passwordCost: passwordCost,
 	}, nil
 }
@@ -157,7 +167,11 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 			UserID:     u.ID,
 			CustomerID: cus.ID,
 		})
+		if err != nil {
+			return err
+		}
 
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, u.ID, AuditUserCreate, nil, u.Email))
 		return err
 	})
 
@@ -238,12 +252,22 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 
 	user.Status = Active
 
-	err = s.store.Users().Update(ctx, user)
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return errs.New(internalErrMsg)
 	}
 
-	return nil
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.Users().Update(ctx, user); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, user.ID, AuditUserActivate, nil, user.Email))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
 }
 
 // ResetPassword - is a method for reseting user password
@@ -279,12 +303,22 @@ func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, passwor
 
 	user.PasswordHash = hash
 
-	err = s.store.Users().Update(ctx, user)
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return err
 	}
 
-	return s.store.ResetPasswordTokens().Delete(ctx, token.Secret)
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.Users().Update(ctx, user); err != nil {
+			return err
+		}
+
+		if _, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, user.ID, AuditPasswordReset, nil, user.Email)); err != nil {
+			return err
+		}
+
+		return tx.ResetPasswordTokens().Delete(ctx, token.Secret)
+	})
 }
 
 // RevokeResetPasswordToken - is a method to revoke reset password token
@@ -299,27 +333,40 @@ func (s *Service) RevokeResetPasswordToken(ctx context.Context, resetPasswordTok
 	return s.store.ResetPasswordTokens().Delete(ctx, secret)
 }
 
-// Token authenticates User by credentials and returns auth token
-func (s *Service) Token(ctx context.Context, email, password string) (token string, err error) {
+// Token authenticates User by credentials and returns auth token.
+// Users with two-factor authentication enabled have to provide a passcode or recovery code.
+func (s *Service) Token(ctx context.Context, request AuthUser) (token string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	email = normalizeEmail(email)
+	email := normalizeEmail(request.Email)
 
 	user, err := s.store.Users().GetByEmail(ctx, email)
 	if err != nil {
 		return "", errs.New(credentialsErrMsg)
 	}
 
-	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password))
+	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(request.Password))
 	if err != nil {
 		return "", ErrUnauthorized.New(credentialsErrMsg)
 	}
 
+	if user.Status == Suspended {
+		return "", ErrUnauthorized.New(suspendedErrMsg)
+	}
+
 	claims := consoleauth.Claims{
 		ID:         user.ID,
 		Expiration: time.Now().Add(tokenExpirationTime),
 	}
 
+	if user.MFAEnabled {
+		err = s.verifyMFA(ctx, user, request.MFAPasscode, request.MFARecoveryCode)
+		if err != nil {
+			return "", err
+		}
+		claims.MFAVerified = true
+	}
+
 	token, err = s.createToken(ctx, &claims)
 	if err != nil {
 		return "", err
@@ -359,19 +406,30 @@ func (s *Service) UpdateAccount(ctx context.Context, info UserInfo) (err error)
 		return err
 	}
 
-	err = s.store.Users().Update(ctx, &User{
-		ID:           auth.User.ID,
-		FullName:     info.FullName,
-		ShortName:    info.ShortName,
-		Email:        auth.User.Email,
-		PasswordHash: nil,
-		Status:       auth.User.Status,
-	})
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return errs.New(internalErrMsg)
 	}
 
-	return nil
+	return withTx(tx, func(tx DBTx) error {
+		err := tx.Users().Update(ctx, &User{
+			ID:           auth.User.ID,
+			FullName:     info.FullName,
+			ShortName:    info.ShortName,
+			Email:        auth.User.Email,
+			PasswordHash: nil,
+			Status:       auth.User.Status,
+		})
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditUserUpdate, nil, auth.User.Email))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
 }
 
 // ChangePassword updates password for a given user
@@ -397,12 +455,23 @@ func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err
 	}
 
 	auth.User.PasswordHash = hash
-	err = s.store.Users().Update(ctx, &auth.User)
+
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return errs.New(internalErrMsg)
 	}
 
-	return nil
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.Users().Update(ctx, &auth.User); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditPasswordChange, nil, auth.User.Email))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
 }
 
 // DeleteAccount deletes User
@@ -418,12 +487,26 @@ func (s *Service) DeleteAccount(ctx context.Context, password string) (err error
 		return ErrUnauthorized.New(oldPassIncorrectErrMsg)
 	}
 
-	err = s.store.Users().Delete(ctx, auth.User.ID)
+	if err = requireMFA(auth); err != nil {
+		return err
+	}
+
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return errs.New(internalErrMsg)
 	}
 
-	return nil
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.Users().Delete(ctx, auth.User.ID); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditUserDelete, nil, auth.User.Email))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
 }
 
 // GetProject is a method for querying project by id
@@ -516,12 +599,17 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 			return errs.New(internalErrMsg)
 		}
 
-		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID)
+		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID, RoleOwner)
 		if err != nil {
 			return errs.New(internalErrMsg)
 		}
 
-		return err
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditProjectCreate, &p.ID, p.Name))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return nil
 	})
 
 	if err != nil {
@@ -539,16 +627,30 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.hasProjectRole(ctx, auth.User.ID, projectID, RoleOwner); err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	err = s.store.Projects().Delete(ctx, projectID)
+	if err = requireMFA(auth); err != nil {
+		return err
+	}
+
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return errs.New(internalErrMsg)
 	}
 
-	return nil
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.Projects().Delete(ctx, projectID); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditProjectDelete, &projectID, projectID.String()))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
 }
 
 // UpdateProject is a method for updating project description by id
@@ -559,7 +661,7 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 		return nil, err
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -567,26 +669,52 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 	project := isMember.project
 	project.Description = description
 
-	err = s.store.Projects().Update(ctx, project)
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return nil, errs.New(internalErrMsg)
 	}
 
+	err = withTx(tx, func(tx DBTx) error {
+		if err := tx.Projects().Update(ctx, project); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditProjectUpdate, &projectID, project.Name))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return project, nil
 }
 
-// AddProjectMembers adds users by email to given project
-func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
+// AddProjectMembers invites users by email to join given project with the given role.
+// Users that are already members of the project are not invited. It returns the invited users.
+func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string, role ProjectMemberRole) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
 	auth, err := GetAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if role < RoleViewer || role >= RoleOwner {
+		return nil, errs.New(invitationRoleErrMsg)
+	}
+
+	isMember, err := s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	// only the owner can make other members admins
+	if role == RoleAdmin && isMember.membership.Role != RoleOwner {
+		return nil, ErrUnauthorized.New(projectRoleErrMsg)
+	}
+
 	var userErr errs.Group
 
 	// collect user querying errors
@@ -597,6 +725,14 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 			continue
 		}
 
+		_, err = s.isProjectMember(ctx, user.ID, projectID)
+		switch {
+		case err == nil:
+			continue
+		case !ErrNoMembership.Has(err):
+			return nil, errs.New(internalErrMsg)
+		}
+
 		users = append(users, user)
 	}
 
@@ -604,7 +740,7 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 		return nil, errs.New(teamMemberDoesNotExistErrMsg)
 	}
 
-	// add project members in transaction scope
+	// add project invitations in transaction scope
 	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return nil, errs.New(internalErrMsg)
@@ -620,8 +756,18 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 	}()
 
 	for _, user := range users {
-		_, err = tx.ProjectMembers().Insert(ctx, user.ID, projectID)
+		_, err = tx.ProjectInvitations().Insert(ctx, &ProjectInvitation{
+			ProjectID: projectID,
+			MemberID:  user.ID,
+			InviterID: auth.User.ID,
+			Role:      role,
+		})
+
+		if err != nil {
+			return nil, errs.New(internalErrMsg)
+		}
 
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditMemberInvite, &projectID, roleTarget(user.Email, role)))
 		if err != nil {
 			return nil, errs.New(internalErrMsg)
 		}
@@ -630,6 +776,203 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 	return users, nil
 }
 
+// GetProjectInvitations returns pending project invitations of the current user
+func (s *Service) GetProjectInvitations(ctx context.Context) (invitations []ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err = s.store.ProjectInvitations().GetByMemberID(ctx, auth.User.ID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return invitations, nil
+}
+
+// AcceptProjectInvitation makes the current user a member of given project
+// with the role the user was invited with
+func (s *Service) AcceptProjectInvitation(ctx context.Context, projectID uuid.UUID) (member *ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.store.ProjectInvitations().Get(ctx, projectID, auth.User.ID)
+	if err != nil {
+		return nil, errs.New(invitationNotFoundErrMsg)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	err = withTx(tx, func(tx DBTx) (err error) {
+		member, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, projectID, invitation.Role)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		err = tx.ProjectInvitations().Delete(ctx, projectID, auth.User.ID)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditMemberInvitationAccept, &projectID, roleTarget(auth.User.Email, invitation.Role)))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// DeclineProjectInvitation removes pending invitation of the current user to given project
+func (s *Service) DeclineProjectInvitation(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.store.ProjectInvitations().Get(ctx, projectID, auth.User.ID); err != nil {
+		return errs.New(invitationNotFoundErrMsg)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.ProjectInvitations().Delete(ctx, projectID, auth.User.ID); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditMemberInvitationDecline, &projectID, auth.User.Email))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
+}
+
+// UpdateProjectMemberRole changes the role of the project member with given email.
+// Only the owner can change the role of admins or make other members admins.
+func (s *Service) UpdateProjectMemberRole(ctx context.Context, projectID uuid.UUID, email string, role ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if role < RoleViewer || role > RoleOwner {
+		return errs.New(invitationRoleErrMsg)
+	}
+	if role == RoleOwner {
+		return errs.New(ownerRoleErrMsg)
+	}
+
+	isMember, err := s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	if err != nil {
+		return errs.New(teamMemberDoesNotExistErrMsg)
+	}
+
+	target, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return err
+	}
+
+	if target.membership.Role == RoleOwner {
+		return errs.New(ownerRoleErrMsg)
+	}
+	if (role == RoleAdmin || target.membership.Role == RoleAdmin) && isMember.membership.Role != RoleOwner {
+		return ErrUnauthorized.New(projectRoleErrMsg)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.ProjectMembers().UpdateRole(ctx, user.ID, projectID, role); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditMemberRoleUpdate, &projectID, roleTarget(user.Email, role)))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
+}
+
+// TransferProjectOwnership makes the project member with given email the owner
+// of the project, the current owner becomes an admin
+func (s *Service) TransferProjectOwnership(ctx context.Context, projectID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.hasProjectRole(ctx, auth.User.ID, projectID, RoleOwner); err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	if err = requireMFA(auth); err != nil {
+		return err
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	if err != nil {
+		return errs.New(teamMemberDoesNotExistErrMsg)
+	}
+	if user.ID == auth.User.ID {
+		return errs.New(transferOwnershipErrMsg)
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+		return errs.New(transferOwnershipErrMsg)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	return withTx(tx, func(tx DBTx) error {
+		if err := tx.ProjectMembers().UpdateRole(ctx, user.ID, projectID, RoleOwner); err != nil {
+			return errs.New(internalErrMsg)
+		}
+		if err := tx.ProjectMembers().UpdateRole(ctx, auth.User.ID, projectID, RoleAdmin); err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err := tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditProjectOwnershipTransfer, &projectID, user.Email))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
+}
+
 // DeleteProjectMembers removes users by email from given project
 func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -638,11 +981,12 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	isMember, err := s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	var userIDs []uuid.UUID
+	var users []*User
 	var userErr errs.Group
 
 	// collect user querying errors
@@ -654,7 +998,18 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 			continue
 		}
 
-		userIDs = append(userIDs, user.ID)
+		// the owner can't be removed and only the owner can remove admins
+		target, err := s.isProjectMember(ctx, user.ID, projectID)
+		if err == nil {
+			if target.membership.Role == RoleOwner {
+				return errs.New(ownerRoleErrMsg)
+			}
+			if target.membership.Role == RoleAdmin && isMember.membership.Role != RoleOwner {
+				return ErrUnauthorized.New(projectRoleErrMsg)
+			}
+		}
+
+		users = append(users, user)
 	}
 
 	if err = userErr.Err(); err != nil {
@@ -676,9 +1031,14 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		err = tx.Commit()
 	}()
 
-	for _, uID := range userIDs {
-		err = tx.ProjectMembers().Delete(ctx, uID, projectID)
+	for _, user := range users {
+		err = tx.ProjectMembers().Delete(ctx, user.ID, projectID)
+
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
 
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditMemberRemove, &projectID, user.Email))
 		if err != nil {
 			return errs.New(internalErrMsg)
 		}
@@ -721,11 +1081,15 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, err
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectRole(ctx, auth.User.ID, projectID, RoleDeveloper)
 	if err != nil {
 		return nil, nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = requireMFA(auth); err != nil {
+		return nil, nil, err
+	}
+
 	secret, err := macaroon.NewSecret()
 	if err != nil {
 		return nil, nil, errs.New(internalErrMsg)
@@ -736,15 +1100,32 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, err
 	}
 
-	info, err := s.store.APIKeys().Create(ctx, key.Head(), APIKeyInfo{
-		Name:      name,
-		ProjectID: projectID,
-		Secret:    secret,
-	})
+	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return nil, nil, errs.New(internalErrMsg)
 	}
 
+	var info *APIKeyInfo
+	err = withTx(tx, func(tx DBTx) (err error) {
+		info, err = tx.APIKeys().Create(ctx, key.Head(), APIKeyInfo{
+			Name:      name,
+			ProjectID: projectID,
+			Secret:    secret,
+		})
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditAPIKeyCreate, &projectID, info.Name))
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return info, key, nil
 }
 
@@ -778,6 +1159,11 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		return err
 	}
 
+	if err = requireMFA(auth); err != nil {
+		return err
+	}
+
+	var keys []*APIKeyInfo
 	var keysErr errs.Group
 
 	for _, keyID := range ids {
@@ -787,11 +1173,13 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+		_, err = s.hasProjectRole(ctx, auth.User.ID, key.ProjectID, RoleDeveloper)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
 		}
+
+		keys = append(keys, key)
 	}
 
 	if err = keysErr.Err(); err != nil {
@@ -812,8 +1200,13 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		err = tx.Commit()
 	}()
 
-	for _, keyToDeleteID := range ids {
-		err = tx.APIKeys().Delete(ctx, keyToDeleteID)
+	for _, key := range keys {
+		err = tx.APIKeys().Delete(ctx, key.ID)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		_, err = tx.AuditLogs().Insert(ctx, newAuditLogEntry(ctx, auth.User.ID, AuditAPIKeyDelete, &key.ProjectID, key.Name))
 		if err != nil {
 			return errs.New(internalErrMsg)
 		}
@@ -865,6 +1258,32 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 	return projectUsage, nil
 }
 
+// GetProjectLimits returns the limits of the project together with its current usage
+func (s *Service) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ *accounting.ProjectLimitUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.projectUsage == nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	limits, err := s.projectUsage.GetProjectLimitUsage(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return limits, nil
+}
+
 // GetBucketTotals retrieves paged bucket total usages since project creation
 func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, cursor BucketUsageCursor, before time.Time) (_ *BucketUsagePage, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1087,6 +1506,10 @@ func (s *Service) authorize(ctx context.Context, claims *consoleauth.Claims) (_
 		return nil, errs.New("authorization failed. no user with id: %s", claims.ID.String())
 	}
 
+	if user.Status == Suspended {
+		return nil, ErrUnauthorized.New(suspendedErrMsg)
+	}
+
 	return user, nil
 }
 
@@ -1123,6 +1546,24 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 	return isProjectMember{}, ErrNoMembership.New(unauthorizedErrMsg)
 }
 
+// ErrProjectRole is error type of having a project role that doesn't allow an action
+var ErrProjectRole = errs.Class("project role error")
+
+// hasProjectRole checks if the user is a member of given project with at least the given role
+func (s *Service) hasProjectRole(ctx context.Context, userID uuid.UUID, projectID uuid.UUID, role ProjectMemberRole) (result isProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	result, err = s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return result, err
+	}
+
+	if result.membership.Role < role {
+		return isProjectMember{}, ErrProjectRole.New(projectRoleErrMsg)
+	}
+
+	return result, nil
+}
+
 // withTx is a helper function for executing db operations
 // in transaction scope
 func withTx(tx DBTx, cb func(tx DBTx) error) (err error) {
@@ -23,6 +23,16 @@ type Users interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	// Update is a method for updating user entity.
 	Update(ctx context.Context, user *User) error
+	// UpdateMFA is a method for updating two-factor authentication settings of user.
+	UpdateMFA(ctx context.Context, user *User) error
+	// UseMFAStep stores the time step of an accepted passcode and resets the failed attempts.
+	// It returns false when the step isn't newer than the step of the last accepted passcode.
+	UseMFAStep(ctx context.Context, id uuid.UUID, step int64) (bool, error)
+	// UpdateMFARecoveryCodes replaces the recovery codes of user, when they haven't changed since reading them.
+	// It returns false when the stored recovery codes differ from old.
+	UpdateMFARecoveryCodes(ctx context.Context, id uuid.UUID, old, new []string) (bool, error)
+	// AddMFAFailure counts a failed two-factor authentication attempt of user.
+	AddMFAFailure(ctx context.Context, id uuid.UUID, failedAt time.Time) error
 }
 
 // UserInfo holds User updatable data.
@@ -74,6 +84,8 @@ const (
 	Active UserStatus = 1
 	// Deleted is a user status that he receives after deleting account
 	Deleted UserStatus = 2
+	// Suspended is a user status set by satellite admins, suspended users can't log in to the console
+	Suspended UserStatus = 3
 )
 
 // User is a database object that describes User entity.
@@ -89,4 +101,13 @@ type User struct {
 	Status UserStatus `json:"status"`
 
 	CreatedAt time.Time `json:"createdAt"`
+
+	// MFAEnabled is set when user has to pass TOTP two-factor authentication to sign in.
+	MFAEnabled   bool   `json:"mfaEnabled"`
+	MFASecretKey string `json:"-"`
+	// MFARecoveryCodes are hashes of the unused recovery codes.
+	MFARecoveryCodes []string `json:"-"`
+	// MFAFailedAttempts is the number of failed passcodes since the last accepted one.
+	MFAFailedAttempts int       `json:"-"`
+	MFAFailedAt       time.Time `json:"-"`
 }
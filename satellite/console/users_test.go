@@ -99,6 +99,95 @@ func TestUserRepository(t *testing.T) {
 			assert.Equal(t, newUser.CreatedAt, oldUser.CreatedAt)
 		})
 
+		t.Run("Update two-factor authentication success", func(t *testing.T) {
+			user, err := repository.GetByEmail(ctx, newEmail)
+			assert.NoError(t, err)
+			assert.False(t, user.MFAEnabled)
+
+			user.MFAEnabled = true
+			user.MFASecretKey = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+			user.MFARecoveryCodes = []string{"first", "second"}
+
+			err = repository.UpdateMFA(ctx, user)
+			assert.NoError(t, err)
+
+			updated, err := repository.Get(ctx, user.ID)
+			assert.NoError(t, err)
+			assert.True(t, updated.MFAEnabled)
+			assert.Equal(t, user.MFASecretKey, updated.MFASecretKey)
+			assert.Equal(t, user.MFARecoveryCodes, updated.MFARecoveryCodes)
+
+			updated.MFAEnabled = false
+			updated.MFASecretKey = ""
+			updated.MFARecoveryCodes = nil
+
+			err = repository.UpdateMFA(ctx, updated)
+			assert.NoError(t, err)
+
+			updated, err = repository.GetByEmail(ctx, newEmail)
+			assert.NoError(t, err)
+			assert.False(t, updated.MFAEnabled)
+			assert.Empty(t, updated.MFASecretKey)
+			assert.Empty(t, updated.MFARecoveryCodes)
+		})
+
+		t.Run("Use two-factor authentication success", func(t *testing.T) {
+			user, err := repository.GetByEmail(ctx, newEmail)
+			assert.NoError(t, err)
+
+			user.MFAEnabled = true
+			user.MFASecretKey = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+			user.MFARecoveryCodes = []string{"first", "second"}
+
+			err = repository.UpdateMFA(ctx, user)
+			assert.NoError(t, err)
+
+			// a time step can be used only once and only after previous ones
+			for _, step := range []struct {
+				step int64
+				used bool
+			}{{10, true}, {10, false}, {9, false}, {11, true}} {
+				used, err := repository.UseMFAStep(ctx, user.ID, step.step)
+				assert.NoError(t, err)
+				assert.Equal(t, step.used, used, step.step)
+			}
+
+			failedAt := time.Now().UTC().Truncate(time.Second)
+			assert.NoError(t, repository.AddMFAFailure(ctx, user.ID, failedAt))
+			assert.NoError(t, repository.AddMFAFailure(ctx, user.ID, failedAt))
+
+			updated, err := repository.Get(ctx, user.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, updated.MFAFailedAttempts)
+			assert.True(t, failedAt.Equal(updated.MFAFailedAt))
+
+			// a used passcode resets the failed attempts
+			used, err := repository.UseMFAStep(ctx, user.ID, 12)
+			assert.NoError(t, err)
+			assert.True(t, used)
+
+			updated, err = repository.Get(ctx, user.ID)
+			assert.NoError(t, err)
+			assert.Zero(t, updated.MFAFailedAttempts)
+
+			// recovery codes are replaced only when they didn't change meanwhile
+			replaced, err := repository.UpdateMFARecoveryCodes(ctx, user.ID, user.MFARecoveryCodes, []string{"second"})
+			assert.NoError(t, err)
+			assert.True(t, replaced)
+
+			replaced, err = repository.UpdateMFARecoveryCodes(ctx, user.ID, user.MFARecoveryCodes, []string{"first"})
+			assert.NoError(t, err)
+			assert.False(t, replaced)
+
+			replaced, err = repository.UpdateMFARecoveryCodes(ctx, user.ID, []string{"second"}, nil)
+			assert.NoError(t, err)
+			assert.True(t, replaced)
+
+			updated, err = repository.Get(ctx, user.ID)
+			assert.NoError(t, err)
+			assert.Empty(t, updated.MFARecoveryCodes)
+		})
+
 		t.Run("Delete user success", func(t *testing.T) {
 			oldUser, err := repository.GetByEmail(ctx, newEmail)
 			assert.NoError(t, err)
@@ -0,0 +1,33 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package damagedpieces
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Report is a piece, which a storage node reported as damaged
+type Report struct {
+	NodeID     storj.NodeID
+	PieceID    storj.PieceID
+	ReportedAt time.Time
+}
+
+// DB stores the damaged pieces reported by storage nodes
+type DB interface {
+	// Add stores the reported pieces of a node, ignoring the already reported ones
+	Add(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID, reportedAt time.Time) error
+	// GetAll returns all reported pieces
+	GetAll(ctx context.Context) ([]Report, error)
+	// List returns at most limit reported pieces ordered by node and piece ID,
+	// which come after the node and piece ID of cursor. The zero cursor lists from the start.
+	List(ctx context.Context, cursor Report, limit int) ([]Report, error)
+	// Has returns whether the node reported the piece
+	Has(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (bool, error)
+	// Delete removes the report of a piece
+	Delete(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) error
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package damagedpieces_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/damagedpieces"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDamagedPieces(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		damaged := db.DamagedPieces()
+		nodeID := testrand.NodeID()
+		pieceIDs := []storj.PieceID{testrand.PieceID(), testrand.PieceID()}
+
+		reportedAt := time.Now().Add(-time.Hour)
+		require.NoError(t, damaged.Add(ctx, nodeID, pieceIDs, reportedAt))
+		// reporting the same piece again is ignored
+		require.NoError(t, damaged.Add(ctx, nodeID, pieceIDs[:1], time.Now()))
+
+		reports, err := damaged.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		for _, report := range reports {
+			assert.Equal(t, nodeID, report.NodeID)
+			assert.Contains(t, pieceIDs, report.PieceID)
+		}
+
+		// listing pages through the reports ordered by node and piece ID
+		otherNodeID, otherPieceID := testrand.NodeID(), testrand.PieceID()
+		require.NoError(t, damaged.Add(ctx, otherNodeID, []storj.PieceID{otherPieceID}, reportedAt))
+
+		var listed []damagedpieces.Report
+		var cursor damagedpieces.Report
+		for {
+			page, err := damaged.List(ctx, cursor, 2)
+			require.NoError(t, err)
+			require.True(t, len(page) <= 2)
+			if len(page) == 0 {
+				break
+			}
+			listed = append(listed, page...)
+			cursor = page[len(page)-1]
+		}
+		require.Len(t, listed, 3)
+		for i := 1; i < len(listed); i++ {
+			a, b := listed[i-1], listed[i]
+			assert.True(t, a.NodeID.Less(b.NodeID) || (a.NodeID == b.NodeID && bytes.Compare(a.PieceID.Bytes(), b.PieceID.Bytes()) < 0))
+		}
+		for _, report := range listed {
+			assert.WithinDuration(t, reportedAt, report.ReportedAt, time.Second)
+		}
+
+		require.NoError(t, damaged.Delete(ctx, otherNodeID, otherPieceID))
+
+		has, err := damaged.Has(ctx, nodeID, pieceIDs[0])
+		require.NoError(t, err)
+		assert.True(t, has)
+
+		require.NoError(t, damaged.Delete(ctx, nodeID, pieceIDs[0]))
+		has, err = damaged.Has(ctx, nodeID, pieceIDs[0])
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		reports, err = damaged.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, pieceIDs[1], reports[0].PieceID)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package damagedpieces receives the pieces, which storage nodes found damaged
+// on their disks. The checker queues the segments with reported pieces for
+// repair, so they are repaired before audits fail on them.
+package damagedpieces
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// Error is the default error class for damaged pieces errors
+	Error = errs.Class("damaged pieces")
+
+	mon = monkit.Package()
+)
+
+// MaxPiecesPerReport is the maximum number of pieces a node can report at once
+const MaxPiecesPerReport = 1000
+
+// Endpoint receives the damaged pieces reported by storage nodes
+type Endpoint struct {
+	log *zap.Logger
+	db  DB
+}
+
+// NewEndpoint creates a new damaged pieces endpoint
+func NewEndpoint(log *zap.Logger, db DB) *Endpoint {
+	return &Endpoint{
+		log: log,
+		db:  db,
+	}
+}
+
+// Report stores the damaged pieces reported by the calling storage node
+func (endpoint *Endpoint) Report(ctx context.Context, req *pb.DamagedPiecesReportRequest) (_ *pb.DamagedPiecesReportResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if len(req.Pieces) > MaxPiecesPerReport {
+		return nil, Error.New("too many pieces reported: %d, maximum is %d", len(req.Pieces), MaxPiecesPerReport)
+	}
+
+	pieceIDs := make([]storj.PieceID, 0, len(req.Pieces))
+	for _, piece := range req.Pieces {
+		pieceIDs = append(pieceIDs, piece.PieceId)
+	}
+
+	if err := endpoint.db.Add(ctx, peer.ID, pieceIDs, time.Now().UTC()); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("damaged pieces reported", zap.Stringer("node ID", peer.ID), zap.Int("count", len(pieceIDs)))
+	mon.Meter("damaged_pieces_reported").Mark(len(pieceIDs))
+
+	return &pb.DamagedPiecesReportResponse{}, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// TestGarbageCollection does the following:
+// * Upload an object
+// * Add pieces unknown to the satellite to a storagenode holding a piece of the object
+// * Create and send the bloom filters
+// * Check that the unknown piece created before the filter is deleted
+// * Check that the unknown piece created after the filter is kept
+// * Check that the piece of the object is kept
+func TestGarbageCollection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		gcService := satellite.GarbageCollection.Service
+		gcService.Loop.Pause()
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := upl.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		pointer := getRemotePointer(t, ctx, planet)
+		rootPieceID := pointer.GetRemote().RootPieceId
+		keptPiece := pointer.GetRemote().GetRemotePieces()[0]
+
+		var targetNode *storagenode.Peer
+		for _, node := range planet.StorageNodes {
+			if node.ID() == keptPiece.NodeId {
+				targetNode = node
+			}
+		}
+		require.NotNil(t, targetNode)
+
+		keptPieceID := rootPieceID.Derive(keptPiece.NodeId, keptPiece.PieceNum)
+
+		// add a piece to the storage node that the satellite doesn't know about
+		garbagePieceID := testrand.PieceID()
+		addPiece(t, ctx, planet, targetNode, garbagePieceID, time.Now().Add(-time.Hour))
+
+		// pieces created after the filter creation date must be kept
+		newPieceID := testrand.PieceID()
+		addPiece(t, ctx, planet, targetNode, newPieceID, time.Now().Add(time.Hour))
+
+		retainInfos, err := gcService.CollectFilters(ctx, time.Now())
+		require.NoError(t, err)
+		require.Contains(t, retainInfos, targetNode.ID())
+
+		gcService.SendFilters(ctx, retainInfos)
+
+		pieceInfos := targetNode.DB.PieceInfo()
+
+		_, err = pieceInfos.Get(ctx, satellite.ID(), garbagePieceID)
+		require.Error(t, err)
+		_, err = targetNode.Storage2.Store.Reader(ctx, satellite.ID(), garbagePieceID)
+		require.Error(t, err)
+
+		_, err = pieceInfos.Get(ctx, satellite.ID(), keptPieceID)
+		require.NoError(t, err)
+		reader, err := targetNode.Storage2.Store.Reader(ctx, satellite.ID(), keptPieceID)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		_, err = pieceInfos.Get(ctx, satellite.ID(), newPieceID)
+		require.NoError(t, err)
+
+		downloaded, err := upl.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, downloaded)
+	})
+}
+
+func getRemotePointer(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) *pb.Pointer {
+	metainfo := planet.Satellites[0].Metainfo.Service
+	items, _, err := metainfo.List(ctx, "", "", "", true, 0, 0)
+	require.NoError(t, err)
+
+	for _, item := range items {
+		pointer, err := metainfo.Get(ctx, item.GetPath())
+		require.NoError(t, err)
+		if pointer.GetType() == pb.Pointer_REMOTE {
+			return pointer
+		}
+	}
+
+	t.Fatal("no remote pointer found")
+	return nil
+}
+
+func addPiece(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, node *storagenode.Peer, pieceID storj.PieceID, creation time.Time) {
+	satelliteID := planet.Satellites[0].ID()
+	uplink := planet.Uplinks[0].Identity
+
+	writer, err := node.Storage2.Store.Writer(ctx, satelliteID, pieceID)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(memory.KiB))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	hash, err := signing.SignPieceHash(ctx, signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+		PieceId: pieceID,
+		Hash:    writer.Hash(),
+	})
+	require.NoError(t, err)
+
+	err = node.DB.PieceInfo().Add(ctx, &pieces.Info{
+		SatelliteID:     satelliteID,
+		PieceID:         pieceID,
+		PieceSize:       writer.Size(),
+		PieceCreation:   creation,
+		UplinkPieceHash: hash,
+		Uplink:          uplink.PeerIdentity(),
+	})
+	require.NoError(t, err)
+}
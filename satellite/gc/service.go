@@ -0,0 +1,212 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package gc implements sending garbage collection bloom filters from the satellite to storage nodes.
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error defines the gc service errors class
+	Error = errs.Class("gc service error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Interval time.Duration `help:"the time between each send of garbage collection filters to storage nodes" releaseDefault:"168h" devDefault:"10m"`
+	Enabled  bool          `help:"set if garbage collection is enabled or not" releaseDefault:"false" devDefault:"true"`
+	// value for InitialPieces currently based on average pieces per node
+	InitialPieces     int     `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" releaseDefault:"400000" devDefault:"10"`
+	FalsePositiveRate float64 `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
+	ConcurrentSends   int     `help:"the number of nodes to concurrently send garbage collection bloom filters to" releaseDefault:"1" devDefault:"1"`
+}
+
+// Service implements the garbage collection service
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	transport transport.Client
+	overlay   *overlay.Cache
+	metainfo  *metainfo.Service
+
+	// lastPieceCounts keeps the number of pieces each node had during
+	// the last run, it is used to size the filters for the next run
+	lastPieceCounts map[storj.NodeID]int
+}
+
+// RetainInfo contains info needed for a storage node to retain important data and delete garbage data
+type RetainInfo struct {
+	Filter       *bloomfilter.Filter
+	CreationDate time.Time
+	Count        int
+}
+
+// NewService creates a new instance of the gc service
+func NewService(log *zap.Logger, config Config, transport transport.Client, overlay *overlay.Cache, metainfo *metainfo.Service) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		transport: transport,
+		overlay:   overlay,
+		metainfo:  metainfo,
+
+		lastPieceCounts: make(map[storj.NodeID]int),
+	}
+}
+
+// Run starts the gc loop service
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		retainInfos, err := service.CollectFilters(ctx, time.Now().UTC())
+		if err != nil {
+			service.log.Error("error collecting bloom filters", zap.Error(err))
+			return nil
+		}
+
+		service.SendFilters(ctx, retainInfos)
+		return nil
+	})
+}
+
+// Close stops the gc service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// CollectFilters walks all the pointers in metainfo and creates a bloom filter
+// per storage node containing the pieces it should keep.
+//
+// Pieces created after creationDate are not part of the filter, hence
+// storage nodes must keep everything created after that.
+func (service *Service) CollectFilters(ctx context.Context, creationDate time.Time) (_ map[storj.NodeID]*RetainInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	retainInfos := make(map[storj.NodeID]*RetainInfo)
+
+	err = service.metainfo.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
+				}
+
+				for _, piece := range remote.GetRemotePieces() {
+					info, ok := retainInfos[piece.NodeId]
+					if !ok {
+						numPieces := service.config.InitialPieces
+						if last := service.lastPieceCounts[piece.NodeId]; last > numPieces {
+							numPieces = last
+						}
+						info = &RetainInfo{
+							Filter:       bloomfilter.NewOptimal(numPieces, service.config.FalsePositiveRate),
+							CreationDate: creationDate,
+						}
+						retainInfos[piece.NodeId] = info
+					}
+
+					info.Filter.Add(remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+					info.Count++
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	pieceCounts := make(map[storj.NodeID]int, len(retainInfos))
+	for id, info := range retainInfos {
+		pieceCounts[id] = info.Count
+	}
+	service.lastPieceCounts = pieceCounts
+
+	return retainInfos, nil
+}
+
+// SendFilters sends the retain requests to all storage nodes, failures are only logged.
+func (service *Service) SendFilters(ctx context.Context, retainInfos map[storj.NodeID]*RetainInfo) {
+	defer mon.Task()(&ctx)(nil)
+
+	concurrent := service.config.ConcurrentSends
+	if concurrent <= 0 {
+		concurrent = 1
+	}
+
+	limiter := sync2.NewLimiter(concurrent)
+	for id, info := range retainInfos {
+		id, info := id, info
+		limiter.Go(ctx, func() {
+			err := service.sendRetainRequest(ctx, id, info)
+			if err != nil {
+				service.log.Error("error sending retain info to node", zap.Stringer("node ID", id), zap.Error(err))
+			}
+		})
+	}
+	limiter.Wait()
+}
+
+// sendRetainRequest sends a single retain request to the storage node.
+func (service *Service) sendRetainRequest(ctx context.Context, id storj.NodeID, info *RetainInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dossier, err := service.overlay.Get(ctx, id)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, &dossier.Node)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, Error.Wrap(conn.Close()))
+	}()
+
+	filter := info.Filter.Bytes()
+	mon.IntVal("retain_filter_size_bytes").Observe(int64(len(filter)))
+
+	_, err = pb.NewGarbageCollectionClient(conn).Retain(ctx, &pb.RetainRequest{
+		CreationDate: info.CreationDate,
+		Filter:       filter,
+	})
+	return Error.Wrap(err)
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+// Chore queues the pieces of the exiting nodes for transfer
+type Chore struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	db       DB
+	overlay  *overlay.Cache
+	metainfo *metainfo.Service
+}
+
+// NewChore creates a new graceful exit chore
+func NewChore(log *zap.Logger, config Config, db DB, overlay *overlay.Cache, metainfo *metainfo.Service) *Chore {
+	return &Chore{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.ChoreInterval),
+
+		db:       db,
+		overlay:  overlay,
+		metainfo: metainfo,
+	}
+}
+
+// Run starts the chore
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		if err := chore.QueuePieces(ctx); err != nil {
+			chore.log.Error("error queueing pieces of exiting nodes", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the chore
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// QueuePieces walks all the pointers in metainfo and adds the pieces stored on
+// exiting nodes to the transfer queue. The exits are marked as ready to be
+// processed afterwards.
+func (chore *Chore) QueuePieces(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exitingNodes, err := chore.overlay.GetExitingNodesLoopIncomplete(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(exitingNodes) == 0 {
+		return nil
+	}
+
+	exiting := make(map[storj.NodeID]struct{}, len(exitingNodes))
+	for _, id := range exitingNodes {
+		exiting[id] = struct{}{}
+	}
+
+	batchSize := chore.config.ChoreBatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	var batch []TransferQueueItem
+	err = chore.metainfo.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
+				}
+
+				for _, piece := range remote.GetRemotePieces() {
+					if _, ok := exiting[piece.NodeId]; !ok {
+						continue
+					}
+					batch = append(batch, TransferQueueItem{
+						NodeID:   piece.NodeId,
+						Path:     []byte(item.Key.String()),
+						PieceNum: piece.PieceNum,
+					})
+				}
+
+				if len(batch) >= batchSize {
+					if err := chore.db.Enqueue(ctx, batch); err != nil {
+						return err
+					}
+					batch = batch[:0]
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := chore.db.Enqueue(ctx, batch); err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+	for _, id := range exitingNodes {
+		status, err := chore.overlay.GetExitStatus(ctx, id)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		status.ExitLoopCompletedAt = &now
+		if err := chore.overlay.UpdateExitStatus(ctx, status); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Progress is the graceful exit progress of a node
+type Progress struct {
+	NodeID            storj.NodeID
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+// TransferQueueItem is a piece, which an exiting node has to transfer
+type TransferQueueItem struct {
+	NodeID       storj.NodeID
+	Path         []byte
+	PieceNum     int32
+	QueuedAt     time.Time
+	RequestedAt  *time.Time
+	LastFailedAt *time.Time
+	FailedCount  *int
+	FinishedAt   *time.Time
+}
+
+// DB stores the graceful exit progress and transfer queue of exiting nodes
+type DB interface {
+	// IncrementProgress adds to the transferred bytes and pieces of a node
+	IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) error
+	// GetProgress returns the progress of a node
+	GetProgress(ctx context.Context, nodeID storj.NodeID) (*Progress, error)
+
+	// Enqueue adds items to the transfer queue, ignoring the already queued ones
+	Enqueue(ctx context.Context, items []TransferQueueItem) error
+	// UpdateTransferQueueItem updates the timestamps and failure count of a queued item
+	UpdateTransferQueueItem(ctx context.Context, item TransferQueueItem) error
+	// DeleteTransferQueueItem removes an item from the transfer queue
+	DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte) error
+	// DeleteTransferQueueItems removes all items of a node from the transfer queue
+	DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) error
+	// GetTransferQueueItem returns a queued item
+	GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte) (*TransferQueueItem, error)
+	// GetIncomplete returns the queued items of a node, which aren't finished
+	GetIncomplete(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) ([]*TransferQueueItem, error)
+}
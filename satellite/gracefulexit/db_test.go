@@ -0,0 +1,112 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProgress(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		exits := db.GracefulExit()
+		nodeID := testrand.NodeID()
+
+		progress, err := exits.GetProgress(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Zero(t, progress.PiecesTransferred)
+
+		require.NoError(t, exits.IncrementProgress(ctx, nodeID, 100, 2, 1))
+		require.NoError(t, exits.IncrementProgress(ctx, nodeID, 50, 1, 0))
+
+		progress, err = exits.GetProgress(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, nodeID, progress.NodeID)
+		assert.EqualValues(t, 150, progress.BytesTransferred)
+		assert.EqualValues(t, 3, progress.PiecesTransferred)
+		assert.EqualValues(t, 1, progress.PiecesFailed)
+	})
+}
+
+func TestTransferQueue(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		exits := db.GracefulExit()
+		nodeID := testrand.NodeID()
+
+		var items []gracefulexit.TransferQueueItem
+		for i := 0; i < 5; i++ {
+			items = append(items, gracefulexit.TransferQueueItem{
+				NodeID:   nodeID,
+				Path:     testrand.Bytes(32),
+				PieceNum: int32(i),
+			})
+		}
+		require.NoError(t, exits.Enqueue(ctx, items))
+		// queueing the same items again is ignored
+		require.NoError(t, exits.Enqueue(ctx, items[:2]))
+
+		incomplete, err := exits.GetIncomplete(ctx, nodeID, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, incomplete, 5)
+
+		incomplete, err = exits.GetIncomplete(ctx, nodeID, 2, 4)
+		require.NoError(t, err)
+		require.Len(t, incomplete, 1)
+
+		item, err := exits.GetTransferQueueItem(ctx, nodeID, items[0].Path)
+		require.NoError(t, err)
+		assert.Equal(t, items[0].PieceNum, item.PieceNum)
+		assert.Nil(t, item.FinishedAt)
+		assert.Nil(t, item.FailedCount)
+
+		now := time.Now().UTC()
+		failedCount := 1
+		item.RequestedAt = &now
+		item.LastFailedAt = &now
+		item.FailedCount = &failedCount
+		require.NoError(t, exits.UpdateTransferQueueItem(ctx, *item))
+
+		require.NoError(t, exits.UpdateTransferQueueItem(ctx, gracefulexit.TransferQueueItem{
+			NodeID:     nodeID,
+			Path:       items[1].Path,
+			PieceNum:   items[1].PieceNum,
+			FinishedAt: &now,
+		}))
+
+		item, err = exits.GetTransferQueueItem(ctx, nodeID, items[0].Path)
+		require.NoError(t, err)
+		require.NotNil(t, item.FailedCount)
+		assert.Equal(t, 1, *item.FailedCount)
+		assert.NotNil(t, item.RequestedAt)
+		assert.Nil(t, item.FinishedAt)
+
+		incomplete, err = exits.GetIncomplete(ctx, nodeID, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, incomplete, 4)
+
+		require.NoError(t, exits.DeleteTransferQueueItem(ctx, nodeID, items[0].Path))
+		_, err = exits.GetTransferQueueItem(ctx, nodeID, items[0].Path)
+		require.Error(t, err)
+
+		require.NoError(t, exits.DeleteTransferQueueItems(ctx, nodeID))
+		incomplete, err = exits.GetIncomplete(ctx, nodeID, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, incomplete)
+	})
+}
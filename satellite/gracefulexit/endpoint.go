@@ -0,0 +1,442 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package gracefulexit implements the satellite side of storage nodes leaving
+// the network gracefully, by transferring their pieces to other nodes.
+package gracefulexit
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/certdb"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error defines the graceful exit errors class
+	Error = errs.Class("graceful exit error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for graceful exit
+type Config struct {
+	Enabled                      bool          `help:"whether or not storage nodes can gracefully exit the satellite" releaseDefault:"false" devDefault:"true"`
+	ChoreInterval                time.Duration `help:"how frequently the pieces of exiting nodes are queued for transfer" releaseDefault:"1h" devDefault:"10s"`
+	ChoreBatchSize               int           `help:"the number of pieces queued for transfer at once" default:"500"`
+	EndpointBatchSize            int           `help:"the number of transfers sent to an exiting node at once" default:"100"`
+	MaxFailuresPerPiece          int           `help:"the number of failed transfers after which a piece isn't transferred anymore" default:"3"`
+	OverallMaxFailuresPercentage int           `help:"the percentage of failed pieces above which the exit fails" default:"10"`
+}
+
+// PeerIdentities looks up the identities of storage nodes
+type PeerIdentities interface {
+	// FetchPeerIdentity returns the identity of a node
+	FetchPeerIdentity(ctx context.Context, nodeID storj.NodeID) (*identity.PeerIdentity, error)
+}
+
+// Endpoint instructs the exiting storage nodes which pieces to transfer
+type Endpoint struct {
+	log    *zap.Logger
+	config Config
+
+	db              DB
+	overlay         *overlay.Cache
+	metainfo        *metainfo.Service
+	orders          *orders.Service
+	certdb          certdb.DB
+	satelliteSignee signing.Signee
+	peerIdentities  PeerIdentities
+}
+
+// pendingTransfer is a piece sent to the exiting node for transfer
+type pendingTransfer struct {
+	item            *TransferQueueItem
+	originalPieceID storj.PieceID
+	limit           *pb.AddressedOrderLimit
+	pieceSize       int64
+}
+
+// NewEndpoint creates a new graceful exit endpoint
+func NewEndpoint(log *zap.Logger, config Config, db DB, overlay *overlay.Cache, metainfo *metainfo.Service, orders *orders.Service, certdb certdb.DB, satelliteSignee signing.Signee, peerIdentities PeerIdentities) *Endpoint {
+	return &Endpoint{
+		log:    log,
+		config: config,
+
+		db:              db,
+		overlay:         overlay,
+		metainfo:        metainfo,
+		orders:          orders,
+		certdb:          certdb,
+		satelliteSignee: satelliteSignee,
+		peerIdentities:  peerIdentities,
+	}
+}
+
+// Process initiates the graceful exit of the calling node and streams the
+// pieces it has to transfer, until its transfer queue is drained.
+func (endpoint *Endpoint) Process(stream pb.SatelliteGracefulExit_ProcessServer) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.Enabled {
+		return status.Error(codes.Unavailable, "graceful exit is disabled")
+	}
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, Error.Wrap(err).Error())
+	}
+	nodeID := peer.ID
+
+	exitStatus, err := endpoint.overlay.GetExitStatus(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	switch {
+	case exitStatus.ExitFinishedAt != nil:
+		return endpoint.sendResult(stream, exitStatus.ExitSuccess)
+	case exitStatus.ExitInitiatedAt == nil:
+		now := time.Now().UTC()
+		exitStatus.ExitInitiatedAt = &now
+		if err := endpoint.overlay.UpdateExitStatus(ctx, exitStatus); err != nil {
+			return status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		endpoint.log.Info("graceful exit initiated", zap.Stringer("node ID", nodeID))
+		return endpoint.sendNotReady(stream)
+	case exitStatus.ExitLoopCompletedAt == nil:
+		return endpoint.sendNotReady(stream)
+	}
+
+	batchSize := endpoint.config.EndpointBatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	for {
+		items, err := endpoint.db.GetIncomplete(ctx, nodeID, batchSize, 0)
+		if err != nil {
+			return status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		if len(items) == 0 {
+			return endpoint.finish(ctx, stream, exitStatus)
+		}
+
+		pending := make(map[storj.PieceID]*pendingTransfer, len(items))
+		for _, item := range items {
+			transfer, err := endpoint.prepareTransfer(ctx, peer, item)
+			if err != nil {
+				return status.Error(codes.Internal, Error.Wrap(err).Error())
+			}
+			if transfer == nil {
+				continue
+			}
+
+			err = stream.Send(&pb.SatelliteMessage{
+				TransferPiece: &pb.TransferPiece{
+					PieceId:            transfer.originalPieceID,
+					Limit:              transfer.limit.Limit,
+					StorageNodeAddress: transfer.limit.StorageNodeAddress,
+				},
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			pending[transfer.originalPieceID] = transfer
+		}
+
+		for len(pending) > 0 {
+			message, err := stream.Recv()
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			switch {
+			case message.Succeeded != nil:
+				transfer, ok := pending[message.Succeeded.PieceId]
+				if !ok {
+					return status.Error(codes.InvalidArgument, "unknown piece transferred")
+				}
+				delete(pending, message.Succeeded.PieceId)
+
+				err = endpoint.handleSucceeded(ctx, stream, nodeID, transfer, message.Succeeded)
+				if err != nil {
+					endpoint.log.Warn("transfer verification failed", zap.Stringer("node ID", nodeID), zap.Error(err))
+					if err := endpoint.handleFailed(ctx, transfer, false); err != nil {
+						return status.Error(codes.Internal, Error.Wrap(err).Error())
+					}
+				}
+			case message.Failed != nil:
+				transfer, ok := pending[message.Failed.PieceId]
+				if !ok {
+					return status.Error(codes.InvalidArgument, "unknown piece failed")
+				}
+				delete(pending, message.Failed.PieceId)
+
+				// a missing piece won't appear by retrying
+				permanent := message.Failed.Error == pb.TransferFailed_NOT_FOUND
+				if err := endpoint.handleFailed(ctx, transfer, permanent); err != nil {
+					return status.Error(codes.Internal, Error.Wrap(err).Error())
+				}
+			default:
+				return status.Error(codes.InvalidArgument, "unexpected message")
+			}
+		}
+	}
+}
+
+// prepareTransfer selects the node receiving the piece of item and creates
+// the order limit for it. It returns nil when the piece doesn't need to be
+// transferred anymore.
+func (endpoint *Endpoint) prepareTransfer(ctx context.Context, exitingNode *identity.PeerIdentity, item *TransferQueueItem) (_ *pendingTransfer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := string(item.Path)
+	pointer, err := endpoint.metainfo.Get(ctx, path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, endpoint.db.DeleteTransferQueueItem(ctx, item.NodeID, item.Path)
+		}
+		return nil, err
+	}
+
+	remote := pointer.GetRemote()
+	if remote == nil || findPiece(remote, item.NodeID, item.PieceNum) == nil {
+		return nil, endpoint.db.DeleteTransferQueueItem(ctx, item.NodeID, item.Path)
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(remote.GetRedundancy())
+	if err != nil {
+		return nil, err
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	excludedNodes := make([]storj.NodeID, 0, len(remote.RemotePieces))
+	for _, piece := range remote.RemotePieces {
+		excludedNodes = append(excludedNodes, piece.NodeId)
+	}
+
+	transfer := &pendingTransfer{
+		item:            item,
+		originalPieceID: remote.RootPieceId.Derive(item.NodeID, item.PieceNum),
+		pieceSize:       pieceSize,
+	}
+
+	newNodes, err := endpoint.overlay.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: 1,
+		FreeBandwidth:  pieceSize,
+		FreeDisk:       pieceSize,
+		ExcludedNodes:  excludedNodes,
+	})
+	if err != nil {
+		endpoint.log.Warn("unable to find node for transfer", zap.String("path", path), zap.Error(err))
+		return nil, endpoint.handleFailed(ctx, transfer, false)
+	}
+
+	bucketID, err := createBucketID(path)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer.limit, err = endpoint.orders.CreateGracefulExitPutOrderLimit(ctx, exitingNode, bucketID, pointer, newNodes[0], item.PieceNum)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	item.RequestedAt = &now
+	if err := endpoint.db.UpdateTransferQueueItem(ctx, *item); err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// handleSucceeded verifies the transferred piece and replaces the exiting
+// node with the receiving node in the pointer.
+func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.SatelliteGracefulExit_ProcessServer, exitingNodeID storj.NodeID, transfer *pendingTransfer, message *pb.TransferSucceeded) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	original, replacement := message.OriginalPieceHash, message.ReplacementPieceHash
+	if original == nil || replacement == nil {
+		return Error.New("missing piece hash")
+	}
+
+	if original.PieceId != transfer.originalPieceID {
+		return Error.New("original piece hash doesn't belong to the transferred piece")
+	}
+	uplinkSignee, err := endpoint.uplinkSignee(ctx, message.OriginalUplinkId)
+	if err != nil {
+		return err
+	}
+	if err := signing.VerifyPieceHashSignature(ctx, uplinkSignee, original); err != nil {
+		return Error.New("invalid original piece hash signature: %v", err)
+	}
+
+	receivingNodeID := transfer.limit.Limit.StorageNodeId
+	if replacement.PieceId != transfer.limit.Limit.PieceId {
+		return Error.New("replacement piece hash doesn't belong to the order limit")
+	}
+	if !bytes.Equal(replacement.Hash, original.Hash) {
+		return Error.New("replacement piece hash doesn't match the original piece hash")
+	}
+	receivingNode, err := endpoint.peerIdentities.FetchPeerIdentity(ctx, receivingNodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := signing.VerifyPieceHashSignature(ctx, signing.SigneeFromPeerIdentity(receivingNode), replacement); err != nil {
+		return Error.New("invalid replacement piece hash signature: %v", err)
+	}
+
+	path := string(transfer.item.Path)
+	pointer, err := endpoint.metainfo.Get(ctx, path)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Error.Wrap(err)
+	}
+	if err == nil && pointer.GetRemote() != nil {
+		if piece := findPiece(pointer.GetRemote(), exitingNodeID, transfer.item.PieceNum); piece != nil {
+			piece.NodeId = receivingNodeID
+			if err := endpoint.metainfo.Put(ctx, path, pointer); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+	}
+
+	err = endpoint.db.IncrementProgress(ctx, exitingNodeID, transfer.pieceSize, 1, 0)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	err = endpoint.db.DeleteTransferQueueItem(ctx, exitingNodeID, transfer.item.Path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	mon.Meter("graceful_exit_transfer_piece_success").Mark(1)
+
+	return Error.Wrap(stream.Send(&pb.SatelliteMessage{
+		DeletePiece: &pb.DeletePiece{PieceId: transfer.originalPieceID},
+	}))
+}
+
+// handleFailed records a failed transfer. The piece isn't retried anymore,
+// when the failure is permanent or it failed too often.
+func (endpoint *Endpoint) handleFailed(ctx context.Context, transfer *pendingTransfer, permanent bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	mon.Meter("graceful_exit_transfer_piece_fail").Mark(1)
+
+	item := transfer.item
+	failedCount := 1
+	if item.FailedCount != nil {
+		failedCount += *item.FailedCount
+	}
+	now := time.Now().UTC()
+	item.FailedCount = &failedCount
+	item.LastFailedAt = &now
+
+	if permanent || failedCount >= endpoint.config.MaxFailuresPerPiece {
+		item.FinishedAt = &now
+		if err := endpoint.db.IncrementProgress(ctx, item.NodeID, 0, 0, 1); err != nil {
+			return err
+		}
+	}
+	return endpoint.db.UpdateTransferQueueItem(ctx, *item)
+}
+
+// finish completes the exit, once all pieces were processed. The exit fails,
+// when too many pieces failed to transfer.
+func (endpoint *Endpoint) finish(ctx context.Context, stream pb.SatelliteGracefulExit_ProcessServer, exitStatus *overlay.ExitStatus) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress, err := endpoint.db.GetProgress(ctx, exitStatus.NodeID)
+	if err != nil {
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	success := true
+	if processed := progress.PiecesTransferred + progress.PiecesFailed; processed > 0 {
+		failedPercentage := float64(progress.PiecesFailed) * 100 / float64(processed)
+		success = failedPercentage <= float64(endpoint.config.OverallMaxFailuresPercentage)
+	}
+
+	now := time.Now().UTC()
+	exitStatus.ExitFinishedAt = &now
+	exitStatus.ExitSuccess = success
+	if err := endpoint.overlay.UpdateExitStatus(ctx, exitStatus); err != nil {
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+	if err := endpoint.db.DeleteTransferQueueItems(ctx, exitStatus.NodeID); err != nil {
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	endpoint.log.Info("graceful exit finished",
+		zap.Stringer("node ID", exitStatus.NodeID),
+		zap.Bool("success", success),
+		zap.Int64("pieces transferred", progress.PiecesTransferred),
+		zap.Int64("pieces failed", progress.PiecesFailed))
+
+	return endpoint.sendResult(stream, success)
+}
+
+func (endpoint *Endpoint) sendNotReady(stream pb.SatelliteGracefulExit_ProcessServer) error {
+	return Error.Wrap(stream.Send(&pb.SatelliteMessage{NotReady: &pb.NotReady{}}))
+}
+
+func (endpoint *Endpoint) sendResult(stream pb.SatelliteGracefulExit_ProcessServer, success bool) error {
+	if success {
+		return Error.Wrap(stream.Send(&pb.SatelliteMessage{ExitCompleted: &pb.ExitCompleted{}}))
+	}
+	return Error.Wrap(stream.Send(&pb.SatelliteMessage{
+		ExitFailed: &pb.ExitFailed{Reason: pb.ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED},
+	}))
+}
+
+// uplinkSignee returns the signee of the uplink, which uploaded a piece:
+// either an uplink, the satellite itself for repaired pieces, or an exiting
+// node for transferred pieces.
+func (endpoint *Endpoint) uplinkSignee(ctx context.Context, uplinkID storj.NodeID) (_ signing.Signee, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if endpoint.satelliteSignee.ID() == uplinkID {
+		return endpoint.satelliteSignee, nil
+	}
+	publicKey, err := endpoint.certdb.GetPublicKey(ctx, uplinkID)
+	if err != nil {
+		return nil, Error.New("unable to find uplink public key: %v", err)
+	}
+	return &signing.PublicKey{Self: uplinkID, Key: publicKey}, nil
+}
+
+func findPiece(remote *pb.RemoteSegment, nodeID storj.NodeID, pieceNum int32) *pb.RemotePiece {
+	for _, piece := range remote.RemotePieces {
+		if piece.NodeId == nodeID && piece.PieceNum == pieceNum {
+			return piece
+		}
+	}
+	return nil
+}
+
+func createBucketID(path storj.Path) ([]byte, error) {
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return nil, Error.New("no bucket component in path: %s", path)
+	}
+	return []byte(storj.JoinPaths(comps[0], comps[2])), nil
+}
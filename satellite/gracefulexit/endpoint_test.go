@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/uplink"
+)
+
+func TestGracefulExit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.GracefulExit.Chore.Loop.Pause()
+		for _, node := range planet.StorageNodes {
+			node.GracefulExit.Chore.Loop.Pause()
+		}
+
+		redundancy := uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+		expectedData := testrand.Bytes(20 * memory.KiB)
+		err := planet.Uplinks[0].UploadWithConfig(ctx, satellite, &redundancy, "testbucket", "test/path", expectedData)
+		require.NoError(t, err)
+
+		path, pointer := getRemotePointer(ctx, t, satellite)
+		exitingNodeID := pointer.GetRemote().GetRemotePieces()[0].NodeId
+		var exitingNode *storagenode.Peer
+		for _, node := range planet.StorageNodes {
+			if node.ID() == exitingNodeID {
+				exitingNode = node
+			}
+		}
+		require.NotNil(t, exitingNode)
+
+		_, err = exitingNode.Storage2.Inspector.GracefulExitSatellite(ctx, &pb.GracefulExitSatelliteRequest{
+			SatelliteId: satellite.ID(),
+		})
+		require.NoError(t, err)
+
+		// the first connection marks the exit as initiated on the satellite
+		require.NoError(t, exitingNode.GracefulExit.Chore.ProcessExits(ctx))
+
+		status, err := satellite.Overlay.Service.GetExitStatus(ctx, exitingNodeID)
+		require.NoError(t, err)
+		require.NotNil(t, status.ExitInitiatedAt)
+		assert.Nil(t, status.ExitLoopCompletedAt)
+
+		require.NoError(t, satellite.GracefulExit.Chore.QueuePieces(ctx))
+
+		status, err = satellite.Overlay.Service.GetExitStatus(ctx, exitingNodeID)
+		require.NoError(t, err)
+		require.NotNil(t, status.ExitLoopCompletedAt)
+
+		require.NoError(t, exitingNode.GracefulExit.Chore.ProcessExits(ctx))
+
+		status, err = satellite.Overlay.Service.GetExitStatus(ctx, exitingNodeID)
+		require.NoError(t, err)
+		require.NotNil(t, status.ExitFinishedAt)
+		assert.True(t, status.ExitSuccess)
+
+		progress, err := satellite.DB.GracefulExit().GetProgress(ctx, exitingNodeID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, progress.PiecesTransferred)
+		assert.EqualValues(t, 0, progress.PiecesFailed)
+
+		nodeStatus, err := exitingNode.DB.GracefulExit().Get(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.NotNil(t, nodeStatus)
+		require.NotNil(t, nodeStatus.FinishedAt)
+		assert.True(t, nodeStatus.Successful)
+		assert.EqualValues(t, 1, nodeStatus.PiecesTransferred)
+		assert.Equal(t, progress.BytesTransferred, nodeStatus.BytesTransferred)
+
+		// the piece moved to another node
+		pointer, err = satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		pieces := pointer.GetRemote().GetRemotePieces()
+		require.Len(t, pieces, 4)
+		for _, piece := range pieces {
+			assert.NotEqual(t, exitingNodeID, piece.NodeId)
+		}
+
+		// the exiting node no longer stores any pieces of the satellite
+		pieceIDs, err := exitingNode.DB.PieceInfo().GetPieceIDs(ctx, satellite.ID(), time.Now().Add(time.Hour), storj.PieceID{}, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pieceIDs)
+
+		// and isn't selected for uploads anymore
+		nodes, err := satellite.Overlay.Service.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: len(planet.StorageNodes) - 1,
+		})
+		require.NoError(t, err)
+		for _, node := range nodes {
+			assert.NotEqual(t, exitingNodeID, node.Id)
+		}
+
+		data, err := planet.Uplinks[0].Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		assert.Equal(t, expectedData, data)
+	})
+}
+
+func getRemotePointer(ctx context.Context, t *testing.T, satellite *satellite.Peer) (path string, pointer *pb.Pointer) {
+	err := satellite.Metainfo.Service.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer = &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return err
+				}
+				if pointer.GetRemote() != nil {
+					path = item.Key.String()
+					return nil
+				}
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	require.NotEmpty(t, path)
+	return path, pointer
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Bucket is the lifecycle configuration of a bucket
+type Bucket struct {
+	ProjectID  uuid.UUID
+	BucketName []byte
+	Rules      []*pb.LifecycleRule
+}
+
+// DB stores the lifecycle rules of buckets
+type DB interface {
+	// Set replaces the lifecycle rules of a bucket. Setting no rules removes the configuration.
+	Set(ctx context.Context, projectID uuid.UUID, bucketName []byte, rules []*pb.LifecycleRule) error
+	// Get returns the lifecycle rules of a bucket
+	Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) ([]*pb.LifecycleRule, error)
+	// List returns the lifecycle configurations of all buckets
+	List(ctx context.Context) ([]*Bucket, error)
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDB(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		lifecycles := db.Lifecycles()
+
+		project := testrand.UUID()
+		alpha, beta := []byte("alpha"), []byte("beta")
+
+		rules, err := lifecycles.Get(ctx, project, alpha)
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+
+		alphaRules := []*pb.LifecycleRule{
+			{Id: "logs", EncryptedPrefix: []byte("logs/"), ExpirationDays: 7},
+			{Id: "tmp", EncryptedPrefix: []byte("tmp/"), ExpirationDays: 1},
+		}
+		betaRules := []*pb.LifecycleRule{
+			{ExpirationDays: 30},
+		}
+
+		require.NoError(t, lifecycles.Set(ctx, project, alpha, alphaRules))
+		require.NoError(t, lifecycles.Set(ctx, project, beta, betaRules))
+
+		rules, err = lifecycles.Get(ctx, project, alpha)
+		require.NoError(t, err)
+		assertRules(t, alphaRules, rules)
+
+		buckets, err := lifecycles.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, buckets, 2)
+		for _, bucket := range buckets {
+			assert.Equal(t, project, bucket.ProjectID)
+			switch string(bucket.BucketName) {
+			case "alpha":
+				assertRules(t, alphaRules, bucket.Rules)
+			case "beta":
+				assertRules(t, betaRules, bucket.Rules)
+			default:
+				t.Fatalf("unexpected bucket %q", bucket.BucketName)
+			}
+		}
+
+		// setting rules replaces the previous ones
+		require.NoError(t, lifecycles.Set(ctx, project, alpha, betaRules))
+		rules, err = lifecycles.Get(ctx, project, alpha)
+		require.NoError(t, err)
+		assertRules(t, betaRules, rules)
+
+		// setting no rules removes the configuration
+		require.NoError(t, lifecycles.Set(ctx, project, alpha, nil))
+		rules, err = lifecycles.Get(ctx, project, alpha)
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+
+		buckets, err = lifecycles.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		assert.Equal(t, beta, buckets[0].BucketName)
+	})
+}
+
+func assertRules(t *testing.T, expected, actual []*pb.LifecycleRule) {
+	assert.True(t, pb.Equal(&pb.BucketLifecycle{Rules: expected}, &pb.BucketLifecycle{Rules: actual}))
+}
@@ -0,0 +1,279 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package lifecycle implements expiring the objects of buckets according to
+// their lifecycle rules.
+package lifecycle
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error defines the lifecycle service errors class
+	Error = errs.Class("lifecycle service error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the lifecycle service
+type Config struct {
+	Interval  time.Duration `help:"how frequently the lifecycle rules of buckets are applied" releaseDefault:"24h" devDefault:"1h"`
+	BatchSize int           `help:"how many expired objects are collected before they are deleted" default:"100"`
+}
+
+// Service deletes the objects, which expired according to the lifecycle rules
+// of their buckets.
+//
+// The pieces of the objects are deleted from the storage nodes like deleting
+// an object through the metainfo endpoint does, so they don't depend on
+// garbage collection, which may be disabled. Pieces on nodes, which can't be
+// reached, are left for garbage collection.
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	lifecycles  DB
+	metainfo    *metainfo.Service
+	orders      *orders.Service
+	containment audit.Containment
+	ec          ecclient.Client
+	identity    *identity.FullIdentity
+}
+
+// NewService creates a new lifecycle service
+func NewService(log *zap.Logger, config Config, lifecycles DB, metainfo *metainfo.Service, orders *orders.Service, containment audit.Containment, ec ecclient.Client, identity *identity.FullIdentity) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		lifecycles:  lifecycles,
+		metainfo:    metainfo,
+		orders:      orders,
+		containment: containment,
+		ec:          ec,
+		identity:    identity,
+	}
+}
+
+// Run starts the lifecycle loop
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		if err := service.ApplyRules(ctx, time.Now()); err != nil {
+			service.log.Error("error applying lifecycle rules", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the lifecycle service
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// ApplyRules deletes the objects of all buckets, which are expired at now.
+// The configuration of deleted buckets is removed.
+func (service *Service) ApplyRules(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets, err := service.lifecycles.List(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, bucket := range buckets {
+		bucketPath, err := metainfo.CreatePath(ctx, bucket.ProjectID, -1, bucket.BucketName, nil)
+		if err != nil {
+			group.Add(Error.Wrap(err))
+			continue
+		}
+
+		_, err = service.metainfo.Get(ctx, bucketPath)
+		if storage.ErrKeyNotFound.Has(err) {
+			err = service.lifecycles.Set(ctx, bucket.ProjectID, bucket.BucketName, nil)
+			if err != nil {
+				group.Add(Error.Wrap(err))
+			}
+			continue
+		}
+		if err != nil {
+			group.Add(Error.Wrap(err))
+			continue
+		}
+
+		for _, rule := range bucket.Rules {
+			err := service.applyRule(ctx, bucket, bucketPath, rule, now)
+			if err != nil {
+				group.Add(Error.Wrap(err))
+			}
+		}
+	}
+
+	return group.Err()
+}
+
+// applyRule deletes the objects in the bucket at bucketPath, which are
+// expired by rule at now. The expired objects are collected and deleted in
+// batches of at most config.BatchSize objects.
+func (service *Service) applyRule(ctx context.Context, bucket *Bucket, bucketPath storj.Path, rule *pb.LifecycleRule, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if rule.ExpirationDays <= 0 {
+		return nil
+	}
+	expiration := now.Add(-time.Duration(rule.ExpirationDays) * 24 * time.Hour)
+
+	batchSize := service.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	var group errs.Group
+	first := ""
+	for {
+		expired, more, err := service.expiredObjects(ctx, bucketPath, rule, expiration, first, batchSize)
+		if err != nil {
+			return errs.Combine(group.Err(), err)
+		}
+
+		for _, path := range expired {
+			err = service.deleteObject(ctx, bucket, path)
+			if err != nil {
+				group.Add(err)
+				continue
+			}
+			mon.Meter("lifecycle_expired_objects").Mark(1)
+		}
+
+		if !more {
+			return group.Err()
+		}
+		// continue after the last object, even if deleting it failed
+		first = bucketPath + "/" + expired[len(expired)-1] + "\x00"
+	}
+}
+
+// expiredObjects returns the encrypted paths of at most limit objects in the
+// bucket at bucketPath starting with the key first, which are expired by rule,
+// because they were created before expiration. more is set, if the limit
+// was reached.
+func (service *Service) expiredObjects(ctx context.Context, bucketPath storj.Path, rule *pb.LifecycleRule, expiration time.Time, first string, limit int) (paths []storj.Path, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	objectsPrefix := bucketPath + "/"
+	err = service.metainfo.Iterate(ctx, objectsPrefix+string(rule.EncryptedPrefix), first, true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				created, err := ptypes.Timestamp(pointer.CreationDate)
+				if err != nil {
+					return Error.Wrap(err)
+				}
+				if !created.Before(expiration) {
+					continue
+				}
+
+				paths = append(paths, strings.TrimPrefix(item.Key.String(), objectsPrefix))
+				if len(paths) >= limit {
+					more = true
+					return nil
+				}
+			}
+			return nil
+		},
+	)
+	return paths, more, err
+}
+
+// deleteObject deletes all segments of an object, starting with the last one,
+// so the object disappears at once.
+func (service *Service) deleteObject(ctx context.Context, bucket *Bucket, path storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for segment := int64(-1); ; segment++ {
+		segmentPath, err := metainfo.CreatePath(ctx, bucket.ProjectID, segment, bucket.BucketName, []byte(path))
+		if err != nil {
+			return err
+		}
+
+		// not every storage backend reports deleting a missing key
+		pointer, err := service.metainfo.Get(ctx, segmentPath)
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = service.metainfo.Delete(ctx, segmentPath)
+		if storage.ErrKeyNotFound.Has(err) {
+			// deleted concurrently, so its pieces are deleted too
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		service.deletePieces(ctx, bucket, segmentPath, pointer)
+	}
+}
+
+// deletePieces deletes the pieces of a deleted segment from the storage nodes.
+// Failures are only logged, because the pieces are left for garbage collection
+// then.
+func (service *Service) deletePieces(ctx context.Context, bucket *Bucket, segmentPath storj.Path, pointer *pb.Pointer) {
+	defer mon.Task()(&ctx)(nil)
+
+	if pointer.Type != pb.Pointer_REMOTE || pointer.Remote == nil {
+		return
+	}
+
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		_, err := service.containment.Delete(ctx, piece.NodeId)
+		if err != nil {
+			service.log.Warn("unable to remove node from containment", zap.Stringer("Node ID", piece.NodeId), zap.Error(err))
+		}
+	}
+
+	bucketID := []byte(storj.JoinPaths(bucket.ProjectID.String(), string(bucket.BucketName)))
+	limits, err := service.orders.CreateDeleteOrderLimits(ctx, service.identity.PeerIdentity(), bucketID, pointer)
+	if err != nil {
+		service.log.Warn("unable to create order limits for deleting pieces", zap.String("path", segmentPath), zap.Error(err))
+		return
+	}
+
+	err = service.ec.Delete(ctx, limits)
+	if err != nil {
+		service.log.Warn("unable to delete pieces", zap.String("path", segmentPath), zap.Error(err))
+	}
+}
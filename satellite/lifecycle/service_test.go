@@ -0,0 +1,130 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+)
+
+func TestApplyRules(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		service := satellite.Lifecycle.Service
+		service.Loop.Pause()
+
+		for _, path := range []storj.Path{"expire/remote", "expire/inline", "keep/remote"} {
+			size := 8 * memory.KiB
+			if path == "expire/inline" {
+				size = memory.KiB
+			}
+			err := upl.Upload(ctx, satellite, "testbucket", path, testrand.Bytes(size))
+			require.NoError(t, err)
+		}
+
+		metainfo, _, cleanup, err := testplanet.DialMetainfo(ctx, upl.Log.Named("metainfo"), upl.GetConfig(satellite), upl.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		rules := []storj.LifecycleRule{{ID: "expire", Prefix: "expire/", ExpirationDays: 1}}
+		require.NoError(t, metainfo.SetBucketLifecycle(ctx, "testbucket", rules))
+
+		got, err := metainfo.GetBucketLifecycle(ctx, "testbucket")
+		require.NoError(t, err)
+		assert.Equal(t, rules, got)
+
+		// whole path components have to be encrypted
+		err = metainfo.SetBucketLifecycle(ctx, "testbucket", []storj.LifecycleRule{{Prefix: "exp", ExpirationDays: 1}})
+		require.Error(t, err)
+
+		err = metainfo.SetBucketLifecycle(ctx, "missing", rules)
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		// nothing is expired yet
+		require.NoError(t, service.ApplyRules(ctx, time.Now()))
+		list, err := metainfo.ListObjects(ctx, "testbucket", storj.ListOptions{Direction: storj.After, Recursive: true})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 3)
+
+		remoteSegments := func() map[string]*pb.Pointer {
+			pointers := make(map[string]*pb.Pointer)
+			err := satellite.Metainfo.Service.Iterate(ctx, "", "", true, false,
+				func(ctx context.Context, it storage.Iterator) error {
+					var item storage.ListItem
+					for it.Next(ctx, &item) {
+						pointer := &pb.Pointer{}
+						if err := proto.Unmarshal(item.Value, pointer); err != nil {
+							return err
+						}
+						if pointer.Type == pb.Pointer_REMOTE {
+							pointers[item.Key.String()] = pointer
+						}
+					}
+					return nil
+				})
+			require.NoError(t, err)
+			return pointers
+		}
+		before := remoteSegments()
+
+		require.NoError(t, service.ApplyRules(ctx, time.Now().Add(48*time.Hour)))
+		list, err = metainfo.ListObjects(ctx, "testbucket", storj.ListOptions{Direction: storj.After, Recursive: true})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "keep/remote", list.Items[0].Path)
+
+		// the pieces of the expired objects are deleted from the storage nodes
+		after := remoteSegments()
+		require.Len(t, before, 2)
+		require.Len(t, after, 1)
+		for path, pointer := range before {
+			_, kept := after[path]
+			for _, piece := range pointer.GetRemote().GetRemotePieces() {
+				var node *storagenode.Peer
+				for _, storageNode := range planet.StorageNodes {
+					if storageNode.ID() == piece.NodeId {
+						node = storageNode
+					}
+				}
+				require.NotNil(t, node)
+
+				pieceID := pointer.GetRemote().RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+				reader, err := node.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+				if kept {
+					require.NoError(t, err)
+					require.NoError(t, reader.Close())
+				} else {
+					require.Error(t, err)
+				}
+			}
+		}
+
+		// the rules of deleted buckets are removed
+		require.NoError(t, metainfo.DeleteObject(ctx, "testbucket", "keep/remote"))
+		require.NoError(t, metainfo.DeleteBucket(ctx, "testbucket"))
+		require.NoError(t, service.ApplyRules(ctx, time.Now()))
+
+		buckets, err := satellite.DB.Lifecycles().List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, buckets)
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+// BeginConcatObjects returns the encrypted metadata of all segments of the
+// objects, which are concatenated into a new object, so the uplink can
+// re-encrypt it for the new path, and the revisions of the objects.
+func (endpoint *Endpoint) BeginConcatObjects(ctx context.Context, req *pb.ObjectBeginConcatRequest) (resp *pb.ObjectBeginConcatResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateConcat(ctx, req.Bucket, req.EncryptedPaths, req.NewEncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &pb.ObjectBeginConcatResponse{}
+	for _, path := range req.EncryptedPaths {
+		object, err := endpoint.getObjectPointers(ctx, keyInfo.ProjectID, req.Bucket, path)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Sources = append(resp.Sources, &pb.ObjectConcatSource{
+			EncryptedPath: path,
+			Segments:      segmentsMetadata(object.pointers),
+			Revision:      object.revision(),
+		})
+	}
+
+	return resp, nil
+}
+
+// FinishConcatObjects stores the segments of the objects one after another as
+// the segments of a new object, replacing their metadata with the re-encrypted
+// one and replacing the object at the new path. The last segment of every
+// object becomes a regular segment of the new object, which ends with the
+// inline segment sent by the uplink. The data of the objects isn't moved.
+//
+// The objects are removed like in FinishMoveObject, once the new object is
+// stored. None of them is removed, if any of them has been modified since
+// BeginConcatObjects returned its revision.
+func (endpoint *Endpoint) FinishConcatObjects(ctx context.Context, req *pb.ObjectFinishConcatRequest) (resp *pb.ObjectFinishConcatResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	paths := make([][]byte, 0, len(req.Sources))
+	for _, source := range req.Sources {
+		paths = append(paths, source.EncryptedPath)
+	}
+
+	keyInfo, err := endpoint.validateConcat(ctx, req.Bucket, paths, req.NewEncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*objectPointers, 0, len(req.Sources))
+	objectsPaths := make([]map[int64]string, 0, len(req.Sources))
+	pointers := make(map[int64]*pb.Pointer)
+	for _, source := range req.Sources {
+		object, err := endpoint.getObjectPointers(ctx, keyInfo.ProjectID, req.Bucket, source.EncryptedPath)
+		if err != nil {
+			return nil, err
+		}
+
+		// the re-encrypted metadata matches only the revision it was read from
+		if !bytes.Equal(object.revision(), source.Revision) {
+			return nil, status.Errorf(codes.Aborted, "object was modified since the concatenation began")
+		}
+
+		err = setSegmentsMetadata(object.pointers, source.Segments)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+
+		segmentPaths, err := objectPaths(ctx, keyInfo.ProjectID, req.Bucket, source.EncryptedPath, object.pointers)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+
+		// the segments of the object continue after the segments stored so far
+		offset := int64(len(pointers))
+		count := int64(len(object.pointers))
+		for segment, pointer := range object.pointers {
+			if segment == -1 {
+				segment = count - 1
+			}
+			pointers[offset+segment] = pointer
+		}
+
+		objects = append(objects, object)
+		objectsPaths = append(objectsPaths, segmentPaths)
+	}
+
+	lastSegment := &pb.Pointer{
+		Type:           pb.Pointer_INLINE,
+		InlineSegment:  req.EncryptedInlineSegment,
+		SegmentSize:    int64(len(req.EncryptedInlineSegment)),
+		ExpirationDate: req.ExpirationDate,
+		Metadata:       req.EncryptedMetadata,
+	}
+	pointers[-1] = lastSegment
+
+	replacement, err := endpoint.putObjectPointers(ctx, keyInfo.ProjectID, req.Bucket, req.NewEncryptedPath, pointers)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, object := range objects {
+		_, err = endpoint.metainfo.CompareAndSwap(ctx, objectsPaths[i][-1], object.stored[-1], nil)
+		if err == nil {
+			continue
+		}
+
+		// restore the objects removed so far, so the data isn't referenced by
+		// the new object and the objects at the same time
+		for k := i - 1; k >= 0; k-- {
+			_, restoreErr := endpoint.metainfo.CompareAndSwap(ctx, objectsPaths[k][-1], nil, objects[k].pointers[-1])
+			if restoreErr != nil {
+				endpoint.log.Warn("unable to restore segment", zap.String("path", objectsPaths[k][-1]), zap.Error(restoreErr))
+			}
+		}
+		replacement.rollback(ctx)
+
+		switch {
+		case storage.ErrKeyNotFound.Has(err):
+			// the object was deleted concurrently
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case storage.ErrValueChanged.Has(err):
+			return nil, status.Errorf(codes.Aborted, "object was modified since the concatenation began")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// the remaining segments aren't reachable without the last segments anymore,
+	// unless new objects are uploaded to the old paths concurrently
+	for i, object := range objects {
+		for segment, path := range objectsPaths[i] {
+			if segment == -1 {
+				continue
+			}
+			_, err := endpoint.metainfo.CompareAndSwap(ctx, path, object.stored[segment], nil)
+			if err != nil && !storage.ErrKeyNotFound.Has(err) && !storage.ErrValueChanged.Has(err) {
+				endpoint.log.Warn("unable to delete concatenated segment", zap.String("path", path), zap.Error(err))
+			}
+		}
+	}
+	replacement.finish(ctx)
+
+	// only the new last segment adds to the stored data
+	inlineUsed, remoteUsed := calculateSpaceUsed(lastSegment)
+	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, inlineUsed, remoteUsed); err != nil {
+		endpoint.log.Sugar().Errorf("Could not track new storage usage by project %v: %v", keyInfo.ProjectID, err)
+	}
+
+	err = endpoint.orders.UpdatePutInlineOrder(ctx, keyInfo.ProjectID, req.Bucket, int64(len(lastSegment.InlineSegment)))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectFinishConcatResponse{}, nil
+}
+
+// validateConcat checks that the API key is allowed to move the objects at
+// paths to newPath and that every object is concatenated only once.
+func (endpoint *Endpoint) validateConcat(ctx context.Context, bucket []byte, paths [][]byte, newPath []byte) (keyInfo *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no objects to concatenate")
+	}
+
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if seen[string(path)] {
+			return nil, status.Errorf(codes.InvalidArgument, "object is concatenated more than once")
+		}
+		seen[string(path)] = true
+
+		keyInfo, err = endpoint.validateTransfer(ctx, bucket, path, bucket, newPath, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return keyInfo, nil
+}
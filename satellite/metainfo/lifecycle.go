@@ -0,0 +1,126 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+const (
+	maxLifecycleRules  = 1000
+	maxLifecycleRuleID = 255
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *pb.SetBucketLifecycleRequest) (resp *pb.SetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateLifecycleRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucketExists(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.lifecycles.Set(ctx, keyInfo.ProjectID, req.Bucket, req.Rules)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketLifecycleResponse{}, nil
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket
+func (endpoint *Endpoint) GetBucketLifecycle(ctx context.Context, req *pb.GetBucketLifecycleRequest) (resp *pb.GetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucketExists(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := endpoint.lifecycles.Get(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.GetBucketLifecycleResponse{Rules: rules}, nil
+}
+
+// validateBucketExists checks that the bucket name is valid and that the bucket exists
+func (endpoint *Endpoint) validateBucketExists(ctx context.Context, projectID uuid.UUID, bucket []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.validateBucket(ctx, bucket)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	path, err := CreatePath(ctx, projectID, -1, bucket, nil)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = endpoint.metainfo.Get(ctx, path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return status.Errorf(codes.NotFound, "bucket not found: %s", bucket)
+		}
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (endpoint *Endpoint) validateLifecycleRules(rules []*pb.LifecycleRule) error {
+	if len(rules) > maxLifecycleRules {
+		return status.Errorf(codes.InvalidArgument, "no more than %d lifecycle rules are allowed", maxLifecycleRules)
+	}
+
+	ids := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if len(rule.Id) > maxLifecycleRuleID {
+			return status.Errorf(codes.InvalidArgument, "lifecycle rule id must be no more than %d characters long", maxLifecycleRuleID)
+		}
+		if rule.Id != "" {
+			if _, ok := ids[rule.Id]; ok {
+				return status.Errorf(codes.InvalidArgument, "duplicate lifecycle rule id %q", rule.Id)
+			}
+			ids[rule.Id] = struct{}{}
+		}
+		if rule.ExpirationDays <= 0 {
+			return status.Errorf(codes.InvalidArgument, "lifecycle rule expiration days must be positive")
+		}
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -27,11 +28,68 @@ import (
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/storage"
 )
 
 const pieceHashExpiration = 2 * time.Hour
 
+// Messages of the ResourceExhausted errors returned when a project exceeds one of its limits.
+const (
+	// ExceededStorageLimit is returned when uploading to a project that stores as much data as its limit allows
+	ExceededStorageLimit = "Exceeded Storage Limit"
+	// ExceededBandwidthLimit is returned when downloading from a project that used its monthly bandwidth
+	ExceededBandwidthLimit = "Exceeded Bandwidth Limit"
+	// ExceededSegmentLimit is returned when uploading to a project that stores as many segments as its limit allows
+	ExceededSegmentLimit = "Exceeded Segment Limit"
+	// ExceededBucketLimit is returned when creating a bucket in a project that has as many buckets as its limit allows
+	ExceededBucketLimit = "Exceeded Bucket Limit"
+)
+
+// ProjectLimit identifies the limit a project exceeded. The ResourceExhausted errors returned when
+// a project exceeds one of its limits carry it as the subject of a QuotaFailure detail.
+type ProjectLimit string
+
+const (
+	// StorageLimit is the limit of the data stored by a project
+	StorageLimit = ProjectLimit("storage")
+	// BandwidthLimit is the limit of the monthly egress bandwidth of a project
+	BandwidthLimit = ProjectLimit("bandwidth")
+	// SegmentLimit is the limit of the segments stored by a project
+	SegmentLimit = ProjectLimit("segments")
+	// BucketLimit is the limit of the buckets of a project
+	BucketLimit = ProjectLimit("buckets")
+)
+
+// exceededLimitError returns the ResourceExhausted error with the message and the details of the limit.
+func exceededLimitError(limit ProjectLimit, message string) error {
+	st, err := status.New(codes.ResourceExhausted, message).WithDetails(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{Subject: string(limit), Description: message},
+		},
+	})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, message)
+	}
+	return st.Err()
+}
+
+// ExceededLimit returns the project limit, which err reports as exceeded.
+func ExceededLimit(err error) (ProjectLimit, bool) {
+	st, ok := status.FromError(errs.Unwrap(err))
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return "", false
+	}
+	for _, detail := range st.Details() {
+		if failure, ok := detail.(*errdetails.QuotaFailure); ok {
+			for _, violation := range failure.GetViolations() {
+				return ProjectLimit(violation.GetSubject()), true
+			}
+		}
+	}
+	return "", false
+}
+
 var (
 	mon = monkit.Package()
 	// Error general metainfo error
@@ -53,6 +111,12 @@ type Containment interface {
 	Delete(ctx context.Context, nodeID pb.NodeID) (bool, error)
 }
 
+// Lifecycles is a copy/paste of lifecycle.DB methods used by the endpoint to avoid import cycle error
+type Lifecycles interface {
+	Set(ctx context.Context, projectID uuid.UUID, bucketName []byte, rules []*pb.LifecycleRule) error
+	Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) ([]*pb.LifecycleRule, error)
+}
+
 // Endpoint metainfo endpoint
 type Endpoint struct {
 	log            *zap.Logger
@@ -62,6 +126,8 @@ type Endpoint struct {
 	partnerinfo    attribution.DB
 	projectUsage   *accounting.ProjectUsage
 	containment    Containment
+	lifecycles     Lifecycles
+	placements     placement.DB
 	apiKeys        APIKeys
 	createRequests *createRequests
 	rsConfig       RSConfig
@@ -69,7 +135,7 @@ type Endpoint struct {
 
 // NewEndpoint creates new metainfo endpoint instance
 func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cache *overlay.Cache, partnerinfo attribution.DB,
-	containment Containment, apiKeys APIKeys, projectUsage *accounting.ProjectUsage, rsConfig RSConfig) *Endpoint {
+	containment Containment, lifecycles Lifecycles, placements placement.DB, apiKeys APIKeys, projectUsage *accounting.ProjectUsage, rsConfig RSConfig) *Endpoint {
 	// TODO do something with too many params
 	return &Endpoint{
 		log:            log,
@@ -78,6 +144,8 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cac
 		cache:          cache,
 		partnerinfo:    partnerinfo,
 		containment:    containment,
+		lifecycles:     lifecycles,
+		placements:     placements,
 		apiKeys:        apiKeys,
 		projectUsage:   projectUsage,
 		createRequests: newCreateRequests(),
@@ -153,10 +221,21 @@ func (endpoint *Endpoint) CreateSegment(ctx context.Context, req *pb.SegmentWrit
 		endpoint.log.Error("retrieving project storage totals", zap.Error(err))
 	}
 	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for storage for projectID %s",
+		endpoint.log.Sugar().Errorf("project storage limit is %s. This limit has been exceeded for projectID %s",
 			limit, keyInfo.ProjectID,
 		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		return nil, exceededLimitError(StorageLimit, ExceededStorageLimit)
+	}
+
+	exceeded, segmentLimit, err := endpoint.projectUsage.ExceedsSegmentCount(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("retrieving project segment count", zap.Error(err))
+	}
+	if exceeded {
+		endpoint.log.Sugar().Errorf("project segment limit is %d. This limit has been exceeded for projectID %s",
+			segmentLimit, keyInfo.ProjectID,
+		)
+		return nil, exceededLimitError(SegmentLimit, ExceededSegmentLimit)
 	}
 
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(req.GetRedundancy())
@@ -166,10 +245,16 @@ func (endpoint *Endpoint) CreateSegment(ctx context.Context, req *pb.SegmentWrit
 
 	maxPieceSize := eestream.CalcPieceSize(req.GetMaxEncryptedSegmentSize(), redundancy)
 
+	policy, err := endpoint.placements.Get(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: int(req.Redundancy.Total),
 		FreeBandwidth:  maxPieceSize,
 		FreeDisk:       maxPieceSize,
+		Placement:      policy,
 	}
 	nodes, err := endpoint.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -247,6 +332,19 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	// committing the last segment without an object path creates the bucket
+	if req.Segment == -1 && len(req.Path) == 0 {
+		_, err = endpoint.metainfo.Get(ctx, path)
+		switch {
+		case storage.ErrKeyNotFound.Has(err):
+			if err := endpoint.checkBucketLimit(ctx, keyInfo.ProjectID); err != nil {
+				return nil, err
+			}
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
 	inlineUsed, remoteUsed := calculateSpaceUsed(req.Pointer)
 	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, inlineUsed, remoteUsed); err != nil {
 		endpoint.log.Sugar().Errorf("Could not track new storage usage by project %v: %v", keyInfo.ProjectID, err)
@@ -305,10 +403,10 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		endpoint.log.Error("retrieving project bandwidth total", zap.Error(err))
 	}
 	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for bandwidth for projectID %s.",
+		endpoint.log.Sugar().Errorf("monthly project bandwidth limit is %s. This limit has been exceeded for projectID %s.",
 			limit, keyInfo.ProjectID,
 		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		return nil, exceededLimitError(BandwidthLimit, ExceededBandwidthLimit)
 	}
 
 	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
@@ -387,6 +485,14 @@ func (endpoint *Endpoint) DeleteSegment(ctx context.Context, req *pb.SegmentDele
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	if req.Segment == -1 && len(req.Path) == 0 {
+		// the pointer held the metadata of the bucket, so the bucket was deleted
+		err = endpoint.placements.Set(ctx, keyInfo.ProjectID, req.Bucket, nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
 	if pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil {
 		uplinkIdentity, err := identity.PeerIdentityFromContext(ctx)
 		if err != nil {
@@ -634,10 +740,46 @@ func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRe
 // CreateBucket creates a bucket
 func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreateRequest) (_ *pb.BucketCreateResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := endpoint.checkBucketLimit(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
 	// TODO: placeholder to implement pb.MetainfoServer interface.
 	return &pb.BucketCreateResponse{}, err
 }
 
+// checkBucketLimit returns ResourceExhausted error when the project can't have more buckets
+func (endpoint *Endpoint) checkBucketLimit(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := endpoint.projectUsage.ExceedsBucketCount(ctx, projectID)
+	if err != nil {
+		endpoint.log.Error("retrieving project bucket count", zap.Error(err))
+	}
+	if exceeded {
+		endpoint.log.Sugar().Errorf("project bucket limit is %d. This limit has been exceeded for projectID %s",
+			limit, projectID,
+		)
+		return exceededLimitError(BucketLimit, ExceededBucketLimit)
+	}
+	return nil
+}
+
 // GetBucket gets a bucket
 func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetRequest) (_ *pb.BucketGetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -27,6 +27,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink/metainfo"
 )
 
@@ -636,6 +637,191 @@ func TestSetAttribution(t *testing.T) {
 	})
 }
 
+func TestBucketPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		config := uplink.GetConfig(satellite)
+		metainfo, _, cleanup, err := testplanet.DialMetainfo(ctx, uplink.Log.Named("metainfo"), config, uplink.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		_, err = metainfo.CreateBucket(ctx, "alpha", &storj.Bucket{PathCipher: config.GetEncryptionParameters().CipherSuite})
+		require.NoError(t, err)
+
+		placement := storj.Placement{RequiredTags: []string{"ssd"}, DeniedCountries: []string{"ZZ"}}
+		require.NoError(t, metainfo.SetBucketPlacement(ctx, "alpha", placement))
+
+		got, err := metainfo.GetBucketPlacement(ctx, "alpha")
+		require.NoError(t, err)
+		assert.Equal(t, placement, got)
+
+		err = metainfo.SetBucketPlacement(ctx, "alpha", storj.Placement{DeniedNetworks: []string{"invalid"}})
+		require.Error(t, err)
+
+		err = metainfo.SetBucketPlacement(ctx, "missing", placement)
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		// no node declares the required tag
+		err = uplink.Upload(ctx, satellite, "alpha", "path", testrand.Bytes(8*memory.KiB))
+		require.Error(t, err)
+
+		for _, node := range planet.StorageNodes {
+			_, err := satellite.Overlay.Service.UpdateNodeInfo(ctx, node.ID(), &pb.InfoResponse{
+				Operator: &pb.NodeOperator{Tags: []string{"ssd"}},
+			})
+			require.NoError(t, err)
+		}
+
+		err = uplink.Upload(ctx, satellite, "alpha", "path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		// an empty placement removes the policy
+		require.NoError(t, metainfo.SetBucketPlacement(ctx, "alpha", storj.Placement{}))
+		got, err = metainfo.GetBucketPlacement(ctx, "alpha")
+		require.NoError(t, err)
+		assert.Equal(t, storj.Placement{}, got)
+
+		// deleting the bucket removes its policy
+		require.NoError(t, metainfo.SetBucketPlacement(ctx, "alpha", placement))
+		require.NoError(t, uplink.Delete(ctx, satellite, "alpha", "path"))
+		require.NoError(t, metainfo.DeleteBucket(ctx, "alpha"))
+
+		_, err = metainfo.CreateBucket(ctx, "alpha", &storj.Bucket{PathCipher: config.GetEncryptionParameters().CipherSuite})
+		require.NoError(t, err)
+		got, err = metainfo.GetBucketPlacement(ctx, "alpha")
+		require.NoError(t, err)
+		assert.Equal(t, storj.Placement{}, got)
+	})
+}
+
+func TestMoveObjectModifiedConcurrently(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+
+		oldPath := storj.JoinPaths(projects[0].ID.String(), "l", "alpha", "old")
+		newPath := storj.JoinPaths(projects[0].ID.String(), "l", "alpha", "new")
+
+		put := func(data string) {
+			err := satellite.Metainfo.Service.Put(ctx, oldPath, &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: []byte(data),
+				Metadata:      []byte(data),
+			})
+			require.NoError(t, err)
+		}
+
+		put("original")
+		segments, revision, _, err := client.BeginMoveObject(ctx, "alpha", "old", "alpha", "new", false)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+
+		// the re-encrypted metadata doesn't match the overwritten object
+		put("overwritten")
+		err = client.FinishMoveObject(ctx, "alpha", "old", "alpha", "new", segments, revision, nil)
+		require.Error(t, err)
+
+		pointer, err := satellite.Metainfo.Service.Get(ctx, oldPath)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("overwritten"), pointer.InlineSegment)
+
+		_, err = satellite.Metainfo.Service.Get(ctx, newPath)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		segments, revision, _, err = client.BeginMoveObject(ctx, "alpha", "old", "alpha", "new", false)
+		require.NoError(t, err)
+		err = client.FinishMoveObject(ctx, "alpha", "old", "alpha", "new", segments, revision, nil)
+		require.NoError(t, err)
+
+		pointer, err = satellite.Metainfo.Service.Get(ctx, newPath)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("overwritten"), pointer.InlineSegment)
+
+		_, err = satellite.Metainfo.Service.Get(ctx, oldPath)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+	})
+}
+
+func TestMoveObjectKeepReplaced(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+
+		oldPath := storj.JoinPaths(projects[0].ID.String(), "l", "alpha", "old")
+		newPath := storj.JoinPaths(projects[0].ID.String(), "l", "alpha", "new")
+		keptPath := storj.JoinPaths(projects[0].ID.String(), "l", "alpha", "kept")
+
+		put := func(path, data string) {
+			err := satellite.Metainfo.Service.Put(ctx, path, &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: []byte(data),
+				Metadata:      []byte(data),
+			})
+			require.NoError(t, err)
+		}
+
+		put(oldPath, "moved")
+		put(newPath, "replaced")
+
+		segments, revision, replaced, err := client.BeginMoveObject(ctx, "alpha", "old", "alpha", "new", true)
+		require.NoError(t, err)
+		require.NotNil(t, replaced)
+		require.Len(t, replaced.Segments, 1)
+		replaced.EncryptedPath = "kept"
+
+		// the replaced object can't be kept, if it was modified concurrently
+		put(newPath, "overwritten")
+		err = client.FinishMoveObject(ctx, "alpha", "old", "alpha", "new", segments, revision, replaced)
+		require.Error(t, err)
+
+		pointer, err := satellite.Metainfo.Service.Get(ctx, newPath)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("overwritten"), pointer.InlineSegment)
+
+		_, err = satellite.Metainfo.Service.Get(ctx, keptPath)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		segments, revision, replaced, err = client.BeginMoveObject(ctx, "alpha", "old", "alpha", "new", true)
+		require.NoError(t, err)
+		replaced.EncryptedPath = "kept"
+		err = client.FinishMoveObject(ctx, "alpha", "old", "alpha", "new", segments, revision, replaced)
+		require.NoError(t, err)
+
+		pointer, err = satellite.Metainfo.Service.Get(ctx, newPath)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("moved"), pointer.InlineSegment)
+
+		pointer, err = satellite.Metainfo.Service.Get(ctx, keptPath)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("overwritten"), pointer.InlineSegment)
+
+		_, err = satellite.Metainfo.Service.Get(ctx, oldPath)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+	})
+}
+
 func TestGetProjectInfo(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 2,
@@ -0,0 +1,459 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+// BeginMoveObject returns the encrypted metadata of all segments of an object,
+// so the uplink can re-encrypt it for the new path, and the revision of the
+// object, which has to be moved.
+func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeginMoveRequest) (resp *pb.ObjectBeginMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateTransfer(ctx, req.Bucket, req.EncryptedPath, req.NewBucket, req.NewEncryptedPath, true)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := endpoint.getObjectPointers(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &pb.ObjectBeginMoveResponse{
+		Segments: segmentsMetadata(object.pointers),
+		Revision: object.revision(),
+	}
+
+	if req.KeepReplaced {
+		replaced, err := endpoint.loadObjectPointers(ctx, keyInfo.ProjectID, req.NewBucket, req.NewEncryptedPath)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		if len(replaced.pointers) > 0 {
+			resp.ReplacedSegments = segmentsMetadata(replaced.pointers)
+			resp.ReplacedRevision = replaced.revision()
+		}
+	}
+
+	return resp, nil
+}
+
+// FinishMoveObject moves the pointers of all segments of an object to the new path,
+// replacing their metadata with the re-encrypted one and replacing the object
+// at the new path. The object isn't moved, if it has been modified since
+// BeginMoveObject returned its revision.
+//
+// The object is moved, once the pointer of its last segment is deleted from the
+// old path. If any step before fails, the pointers at the new path are restored,
+// so either the original or the moved object remains.
+//
+// If the replaced object is kept, it's moved to the replaced path in the same
+// way, unless it has been modified since BeginMoveObject returned its revision.
+func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFinishMoveRequest) (resp *pb.ObjectFinishMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateTransfer(ctx, req.Bucket, req.EncryptedPath, req.NewBucket, req.NewEncryptedPath, true)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := endpoint.getObjectPointers(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// the re-encrypted metadata matches only the revision it was read from
+	if !bytes.Equal(object.revision(), req.Revision) {
+		return nil, status.Errorf(codes.Aborted, "object was modified since the move began")
+	}
+
+	err = setSegmentsMetadata(object.pointers, req.Segments)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	paths, err := objectPaths(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath, object.pointers)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	kept, err := endpoint.keepReplaced(ctx, keyInfo.ProjectID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, err := endpoint.putObjectPointers(ctx, keyInfo.ProjectID, req.NewBucket, req.NewEncryptedPath, object.pointers)
+	if err != nil {
+		kept.rollback(ctx)
+		return nil, err
+	}
+
+	_, err = endpoint.metainfo.CompareAndSwap(ctx, paths[-1], object.stored[-1], nil)
+	if err != nil {
+		replacement.rollback(ctx)
+		kept.rollback(ctx)
+		switch {
+		case storage.ErrKeyNotFound.Has(err):
+			// the object was deleted concurrently
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case storage.ErrValueChanged.Has(err):
+			return nil, status.Errorf(codes.Aborted, "object was modified since the move began")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// the remaining segments aren't reachable without the last segment anymore,
+	// unless a new object is uploaded to the old path concurrently
+	for segment, path := range paths {
+		if segment == -1 {
+			continue
+		}
+		_, err := endpoint.metainfo.CompareAndSwap(ctx, path, object.stored[segment], nil)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) && !storage.ErrValueChanged.Has(err) {
+			endpoint.log.Warn("unable to delete moved segment", zap.String("path", path), zap.Error(err))
+		}
+	}
+	replacement.finish(ctx)
+	kept.finish(ctx)
+
+	return &pb.ObjectFinishMoveResponse{}, nil
+}
+
+// keepReplaced stores the object at the new path of a move at the replaced
+// path, if the move keeps the replaced object. The object must still be at
+// the revision, which BeginMoveObject returned.
+func (endpoint *Endpoint) keepReplaced(ctx context.Context, projectID uuid.UUID, req *pb.ObjectFinishMoveRequest) (_ *objectReplacement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !req.KeepReplaced {
+		return nil, nil
+	}
+
+	replaced, err := endpoint.loadObjectPointers(ctx, projectID, req.NewBucket, req.NewEncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	if len(replaced.pointers) == 0 {
+		if len(req.ReplacedRevision) > 0 {
+			return nil, status.Errorf(codes.Aborted, "object at the new path was modified concurrently")
+		}
+		return nil, nil
+	}
+	if !bytes.Equal(replaced.revision(), req.ReplacedRevision) {
+		return nil, status.Errorf(codes.Aborted, "object at the new path was modified concurrently")
+	}
+
+	if len(req.ReplacedEncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no path specified for the replaced object")
+	}
+	if bytes.Equal(req.ReplacedEncryptedPath, req.NewEncryptedPath) || (bytes.Equal(req.NewBucket, req.Bucket) && bytes.Equal(req.ReplacedEncryptedPath, req.EncryptedPath)) {
+		return nil, status.Errorf(codes.InvalidArgument, "the replaced object can't be kept at the path of a moved object")
+	}
+
+	_, err = endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.NewBucket,
+		EncryptedPath: req.ReplacedEncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = setSegmentsMetadata(replaced.pointers, req.ReplacedSegments)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return endpoint.putObjectPointers(ctx, projectID, req.NewBucket, req.ReplacedEncryptedPath, replaced.pointers)
+}
+
+// validateTransfer checks that the API key is allowed to move or copy the
+// object at path to newPath and to replace the object at newPath.
+func (endpoint *Endpoint) validateTransfer(ctx context.Context, bucket, path, newBucket, newPath []byte, move bool) (keyInfo *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	actions := []macaroon.Action{
+		{Op: macaroon.ActionRead, Bucket: bucket, EncryptedPath: path, Time: now},
+		{Op: macaroon.ActionWrite, Bucket: newBucket, EncryptedPath: newPath, Time: now},
+	}
+	if move {
+		actions = append(actions, macaroon.Action{Op: macaroon.ActionDelete, Bucket: bucket, EncryptedPath: path, Time: now})
+	}
+	for _, action := range actions {
+		keyInfo, err = endpoint.validateAuth(ctx, action)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+	}
+
+	for _, name := range [][]byte{bucket, newBucket} {
+		err = endpoint.validateBucket(ctx, name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(path) == 0 || len(newPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no path specified")
+	}
+	if bytes.Equal(bucket, newBucket) && bytes.Equal(path, newPath) {
+		return nil, status.Errorf(codes.InvalidArgument, "the new path is the same as the old one")
+	}
+
+	// replacing an object deletes it
+	lastSegment, err := CreatePath(ctx, keyInfo.ProjectID, -1, newBucket, newPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = endpoint.metainfo.Get(ctx, lastSegment)
+	switch {
+	case err == nil:
+		_, err = endpoint.validateAuth(ctx, macaroon.Action{Op: macaroon.ActionDelete, Bucket: newBucket, EncryptedPath: newPath, Time: now})
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+	case !storage.ErrKeyNotFound.Has(err):
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return keyInfo, nil
+}
+
+// objectPointers are the pointers of all segments of an object, keyed by
+// the segment index.
+type objectPointers struct {
+	pointers map[int64]*pb.Pointer
+	// stored are the stored bytes of the pointers, which identify their revision
+	stored map[int64][]byte
+}
+
+// revision returns a digest of the stored pointers, which changes whenever
+// any segment of the object is modified.
+func (object *objectPointers) revision() []byte {
+	segments := make([]int64, 0, len(object.stored))
+	for segment := range object.stored {
+		segments = append(segments, segment)
+	}
+	sort.Slice(segments, func(i, k int) bool { return segments[i] < segments[k] })
+
+	digest := sha256.New()
+	var header [16]byte
+	for _, segment := range segments {
+		binary.BigEndian.PutUint64(header[:8], uint64(segment))
+		binary.BigEndian.PutUint64(header[8:], uint64(len(object.stored[segment])))
+		_, _ = digest.Write(header[:])
+		_, _ = digest.Write(object.stored[segment])
+	}
+	return digest.Sum(nil)
+}
+
+// getObjectPointers returns the pointers of all segments of the object at path.
+func (endpoint *Endpoint) getObjectPointers(ctx context.Context, projectID uuid.UUID, bucket, path []byte) (object *objectPointers, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err = endpoint.loadObjectPointers(ctx, projectID, bucket, path)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if len(object.pointers) == 0 {
+		return nil, status.Errorf(codes.NotFound, "object not found")
+	}
+	return object, nil
+}
+
+// loadObjectPointers returns the pointers of all segments of the object at
+// path. It returns no pointers, if there's no object at path.
+func (endpoint *Endpoint) loadObjectPointers(ctx context.Context, projectID uuid.UUID, bucket, path []byte) (object *objectPointers, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object = &objectPointers{
+		pointers: make(map[int64]*pb.Pointer),
+		stored:   make(map[int64][]byte),
+	}
+	for segment := int64(-1); ; segment++ {
+		segmentPath, err := CreatePath(ctx, projectID, segment, bucket, path)
+		if err != nil {
+			return nil, err
+		}
+
+		pointerBytes, pointer, err := endpoint.metainfo.GetWithBytes(ctx, segmentPath)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return object, nil
+			}
+			return nil, err
+		}
+
+		object.pointers[segment] = pointer
+		object.stored[segment] = pointerBytes
+	}
+}
+
+// objectReplacement is an object stored in place of another one
+type objectReplacement struct {
+	endpoint *Endpoint
+	paths    map[int64]string
+	replaced *objectPointers
+	// written are the stored bytes of the pointers stored in place of the replaced ones
+	written map[int64][]byte
+	stored  []int64
+}
+
+// putObjectPointers stores the pointers of an object at path, replacing the
+// object stored there. The pointer of the last segment is stored after all
+// other segments, so the new object is visible only once it's complete. If
+// storing a pointer fails or the object at path is modified concurrently,
+// the replaced object is restored.
+func (endpoint *Endpoint) putObjectPointers(ctx context.Context, projectID uuid.UUID, bucket, path []byte, pointers map[int64]*pb.Pointer) (_ *objectReplacement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	replaced, err := endpoint.loadObjectPointers(ctx, projectID, bucket, path)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	replacement := &objectReplacement{
+		endpoint: endpoint,
+		paths:    make(map[int64]string),
+		replaced: replaced,
+		written:  make(map[int64][]byte),
+	}
+
+	var segments []int64
+	for _, all := range []map[int64]*pb.Pointer{pointers, replaced.pointers} {
+		for segment := range all {
+			if _, ok := replacement.paths[segment]; ok {
+				continue
+			}
+			replacement.paths[segment], err = CreatePath(ctx, projectID, segment, bucket, path)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if _, ok := pointers[segment]; ok && segment != -1 {
+				segments = append(segments, segment)
+			}
+		}
+	}
+	sort.Slice(segments, func(i, k int) bool { return segments[i] < segments[k] })
+
+	for _, segment := range append(segments, -1) {
+		written, err := endpoint.metainfo.CompareAndSwap(ctx, replacement.paths[segment], replaced.stored[segment], pointers[segment])
+		if err != nil {
+			replacement.rollback(ctx)
+			if storage.ErrKeyNotFound.Has(err) || storage.ErrValueChanged.Has(err) {
+				return nil, status.Errorf(codes.Aborted, "object at the new path was modified concurrently")
+			}
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		replacement.written[segment] = written
+		replacement.stored = append(replacement.stored, segment)
+	}
+
+	return replacement, nil
+}
+
+// rollback restores the replaced object, unless its segments have been
+// modified concurrently. It does nothing for a nil replacement.
+func (replacement *objectReplacement) rollback(ctx context.Context) {
+	if replacement == nil {
+		return
+	}
+	for i := len(replacement.stored) - 1; i >= 0; i-- {
+		segment := replacement.stored[i]
+		path := replacement.paths[segment]
+
+		_, err := replacement.endpoint.metainfo.CompareAndSwap(ctx, path, replacement.written[segment], replacement.replaced.pointers[segment])
+		if err != nil {
+			replacement.endpoint.log.Warn("unable to restore segment", zap.String("path", path), zap.Error(err))
+		}
+	}
+	replacement.stored = nil
+}
+
+// finish deletes the segments of the replaced object, which weren't
+// overwritten. Their pieces are removed by garbage collection. It does
+// nothing for a nil replacement.
+func (replacement *objectReplacement) finish(ctx context.Context) {
+	if replacement == nil {
+		return
+	}
+	stored := make(map[int64]bool, len(replacement.stored))
+	for _, segment := range replacement.stored {
+		stored[segment] = true
+	}
+
+	for segment := range replacement.replaced.pointers {
+		if stored[segment] {
+			continue
+		}
+		path := replacement.paths[segment]
+		_, err := replacement.endpoint.metainfo.CompareAndSwap(ctx, path, replacement.replaced.stored[segment], nil)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) && !storage.ErrValueChanged.Has(err) {
+			replacement.endpoint.log.Warn("unable to delete replaced segment", zap.String("path", path), zap.Error(err))
+		}
+	}
+}
+
+// objectPaths returns the paths of the segments of the object at path.
+func objectPaths(ctx context.Context, projectID uuid.UUID, bucket, path []byte, pointers map[int64]*pb.Pointer) (map[int64]string, error) {
+	paths := make(map[int64]string, len(pointers))
+	for segment := range pointers {
+		segmentPath, err := CreatePath(ctx, projectID, segment, bucket, path)
+		if err != nil {
+			return nil, err
+		}
+		paths[segment] = segmentPath
+	}
+	return paths, nil
+}
+
+// segmentsMetadata returns the encrypted metadata of the segments.
+func segmentsMetadata(pointers map[int64]*pb.Pointer) (segments []*pb.ObjectMoveSegment) {
+	for segment, pointer := range pointers {
+		segments = append(segments, &pb.ObjectMoveSegment{
+			Segment:           segment,
+			EncryptedMetadata: pointer.Metadata,
+		})
+	}
+	return segments
+}
+
+// setSegmentsMetadata replaces the metadata of the pointers with the
+// re-encrypted metadata of the segments.
+func setSegmentsMetadata(pointers map[int64]*pb.Pointer, segments []*pb.ObjectMoveSegment) error {
+	if len(segments) != len(pointers) {
+		return errs.New("expected metadata for %d segments, got %d", len(pointers), len(segments))
+	}
+	for _, segment := range segments {
+		pointer, ok := pointers[segment.Segment]
+		if !ok {
+			return errs.New("segment %d doesn't exist", segment.Segment)
+		}
+		pointer.Metadata = segment.EncryptedMetadata
+	}
+	return nil
+}
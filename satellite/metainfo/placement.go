@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/placement"
+)
+
+// SetBucketPlacement replaces the placement policy of a bucket
+func (endpoint *Endpoint) SetBucketPlacement(ctx context.Context, req *pb.SetBucketPlacementRequest) (resp *pb.SetBucketPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = placement.Validate(req.Policy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.validateBucketExists(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.placements.Set(ctx, keyInfo.ProjectID, req.Bucket, placement.Normalize(req.Policy))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketPlacementResponse{}, nil
+}
+
+// GetBucketPlacement returns the placement policy of a bucket
+func (endpoint *Endpoint) GetBucketPlacement(ctx context.Context, req *pb.GetBucketPlacementRequest) (resp *pb.GetBucketPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucketExists(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := endpoint.placements.Get(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.GetBucketPlacementResponse{Policy: policy}, nil
+}
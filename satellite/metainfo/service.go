@@ -8,6 +8,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
@@ -66,6 +67,49 @@ func (s *Service) Get(ctx context.Context, path string) (pointer *pb.Pointer, er
 	return pointer, nil
 }
 
+// GetWithBytes gets the pointer from db together with its stored bytes,
+// which identify the revision of the pointer in CompareAndSwap
+func (s *Service) GetWithBytes(ctx context.Context, path string) (pointerBytes []byte, pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+	pointerBytes, err = s.DB.Get(ctx, []byte(path))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pointer = &pb.Pointer{}
+	err = proto.Unmarshal(pointerBytes, pointer)
+	if err != nil {
+		return nil, nil, errs.New("error unmarshaling pointer: %v", err)
+	}
+
+	return pointerBytes, pointer, nil
+}
+
+// CompareAndSwap replaces the pointer stored as oldPointerBytes at path with
+// newPointer. A nil oldPointerBytes requires that there's no pointer at path
+// and a nil newPointer deletes the pointer. It returns storage.ErrValueChanged,
+// if the pointer at path has been changed, and the stored bytes of newPointer
+// otherwise.
+func (s *Service) CompareAndSwap(ctx context.Context, path string, oldPointerBytes []byte, newPointer *pb.Pointer) (newPointerBytes []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if newPointer != nil {
+		// Update the pointer with the creation date
+		newPointer.CreationDate = ptypes.TimestampNow()
+
+		newPointerBytes, err = proto.Marshal(newPointer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, newPointerBytes)
+	if err != nil {
+		return nil, err
+	}
+	return newPointerBytes, nil
+}
+
 // List returns all Path keys in the pointers bucket
 func (s *Service) List(ctx context.Context, prefix string, startAfter string, endBefore string, recursive bool, limit int32,
 	metaFlags uint32) (items []*pb.ListResponse_Item, more bool, err error) {
@@ -165,3 +209,26 @@ func (s *Service) Iterate(ctx context.Context, prefix string, first string, recu
 	}
 	return s.DB.Iterate(ctx, opts, f)
 }
+
+// CountBuckets returns the number of buckets of a project, counting at most max buckets.
+// Buckets are stored as last segment pointers without an object path.
+func (s *Service) CountBuckets(ctx context.Context, projectID uuid.UUID, max int64) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix, err := CreatePath(ctx, projectID, -1, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.Iterate(ctx, prefix+"/", "", false, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for (max <= 0 || count < max) && it.Next(ctx, &item) {
+				if !item.IsPrefix {
+					count++
+				}
+			}
+			return nil
+		})
+	return count, err
+}
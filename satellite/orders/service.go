@@ -684,6 +684,72 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, repairer
 	return limits, nil
 }
 
+// CreateGracefulExitPutOrderLimit creates an order limit for the exiting node to upload the piece pieceNum of pointer to node.
+func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, exitingNode *identity.PeerIdentity, bucketID []byte, pointer *pb.Pointer, node *pb.Node, pieceNum int32) (limit *pb.AddressedOrderLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	rootPieceID := pointer.GetRemote().RootPieceId
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	// convert orderExpiration from duration to timestamp
+	orderExpirationTime := time.Now().UTC().Add(service.orderExpiration)
+	orderExpiration, err := ptypes.TimestampProto(orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	serialNumber, err := service.createSerial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderLimit, err := signing.SignOrderLimit(ctx, service.satellite, &pb.OrderLimit{
+		SerialNumber:     serialNumber,
+		SatelliteId:      service.satellite.ID(),
+		SatelliteAddress: service.satelliteAddress,
+		UplinkId:         exitingNode.ID,
+		StorageNodeId:    node.Id,
+		PieceId:          rootPieceID.Derive(node.Id, pieceNum),
+		Action:           pb.PieceAction_PUT_REPAIR,
+		Limit:            pieceSize,
+		PieceExpiration:  pointer.ExpirationDate,
+		OrderCreation:    time.Now(),
+		OrderExpiration:  orderExpiration,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	limit = &pb.AddressedOrderLimit{
+		Limit:              orderLimit,
+		StorageNodeAddress: node.Address,
+	}
+
+	// the receiving node settles the order signed by the exiting node
+	err = service.certdb.SavePublicKey(ctx, exitingNode.ID, exitingNode.Leaf.PublicKey)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = service.saveSerial(ctx, serialNumber, bucketID, orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	projectID, bucketName, err := SplitBucketID(bucketID)
+	if err != nil {
+		return limit, err
+	}
+	if err := service.updateBandwidth(ctx, *projectID, bucketName, limit); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return limit, nil
+}
+
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket
 func (service *Service) UpdateGetInlineOrder(ctx context.Context, projectID uuid.UUID, bucketName []byte, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -40,13 +40,19 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/server"
+	ecclient "storj.io/storj/pkg/storage/ec"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/damagedpieces"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/marketingweb"
@@ -56,6 +62,7 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/localpayments"
 	"storj.io/storj/satellite/payments/stripepayments"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/satellite/rewards"
 	"storj.io/storj/satellite/vouchers"
 	"storj.io/storj/storage"
@@ -96,8 +103,16 @@ type DB interface {
 	Rewards() rewards.DB
 	// Orders returns database for orders
 	Orders() orders.DB
+	// Lifecycles returns database for bucket lifecycle rules
+	Lifecycles() lifecycle.DB
+	// Placements returns database for bucket placement policies
+	Placements() placement.DB
 	// Containment returns database for containment
 	Containment() audit.Containment
+	// GracefulExit returns database for graceful exit
+	GracefulExit() gracefulexit.DB
+	// DamagedPieces returns database for the damaged pieces reported by storage nodes
+	DamagedPieces() damagedpieces.DB
 }
 
 // Config is the global config satellite
@@ -116,9 +131,14 @@ type Config struct {
 	Repairer repairer.Config
 	Audit    audit.Config
 
+	GarbageCollection gc.Config
+	Lifecycle         lifecycle.Config
+	GracefulExit      gracefulexit.Config
+
 	Tally          tally.Config
 	Rollup         rollup.Config
 	LiveAccounting live.Config
+	ProjectLimits  accounting.ProjectLimitConfig
 
 	Mail    mailservice.Config
 	Console consoleweb.Config
@@ -126,6 +146,8 @@ type Config struct {
 	Marketing marketingweb.Config
 	Vouchers  vouchers.Config
 
+	Admin admin.Config
+
 	Version version.Config
 }
 
@@ -154,6 +176,7 @@ type Peer struct {
 
 	Overlay struct {
 		Service   *overlay.Cache
+		Recorder  *overlay.PerformanceRecorder
 		Inspector *overlay.Inspector
 	}
 
@@ -182,7 +205,21 @@ type Peer struct {
 		Inspector *irreparable.Inspector
 	}
 	Audit struct {
-		Service *audit.Service
+		Service   *audit.Service
+		Inspector *audit.Inspector
+	}
+
+	GarbageCollection struct {
+		Service *gc.Service
+	}
+
+	Lifecycle struct {
+		Service *lifecycle.Service
+	}
+
+	GracefulExit struct {
+		Chore    *gracefulexit.Chore
+		Endpoint *gracefulexit.Endpoint
 	}
 
 	Accounting struct {
@@ -214,9 +251,18 @@ type Peer struct {
 		Endpoint *marketingweb.Server
 	}
 
+	Admin struct {
+		Listener net.Listener
+		Endpoint *admin.Server
+	}
+
 	NodeStats struct {
 		Endpoint *nodestats.Endpoint
 	}
+
+	DamagedPieces struct {
+		Endpoint *damagedpieces.Endpoint
+	}
 }
 
 // New creates a new satellite
@@ -262,8 +308,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		log.Debug("Starting overlay")
 		config := config.Overlay
 
-		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), config.Node)
+		var geoip *overlay.GeoIP
+		if config.GeoIPDatabase != "" {
+			geoip, err = overlay.LoadGeoIP(config.GeoIPDatabase)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+
+		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), geoip, config.Node)
 		peer.Transport = peer.Transport.WithObservers(peer.Overlay.Service)
+		peer.Overlay.Recorder = overlay.NewPerformanceRecorder(peer.Log.Named("overlay:recorder"), config.Recorder, peer.Overlay.Service)
 
 		peer.Overlay.Inspector = overlay.NewInspector(peer.Overlay.Service)
 		pb.RegisterOverlayInspectorServer(peer.Server.PrivateGRPC(), peer.Overlay.Inspector)
@@ -293,6 +348,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			Operator: pb.NodeOperator{
 				Email:  config.Operator.Email,
 				Wallet: config.Operator.Wallet,
+				Tags:   config.Operator.TagList(),
 			},
 			Version: *pbVersion,
 		}
@@ -360,15 +416,6 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.LiveAccounting.Service = liveAccountingService
 	}
 
-	{ // setup accounting project usage
-		log.Debug("Setting up accounting project usage")
-		peer.Accounting.ProjectUsage = accounting.NewProjectUsage(
-			peer.DB.ProjectAccounting(),
-			peer.LiveAccounting.Service,
-			config.Rollup.MaxAlphaUsage,
-		)
-	}
-
 	{ // setup orders
 		log.Debug("Setting up orders")
 		satelliteSignee := signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity())
@@ -403,6 +450,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Metainfo.Database = db // for logging: storelogger.New(peer.Log.Named("pdb"), db)
 		peer.Metainfo.Service = metainfo.NewService(peer.Log.Named("metainfo:service"), peer.Metainfo.Database)
 
+		log.Debug("Setting up accounting project usage")
+		if config.Rollup.MaxAlphaUsage > 0 {
+			log.Warn("rollup.max-alpha-usage is deprecated, use project-limits.default-max-storage and project-limits.default-max-bandwidth instead")
+		}
+		peer.Accounting.ProjectUsage = accounting.NewProjectUsage(
+			peer.DB.ProjectAccounting(),
+			peer.LiveAccounting.Service,
+			peer.Metainfo.Service,
+			config.ProjectLimits.WithMaxAlphaUsage(config.Rollup.MaxAlphaUsage),
+		)
+
 		peer.Metainfo.Endpoint2 = metainfo.NewEndpoint(
 			peer.Log.Named("metainfo:endpoint"),
 			peer.Metainfo.Service,
@@ -410,6 +468,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Overlay.Service,
 			peer.DB.Attribution(),
 			peer.DB.Containment(),
+			peer.DB.Lifecycles(),
+			peer.DB.Placements(),
 			peer.DB.Console().APIKeys(),
 			peer.Accounting.ProjectUsage,
 			config.Metainfo.RS,
@@ -425,6 +485,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Metainfo.Service,
 			peer.DB.RepairQueue(),
 			peer.Overlay.Service, peer.DB.Irreparable(),
+			peer.DB.DamagedPieces(),
 			0, peer.Log.Named("checker"),
 			config.Checker.Interval,
 			config.Checker.IrreparableInterval)
@@ -439,6 +500,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Metainfo.Service,
 			peer.Orders.Service,
 			peer.Overlay.Service,
+			peer.Overlay.Recorder,
+			peer.DB.Placements(),
+			peer.DB.DamagedPieces(),
 		)
 
 		peer.Repair.Inspector = irreparable.NewInspector(peer.DB.Irreparable())
@@ -461,6 +525,70 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Audit.Inspector = audit.NewInspector(peer.Audit.Service.Scheduler)
+		pb.RegisterAuditInspectorServer(peer.Server.PrivateGRPC(), peer.Audit.Inspector)
+	}
+
+	{ // setup garbage collection
+		log.Debug("Setting up garbage collection")
+
+		peer.GarbageCollection.Service = gc.NewService(
+			peer.Log.Named("garbage collection"),
+			config.GarbageCollection,
+			peer.Transport,
+			peer.Overlay.Service,
+			peer.Metainfo.Service,
+		)
+	}
+
+	{ // setup bucket lifecycles
+		log.Debug("Setting up bucket lifecycles")
+
+		peer.Lifecycle.Service = lifecycle.NewService(
+			peer.Log.Named("lifecycle"),
+			config.Lifecycle,
+			peer.DB.Lifecycles(),
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.DB.Containment(),
+			ecclient.NewClient(peer.Log.Named("lifecycle:ecclient"), peer.Transport, 0),
+			peer.Identity,
+		)
+	}
+
+	{ // setup damaged pieces
+		log.Debug("Setting up damaged pieces")
+
+		peer.DamagedPieces.Endpoint = damagedpieces.NewEndpoint(
+			peer.Log.Named("damagedpieces:endpoint"),
+			peer.DB.DamagedPieces(),
+		)
+		pb.RegisterDamagedPiecesServer(peer.Server.GRPC(), peer.DamagedPieces.Endpoint)
+	}
+
+	{ // setup graceful exit
+		log.Debug("Setting up graceful exit")
+
+		peer.GracefulExit.Chore = gracefulexit.NewChore(
+			peer.Log.Named("gracefulexit:chore"),
+			config.GracefulExit,
+			peer.DB.GracefulExit(),
+			peer.Overlay.Service,
+			peer.Metainfo.Service,
+		)
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("gracefulexit:endpoint"),
+			config.GracefulExit,
+			peer.DB.GracefulExit(),
+			peer.Overlay.Service,
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.DB.CertDB(),
+			signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity()),
+			peer.Kademlia.Service,
+		)
+		pb.RegisterSatelliteGracefulExitServer(peer.Server.GRPC(), peer.GracefulExit.Endpoint)
 	}
 
 	{ // setup accounting
@@ -575,6 +703,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.DB.Console(),
 			peer.DB.Rewards(),
 			pmService,
+			peer.Accounting.ProjectUsage,
 			consoleConfig.PasswordCost,
 		)
 
@@ -611,6 +740,32 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		}
 	}
 
+	{ // setup admin api
+		adminConfig := config.Admin
+		if adminConfig.Address != "" {
+			log.Debug("Setting up admin api")
+
+			peer.Admin.Listener, err = net.Listen("tcp", adminConfig.Address)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Admin.Endpoint, err = admin.NewServer(
+				peer.Log.Named("admin:endpoint"),
+				adminConfig,
+				peer.Admin.Listener,
+				peer.Console.Service,
+				peer.Accounting.ProjectUsage,
+				peer.Overlay.Service,
+				peer.Metainfo.Service,
+				peer.DB.RepairQueue(),
+			)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+	}
+
 	{ // setup node stats endpoint
 		log.Debug("Setting up node stats endpoint")
 
@@ -643,6 +798,9 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Overlay.Recorder.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Checker.Run(ctx))
 	})
@@ -658,6 +816,15 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GarbageCollection.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Lifecycle.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Chore.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		// Don't change the format of this comment, it is used to figure out the node id.
@@ -672,6 +839,11 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Marketing.Endpoint.Run(ctx))
 	})
+	if peer.Admin.Endpoint != nil {
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Admin.Endpoint.Run(ctx))
+		})
+	}
 
 	return group.Wait()
 }
@@ -703,7 +875,22 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Marketing.Listener.Close())
 	}
 
+	if peer.Admin.Endpoint != nil {
+		errlist.Add(peer.Admin.Endpoint.Close())
+	} else if peer.Admin.Listener != nil {
+		errlist.Add(peer.Admin.Listener.Close())
+	}
+
 	// close services in reverse initialization order
+	if peer.GracefulExit.Chore != nil {
+		errlist.Add(peer.GracefulExit.Chore.Close())
+	}
+	if peer.Lifecycle.Service != nil {
+		errlist.Add(peer.Lifecycle.Service.Close())
+	}
+	if peer.GarbageCollection.Service != nil {
+		errlist.Add(peer.GarbageCollection.Service.Close())
+	}
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
 	}
@@ -727,6 +914,9 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Kademlia.RoutingTable.Close())
 	}
 
+	if peer.Overlay.Recorder != nil {
+		errlist.Add(peer.Overlay.Recorder.Close())
+	}
 	if peer.Overlay.Service != nil {
 		errlist.Add(peer.Overlay.Service.Close())
 	}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package placement
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+)
+
+// Error is the default error class for placement policies
+var Error = errs.Class("placement error")
+
+const (
+	maxCountries = 250
+	maxNetworks  = 100
+)
+
+// DB stores the placement policies of buckets
+type DB interface {
+	// Set replaces the placement policy of a bucket. Setting an empty policy removes it.
+	Set(ctx context.Context, projectID uuid.UUID, bucketName []byte, policy *pb.PlacementPolicy) error
+	// Get returns the placement policy of a bucket, nil when the bucket has none
+	Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (*pb.PlacementPolicy, error)
+}
+
+// IsEmpty returns whether the policy doesn't restrict node selection
+func IsEmpty(policy *pb.PlacementPolicy) bool {
+	return len(policy.GetAllowedCountries()) == 0 &&
+		len(policy.GetDeniedCountries()) == 0 &&
+		len(policy.GetDeniedNetworks()) == 0 &&
+		len(policy.GetRequiredTags()) == 0 &&
+		!policy.GetDistinctSubnets()
+}
+
+// Validate checks that the policy is well formed
+func Validate(policy *pb.PlacementPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	if len(policy.AllowedCountries) > maxCountries || len(policy.DeniedCountries) > maxCountries {
+		return Error.New("no more than %d countries are allowed", maxCountries)
+	}
+	for _, codes := range [][]string{policy.AllowedCountries, policy.DeniedCountries} {
+		for _, code := range codes {
+			if !validCountryCode(code) {
+				return Error.New("invalid country code %q", code)
+			}
+		}
+	}
+
+	if len(policy.DeniedNetworks) > maxNetworks {
+		return Error.New("no more than %d networks are allowed", maxNetworks)
+	}
+	for _, network := range policy.DeniedNetworks {
+		if _, _, err := net.ParseCIDR(network); err != nil {
+			return Error.New("invalid network %q", network)
+		}
+	}
+
+	if len(policy.RequiredTags) > overlay.MaxNodeTags {
+		return Error.New("no more than %d tags are allowed", overlay.MaxNodeTags)
+	}
+	for _, tag := range policy.RequiredTags {
+		if !overlay.ValidTag(tag) {
+			return Error.New("invalid tag %q", tag)
+		}
+	}
+
+	return nil
+}
+
+// Normalize returns a copy of the policy with upper case country codes
+func Normalize(policy *pb.PlacementPolicy) *pb.PlacementPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	normalized := *policy
+	normalized.AllowedCountries = upper(policy.AllowedCountries)
+	normalized.DeniedCountries = upper(policy.DeniedCountries)
+	return &normalized
+}
+
+// validCountryCode returns whether the code is a two letter country code
+func validCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range strings.ToUpper(code) {
+		if r < 'A' || 'Z' < r {
+			return false
+		}
+	}
+	return true
+}
+
+func upper(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, strings.ToUpper(value))
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package placement_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/placement"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDB(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		placements := db.Placements()
+
+		project := testrand.UUID()
+		bucket := []byte("bucket")
+
+		policy, err := placements.Get(ctx, project, bucket)
+		require.NoError(t, err)
+		assert.Nil(t, policy)
+
+		expected := &pb.PlacementPolicy{
+			AllowedCountries: []string{"DE", "FR"},
+			DeniedNetworks:   []string{"192.0.2.0/24"},
+			RequiredTags:     []string{"ssd"},
+			DistinctSubnets:  true,
+		}
+		require.NoError(t, placements.Set(ctx, project, bucket, expected))
+
+		policy, err = placements.Get(ctx, project, bucket)
+		require.NoError(t, err)
+		assert.Equal(t, expected.AllowedCountries, policy.AllowedCountries)
+		assert.Equal(t, expected.DeniedNetworks, policy.DeniedNetworks)
+		assert.Equal(t, expected.RequiredTags, policy.RequiredTags)
+		assert.True(t, policy.DistinctSubnets)
+
+		// other buckets aren't affected
+		policy, err = placements.Get(ctx, project, []byte("other"))
+		require.NoError(t, err)
+		assert.Nil(t, policy)
+
+		// an empty policy removes the placement
+		require.NoError(t, placements.Set(ctx, project, bucket, &pb.PlacementPolicy{}))
+		policy, err = placements.Get(ctx, project, bucket)
+		require.NoError(t, err)
+		assert.Nil(t, policy)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	for _, policy := range []*pb.PlacementPolicy{
+		nil,
+		{},
+		{AllowedCountries: []string{"de", "US"}},
+		{DeniedNetworks: []string{"192.0.2.0/24", "2001:db8::/32"}},
+		{RequiredTags: []string{"ssd", "tier-1", "eu_west.2"}},
+	} {
+		assert.NoError(t, placement.Validate(policy), policy.String())
+	}
+
+	for _, policy := range []*pb.PlacementPolicy{
+		{AllowedCountries: []string{"DEU"}},
+		{DeniedCountries: []string{"1A"}},
+		{DeniedNetworks: []string{"192.0.2.1"}},
+		{RequiredTags: []string{"has space"}},
+		{RequiredTags: []string{""}},
+	} {
+		assert.Error(t, placement.Validate(policy), policy.String())
+	}
+
+	normalized := placement.Normalize(&pb.PlacementPolicy{AllowedCountries: []string{"de"}, DeniedCountries: []string{"us"}})
+	assert.Equal(t, []string{"DE"}, normalized.AllowedCountries)
+	assert.Equal(t, []string{"US"}, normalized.DeniedCountries)
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// auditLogs exposes methods to manage audit_logs table in database.
+type auditLogs struct {
+	methods dbx.Methods
+}
+
+// Insert is a method for appending an entry to the audit log.
+func (logs *auditLogs) Insert(ctx context.Context, entry *console.AuditLogEntry) (_ *console.AuditLogEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	optional := dbx.AuditLog_Create_Fields{}
+	if entry.ProjectID != nil {
+		optional.ProjectId = dbx.AuditLog_ProjectId(entry.ProjectID[:])
+	}
+
+	created, err := logs.methods.Create_AuditLog(ctx,
+		dbx.AuditLog_Id(id[:]),
+		dbx.AuditLog_ActorId(entry.ActorID[:]),
+		dbx.AuditLog_Action(string(entry.Action)),
+		dbx.AuditLog_Target(entry.Target),
+		dbx.AuditLog_IpAddress(entry.IPAddress),
+		optional)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLogEntryFromDBX(ctx, created)
+}
+
+// GetByProjectID is a method for querying a page of audit log entries of a project, newest first.
+func (logs *auditLogs) GetByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.AuditLogCursor) (_ *console.AuditLogPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > 50 {
+		cursor.Limit = 50
+	}
+	if cursor.Limit == 0 {
+		return nil, errs.New("limit can not be 0")
+	}
+	if cursor.Page == 0 {
+		return nil, errs.New("page can not be 0")
+	}
+
+	page := &console.AuditLogPage{
+		Limit:  cursor.Limit,
+		Offset: uint64((cursor.Page - 1) * cursor.Limit),
+	}
+
+	totalCount, err := logs.methods.Count_AuditLog_By_ProjectId(ctx, dbx.AuditLog_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+	page.TotalCount = uint64(totalCount)
+	if page.TotalCount == 0 {
+		return page, nil
+	}
+	if page.Offset > page.TotalCount-1 {
+		return nil, errs.New("page is out of range")
+	}
+
+	entriesDbx, err := logs.methods.Limited_AuditLog_By_ProjectId_OrderBy_Desc_CreatedAt(ctx,
+		dbx.AuditLog_ProjectId(projectID[:]),
+		int(page.Limit), int64(page.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entryDbx := range entriesDbx {
+		entry, err := auditLogEntryFromDBX(ctx, entryDbx)
+		if err != nil {
+			return nil, err
+		}
+		page.Entries = append(page.Entries, *entry)
+	}
+
+	page.PageCount = uint(page.TotalCount / uint64(cursor.Limit))
+	if page.TotalCount%uint64(cursor.Limit) != 0 {
+		page.PageCount++
+	}
+	page.CurrentPage = cursor.Page
+
+	return page, nil
+}
+
+// Iterate calls fn for every audit log entry created in [since, before), oldest first.
+func (logs *auditLogs) Iterate(ctx context.Context, since, before time.Time, fn func(context.Context, console.AuditLogEntry) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	entriesDbx, err := logs.methods.All_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx,
+		dbx.AuditLog_CreatedAt(since.UTC()),
+		dbx.AuditLog_CreatedAt(before.UTC()))
+	if err != nil {
+		return err
+	}
+
+	for _, entryDbx := range entriesDbx {
+		entry, err := auditLogEntryFromDBX(ctx, entryDbx)
+		if err != nil {
+			return err
+		}
+		if err := fn(ctx, *entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// auditLogEntryFromDBX is used for creating AuditLogEntry entity from autogenerated dbx.AuditLog struct
+func auditLogEntryFromDBX(ctx context.Context, entry *dbx.AuditLog) (_ *console.AuditLogEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if entry == nil {
+		return nil, errs.New("audit log entry parameter is nil")
+	}
+
+	id, err := bytesToUUID(entry.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID, err := bytesToUUID(entry.ActorId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &console.AuditLogEntry{
+		ID:        id,
+		ActorID:   actorID,
+		Action:    console.AuditAction(entry.Action),
+		Target:    entry.Target,
+		IPAddress: entry.IpAddress,
+		CreatedAt: entry.CreatedAt,
+	}
+
+	if entry.ProjectId != nil {
+		projectID, err := bytesToUUID(entry.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+		result.ProjectID = &projectID
+	}
+
+	return result, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/lifecycle"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type bucketLifecycles struct {
+	db *dbx.DB
+}
+
+// Set replaces the lifecycle rules of a bucket
+func (lifecycles *bucketLifecycles) Set(ctx context.Context, projectID uuid.UUID, bucketName []byte, rules []*pb.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var data []byte
+	if len(rules) > 0 {
+		data, err = proto.Marshal(&pb.BucketLifecycle{Rules: rules})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return Error.Wrap(lifecycles.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketLifecycle_ProjectId(projectID[:]),
+			dbx.BucketLifecycle_BucketName(bucketName))
+		if err != nil || len(data) == 0 {
+			return err
+		}
+
+		_, err = tx.Create_BucketLifecycle(ctx,
+			dbx.BucketLifecycle_ProjectId(projectID[:]),
+			dbx.BucketLifecycle_BucketName(bucketName),
+			dbx.BucketLifecycle_Rules(data))
+		return err
+	}))
+}
+
+// Get returns the lifecycle rules of a bucket
+func (lifecycles *bucketLifecycles) Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ []*pb.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxLifecycle, err := lifecycles.db.Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycle_ProjectId(projectID[:]),
+		dbx.BucketLifecycle_BucketName(bucketName))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var config pb.BucketLifecycle
+	if err := proto.Unmarshal(dbxLifecycle.Rules, &config); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return config.Rules, nil
+}
+
+// List returns the lifecycle configurations of all buckets
+func (lifecycles *bucketLifecycles) List(ctx context.Context) (_ []*lifecycle.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxLifecycles, err := lifecycles.db.All_BucketLifecycle(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var buckets []*lifecycle.Bucket
+	for _, dbxLifecycle := range dbxLifecycles {
+		id, err := bytesToUUID(dbxLifecycle.ProjectId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		var config pb.BucketLifecycle
+		if err := proto.Unmarshal(dbxLifecycle.Rules, &config); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		buckets = append(buckets, &lifecycle.Bucket{
+			ProjectID:  id,
+			BucketName: dbxLifecycle.BucketName,
+			Rules:      config.Rules,
+		})
+	}
+	return buckets, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/placement"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+var _ placement.DB = (*bucketPlacements)(nil)
+
+type bucketPlacements struct {
+	db *dbx.DB
+}
+
+// Set replaces the placement policy of a bucket
+func (placements *bucketPlacements) Set(ctx context.Context, projectID uuid.UUID, bucketName []byte, policy *pb.PlacementPolicy) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var data []byte
+	if !placement.IsEmpty(policy) {
+		data, err = proto.Marshal(policy)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return Error.Wrap(placements.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Delete_BucketPlacement_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketPlacement_ProjectId(projectID[:]),
+			dbx.BucketPlacement_BucketName(bucketName))
+		if err != nil || len(data) == 0 {
+			return err
+		}
+
+		_, err = tx.Create_BucketPlacement(ctx,
+			dbx.BucketPlacement_ProjectId(projectID[:]),
+			dbx.BucketPlacement_BucketName(bucketName),
+			dbx.BucketPlacement_Policy(data))
+		return err
+	}))
+}
+
+// Get returns the placement policy of a bucket
+func (placements *bucketPlacements) Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ *pb.PlacementPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPlacement, err := placements.db.Get_BucketPlacement_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketPlacement_ProjectId(projectID[:]),
+		dbx.BucketPlacement_BucketName(bucketName))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	policy := &pb.PlacementPolicy{}
+	if err := proto.Unmarshal(dbxPlacement.Policy, policy); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return policy, nil
+}
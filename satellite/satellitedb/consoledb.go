@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/zeebo/errs"
 
@@ -23,7 +24,7 @@ type ConsoleDB struct {
 
 // Users is getter a for Users repository
 func (db *ConsoleDB) Users() console.Users {
-	return &users{db.methods}
+	return &users{db.methods, db.queryer()}
 }
 
 // Projects is a getter for Projects repository
@@ -33,7 +34,12 @@ func (db *ConsoleDB) Projects() console.Projects {
 
 // ProjectMembers is a getter for ProjectMembers repository
 func (db *ConsoleDB) ProjectMembers() console.ProjectMembers {
-	return &projectMembers{db.methods, db.db}
+	return &projectMembers{db.methods, db.queryer()}
+}
+
+// ProjectInvitations is a getter for ProjectInvitations repository
+func (db *ConsoleDB) ProjectInvitations() console.ProjectInvitations {
+	return &projectInvitations{db.methods}
 }
 
 // APIKeys is a getter for APIKeys repository
@@ -41,6 +47,11 @@ func (db *ConsoleDB) APIKeys() console.APIKeys {
 	return &apikeys{db.methods}
 }
 
+// AuditLogs is a getter for AuditLogs repository
+func (db *ConsoleDB) AuditLogs() console.AuditLogs {
+	return &auditLogs{db.methods}
+}
+
 // BucketUsage is a getter for accounting.BucketUsage repository
 func (db *ConsoleDB) BucketUsage() accounting.BucketUsage {
 	return &bucketusage{db.methods}
@@ -81,6 +92,14 @@ func (db *ConsoleDB) ProjectInvoiceStamps() console.ProjectInvoiceStamps {
 	return &projectinvoicestamps{db.methods}
 }
 
+// queryer returns the transaction when ConsoleDB is transaction scoped, otherwise the database.
+func (db *ConsoleDB) queryer() queryer {
+	if db.tx != nil {
+		return txQueryer{db.tx}
+	}
+	return db.db
+}
+
 // BeginTx is a method for opening transaction
 func (db *ConsoleDB) BeginTx(ctx context.Context) (console.DBTx, error) {
 	if db.db == nil {
@@ -100,6 +119,34 @@ func (db *ConsoleDB) BeginTx(ctx context.Context) (console.DBTx, error) {
 	}, nil
 }
 
+// queryer executes raw queries.
+type queryer interface {
+	Rebind(query string) string
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// txQueryer executes raw queries within dbx transaction.
+type txQueryer struct {
+	*dbx.Tx
+}
+
+// ExecContext executes a query without returning any rows.
+func (tx txQueryer) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return tx.Tx.Tx.ExecContext(ctx, query, args...)
+}
+
+// QueryContext executes a query that returns rows.
+func (tx txQueryer) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return tx.Tx.Tx.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext executes a query that is expected to return at most one row.
+func (tx txQueryer) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return tx.Tx.Tx.QueryRowContext(ctx, query, args...)
+}
+
 // DBTx extends Database with transaction scope
 type DBTx struct {
 	*ConsoleDB
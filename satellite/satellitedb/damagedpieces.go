@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/damagedpieces"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type damagedPiecesDB struct {
+	db *dbx.DB
+}
+
+// Add stores the reported pieces of a node, ignoring the already reported ones
+func (db *damagedPiecesDB) Add(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID, reportedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(pieceIDs) == 0 {
+		return nil
+	}
+
+	statement := db.db.Rebind(
+		`INSERT INTO damaged_pieces (node_id, piece_id, reported_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT DO NOTHING`,
+	)
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, pieceID := range pieceIDs {
+			_, err := tx.Tx.ExecContext(ctx, statement, nodeID.Bytes(), pieceID.Bytes(), reportedAt.UTC())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// GetAll returns all reported pieces
+func (db *damagedPiecesDB) GetAll(ctx context.Context) (reports []damagedpieces.Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPieces, err := db.db.All_DamagedPiece(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, dbxPiece := range dbxPieces {
+		var report damagedpieces.Report
+		report.NodeID, err = storj.NodeIDFromBytes(dbxPiece.NodeId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		report.PieceID, err = storj.PieceIDFromBytes(dbxPiece.PieceId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		report.ReportedAt = dbxPiece.ReportedAt
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// List returns at most limit reported pieces ordered by node and piece ID,
+// which come after the node and piece ID of cursor. The zero cursor lists from the start.
+func (db *damagedPiecesDB) List(ctx context.Context, cursor damagedpieces.Report, limit int) (reports []damagedpieces.Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rows *sql.Rows
+	if cursor.NodeID.IsZero() && cursor.PieceID.IsZero() {
+		rows, err = db.db.QueryContext(ctx, db.db.Rebind(`
+			SELECT node_id, piece_id, reported_at
+			FROM damaged_pieces
+			ORDER BY node_id, piece_id
+			LIMIT ?`), limit)
+	} else {
+		rows, err = db.db.QueryContext(ctx, db.db.Rebind(`
+			SELECT node_id, piece_id, reported_at
+			FROM damaged_pieces
+			WHERE node_id > ? OR (node_id = ? AND piece_id > ?)
+			ORDER BY node_id, piece_id
+			LIMIT ?`), cursor.NodeID.Bytes(), cursor.NodeID.Bytes(), cursor.PieceID.Bytes(), limit)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var nodeID, pieceID []byte
+		var report damagedpieces.Report
+		if err := rows.Scan(&nodeID, &pieceID, &report.ReportedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		report.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		report.PieceID, err = storj.PieceIDFromBytes(pieceID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, Error.Wrap(rows.Err())
+}
+
+// Has returns whether the node reported the piece
+func (db *damagedPiecesDB) Has(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Get_DamagedPiece_By_NodeId_And_PieceId(ctx,
+		dbx.DamagedPiece_NodeId(nodeID.Bytes()),
+		dbx.DamagedPiece_PieceId(pieceID.Bytes()))
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return true, nil
+}
+
+// Delete removes the report of a piece
+func (db *damagedPiecesDB) Delete(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_DamagedPiece_By_NodeId_And_PieceId(ctx,
+		dbx.DamagedPiece_NodeId(nodeID.Bytes()),
+		dbx.DamagedPiece_PieceId(pieceID.Bytes()))
+	return Error.Wrap(err)
+}
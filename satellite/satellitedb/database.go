@@ -18,7 +18,11 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/damagedpieces"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/satellite/rewards"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -141,6 +145,26 @@ func (db *DB) Rewards() rewards.DB {
 	return &offersDB{db: db.db}
 }
 
+// GracefulExit returns database for graceful exit
+func (db *DB) GracefulExit() gracefulexit.DB {
+	return &gracefulexitDB{db: db.db}
+}
+
+// DamagedPieces returns database for the damaged pieces reported by storage nodes
+func (db *DB) DamagedPieces() damagedpieces.DB {
+	return &damagedPiecesDB{db: db.db}
+}
+
+// Lifecycles returns database for storing bucket lifecycle rules
+func (db *DB) Lifecycles() lifecycle.DB {
+	return &bucketLifecycles{db: db.db}
+}
+
+// Placements returns database for storing bucket placement policies
+func (db *DB) Placements() placement.DB {
+	return &bucketPlacements{db: db.db}
+}
+
 // Orders returns database for storing orders
 func (db *DB) Orders() orders.DB {
 	return &ordersDB{db: db.db}
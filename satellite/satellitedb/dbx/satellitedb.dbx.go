@@ -289,6 +289,16 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_logs (
+	id bytea NOT NULL,
+	actor_id bytea NOT NULL,
+	project_id bytea,
+	action text NOT NULL,
+	target text NOT NULL,
+	ip_address text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -300,6 +310,20 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_lifecycles (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	updated_at timestamp NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_placements (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	policy bytea NOT NULL,
+	updated_at timestamp NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -333,10 +357,36 @@ CREATE TABLE certRecords (
 	update_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE damaged_pieces (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
+CREATE TABLE graceful_exit_progress (
+	node_id bytea NOT NULL,
+	bytes_transferred bigint NOT NULL,
+	pieces_transferred bigint NOT NULL,
+	pieces_failed bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE graceful_exit_transfer_queue (
+	node_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_num integer NOT NULL,
+	queued_at timestamp with time zone NOT NULL,
+	requested_at timestamp with time zone,
+	last_failed_at timestamp with time zone,
+	failed_count integer,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id, path )
+);
 CREATE TABLE injuredsegments (
 	path text NOT NULL,
 	data bytea NOT NULL,
 	attempted timestamp,
+	segment_health integer NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -351,6 +401,7 @@ CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL,
 	last_net text NOT NULL,
+	last_ip_address text,
 	protocol integer NOT NULL,
 	type integer NOT NULL,
 	email text NOT NULL,
@@ -378,8 +429,32 @@ CREATE TABLE nodes (
 	audit_reputation_beta double precision NOT NULL,
 	uptime_reputation_alpha double precision NOT NULL,
 	uptime_reputation_beta double precision NOT NULL,
+	exit_initiated_at timestamp with time zone,
+	exit_loop_completed_at timestamp with time zone,
+	exit_finished_at timestamp with time zone,
+	exit_success boolean NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_countries (
+	node_id bytea NOT NULL,
+	country_code text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	tag text NOT NULL,
+	PRIMARY KEY ( node_id, tag )
+);
+CREATE TABLE node_throughputs (
+	node_id bytea NOT NULL,
+	upload_bytes_per_second double precision NOT NULL,
+	upload_count bigint NOT NULL,
+	download_bytes_per_second double precision NOT NULL,
+	download_count bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE offers (
 	id serial NOT NULL,
 	name text NOT NULL,
@@ -410,6 +485,10 @@ CREATE TABLE projects (
 	name text NOT NULL,
 	description text NOT NULL,
 	usage_limit bigint NOT NULL,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	bucket_limit bigint,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -459,6 +538,12 @@ CREATE TABLE users (
 	password_hash bytea NOT NULL,
 	status integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
+	mfa_enabled boolean NOT NULL,
+	mfa_secret_key text,
+	mfa_recovery_codes text,
+	mfa_last_step bigint,
+	mfa_failed_attempts integer,
+	mfa_failed_at timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -506,10 +591,19 @@ CREATE TABLE project_invoice_stamps (
 	PRIMARY KEY ( project_id, start_date, end_date ),
 	UNIQUE ( invoice_id )
 );
+CREATE TABLE project_invitations (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	inviter_id bytea NOT NULL,
+	role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, member_id )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at timestamp with time zone NOT NULL,
+	role integer NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
 CREATE TABLE used_serials (
@@ -542,8 +636,10 @@ CREATE TABLE project_payments (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( project_id )
 );
+CREATE INDEX audit_logs_project_id_created_at_index ON audit_logs ( project_id, created_at );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
+CREATE INDEX injuredsegments_segment_health_index ON injuredsegments ( segment_health );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
@@ -628,6 +724,16 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_logs (
+	id BLOB NOT NULL,
+	actor_id BLOB NOT NULL,
+	project_id BLOB,
+	action TEXT NOT NULL,
+	target TEXT NOT NULL,
+	ip_address TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name BLOB NOT NULL,
 	project_id BLOB NOT NULL,
@@ -639,6 +745,20 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled INTEGER NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_lifecycles (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	rules BLOB NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_placements (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	policy BLOB NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name BLOB NOT NULL,
 	project_id BLOB NOT NULL,
@@ -672,10 +792,36 @@ CREATE TABLE certRecords (
 	update_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE damaged_pieces (
+	node_id BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	reported_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
+CREATE TABLE graceful_exit_progress (
+	node_id BLOB NOT NULL,
+	bytes_transferred INTEGER NOT NULL,
+	pieces_transferred INTEGER NOT NULL,
+	pieces_failed INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE graceful_exit_transfer_queue (
+	node_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_num INTEGER NOT NULL,
+	queued_at TIMESTAMP NOT NULL,
+	requested_at TIMESTAMP,
+	last_failed_at TIMESTAMP,
+	failed_count INTEGER,
+	finished_at TIMESTAMP,
+	PRIMARY KEY ( node_id, path )
+);
 CREATE TABLE injuredsegments (
 	path TEXT NOT NULL,
 	data BLOB NOT NULL,
 	attempted TIMESTAMP,
+	segment_health INTEGER NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -690,6 +836,7 @@ CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	address TEXT NOT NULL,
 	last_net TEXT NOT NULL,
+	last_ip_address TEXT,
 	protocol INTEGER NOT NULL,
 	type INTEGER NOT NULL,
 	email TEXT NOT NULL,
@@ -717,8 +864,32 @@ CREATE TABLE nodes (
 	audit_reputation_beta REAL NOT NULL,
 	uptime_reputation_alpha REAL NOT NULL,
 	uptime_reputation_beta REAL NOT NULL,
+	exit_initiated_at TIMESTAMP,
+	exit_loop_completed_at TIMESTAMP,
+	exit_finished_at TIMESTAMP,
+	exit_success INTEGER NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_countries (
+	node_id BLOB NOT NULL,
+	country_code TEXT NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_tags (
+	node_id BLOB NOT NULL,
+	tag TEXT NOT NULL,
+	PRIMARY KEY ( node_id, tag )
+);
+CREATE TABLE node_throughputs (
+	node_id BLOB NOT NULL,
+	upload_bytes_per_second REAL NOT NULL,
+	upload_count INTEGER NOT NULL,
+	download_bytes_per_second REAL NOT NULL,
+	download_count INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE offers (
 	id INTEGER NOT NULL,
 	name TEXT NOT NULL,
@@ -749,6 +920,10 @@ CREATE TABLE projects (
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
 	usage_limit INTEGER NOT NULL,
+	storage_limit INTEGER,
+	bandwidth_limit INTEGER,
+	segment_limit INTEGER,
+	bucket_limit INTEGER,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -798,6 +973,12 @@ CREATE TABLE users (
 	password_hash BLOB NOT NULL,
 	status INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
+	mfa_enabled INTEGER NOT NULL,
+	mfa_secret_key TEXT,
+	mfa_recovery_codes TEXT,
+	mfa_last_step INTEGER,
+	mfa_failed_attempts INTEGER,
+	mfa_failed_at TIMESTAMP,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -845,10 +1026,19 @@ CREATE TABLE project_invoice_stamps (
 	PRIMARY KEY ( project_id, start_date, end_date ),
 	UNIQUE ( invoice_id )
 );
+CREATE TABLE project_invitations (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	inviter_id BLOB NOT NULL,
+	role INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, member_id )
+);
 CREATE TABLE project_members (
 	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at TIMESTAMP NOT NULL,
+	role INTEGER NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
 CREATE TABLE used_serials (
@@ -881,8 +1071,10 @@ CREATE TABLE project_payments (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( project_id )
 );
+CREATE INDEX audit_logs_project_id_created_at_index ON audit_logs ( project_id, created_at );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
+CREATE INDEX injuredsegments_segment_health_index ON injuredsegments ( segment_health );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
@@ -1186,6 +1378,171 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
+type AuditLog struct {
+	Id        []byte
+	ActorId   []byte
+	ProjectId []byte
+	Action    string
+	Target    string
+	IpAddress string
+	CreatedAt time.Time
+}
+
+func (AuditLog) _Table() string { return "audit_logs" }
+
+type AuditLog_Create_Fields struct {
+	ProjectId AuditLog_ProjectId_Field
+}
+
+type AuditLog_Update_Fields struct {
+}
+
+type AuditLog_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditLog_Id(v []byte) AuditLog_Id_Field {
+	return AuditLog_Id_Field{_set: true, _value: v}
+}
+
+func (f AuditLog_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditLog_Id_Field) _Column() string { return "id" }
+
+type AuditLog_ActorId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditLog_ActorId(v []byte) AuditLog_ActorId_Field {
+	return AuditLog_ActorId_Field{_set: true, _value: v}
+}
+
+func (f AuditLog_ActorId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditLog_ActorId_Field) _Column() string { return "actor_id" }
+
+type AuditLog_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditLog_ProjectId(v []byte) AuditLog_ProjectId_Field {
+	return AuditLog_ProjectId_Field{_set: true, _value: v}
+}
+
+func AuditLog_ProjectId_Raw(v []byte) AuditLog_ProjectId_Field {
+	if v == nil {
+		return AuditLog_ProjectId_Null()
+	}
+	return AuditLog_ProjectId(v)
+}
+
+func AuditLog_ProjectId_Null() AuditLog_ProjectId_Field {
+	return AuditLog_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f AuditLog_ProjectId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditLog_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditLog_ProjectId_Field) _Column() string { return "project_id" }
+
+type AuditLog_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AuditLog_Action(v string) AuditLog_Action_Field {
+	return AuditLog_Action_Field{_set: true, _value: v}
+}
+
+func (f AuditLog_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditLog_Action_Field) _Column() string { return "action" }
+
+type AuditLog_Target_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AuditLog_Target(v string) AuditLog_Target_Field {
+	return AuditLog_Target_Field{_set: true, _value: v}
+}
+
+func (f AuditLog_Target_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditLog_Target_Field) _Column() string { return "target" }
+
+type AuditLog_IpAddress_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AuditLog_IpAddress(v string) AuditLog_IpAddress_Field {
+	return AuditLog_IpAddress_Field{_set: true, _value: v}
+}
+
+func (f AuditLog_IpAddress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditLog_IpAddress_Field) _Column() string { return "ip_address" }
+
+type AuditLog_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AuditLog_CreatedAt(v time.Time) AuditLog_CreatedAt_Field {
+	return AuditLog_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f AuditLog_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditLog_CreatedAt_Field) _Column() string { return "created_at" }
+
 type BucketBandwidthRollup struct {
 	BucketName      []byte
 	ProjectId       []byte
@@ -1358,165 +1715,343 @@ func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 
 func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type BucketStorageTally struct {
-	BucketName          []byte
-	ProjectId           []byte
-	IntervalStart       time.Time
-	Inline              uint64
-	Remote              uint64
-	RemoteSegmentsCount uint
-	InlineSegmentsCount uint
-	ObjectCount         uint
-	MetadataSize        uint64
+type BucketLifecycle struct {
+	ProjectId  []byte
+	BucketName []byte
+	Rules      []byte
+	UpdatedAt  time.Time
 }
 
-func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+func (BucketLifecycle) _Table() string { return "bucket_lifecycles" }
 
-type BucketStorageTally_Update_Fields struct {
+type BucketLifecycle_Update_Fields struct {
 }
 
-type BucketStorageTally_BucketName_Field struct {
+type BucketLifecycle_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
-	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+func BucketLifecycle_ProjectId(v []byte) BucketLifecycle_ProjectId_Field {
+	return BucketLifecycle_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_BucketName_Field) value() interface{} {
+func (f BucketLifecycle_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketLifecycle_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_ProjectId_Field struct {
+type BucketLifecycle_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
-	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+func BucketLifecycle_BucketName(v []byte) BucketLifecycle_BucketName_Field {
+	return BucketLifecycle_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+func (f BucketLifecycle_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketLifecycle_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_IntervalStart_Field struct {
+type BucketLifecycle_Rules_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
-	v = toUTC(v)
-	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
+func BucketLifecycle_Rules(v []byte) BucketLifecycle_Rules_Field {
+	return BucketLifecycle_Rules_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
+func (f BucketLifecycle_Rules_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BucketLifecycle_Rules_Field) _Column() string { return "rules" }
 
-type BucketStorageTally_Inline_Field struct {
+type BucketLifecycle_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
-	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+func BucketLifecycle_UpdatedAt(v time.Time) BucketLifecycle_UpdatedAt_Field {
+	v = toUTC(v)
+	return BucketLifecycle_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Inline_Field) value() interface{} {
+func (f BucketLifecycle_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+func (BucketLifecycle_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type BucketStorageTally_Remote_Field struct {
+type BucketPlacement struct {
+	ProjectId  []byte
+	BucketName []byte
+	Policy     []byte
+	UpdatedAt  time.Time
+}
+
+func (BucketPlacement) _Table() string { return "bucket_placements" }
+
+type BucketPlacement_Update_Fields struct {
+}
+
+type BucketPlacement_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
-	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+func BucketPlacement_ProjectId(v []byte) BucketPlacement_ProjectId_Field {
+	return BucketPlacement_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Remote_Field) value() interface{} {
+func (f BucketPlacement_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+func (BucketPlacement_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_RemoteSegmentsCount_Field struct {
+type BucketPlacement_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
-	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+func BucketPlacement_BucketName(v []byte) BucketPlacement_BucketName_Field {
+	return BucketPlacement_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+func (f BucketPlacement_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+func (BucketPlacement_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_InlineSegmentsCount_Field struct {
+type BucketPlacement_Policy_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
-	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
+func BucketPlacement_Policy(v []byte) BucketPlacement_Policy_Field {
+	return BucketPlacement_Policy_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+func (f BucketPlacement_Policy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+func (BucketPlacement_Policy_Field) _Column() string { return "policy" }
 
-type BucketStorageTally_ObjectCount_Field struct {
+type BucketPlacement_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
-	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+func BucketPlacement_UpdatedAt(v time.Time) BucketPlacement_UpdatedAt_Field {
+	v = toUTC(v)
+	return BucketPlacement_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacement_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPlacement_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type BucketStorageTally struct {
+	BucketName          []byte
+	ProjectId           []byte
+	IntervalStart       time.Time
+	Inline              uint64
+	Remote              uint64
+	RemoteSegmentsCount uint
+	InlineSegmentsCount uint
+	ObjectCount         uint
+	MetadataSize        uint64
+}
+
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+
+type BucketStorageTally_Update_Fields struct {
+}
+
+type BucketStorageTally_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketStorageTally_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketStorageTally_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
+	v = toUTC(v)
+	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type BucketStorageTally_Inline_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
+	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_Inline_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+
+type BucketStorageTally_Remote_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
+	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_Remote_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+
+type BucketStorageTally_RemoteSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
+	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+
+type BucketStorageTally_InlineSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
+	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+
+type BucketStorageTally_ObjectCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
 }
 
 func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
@@ -1863,856 +2398,1707 @@ func (f CertRecord_UpdateAt_Field) value() interface{} {
 
 func (CertRecord_UpdateAt_Field) _Column() string { return "update_at" }
 
-type Injuredsegment struct {
-	Path      string
-	Data      []byte
-	Attempted *time.Time
+type DamagedPiece struct {
+	NodeId     []byte
+	PieceId    []byte
+	ReportedAt time.Time
 }
 
-func (Injuredsegment) _Table() string { return "injuredsegments" }
-
-type Injuredsegment_Create_Fields struct {
-	Attempted Injuredsegment_Attempted_Field
-}
+func (DamagedPiece) _Table() string { return "damaged_pieces" }
 
-type Injuredsegment_Update_Fields struct {
-	Attempted Injuredsegment_Attempted_Field
+type DamagedPiece_Update_Fields struct {
 }
 
-type Injuredsegment_Path_Field struct {
+type DamagedPiece_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Injuredsegment_Path(v string) Injuredsegment_Path_Field {
-	return Injuredsegment_Path_Field{_set: true, _value: v}
+func DamagedPiece_NodeId(v []byte) DamagedPiece_NodeId_Field {
+	return DamagedPiece_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Path_Field) value() interface{} {
+func (f DamagedPiece_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Path_Field) _Column() string { return "path" }
+func (DamagedPiece_NodeId_Field) _Column() string { return "node_id" }
 
-type Injuredsegment_Data_Field struct {
+type DamagedPiece_PieceId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
-	return Injuredsegment_Data_Field{_set: true, _value: v}
+func DamagedPiece_PieceId(v []byte) DamagedPiece_PieceId_Field {
+	return DamagedPiece_PieceId_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Data_Field) value() interface{} {
+func (f DamagedPiece_PieceId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Data_Field) _Column() string { return "data" }
+func (DamagedPiece_PieceId_Field) _Column() string { return "piece_id" }
 
-type Injuredsegment_Attempted_Field struct {
+type DamagedPiece_ReportedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Injuredsegment_Attempted(v time.Time) Injuredsegment_Attempted_Field {
-	v = toUTC(v)
-	return Injuredsegment_Attempted_Field{_set: true, _value: &v}
-}
-
-func Injuredsegment_Attempted_Raw(v *time.Time) Injuredsegment_Attempted_Field {
-	if v == nil {
-		return Injuredsegment_Attempted_Null()
-	}
-	return Injuredsegment_Attempted(*v)
+	_value time.Time
 }
 
-func Injuredsegment_Attempted_Null() Injuredsegment_Attempted_Field {
-	return Injuredsegment_Attempted_Field{_set: true, _null: true}
+func DamagedPiece_ReportedAt(v time.Time) DamagedPiece_ReportedAt_Field {
+	return DamagedPiece_ReportedAt_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Attempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Injuredsegment_Attempted_Field) value() interface{} {
+func (f DamagedPiece_ReportedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Attempted_Field) _Column() string { return "attempted" }
+func (DamagedPiece_ReportedAt_Field) _Column() string { return "reported_at" }
 
-type Irreparabledb struct {
-	Segmentpath        []byte
-	Segmentdetail      []byte
-	PiecesLostCount    int64
-	SegDamagedUnixSec  int64
-	RepairAttemptCount int64
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
 }
 
-func (Irreparabledb) _Table() string { return "irreparabledbs" }
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
 
-type Irreparabledb_Update_Fields struct {
-	Segmentdetail      Irreparabledb_Segmentdetail_Field
-	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
-	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
-	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
 }
 
-type Irreparabledb_Segmentpath_Field struct {
+type GracefulExitProgress_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
-	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
 
-type Irreparabledb_Segmentdetail_Field struct {
+type GracefulExitProgress_BytesTransferred_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
-	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
 
-type Irreparabledb_PiecesLostCount_Field struct {
+type GracefulExitProgress_PiecesTransferred_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
-	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
 
-type Irreparabledb_SegDamagedUnixSec_Field struct {
+type GracefulExitProgress_PiecesFailed_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
-	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
 
-type Irreparabledb_RepairAttemptCount_Field struct {
+type GracefulExitProgress_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
-	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node struct {
-	Id                    []byte
-	Address               string
-	LastNet               string
-	Protocol              int
-	Type                  int
-	Email                 string
-	Wallet                string
-	FreeBandwidth         int64
-	FreeDisk              int64
-	Major                 int64
-	Minor                 int64
-	Patch                 int64
-	Hash                  string
-	Timestamp             time.Time
-	Release               bool
-	Latency90             int64
-	AuditSuccessCount     int64
-	TotalAuditCount       int64
-	UptimeSuccessCount    int64
-	TotalUptimeCount      int64
-	CreatedAt             time.Time
-	UpdatedAt             time.Time
-	LastContactSuccess    time.Time
-	LastContactFailure    time.Time
-	Contained             bool
-	Disqualified          *time.Time
-	AuditReputationAlpha  float64
-	AuditReputationBeta   float64
-	UptimeReputationAlpha float64
-	UptimeReputationBeta  float64
+type GracefulExitTransferQueue struct {
+	NodeId       []byte
+	Path         []byte
+	PieceNum     int
+	QueuedAt     time.Time
+	RequestedAt  *time.Time
+	LastFailedAt *time.Time
+	FailedCount  *int
+	FinishedAt   *time.Time
 }
 
-func (Node) _Table() string { return "nodes" }
+func (GracefulExitTransferQueue) _Table() string { return "graceful_exit_transfer_queue" }
 
-type Node_Create_Fields struct {
-	Disqualified Node_Disqualified_Field
+type GracefulExitTransferQueue_Create_Fields struct {
+	RequestedAt  GracefulExitTransferQueue_RequestedAt_Field
+	LastFailedAt GracefulExitTransferQueue_LastFailedAt_Field
+	FailedCount  GracefulExitTransferQueue_FailedCount_Field
+	FinishedAt   GracefulExitTransferQueue_FinishedAt_Field
 }
 
-type Node_Update_Fields struct {
-	Address               Node_Address_Field
-	LastNet               Node_LastNet_Field
-	Protocol              Node_Protocol_Field
-	Type                  Node_Type_Field
-	Email                 Node_Email_Field
-	Wallet                Node_Wallet_Field
-	FreeBandwidth         Node_FreeBandwidth_Field
-	FreeDisk              Node_FreeDisk_Field
-	Major                 Node_Major_Field
-	Minor                 Node_Minor_Field
-	Patch                 Node_Patch_Field
-	Hash                  Node_Hash_Field
-	Timestamp             Node_Timestamp_Field
-	Release               Node_Release_Field
-	Latency90             Node_Latency90_Field
-	AuditSuccessCount     Node_AuditSuccessCount_Field
-	TotalAuditCount       Node_TotalAuditCount_Field
-	UptimeSuccessCount    Node_UptimeSuccessCount_Field
-	TotalUptimeCount      Node_TotalUptimeCount_Field
-	LastContactSuccess    Node_LastContactSuccess_Field
-	LastContactFailure    Node_LastContactFailure_Field
-	Contained             Node_Contained_Field
-	Disqualified          Node_Disqualified_Field
-	AuditReputationAlpha  Node_AuditReputationAlpha_Field
-	AuditReputationBeta   Node_AuditReputationBeta_Field
-	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
-	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+type GracefulExitTransferQueue_Update_Fields struct {
+	RequestedAt  GracefulExitTransferQueue_RequestedAt_Field
+	LastFailedAt GracefulExitTransferQueue_LastFailedAt_Field
+	FailedCount  GracefulExitTransferQueue_FailedCount_Field
+	FinishedAt   GracefulExitTransferQueue_FinishedAt_Field
 }
 
-type Node_Id_Field struct {
+type GracefulExitTransferQueue_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_NodeId(v []byte) GracefulExitTransferQueue_NodeId_Field {
+	return GracefulExitTransferQueue_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f GracefulExitTransferQueue_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (GracefulExitTransferQueue_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_Address_Field struct {
+type GracefulExitTransferQueue_Path_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_Path(v []byte) GracefulExitTransferQueue_Path_Field {
+	return GracefulExitTransferQueue_Path_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f GracefulExitTransferQueue_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (GracefulExitTransferQueue_Path_Field) _Column() string { return "path" }
 
-type Node_LastNet_Field struct {
+type GracefulExitTransferQueue_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitTransferQueue_PieceNum(v int) GracefulExitTransferQueue_PieceNum_Field {
+	return GracefulExitTransferQueue_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_PieceNum_Field) _Column() string { return "piece_num" }
+
+type GracefulExitTransferQueue_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitTransferQueue_QueuedAt(v time.Time) GracefulExitTransferQueue_QueuedAt_Field {
+	return GracefulExitTransferQueue_QueuedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_QueuedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_QueuedAt_Field) _Column() string { return "queued_at" }
+
+type GracefulExitTransferQueue_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitTransferQueue_RequestedAt(v time.Time) GracefulExitTransferQueue_RequestedAt_Field {
+	return GracefulExitTransferQueue_RequestedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_RequestedAt_Raw(v *time.Time) GracefulExitTransferQueue_RequestedAt_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_RequestedAt_Null()
+	}
+	return GracefulExitTransferQueue_RequestedAt(*v)
+}
+
+func GracefulExitTransferQueue_RequestedAt_Null() GracefulExitTransferQueue_RequestedAt_Field {
+	return GracefulExitTransferQueue_RequestedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_RequestedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type GracefulExitTransferQueue_LastFailedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitTransferQueue_LastFailedAt(v time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
+	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_LastFailedAt_Raw(v *time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_LastFailedAt_Null()
+	}
+	return GracefulExitTransferQueue_LastFailedAt(*v)
+}
+
+func GracefulExitTransferQueue_LastFailedAt_Null() GracefulExitTransferQueue_LastFailedAt_Field {
+	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_LastFailedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+
+type GracefulExitTransferQueue_FailedCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitTransferQueue_FailedCount(v int) GracefulExitTransferQueue_FailedCount_Field {
+	return GracefulExitTransferQueue_FailedCount_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_FailedCount_Raw(v *int) GracefulExitTransferQueue_FailedCount_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_FailedCount_Null()
+	}
+	return GracefulExitTransferQueue_FailedCount(*v)
+}
+
+func GracefulExitTransferQueue_FailedCount_Null() GracefulExitTransferQueue_FailedCount_Field {
+	return GracefulExitTransferQueue_FailedCount_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_FailedCount_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_FailedCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_FailedCount_Field) _Column() string { return "failed_count" }
+
+type GracefulExitTransferQueue_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitTransferQueue_FinishedAt(v time.Time) GracefulExitTransferQueue_FinishedAt_Field {
+	return GracefulExitTransferQueue_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_FinishedAt_Raw(v *time.Time) GracefulExitTransferQueue_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_FinishedAt_Null()
+	}
+	return GracefulExitTransferQueue_FinishedAt(*v)
+}
+
+func GracefulExitTransferQueue_FinishedAt_Null() GracefulExitTransferQueue_FinishedAt_Field {
+	return GracefulExitTransferQueue_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type Injuredsegment struct {
+	Path          string
+	Data          []byte
+	Attempted     *time.Time
+	SegmentHealth int
+}
+
+func (Injuredsegment) _Table() string { return "injuredsegments" }
+
+type Injuredsegment_Create_Fields struct {
+	Attempted Injuredsegment_Attempted_Field
+}
+
+type Injuredsegment_Update_Fields struct {
+	Attempted     Injuredsegment_Attempted_Field
+	SegmentHealth Injuredsegment_SegmentHealth_Field
+}
+
+type Injuredsegment_Path_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_LastNet(v string) Node_LastNet_Field {
-	return Node_LastNet_Field{_set: true, _value: v}
+func Injuredsegment_Path(v string) Injuredsegment_Path_Field {
+	return Injuredsegment_Path_Field{_set: true, _value: v}
 }
 
-func (f Node_LastNet_Field) value() interface{} {
+func (f Injuredsegment_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastNet_Field) _Column() string { return "last_net" }
+func (Injuredsegment_Path_Field) _Column() string { return "path" }
 
-type Node_Protocol_Field struct {
+type Injuredsegment_Data_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
+	return Injuredsegment_Data_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Data_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Data_Field) _Column() string { return "data" }
+
+type Injuredsegment_Attempted_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Injuredsegment_Attempted(v time.Time) Injuredsegment_Attempted_Field {
+	v = toUTC(v)
+	return Injuredsegment_Attempted_Field{_set: true, _value: &v}
+}
+
+func Injuredsegment_Attempted_Raw(v *time.Time) Injuredsegment_Attempted_Field {
+	if v == nil {
+		return Injuredsegment_Attempted_Null()
+	}
+	return Injuredsegment_Attempted(*v)
+}
+
+func Injuredsegment_Attempted_Null() Injuredsegment_Attempted_Field {
+	return Injuredsegment_Attempted_Field{_set: true, _null: true}
+}
+
+func (f Injuredsegment_Attempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Injuredsegment_Attempted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Attempted_Field) _Column() string { return "attempted" }
+
+type Injuredsegment_SegmentHealth_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Injuredsegment_SegmentHealth(v int) Injuredsegment_SegmentHealth_Field {
+	return Injuredsegment_SegmentHealth_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_SegmentHealth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_SegmentHealth_Field) _Column() string { return "segment_health" }
+
+type Irreparabledb struct {
+	Segmentpath        []byte
+	Segmentdetail      []byte
+	PiecesLostCount    int64
+	SegDamagedUnixSec  int64
+	RepairAttemptCount int64
+}
+
+func (Irreparabledb) _Table() string { return "irreparabledbs" }
+
+type Irreparabledb_Update_Fields struct {
+	Segmentdetail      Irreparabledb_Segmentdetail_Field
+	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
+	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
+	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+}
+
+type Irreparabledb_Segmentpath_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
+	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+
+type Irreparabledb_Segmentdetail_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
+	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+
+type Irreparabledb_PiecesLostCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
+	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+
+type Irreparabledb_SegDamagedUnixSec_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
+	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+
+type Irreparabledb_RepairAttemptCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
+	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+
+type Node struct {
+	Id                    []byte
+	Address               string
+	LastNet               string
+	LastIpAddress         *string
+	Protocol              int
+	Type                  int
+	Email                 string
+	Wallet                string
+	FreeBandwidth         int64
+	FreeDisk              int64
+	Major                 int64
+	Minor                 int64
+	Patch                 int64
+	Hash                  string
+	Timestamp             time.Time
+	Release               bool
+	Latency90             int64
+	AuditSuccessCount     int64
+	TotalAuditCount       int64
+	UptimeSuccessCount    int64
+	TotalUptimeCount      int64
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	LastContactSuccess    time.Time
+	LastContactFailure    time.Time
+	Contained             bool
+	Disqualified          *time.Time
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+	ExitInitiatedAt       *time.Time
+	ExitLoopCompletedAt   *time.Time
+	ExitFinishedAt        *time.Time
+	ExitSuccess           bool
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Create_Fields struct {
+	LastIpAddress       Node_LastIpAddress_Field
+	Disqualified        Node_Disqualified_Field
+	ExitInitiatedAt     Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt      Node_ExitFinishedAt_Field
+}
+
+type Node_Update_Fields struct {
+	Address               Node_Address_Field
+	LastNet               Node_LastNet_Field
+	LastIpAddress         Node_LastIpAddress_Field
+	Protocol              Node_Protocol_Field
+	Type                  Node_Type_Field
+	Email                 Node_Email_Field
+	Wallet                Node_Wallet_Field
+	FreeBandwidth         Node_FreeBandwidth_Field
+	FreeDisk              Node_FreeDisk_Field
+	Major                 Node_Major_Field
+	Minor                 Node_Minor_Field
+	Patch                 Node_Patch_Field
+	Hash                  Node_Hash_Field
+	Timestamp             Node_Timestamp_Field
+	Release               Node_Release_Field
+	Latency90             Node_Latency90_Field
+	AuditSuccessCount     Node_AuditSuccessCount_Field
+	TotalAuditCount       Node_TotalAuditCount_Field
+	UptimeSuccessCount    Node_UptimeSuccessCount_Field
+	TotalUptimeCount      Node_TotalUptimeCount_Field
+	LastContactSuccess    Node_LastContactSuccess_Field
+	LastContactFailure    Node_LastContactFailure_Field
+	Contained             Node_Contained_Field
+	Disqualified          Node_Disqualified_Field
+	AuditReputationAlpha  Node_AuditReputationAlpha_Field
+	AuditReputationBeta   Node_AuditReputationBeta_Field
+	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+	ExitInitiatedAt       Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt   Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt        Node_ExitFinishedAt_Field
+	ExitSuccess           Node_ExitSuccess_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
+}
+
+func (f Node_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Address_Field) _Column() string { return "address" }
+
+type Node_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
+}
+
+func (f Node_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastNet_Field) _Column() string { return "last_net" }
+
+type Node_LastIpAddress_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_LastIpAddress(v string) Node_LastIpAddress_Field {
+	return Node_LastIpAddress_Field{_set: true, _value: &v}
+}
+
+func Node_LastIpAddress_Raw(v *string) Node_LastIpAddress_Field {
+	if v == nil {
+		return Node_LastIpAddress_Null()
+	}
+	return Node_LastIpAddress(*v)
+}
+
+func Node_LastIpAddress_Null() Node_LastIpAddress_Field {
+	return Node_LastIpAddress_Field{_set: true, _null: true}
+}
+
+func (f Node_LastIpAddress_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastIpAddress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastIpAddress_Field) _Column() string { return "last_ip_address" }
+
+type Node_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
+}
+
+func (f Node_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Protocol_Field) _Column() string { return "protocol" }
+
+type Node_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Type(v int) Node_Type_Field {
+	return Node_Type_Field{_set: true, _value: v}
+}
+
+func (f Node_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Type_Field) _Column() string { return "type" }
+
+type Node_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
+}
+
+func (f Node_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Email_Field) _Column() string { return "email" }
+
+type Node_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
+}
+
+func (f Node_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Wallet_Field) _Column() string { return "wallet" }
+
+type Node_FreeBandwidth_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_FreeBandwidth(v int64) Node_FreeBandwidth_Field {
+	return Node_FreeBandwidth_Field{_set: true, _value: v}
+}
+
+func (f Node_FreeBandwidth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
+
+type Node_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f Node_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type Node_Major_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Major(v int64) Node_Major_Field {
+	return Node_Major_Field{_set: true, _value: v}
+}
+
+func (f Node_Major_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Major_Field) _Column() string { return "major" }
+
+type Node_Minor_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Minor(v int64) Node_Minor_Field {
+	return Node_Minor_Field{_set: true, _value: v}
+}
+
+func (f Node_Minor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Minor_Field) _Column() string { return "minor" }
+
+type Node_Patch_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Patch(v int64) Node_Patch_Field {
+	return Node_Patch_Field{_set: true, _value: v}
+}
+
+func (f Node_Patch_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Patch_Field) _Column() string { return "patch" }
+
+type Node_Hash_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Hash(v string) Node_Hash_Field {
+	return Node_Hash_Field{_set: true, _value: v}
+}
+
+func (f Node_Hash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Hash_Field) _Column() string { return "hash" }
+
+type Node_Timestamp_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_Timestamp(v time.Time) Node_Timestamp_Field {
+	return Node_Timestamp_Field{_set: true, _value: v}
+}
+
+func (f Node_Timestamp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+
+type Node_Release_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Release(v bool) Node_Release_Field {
+	return Node_Release_Field{_set: true, _value: v}
+}
+
+func (f Node_Release_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Release_Field) _Column() string { return "release" }
+
+type Node_Latency90_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Latency90(v int64) Node_Latency90_Field {
+	return Node_Latency90_Field{_set: true, _value: v}
+}
+
+func (f Node_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Latency90_Field) _Column() string { return "latency_90" }
+
+type Node_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
+	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type Node_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
+	return Node_TotalAuditCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalAuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+
+type Node_UptimeSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
+	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f Node_UptimeSuccessCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
 
-type Node_Type_Field struct {
+type Node_TotalUptimeCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func Node_Type(v int) Node_Type_Field {
-	return Node_Type_Field{_set: true, _value: v}
+func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
+	return Node_TotalUptimeCount_Field{_set: true, _value: v}
 }
 
-func (f Node_Type_Field) value() interface{} {
+func (f Node_TotalUptimeCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Type_Field) _Column() string { return "type" }
+func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
 
-type Node_Email_Field struct {
+type Node_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func (f Node_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_Wallet_Field struct {
+type Node_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func (f Node_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node_FreeBandwidth_Field struct {
+type Node_LastContactSuccess_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_FreeBandwidth(v int64) Node_FreeBandwidth_Field {
-	return Node_FreeBandwidth_Field{_set: true, _value: v}
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeBandwidth_Field) value() interface{} {
+func (f Node_LastContactSuccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
 
-type Node_FreeDisk_Field struct {
+type Node_LastContactFailure_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f Node_LastContactFailure_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
 
-type Node_Major_Field struct {
+type Node_Contained_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value bool
 }
 
-func Node_Major(v int64) Node_Major_Field {
-	return Node_Major_Field{_set: true, _value: v}
+func Node_Contained(v bool) Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _value: v}
 }
 
-func (f Node_Major_Field) value() interface{} {
+func (f Node_Contained_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Major_Field) _Column() string { return "major" }
+func (Node_Contained_Field) _Column() string { return "contained" }
 
-type Node_Minor_Field struct {
+type Node_Disqualified_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Node_Minor(v int64) Node_Minor_Field {
-	return Node_Minor_Field{_set: true, _value: v}
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _value: &v}
 }
 
-func (f Node_Minor_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+	if v == nil {
+		return Node_Disqualified_Null()
 	}
-	return f._value
+	return Node_Disqualified(*v)
 }
 
-func (Node_Minor_Field) _Column() string { return "minor" }
-
-type Node_Patch_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
 }
 
-func Node_Patch(v int64) Node_Patch_Field {
-	return Node_Patch_Field{_set: true, _value: v}
-}
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_Patch_Field) value() interface{} {
+func (f Node_Disqualified_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Patch_Field) _Column() string { return "patch" }
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
 
-type Node_Hash_Field struct {
+type Node_AuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value float64
 }
 
-func Node_Hash(v string) Node_Hash_Field {
-	return Node_Hash_Field{_set: true, _value: v}
+func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
+	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f Node_Hash_Field) value() interface{} {
+func (f Node_AuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Hash_Field) _Column() string { return "hash" }
+func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
 
-type Node_Timestamp_Field struct {
+type Node_AuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func Node_Timestamp(v time.Time) Node_Timestamp_Field {
-	return Node_Timestamp_Field{_set: true, _value: v}
+func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
+	return Node_AuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f Node_Timestamp_Field) value() interface{} {
+func (f Node_AuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
 
-type Node_Release_Field struct {
+type Node_UptimeReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value float64
 }
 
-func Node_Release(v bool) Node_Release_Field {
-	return Node_Release_Field{_set: true, _value: v}
+func Node_UptimeReputationAlpha(v float64) Node_UptimeReputationAlpha_Field {
+	return Node_UptimeReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f Node_Release_Field) value() interface{} {
+func (f Node_UptimeReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Release_Field) _Column() string { return "release" }
+func (Node_UptimeReputationAlpha_Field) _Column() string { return "uptime_reputation_alpha" }
 
-type Node_Latency90_Field struct {
+type Node_UptimeReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func Node_Latency90(v int64) Node_Latency90_Field {
-	return Node_Latency90_Field{_set: true, _value: v}
+func Node_UptimeReputationBeta(v float64) Node_UptimeReputationBeta_Field {
+	return Node_UptimeReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f Node_Latency90_Field) value() interface{} {
+func (f Node_UptimeReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Latency90_Field) _Column() string { return "latency_90" }
+func (Node_UptimeReputationBeta_Field) _Column() string { return "uptime_reputation_beta" }
 
-type Node_AuditSuccessCount_Field struct {
+type Node_ExitInitiatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
-	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
 }
 
-func (f Node_AuditSuccessCount_Field) value() interface{} {
+func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
+	if v == nil {
+		return Node_ExitInitiatedAt_Null()
+	}
+	return Node_ExitInitiatedAt(*v)
+}
+
+func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitInitiatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
 
-type Node_TotalAuditCount_Field struct {
+type Node_ExitLoopCompletedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
-	return Node_TotalAuditCount_Field{_set: true, _value: v}
+func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
 }
 
-func (f Node_TotalAuditCount_Field) value() interface{} {
+func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
+	if v == nil {
+		return Node_ExitLoopCompletedAt_Null()
+	}
+	return Node_ExitLoopCompletedAt(*v)
+}
+
+func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
 
-type Node_UptimeSuccessCount_Field struct {
+type Node_ExitFinishedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
-	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
 }
 
-func (f Node_UptimeSuccessCount_Field) value() interface{} {
+func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+	if v == nil {
+		return Node_ExitFinishedAt_Null()
+	}
+	return Node_ExitFinishedAt(*v)
+}
+
+func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitFinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
 
-type Node_TotalUptimeCount_Field struct {
+type Node_ExitSuccess_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value bool
 }
 
-func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
-	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
+	return Node_ExitSuccess_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalUptimeCount_Field) value() interface{} {
+func (f Node_ExitSuccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
 
-type Node_CreatedAt_Field struct {
+type NodeCountry struct {
+	NodeId      []byte
+	CountryCode string
+	UpdatedAt   time.Time
+}
+
+func (NodeCountry) _Table() string { return "node_countries" }
+
+type NodeCountry_Update_Fields struct {
+	CountryCode NodeCountry_CountryCode_Field
+}
+
+type NodeCountry_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func NodeCountry_NodeId(v []byte) NodeCountry_NodeId_Field {
+	return NodeCountry_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f NodeCountry_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeCountry_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_UpdatedAt_Field struct {
+type NodeCountry_CountryCode_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func NodeCountry_CountryCode(v string) NodeCountry_CountryCode_Field {
+	return NodeCountry_CountryCode_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f NodeCountry_CountryCode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (NodeCountry_CountryCode_Field) _Column() string { return "country_code" }
 
-type Node_LastContactSuccess_Field struct {
+type NodeCountry_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
-	return Node_LastContactSuccess_Field{_set: true, _value: v}
+func NodeCountry_UpdatedAt(v time.Time) NodeCountry_UpdatedAt_Field {
+	return NodeCountry_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactSuccess_Field) value() interface{} {
+func (f NodeCountry_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+func (NodeCountry_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node_LastContactFailure_Field struct {
+type NodeTag struct {
+	NodeId []byte
+	Tag    string
+}
+
+func (NodeTag) _Table() string { return "node_tags" }
+
+type NodeTag_Update_Fields struct {
+}
+
+type NodeTag_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
-	return Node_LastContactFailure_Field{_set: true, _value: v}
+func NodeTag_NodeId(v []byte) NodeTag_NodeId_Field {
+	return NodeTag_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactFailure_Field) value() interface{} {
+func (f NodeTag_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+func (NodeTag_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_Contained_Field struct {
+type NodeTag_Tag_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value string
 }
 
-func Node_Contained(v bool) Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _value: v}
+func NodeTag_Tag(v string) NodeTag_Tag_Field {
+	return NodeTag_Tag_Field{_set: true, _value: v}
 }
 
-func (f Node_Contained_Field) value() interface{} {
+func (f NodeTag_Tag_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Contained_Field) _Column() string { return "contained" }
+func (NodeTag_Tag_Field) _Column() string { return "tag" }
 
-type Node_Disqualified_Field struct {
+type NodeThroughput struct {
+	NodeId                 []byte
+	UploadBytesPerSecond   float64
+	UploadCount            int64
+	DownloadBytesPerSecond float64
+	DownloadCount          int64
+	UpdatedAt              time.Time
+}
+
+func (NodeThroughput) _Table() string { return "node_throughputs" }
+
+type NodeThroughput_Update_Fields struct {
+	UploadBytesPerSecond   NodeThroughput_UploadBytesPerSecond_Field
+	UploadCount            NodeThroughput_UploadCount_Field
+	DownloadBytesPerSecond NodeThroughput_DownloadBytesPerSecond_Field
+	DownloadCount          NodeThroughput_DownloadCount_Field
+}
+
+type NodeThroughput_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Node_Disqualified(v time.Time) Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _value: &v}
+func NodeThroughput_NodeId(v []byte) NodeThroughput_NodeId_Field {
+	return NodeThroughput_NodeId_Field{_set: true, _value: v}
 }
 
-func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
-	if v == nil {
-		return Node_Disqualified_Null()
+func (f NodeThroughput_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_Disqualified(*v)
+	return f._value
 }
 
-func Node_Disqualified_Null() Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _null: true}
+func (NodeThroughput_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeThroughput_UploadBytesPerSecond_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func NodeThroughput_UploadBytesPerSecond(v float64) NodeThroughput_UploadBytesPerSecond_Field {
+	return NodeThroughput_UploadBytesPerSecond_Field{_set: true, _value: v}
+}
 
-func (f Node_Disqualified_Field) value() interface{} {
+func (f NodeThroughput_UploadBytesPerSecond_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+func (NodeThroughput_UploadBytesPerSecond_Field) _Column() string { return "upload_bytes_per_second" }
 
-type Node_AuditReputationAlpha_Field struct {
+type NodeThroughput_UploadCount_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
-	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+func NodeThroughput_UploadCount(v int64) NodeThroughput_UploadCount_Field {
+	return NodeThroughput_UploadCount_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditReputationAlpha_Field) value() interface{} {
+func (f NodeThroughput_UploadCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+func (NodeThroughput_UploadCount_Field) _Column() string { return "upload_count" }
 
-type Node_AuditReputationBeta_Field struct {
+type NodeThroughput_DownloadBytesPerSecond_Field struct {
 	_set   bool
 	_null  bool
 	_value float64
 }
 
-func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
-	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+func NodeThroughput_DownloadBytesPerSecond(v float64) NodeThroughput_DownloadBytesPerSecond_Field {
+	return NodeThroughput_DownloadBytesPerSecond_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditReputationBeta_Field) value() interface{} {
+func (f NodeThroughput_DownloadBytesPerSecond_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+func (NodeThroughput_DownloadBytesPerSecond_Field) _Column() string {
+	return "download_bytes_per_second"
+}
 
-type Node_UptimeReputationAlpha_Field struct {
+type NodeThroughput_DownloadCount_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func Node_UptimeReputationAlpha(v float64) Node_UptimeReputationAlpha_Field {
-	return Node_UptimeReputationAlpha_Field{_set: true, _value: v}
+func NodeThroughput_DownloadCount(v int64) NodeThroughput_DownloadCount_Field {
+	return NodeThroughput_DownloadCount_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeReputationAlpha_Field) value() interface{} {
+func (f NodeThroughput_DownloadCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeReputationAlpha_Field) _Column() string { return "uptime_reputation_alpha" }
+func (NodeThroughput_DownloadCount_Field) _Column() string { return "download_count" }
 
-type Node_UptimeReputationBeta_Field struct {
+type NodeThroughput_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func Node_UptimeReputationBeta(v float64) Node_UptimeReputationBeta_Field {
-	return Node_UptimeReputationBeta_Field{_set: true, _value: v}
+func NodeThroughput_UpdatedAt(v time.Time) NodeThroughput_UpdatedAt_Field {
+	return NodeThroughput_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeReputationBeta_Field) value() interface{} {
+func (f NodeThroughput_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeReputationBeta_Field) _Column() string { return "uptime_reputation_beta" }
+func (NodeThroughput_UpdatedAt_Field) _Column() string { return "updated_at" }
 
 type Offer struct {
 	Id                        int
@@ -3123,18 +4509,33 @@ func (f PendingAudits_ReverifyCount_Field) value() interface{} {
 func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
 type Project struct {
-	Id          []byte
-	Name        string
-	Description string
-	UsageLimit  int64
-	CreatedAt   time.Time
+	Id             []byte
+	Name           string
+	Description    string
+	UsageLimit     int64
+	StorageLimit   *int64
+	BandwidthLimit *int64
+	SegmentLimit   *int64
+	BucketLimit    *int64
+	CreatedAt      time.Time
 }
 
 func (Project) _Table() string { return "projects" }
 
+type Project_Create_Fields struct {
+	StorageLimit   Project_StorageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	SegmentLimit   Project_SegmentLimit_Field
+	BucketLimit    Project_BucketLimit_Field
+}
+
 type Project_Update_Fields struct {
-	Description Project_Description_Field
-	UsageLimit  Project_UsageLimit_Field
+	Description    Project_Description_Field
+	UsageLimit     Project_UsageLimit_Field
+	StorageLimit   Project_StorageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	SegmentLimit   Project_SegmentLimit_Field
+	BucketLimit    Project_BucketLimit_Field
 }
 
 type Project_Id_Field struct {
@@ -3213,6 +4614,134 @@ func (f Project_UsageLimit_Field) value() interface{} {
 
 func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
 
+type Project_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Project_StorageLimit(v int64) Project_StorageLimit_Field {
+	return Project_StorageLimit_Field{_set: true, _value: &v}
+}
+
+func Project_StorageLimit_Raw(v *int64) Project_StorageLimit_Field {
+	if v == nil {
+		return Project_StorageLimit_Null()
+	}
+	return Project_StorageLimit(*v)
+}
+
+func Project_StorageLimit_Null() Project_StorageLimit_Field {
+	return Project_StorageLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_StorageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_StorageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_StorageLimit_Field) _Column() string { return "storage_limit" }
+
+type Project_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+}
+
+func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+	if v == nil {
+		return Project_BandwidthLimit_Null()
+	}
+	return Project_BandwidthLimit(*v)
+}
+
+func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BandwidthLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+
+type Project_SegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _value: &v}
+}
+
+func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
+	if v == nil {
+		return Project_SegmentLimit_Null()
+	}
+	return Project_SegmentLimit(*v)
+}
+
+func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_SegmentLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
+
+type Project_BucketLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Project_BucketLimit(v int64) Project_BucketLimit_Field {
+	return Project_BucketLimit_Field{_set: true, _value: &v}
+}
+
+func Project_BucketLimit_Raw(v *int64) Project_BucketLimit_Field {
+	if v == nil {
+		return Project_BucketLimit_Null()
+	}
+	return Project_BucketLimit(*v)
+}
+
+func Project_BucketLimit_Null() Project_BucketLimit_Field {
+	return Project_BucketLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_BucketLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BucketLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_BucketLimit_Field) _Column() string { return "bucket_limit" }
+
 type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
@@ -3716,174 +5245,370 @@ func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
 func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
 
 type User struct {
-	Id           []byte
-	Email        string
-	FullName     string
-	ShortName    *string
-	PasswordHash []byte
-	Status       int
-	CreatedAt    time.Time
+	Id                []byte
+	Email             string
+	FullName          string
+	ShortName         *string
+	PasswordHash      []byte
+	Status            int
+	CreatedAt         time.Time
+	MfaEnabled        bool
+	MfaSecretKey      *string
+	MfaRecoveryCodes  *string
+	MfaLastStep       *int64
+	MfaFailedAttempts *int
+	MfaFailedAt       *time.Time
 }
 
 func (User) _Table() string { return "users" }
 
 type User_Create_Fields struct {
-	ShortName User_ShortName_Field
+	ShortName         User_ShortName_Field
+	MfaSecretKey      User_MfaSecretKey_Field
+	MfaRecoveryCodes  User_MfaRecoveryCodes_Field
+	MfaLastStep       User_MfaLastStep_Field
+	MfaFailedAttempts User_MfaFailedAttempts_Field
+	MfaFailedAt       User_MfaFailedAt_Field
 }
 
 type User_Update_Fields struct {
-	Email        User_Email_Field
-	FullName     User_FullName_Field
-	ShortName    User_ShortName_Field
-	PasswordHash User_PasswordHash_Field
-	Status       User_Status_Field
+	Email             User_Email_Field
+	FullName          User_FullName_Field
+	ShortName         User_ShortName_Field
+	PasswordHash      User_PasswordHash_Field
+	Status            User_Status_Field
+	MfaEnabled        User_MfaEnabled_Field
+	MfaSecretKey      User_MfaSecretKey_Field
+	MfaRecoveryCodes  User_MfaRecoveryCodes_Field
+	MfaLastStep       User_MfaLastStep_Field
+	MfaFailedAttempts User_MfaFailedAttempts_Field
+	MfaFailedAt       User_MfaFailedAt_Field
+}
+
+type User_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_Id(v []byte) User_Id_Field {
+	return User_Id_Field{_set: true, _value: v}
+}
+
+func (f User_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Id_Field) _Column() string { return "id" }
+
+type User_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_Email(v string) User_Email_Field {
+	return User_Email_Field{_set: true, _value: v}
+}
+
+func (f User_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Email_Field) _Column() string { return "email" }
+
+type User_FullName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_FullName(v string) User_FullName_Field {
+	return User_FullName_Field{_set: true, _value: v}
+}
+
+func (f User_FullName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_FullName_Field) _Column() string { return "full_name" }
+
+type User_ShortName_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_ShortName(v string) User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _value: &v}
+}
+
+func User_ShortName_Raw(v *string) User_ShortName_Field {
+	if v == nil {
+		return User_ShortName_Null()
+	}
+	return User_ShortName(*v)
+}
+
+func User_ShortName_Null() User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _null: true}
+}
+
+func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_ShortName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_ShortName_Field) _Column() string { return "short_name" }
+
+type User_PasswordHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_PasswordHash(v []byte) User_PasswordHash_Field {
+	return User_PasswordHash_Field{_set: true, _value: v}
+}
+
+func (f User_PasswordHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+
+type User_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func User_Status(v int) User_Status_Field {
+	return User_Status_Field{_set: true, _value: v}
 }
 
-type User_Id_Field struct {
+func (f User_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Status_Field) _Column() string { return "status" }
+
+type User_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func User_Id(v []byte) User_Id_Field {
-	return User_Id_Field{_set: true, _value: v}
+func User_CreatedAt(v time.Time) User_CreatedAt_Field {
+	return User_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_Id_Field) value() interface{} {
+func (f User_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Id_Field) _Column() string { return "id" }
+func (User_CreatedAt_Field) _Column() string { return "created_at" }
 
-type User_Email_Field struct {
+type User_MfaEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value bool
 }
 
-func User_Email(v string) User_Email_Field {
-	return User_Email_Field{_set: true, _value: v}
+func User_MfaEnabled(v bool) User_MfaEnabled_Field {
+	return User_MfaEnabled_Field{_set: true, _value: v}
 }
 
-func (f User_Email_Field) value() interface{} {
+func (f User_MfaEnabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Email_Field) _Column() string { return "email" }
+func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
 
-type User_FullName_Field struct {
+type User_MfaSecretKey_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *string
 }
 
-func User_FullName(v string) User_FullName_Field {
-	return User_FullName_Field{_set: true, _value: v}
+func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _value: &v}
 }
 
-func (f User_FullName_Field) value() interface{} {
+func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
+	if v == nil {
+		return User_MfaSecretKey_Null()
+	}
+	return User_MfaSecretKey(*v)
+}
+
+func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _null: true}
+}
+
+func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaSecretKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FullName_Field) _Column() string { return "full_name" }
+func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
 
-type User_ShortName_Field struct {
+type User_MfaRecoveryCodes_Field struct {
 	_set   bool
 	_null  bool
 	_value *string
 }
 
-func User_ShortName(v string) User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _value: &v}
+func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
 }
 
-func User_ShortName_Raw(v *string) User_ShortName_Field {
+func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
 	if v == nil {
-		return User_ShortName_Null()
+		return User_MfaRecoveryCodes_Null()
 	}
-	return User_ShortName(*v)
+	return User_MfaRecoveryCodes(*v)
 }
 
-func User_ShortName_Null() User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _null: true}
+func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
 }
 
-func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_ShortName_Field) value() interface{} {
+func (f User_MfaRecoveryCodes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ShortName_Field) _Column() string { return "short_name" }
+func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
 
-type User_PasswordHash_Field struct {
+type User_MfaLastStep_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int64
 }
 
-func User_PasswordHash(v []byte) User_PasswordHash_Field {
-	return User_PasswordHash_Field{_set: true, _value: v}
+func User_MfaLastStep(v int64) User_MfaLastStep_Field {
+	return User_MfaLastStep_Field{_set: true, _value: &v}
 }
 
-func (f User_PasswordHash_Field) value() interface{} {
+func User_MfaLastStep_Raw(v *int64) User_MfaLastStep_Field {
+	if v == nil {
+		return User_MfaLastStep_Null()
+	}
+	return User_MfaLastStep(*v)
+}
+
+func User_MfaLastStep_Null() User_MfaLastStep_Field {
+	return User_MfaLastStep_Field{_set: true, _null: true}
+}
+
+func (f User_MfaLastStep_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaLastStep_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+func (User_MfaLastStep_Field) _Column() string { return "mfa_last_step" }
 
-type User_Status_Field struct {
+type User_MfaFailedAttempts_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func User_Status(v int) User_Status_Field {
-	return User_Status_Field{_set: true, _value: v}
+func User_MfaFailedAttempts(v int) User_MfaFailedAttempts_Field {
+	return User_MfaFailedAttempts_Field{_set: true, _value: &v}
 }
 
-func (f User_Status_Field) value() interface{} {
+func User_MfaFailedAttempts_Raw(v *int) User_MfaFailedAttempts_Field {
+	if v == nil {
+		return User_MfaFailedAttempts_Null()
+	}
+	return User_MfaFailedAttempts(*v)
+}
+
+func User_MfaFailedAttempts_Null() User_MfaFailedAttempts_Field {
+	return User_MfaFailedAttempts_Field{_set: true, _null: true}
+}
+
+func (f User_MfaFailedAttempts_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaFailedAttempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Status_Field) _Column() string { return "status" }
+func (User_MfaFailedAttempts_Field) _Column() string { return "mfa_failed_attempts" }
 
-type User_CreatedAt_Field struct {
+type User_MfaFailedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func User_CreatedAt(v time.Time) User_CreatedAt_Field {
-	return User_CreatedAt_Field{_set: true, _value: v}
+func User_MfaFailedAt(v time.Time) User_MfaFailedAt_Field {
+	return User_MfaFailedAt_Field{_set: true, _value: &v}
 }
 
-func (f User_CreatedAt_Field) value() interface{} {
+func User_MfaFailedAt_Raw(v *time.Time) User_MfaFailedAt_Field {
+	if v == nil {
+		return User_MfaFailedAt_Null()
+	}
+	return User_MfaFailedAt(*v)
+}
+
+func User_MfaFailedAt_Null() User_MfaFailedAt_Field {
+	return User_MfaFailedAt_Field{_set: true, _null: true}
+}
+
+func (f User_MfaFailedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaFailedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CreatedAt_Field) _Column() string { return "created_at" }
+func (User_MfaFailedAt_Field) _Column() string { return "mfa_failed_at" }
 
 type ValueAttribution struct {
 	ProjectId   []byte
@@ -4524,15 +6249,125 @@ func (f ProjectInvoiceStamp_CreatedAt_Field) value() interface{} {
 
 func (ProjectInvoiceStamp_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ProjectInvitation struct {
+	ProjectId []byte
+	MemberId  []byte
+	InviterId []byte
+	Role      int
+	CreatedAt time.Time
+}
+
+func (ProjectInvitation) _Table() string { return "project_invitations" }
+
+type ProjectInvitation_Update_Fields struct {
+}
+
+type ProjectInvitation_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectInvitation_ProjectId(v []byte) ProjectInvitation_ProjectId_Field {
+	return ProjectInvitation_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectInvitation_MemberId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectInvitation_MemberId(v []byte) ProjectInvitation_MemberId_Field {
+	return ProjectInvitation_MemberId_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_MemberId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_MemberId_Field) _Column() string { return "member_id" }
+
+type ProjectInvitation_InviterId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectInvitation_InviterId(v []byte) ProjectInvitation_InviterId_Field {
+	return ProjectInvitation_InviterId_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_InviterId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_InviterId_Field) _Column() string { return "inviter_id" }
+
+type ProjectInvitation_Role_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ProjectInvitation_Role(v int) ProjectInvitation_Role_Field {
+	return ProjectInvitation_Role_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_Role_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_Role_Field) _Column() string { return "role" }
+
+type ProjectInvitation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectInvitation_CreatedAt(v time.Time) ProjectInvitation_CreatedAt_Field {
+	return ProjectInvitation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_CreatedAt_Field) _Column() string { return "created_at" }
+
 type ProjectMember struct {
 	MemberId  []byte
 	ProjectId []byte
 	CreatedAt time.Time
+	Role      int
 }
 
 func (ProjectMember) _Table() string { return "project_members" }
 
 type ProjectMember_Update_Fields struct {
+	Role ProjectMember_Role_Field
 }
 
 type ProjectMember_MemberId_Field struct {
@@ -4592,6 +6427,25 @@ func (f ProjectMember_CreatedAt_Field) value() interface{} {
 
 func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ProjectMember_Role_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ProjectMember_Role(v int) ProjectMember_Role_Field {
+	return ProjectMember_Role_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_Role_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_Role_Field) _Column() string { return "role" }
+
 type UsedSerial struct {
 	SerialNumberId int
 	StorageNodeId  []byte
@@ -5231,6 +7085,58 @@ func (obj *postgresImpl) Create_ValueAttribution(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_project_id.value()
+	__bucket_name_val := bucket_lifecycle_bucket_name.value()
+	__rules_val := bucket_lifecycle_rules.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycles ( project_id, bucket_name, rules, updated_at ) VALUES ( ?, ?, ?, ? ) RETURNING bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __rules_val, __updated_at_val)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __rules_val, __updated_at_val).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketPlacement(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field,
+	bucket_placement_policy BucketPlacement_Policy_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_placement_project_id.value()
+	__bucket_name_val := bucket_placement_bucket_name.value()
+	__policy_val := bucket_placement_policy.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_placements ( project_id, bucket_name, policy, updated_at ) VALUES ( ?, ?, ?, ? ) RETURNING bucket_placements.project_id, bucket_placements.bucket_name, bucket_placements.policy, bucket_placements.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __policy_val, __updated_at_val)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __policy_val, __updated_at_val).Scan(&bucket_placement.ProjectId, &bucket_placement.BucketName, &bucket_placement.Policy, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
 func (obj *postgresImpl) Create_PendingAudits(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field,
 	pending_audits_piece_id PendingAudits_PieceId_Field,
@@ -5369,6 +7275,7 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	node_audit_reputation_beta Node_AuditReputationBeta_Field,
 	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_exit_success Node_ExitSuccess_Field,
 	optional Node_Create_Fields) (
 	node *Node, err error) {
 
@@ -5376,6 +7283,7 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	__id_val := node_id.value()
 	__address_val := node_address.value()
 	__last_net_val := node_last_net.value()
+	__last_ip_address_val := optional.LastIpAddress.value()
 	__protocol_val := node_protocol.value()
 	__type_val := node_type.value()
 	__email_val := node_email.value()
@@ -5403,14 +7311,18 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	__audit_reputation_beta_val := node_audit_reputation_beta.value()
 	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
 	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__exit_initiated_at_val := optional.ExitInitiatedAt.value()
+	__exit_loop_completed_at_val := optional.ExitLoopCompletedAt.value()
+	__exit_finished_at_val := optional.ExitFinishedAt.value()
+	__exit_success_val := node_exit_success.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, last_ip_address, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val)
+	obj.logStmt(__stmt, __id_val, __address_val, __last_net_val, __last_ip_address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+	err = obj.driver.QueryRow(__stmt, __id_val, __address_val, __last_net_val, __last_ip_address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5418,11 +7330,89 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Create_NodeCountry(ctx context.Context,
+	node_country_node_id NodeCountry_NodeId_Field,
+	node_country_country_code NodeCountry_CountryCode_Field) (
+	node_country *NodeCountry, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := node_country_node_id.value()
+	__country_code_val := node_country_country_code.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_countries ( node_id, country_code, updated_at ) VALUES ( ?, ?, ? ) RETURNING node_countries.node_id, node_countries.country_code, node_countries.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __country_code_val, __updated_at_val)
+
+	node_country = &NodeCountry{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __country_code_val, __updated_at_val).Scan(&node_country.NodeId, &node_country.CountryCode, &node_country.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_country, nil
+
+}
+
+func (obj *postgresImpl) Create_NodeTag(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_tag NodeTag_Tag_Field) (
+	node_tag *NodeTag, err error) {
+	__node_id_val := node_tag_node_id.value()
+	__tag_val := node_tag_tag.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_tags ( node_id, tag ) VALUES ( ?, ? ) RETURNING node_tags.node_id, node_tags.tag")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __tag_val)
+
+	node_tag = &NodeTag{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __tag_val).Scan(&node_tag.NodeId, &node_tag.Tag)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_tag, nil
+
+}
+
+func (obj *postgresImpl) Create_AuditLog(ctx context.Context,
+	audit_log_id AuditLog_Id_Field,
+	audit_log_actor_id AuditLog_ActorId_Field,
+	audit_log_action AuditLog_Action_Field,
+	audit_log_target AuditLog_Target_Field,
+	audit_log_ip_address AuditLog_IpAddress_Field,
+	optional AuditLog_Create_Fields) (
+	audit_log *AuditLog, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := audit_log_id.value()
+	__actor_id_val := audit_log_actor_id.value()
+	__project_id_val := optional.ProjectId.value()
+	__action_val := audit_log_action.value()
+	__target_val := audit_log_target.value()
+	__ip_address_val := audit_log_ip_address.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_logs ( id, actor_id, project_id, action, target, ip_address, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING audit_logs.id, audit_logs.actor_id, audit_logs.project_id, audit_logs.action, audit_logs.target, audit_logs.ip_address, audit_logs.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __actor_id_val, __project_id_val, __action_val, __target_val, __ip_address_val, __created_at_val)
+
+	audit_log = &AuditLog{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __actor_id_val, __project_id_val, __action_val, __target_val, __ip_address_val, __created_at_val).Scan(&audit_log.Id, &audit_log.ActorId, &audit_log.ProjectId, &audit_log.Action, &audit_log.Target, &audit_log.IpAddress, &audit_log.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_log, nil
+
+}
+
 func (obj *postgresImpl) Create_User(ctx context.Context,
 	user_id User_Id_Field,
 	user_email User_Email_Field,
 	user_full_name User_FullName_Field,
 	user_password_hash User_PasswordHash_Field,
+	user_mfa_enabled User_MfaEnabled_Field,
 	optional User_Create_Fields) (
 	user *User, err error) {
 
@@ -5434,14 +7424,20 @@ func (obj *postgresImpl) Create_User(ctx context.Context,
 	__password_hash_val := user_password_hash.value()
 	__status_val := int(0)
 	__created_at_val := __now
+	__mfa_enabled_val := user_mfa_enabled.value()
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
+	__mfa_last_step_val := optional.MfaLastStep.value()
+	__mfa_failed_attempts_val := optional.MfaFailedAttempts.value()
+	__mfa_failed_at_val := optional.MfaFailedAt.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, email, full_name, short_name, password_hash, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, email, full_name, short_name, password_hash, status, created_at, mfa_enabled, mfa_secret_key, mfa_recovery_codes, mfa_last_step, mfa_failed_attempts, mfa_failed_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_last_step_val, __mfa_failed_attempts_val, __mfa_failed_at_val)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_last_step_val, __mfa_failed_attempts_val, __mfa_failed_at_val).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5477,7 +7473,8 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
-	project_usage_limit Project_UsageLimit_Field) (
+	project_usage_limit Project_UsageLimit_Field,
+	optional Project_Create_Fields) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -5485,15 +7482,19 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 	__name_val := project_name.value()
 	__description_val := project_description.value()
 	__usage_limit_val := project_usage_limit.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__bucket_limit_val := optional.BucketLimit.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, storage_limit, bandwidth_limit, segment_limit, bucket_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __bucket_limit_val, __created_at_val)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __bucket_limit_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5556,25 +7557,55 @@ func (obj *postgresImpl) Create_ProjectInvoiceStamp(ctx context.Context,
 
 func (obj *postgresImpl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__member_id_val := project_member_member_id.value()
 	__project_id_val := project_member_project_id.value()
 	__created_at_val := __now
+	__role_val := project_member_role.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at, role ) VALUES ( ?, ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val, __role_val)
+
+	project_member = &ProjectMember{}
+	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val, __role_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectInvitation(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field,
+	project_invitation_inviter_id ProjectInvitation_InviterId_Field,
+	project_invitation_role ProjectInvitation_Role_Field) (
+	project_invitation *ProjectInvitation, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_invitation_project_id.value()
+	__member_id_val := project_invitation_member_id.value()
+	__inviter_id_val := project_invitation_inviter_id.value()
+	__role_val := project_invitation_role.value()
+	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_invitations ( project_id, member_id, inviter_id, role, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	obj.logStmt(__stmt, __project_id_val, __member_id_val, __inviter_id_val, __role_val, __created_at_val)
 
-	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	project_invitation = &ProjectInvitation{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __member_id_val, __inviter_id_val, __role_val, __created_at_val).Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return project_member, nil
+	return project_invitation, nil
 
 }
 
@@ -5965,6 +7996,82 @@ func (obj *postgresImpl) Get_ValueAttribution_By_ProjectId_And_BucketName(ctx co
 
 }
 
+func (obj *postgresImpl) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *postgresImpl) All_BucketLifecycle(ctx context.Context) (
+	rows []*BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.updated_at FROM bucket_lifecycles")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_lifecycle := &BucketLifecycle{}
+		err = __rows.Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_lifecycle)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placements.project_id, bucket_placements.bucket_name, bucket_placements.policy, bucket_placements.updated_at FROM bucket_placements WHERE bucket_placements.project_id = ? AND bucket_placements.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_placement_project_id.value(), bucket_placement_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_placement.ProjectId, &bucket_placement.BucketName, &bucket_placement.Policy, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
 func (obj *postgresImpl) Get_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field) (
 	pending_audits *PendingAudits, err error) {
@@ -6046,55 +8153,287 @@ func (obj *postgresImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Con
 	accounting_timestamps_name AccountingTimestamps_Name_Field) (
 	row *Value_Row, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_timestamps_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Value_Row{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *postgresImpl) Get_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	accounting_rollup *AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_rollup = &AccountingRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_rollup, nil
+
+}
+
+func (obj *postgresImpl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_rollup := &AccountingRollup{}
+		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	node *Node, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *postgresImpl) All_Node_Id(ctx context.Context) (
+	rows []*Id_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Id_Row{}
+		err = __rows.Scan(&row.Id)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx context.Context,
+	node_id_greater_or_equal Node_Id_Field,
+	limit int, offset int64) (
+	rows []*Node, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node := &Node{}
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_Node_Id_By_ExitInitiatedAt_IsNot_Null_And_ExitLoopCompletedAt_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context) (
+	rows []*Id_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes WHERE nodes.exit_initiated_at is not NULL AND nodes.exit_loop_completed_at is NULL AND nodes.exit_finished_at is NULL")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Id_Row{}
+		err = __rows.Scan(&row.Id)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_GracefulExitProgress_By_NodeId(ctx context.Context,
+	graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
+	graceful_exit_progress *GracefulExitProgress, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_progress.node_id, graceful_exit_progress.bytes_transferred, graceful_exit_progress.pieces_transferred, graceful_exit_progress.pieces_failed, graceful_exit_progress.updated_at FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_progress_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit_progress = &GracefulExitProgress{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit_progress.NodeId, &graceful_exit_progress.BytesTransferred, &graceful_exit_progress.PiecesTransferred, &graceful_exit_progress.PiecesFailed, &graceful_exit_progress.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit_progress, nil
+
+}
+
+func (obj *postgresImpl) Get_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
+	graceful_exit_transfer_queue *GracefulExitTransferQueue, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_transfer_queue.node_id, graceful_exit_transfer_queue.path, graceful_exit_transfer_queue.piece_num, graceful_exit_transfer_queue.queued_at, graceful_exit_transfer_queue.requested_at, graceful_exit_transfer_queue.last_failed_at, graceful_exit_transfer_queue.failed_count, graceful_exit_transfer_queue.finished_at FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_timestamps_name.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &Value_Row{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	graceful_exit_transfer_queue = &GracefulExitTransferQueue{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit_transfer_queue.NodeId, &graceful_exit_transfer_queue.Path, &graceful_exit_transfer_queue.PieceNum, &graceful_exit_transfer_queue.QueuedAt, &graceful_exit_transfer_queue.RequestedAt, &graceful_exit_transfer_queue.LastFailedAt, &graceful_exit_transfer_queue.FailedCount, &graceful_exit_transfer_queue.FinishedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return row, nil
+	return graceful_exit_transfer_queue, nil
 
 }
 
-func (obj *postgresImpl) Get_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	accounting_rollup *AccountingRollup, err error) {
+func (obj *postgresImpl) Limited_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_Is_Null_OrderBy_Asc_QueuedAt(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	limit int, offset int64) (
+	rows []*GracefulExitTransferQueue, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_transfer_queue.node_id, graceful_exit_transfer_queue.path, graceful_exit_transfer_queue.piece_num, graceful_exit_transfer_queue.queued_at, graceful_exit_transfer_queue.requested_at, graceful_exit_transfer_queue.last_failed_at, graceful_exit_transfer_queue.failed_count, graceful_exit_transfer_queue.finished_at FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.finished_at is NULL ORDER BY graceful_exit_transfer_queue.queued_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	accounting_rollup = &AccountingRollup{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return accounting_rollup, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		graceful_exit_transfer_queue := &GracefulExitTransferQueue{}
+		err = __rows.Scan(&graceful_exit_transfer_queue.NodeId, &graceful_exit_transfer_queue.Path, &graceful_exit_transfer_queue.PieceNum, &graceful_exit_transfer_queue.QueuedAt, &graceful_exit_transfer_queue.RequestedAt, &graceful_exit_transfer_queue.LastFailedAt, &graceful_exit_transfer_queue.FailedCount, &graceful_exit_transfer_queue.FinishedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, graceful_exit_transfer_queue)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
-	rows []*AccountingRollup, err error) {
+func (obj *postgresImpl) All_DamagedPiece(ctx context.Context) (
+	rows []*DamagedPiece, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT damaged_pieces.node_id, damaged_pieces.piece_id, damaged_pieces.reported_at FROM damaged_pieces")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+	__values = append(__values)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6106,12 +8445,12 @@ func (obj *postgresImpl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx co
 	defer __rows.Close()
 
 	for __rows.Next() {
-		accounting_rollup := &AccountingRollup{}
-		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		damaged_piece := &DamagedPiece{}
+		err = __rows.Scan(&damaged_piece.NodeId, &damaged_piece.PieceId, &damaged_piece.ReportedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, accounting_rollup)
+		rows = append(rows, damaged_piece)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -6120,35 +8459,75 @@ func (obj *postgresImpl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx co
 
 }
 
-func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	node *Node, err error) {
+func (obj *postgresImpl) Get_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+	damaged_piece_node_id DamagedPiece_NodeId_Field,
+	damaged_piece_piece_id DamagedPiece_PieceId_Field) (
+	damaged_piece *DamagedPiece, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT damaged_pieces.node_id, damaged_pieces.piece_id, damaged_pieces.reported_at FROM damaged_pieces WHERE damaged_pieces.node_id = ? AND damaged_pieces.piece_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, damaged_piece_node_id.value(), damaged_piece_piece_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+	damaged_piece = &DamagedPiece{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&damaged_piece.NodeId, &damaged_piece.PieceId, &damaged_piece.ReportedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return damaged_piece, nil
 
 }
 
-func (obj *postgresImpl) All_Node_Id(ctx context.Context) (
-	rows []*Id_Row, err error) {
+func (obj *postgresImpl) Count_AuditLog_By_ProjectId(ctx context.Context,
+	audit_log_project_id AuditLog_ProjectId_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
+	var __cond_0 = &__sqlbundle_Condition{Left: "audit_logs.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT COUNT(*) FROM audit_logs WHERE "), __cond_0}}
+
+	var __values []interface{}
+	__values = append(__values)
+
+	if !audit_log_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, audit_log_project_id.value())
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *postgresImpl) Limited_AuditLog_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	audit_log_project_id AuditLog_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*AuditLog, err error) {
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "audit_logs.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor_id, audit_logs.project_id, audit_logs.action, audit_logs.target, audit_logs.ip_address, audit_logs.created_at FROM audit_logs WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY audit_logs.created_at DESC LIMIT ? OFFSET ?")}}
 
 	var __values []interface{}
 	__values = append(__values)
 
+	if !audit_log_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, audit_log_project_id.value())
+	}
+
+	__values = append(__values, limit, offset)
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
@@ -6159,12 +8538,12 @@ func (obj *postgresImpl) All_Node_Id(ctx context.Context) (
 	defer __rows.Close()
 
 	for __rows.Next() {
-		row := &Id_Row{}
-		err = __rows.Scan(&row.Id)
+		audit_log := &AuditLog{}
+		err = __rows.Scan(&audit_log.Id, &audit_log.ActorId, &audit_log.ProjectId, &audit_log.Action, &audit_log.Target, &audit_log.IpAddress, &audit_log.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, row)
+		rows = append(rows, audit_log)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -6173,17 +8552,15 @@ func (obj *postgresImpl) All_Node_Id(ctx context.Context) (
 
 }
 
-func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx context.Context,
-	node_id_greater_or_equal Node_Id_Field,
-	limit int, offset int64) (
-	rows []*Node, err error) {
+func (obj *postgresImpl) All_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+	audit_log_created_at_less AuditLog_CreatedAt_Field) (
+	rows []*AuditLog, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor_id, audit_logs.project_id, audit_logs.action, audit_logs.target, audit_logs.ip_address, audit_logs.created_at FROM audit_logs WHERE audit_logs.created_at >= ? AND audit_logs.created_at < ? ORDER BY audit_logs.created_at")
 
 	var __values []interface{}
-	__values = append(__values, node_id_greater_or_equal.value())
-
-	__values = append(__values, limit, offset)
+	__values = append(__values, audit_log_created_at_greater_or_equal.value(), audit_log_created_at_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6195,12 +8572,12 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 	defer __rows.Close()
 
 	for __rows.Next() {
-		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+		audit_log := &AuditLog{}
+		err = __rows.Scan(&audit_log.Id, &audit_log.ActorId, &audit_log.ProjectId, &audit_log.Action, &audit_log.Target, &audit_log.IpAddress, &audit_log.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, node)
+		rows = append(rows, audit_log)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -6213,7 +8590,7 @@ func (obj *postgresImpl) Get_User_By_Email_And_Status_Not_Number(ctx context.Con
 	user_email User_Email_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_email.value())
@@ -6235,7 +8612,7 @@ func (obj *postgresImpl) Get_User_By_Email_And_Status_Not_Number(ctx context.Con
 	}
 
 	user = &User{}
-	err = __rows.Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = __rows.Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6256,7 +8633,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -6265,7 +8642,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6298,7 +8675,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -6307,7 +8684,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6318,7 +8695,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 func (obj *postgresImpl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -6334,7 +8711,7 @@ func (obj *postgresImpl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6351,7 +8728,7 @@ func (obj *postgresImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 	project_created_at_less Project_CreatedAt_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -6367,7 +8744,7 @@ func (obj *postgresImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6384,7 +8761,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -6400,7 +8777,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6558,7 +8935,7 @@ func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -6574,7 +8951,7 @@ func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6592,7 +8969,7 @@ func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 	limit int, offset int64) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_project_id.value())
@@ -6610,7 +8987,7 @@ func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6623,6 +9000,94 @@ func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Get_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	project_invitation *ProjectInvitation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.member_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value(), project_invitation_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_invitation = &ProjectInvitation{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invitation, nil
+
+}
+
+func (obj *postgresImpl) All_ProjectInvitation_By_MemberId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	rows []*ProjectInvitation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at FROM project_invitations WHERE project_invitations.member_id = ? ORDER BY project_invitations.created_at")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_invitation := &ProjectInvitation{}
+		err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_invitation)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_ProjectInvitation_By_ProjectId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field) (
+	rows []*ProjectInvitation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ? ORDER BY project_invitations.created_at")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_invitation := &ProjectInvitation{}
+		err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_invitation)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	api_key *ApiKey, err error) {
@@ -7471,7 +9936,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -7487,6 +9952,11 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
 	}
 
+	if update.LastIpAddress._set {
+		__values = append(__values, update.LastIpAddress.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_address = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -7562,62 +10032,179 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
 	}
 
-	if update.UptimeSuccessCount._set {
-		__values = append(__values, update.UptimeSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.TotalUptimeCount._set {
+		__values = append(__values, update.TotalUptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_uptime_count = ?"))
+	}
+
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+
+	if update.Contained._set {
+		__values = append(__values, update.Contained.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
+
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
+
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	}
+
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *postgresImpl) Update_NodeCountry_By_NodeId(ctx context.Context,
+	node_country_node_id NodeCountry_NodeId_Field,
+	update NodeCountry_Update_Fields) (
+	node_country *NodeCountry, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_countries SET "), __sets, __sqlbundle_Literal(" WHERE node_countries.node_id = ? RETURNING node_countries.node_id, node_countries.country_code, node_countries.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_country_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_country = &NodeCountry{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_country.NodeId, &node_country.CountryCode, &node_country.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-
-	if update.TotalUptimeCount._set {
-		__values = append(__values, update.TotalUptimeCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_uptime_count = ?"))
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return node_country, nil
+}
 
-	if update.LastContactSuccess._set {
-		__values = append(__values, update.LastContactSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
-	}
+func (obj *postgresImpl) Update_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
+	update GracefulExitTransferQueue_Update_Fields) (
+	graceful_exit_transfer_queue *GracefulExitTransferQueue, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	if update.LastContactFailure._set {
-		__values = append(__values, update.LastContactFailure.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE graceful_exit_transfer_queue SET "), __sets, __sqlbundle_Literal(" WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ? RETURNING graceful_exit_transfer_queue.node_id, graceful_exit_transfer_queue.path, graceful_exit_transfer_queue.piece_num, graceful_exit_transfer_queue.queued_at, graceful_exit_transfer_queue.requested_at, graceful_exit_transfer_queue.last_failed_at, graceful_exit_transfer_queue.failed_count, graceful_exit_transfer_queue.finished_at")}}
 
-	if update.Contained._set {
-		__values = append(__values, update.Contained.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
-	}
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	if update.RequestedAt._set {
+		__values = append(__values, update.RequestedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("requested_at = ?"))
 	}
 
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	if update.LastFailedAt._set {
+		__values = append(__values, update.LastFailedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_failed_at = ?"))
 	}
 
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	if update.FailedCount._set {
+		__values = append(__values, update.FailedCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("failed_count = ?"))
 	}
 
-	if update.UptimeReputationAlpha._set {
-		__values = append(__values, update.UptimeReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	if update.FinishedAt._set {
+		__values = append(__values, update.FinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("finished_at = ?"))
 	}
 
-	if update.UptimeReputationBeta._set {
-		__values = append(__values, update.UptimeReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
-
-	__args = append(__args, node_id.value())
+	__args = append(__args, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -7625,15 +10212,15 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+	graceful_exit_transfer_queue = &GracefulExitTransferQueue{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit_transfer_queue.NodeId, &graceful_exit_transfer_queue.Path, &graceful_exit_transfer_queue.PieceNum, &graceful_exit_transfer_queue.QueuedAt, &graceful_exit_transfer_queue.RequestedAt, &graceful_exit_transfer_queue.LastFailedAt, &graceful_exit_transfer_queue.FailedCount, &graceful_exit_transfer_queue.FinishedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return graceful_exit_transfer_queue, nil
 }
 
 func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
@@ -7642,7 +10229,7 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -7673,6 +10260,36 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
+	if update.MfaLastStep._set {
+		__values = append(__values, update.MfaLastStep.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_step = ?"))
+	}
+
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
+	}
+
+	if update.MfaFailedAt._set {
+		__values = append(__values, update.MfaFailedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_at = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -7686,7 +10303,7 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -7702,7 +10319,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	project *Project, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -7718,6 +10335,26 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("usage_limit = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
+	if update.BucketLimit._set {
+		__values = append(__values, update.BucketLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bucket_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -7731,7 +10368,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -7741,6 +10378,47 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *postgresImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ? RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
 func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	update ApiKey_Update_Fields) (
@@ -7974,40 +10652,199 @@ func (obj *postgresImpl) Delete_ValueAttribution_By_ProjectId_And_BucketName(ctx
 
 }
 
-func (obj *postgresImpl) Delete_PendingAudits_By_NodeId(ctx context.Context,
-	pending_audits_node_id PendingAudits_NodeId_Field) (
-	deleted bool, err error) {
+func (obj *postgresImpl) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_placements WHERE bucket_placements.project_id = ? AND bucket_placements.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_placement_project_id.value(), bucket_placement_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audits_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_segmentpath.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_NodeTag_By_NodeId(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_tags WHERE node_tags.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, pending_audits_node_id.value())
+	__values = append(__values, node_tag_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ?")
 
 	var __values []interface{}
-	__values = append(__values, irreparabledb_segmentpath.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -8026,40 +10863,41 @@ func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context
 
 }
 
-func (obj *postgresImpl) Delete_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	deleted bool, err error) {
+func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *postgresImpl) Delete_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
+func (obj *postgresImpl) Delete_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+	damaged_piece_node_id DamagedPiece_NodeId_Field,
+	damaged_piece_piece_id DamagedPiece_PieceId_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM damaged_pieces WHERE damaged_pieces.node_id = ? AND damaged_pieces.piece_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, damaged_piece_node_id.value(), damaged_piece_piece_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -8157,6 +10995,33 @@ func (obj *postgresImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx cont
 
 }
 
+func (obj *postgresImpl) Delete_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.member_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value(), project_invitation_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *postgresImpl) Delete_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	deleted bool, err error) {
@@ -8398,6 +11263,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_invitations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8528,6 +11403,36 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_throughputs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_countries;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8558,6 +11463,36 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exit_transfer_queue;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exit_progress;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM damaged_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8588,6 +11523,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_placements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_lifecycles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8598,6 +11553,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8657,6 +11622,64 @@ func (obj *sqlite3Impl) Create_ValueAttribution(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_project_id.value()
+	__bucket_name_val := bucket_lifecycle_bucket_name.value()
+	__rules_val := bucket_lifecycle_rules.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycles ( project_id, bucket_name, rules, updated_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __rules_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __rules_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketLifecycle(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketPlacement(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field,
+	bucket_placement_policy BucketPlacement_Policy_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_placement_project_id.value()
+	__bucket_name_val := bucket_placement_bucket_name.value()
+	__policy_val := bucket_placement_policy.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_placements ( project_id, bucket_name, policy, updated_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __policy_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __policy_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketPlacement(ctx, __pk)
+
+}
+
 func (obj *sqlite3Impl) Create_PendingAudits(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field,
 	pending_audits_piece_id PendingAudits_PieceId_Field,
@@ -8807,6 +11830,7 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	node_audit_reputation_beta Node_AuditReputationBeta_Field,
 	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_exit_success Node_ExitSuccess_Field,
 	optional Node_Create_Fields) (
 	node *Node, err error) {
 
@@ -8814,6 +11838,7 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	__id_val := node_id.value()
 	__address_val := node_address.value()
 	__last_net_val := node_last_net.value()
+	__last_ip_address_val := optional.LastIpAddress.value()
 	__protocol_val := node_protocol.value()
 	__type_val := node_type.value()
 	__email_val := node_email.value()
@@ -8841,13 +11866,17 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	__audit_reputation_beta_val := node_audit_reputation_beta.value()
 	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
 	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__exit_initiated_at_val := optional.ExitInitiatedAt.value()
+	__exit_loop_completed_at_val := optional.ExitLoopCompletedAt.value()
+	__exit_finished_at_val := optional.ExitFinishedAt.value()
+	__exit_success_val := node_exit_success.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, last_ip_address, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val)
+	obj.logStmt(__stmt, __id_val, __address_val, __last_net_val, __last_ip_address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __address_val, __last_net_val, __last_ip_address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8859,11 +11888,98 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Create_NodeCountry(ctx context.Context,
+	node_country_node_id NodeCountry_NodeId_Field,
+	node_country_country_code NodeCountry_CountryCode_Field) (
+	node_country *NodeCountry, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := node_country_node_id.value()
+	__country_code_val := node_country_country_code.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_countries ( node_id, country_code, updated_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __country_code_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __country_code_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNodeCountry(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_NodeTag(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_tag NodeTag_Tag_Field) (
+	node_tag *NodeTag, err error) {
+	__node_id_val := node_tag_node_id.value()
+	__tag_val := node_tag_tag.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_tags ( node_id, tag ) VALUES ( ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __tag_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __tag_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNodeTag(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AuditLog(ctx context.Context,
+	audit_log_id AuditLog_Id_Field,
+	audit_log_actor_id AuditLog_ActorId_Field,
+	audit_log_action AuditLog_Action_Field,
+	audit_log_target AuditLog_Target_Field,
+	audit_log_ip_address AuditLog_IpAddress_Field,
+	optional AuditLog_Create_Fields) (
+	audit_log *AuditLog, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := audit_log_id.value()
+	__actor_id_val := audit_log_actor_id.value()
+	__project_id_val := optional.ProjectId.value()
+	__action_val := audit_log_action.value()
+	__target_val := audit_log_target.value()
+	__ip_address_val := audit_log_ip_address.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_logs ( id, actor_id, project_id, action, target, ip_address, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __actor_id_val, __project_id_val, __action_val, __target_val, __ip_address_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __actor_id_val, __project_id_val, __action_val, __target_val, __ip_address_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAuditLog(ctx, __pk)
+
+}
+
 func (obj *sqlite3Impl) Create_User(ctx context.Context,
 	user_id User_Id_Field,
 	user_email User_Email_Field,
 	user_full_name User_FullName_Field,
 	user_password_hash User_PasswordHash_Field,
+	user_mfa_enabled User_MfaEnabled_Field,
 	optional User_Create_Fields) (
 	user *User, err error) {
 
@@ -8875,13 +11991,19 @@ func (obj *sqlite3Impl) Create_User(ctx context.Context,
 	__password_hash_val := user_password_hash.value()
 	__status_val := int(0)
 	__created_at_val := __now
+	__mfa_enabled_val := user_mfa_enabled.value()
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
+	__mfa_last_step_val := optional.MfaLastStep.value()
+	__mfa_failed_attempts_val := optional.MfaFailedAttempts.value()
+	__mfa_failed_at_val := optional.MfaFailedAt.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, email, full_name, short_name, password_hash, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, email, full_name, short_name, password_hash, status, created_at, mfa_enabled, mfa_secret_key, mfa_recovery_codes, mfa_last_step, mfa_failed_attempts, mfa_failed_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_last_step_val, __mfa_failed_attempts_val, __mfa_failed_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __created_at_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_last_step_val, __mfa_failed_attempts_val, __mfa_failed_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8924,7 +12046,8 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
-	project_usage_limit Project_UsageLimit_Field) (
+	project_usage_limit Project_UsageLimit_Field,
+	optional Project_Create_Fields) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -8932,14 +12055,18 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	__name_val := project_name.value()
 	__description_val := project_description.value()
 	__usage_limit_val := project_usage_limit.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__bucket_limit_val := optional.BucketLimit.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, storage_limit, bandwidth_limit, segment_limit, bucket_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __bucket_limit_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __bucket_limit_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8996,9 +12123,38 @@ func (obj *sqlite3Impl) Create_ProjectInvoiceStamp(ctx context.Context,
 	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_invoice_stamps ( project_id, invoice_id, start_date, end_date, created_at ) VALUES ( ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __project_id_val, __invoice_id_val, __start_date_val, __end_date_val, __created_at_val)
+	obj.logStmt(__stmt, __project_id_val, __invoice_id_val, __start_date_val, __end_date_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __invoice_id_val, __start_date_val, __end_date_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectInvoiceStamp(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
+	project_member *ProjectMember, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__member_id_val := project_member_member_id.value()
+	__project_id_val := project_member_project_id.value()
+	__created_at_val := __now
+	__role_val := project_member_role.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at, role ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val, __role_val)
 
-	__res, err := obj.driver.Exec(__stmt, __project_id_val, __invoice_id_val, __start_date_val, __end_date_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __created_at_val, __role_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9006,26 +12162,30 @@ func (obj *sqlite3Impl) Create_ProjectInvoiceStamp(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastProjectInvoiceStamp(ctx, __pk)
+	return obj.getLastProjectMember(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
-	project_member *ProjectMember, err error) {
+func (obj *sqlite3Impl) Create_ProjectInvitation(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field,
+	project_invitation_inviter_id ProjectInvitation_InviterId_Field,
+	project_invitation_role ProjectInvitation_Role_Field) (
+	project_invitation *ProjectInvitation, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__member_id_val := project_member_member_id.value()
-	__project_id_val := project_member_project_id.value()
+	__project_id_val := project_invitation_project_id.value()
+	__member_id_val := project_invitation_member_id.value()
+	__inviter_id_val := project_invitation_inviter_id.value()
+	__role_val := project_invitation_role.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_invitations ( project_id, member_id, inviter_id, role, created_at ) VALUES ( ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	obj.logStmt(__stmt, __project_id_val, __member_id_val, __inviter_id_val, __role_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __member_id_val, __inviter_id_val, __role_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9033,7 +12193,7 @@ func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastProjectMember(ctx, __pk)
+	return obj.getLastProjectInvitation(ctx, __pk)
 
 }
 
@@ -9460,6 +12620,82 @@ func (obj *sqlite3Impl) Get_ValueAttribution_By_ProjectId_And_BucketName(ctx con
 
 }
 
+func (obj *sqlite3Impl) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *sqlite3Impl) All_BucketLifecycle(ctx context.Context) (
+	rows []*BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.updated_at FROM bucket_lifecycles")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_lifecycle := &BucketLifecycle{}
+		err = __rows.Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_lifecycle)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placements.project_id, bucket_placements.bucket_name, bucket_placements.policy, bucket_placements.updated_at FROM bucket_placements WHERE bucket_placements.project_id = ? AND bucket_placements.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_placement_project_id.value(), bucket_placement_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_placement.ProjectId, &bucket_placement.BucketName, &bucket_placement.Policy, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
 func (obj *sqlite3Impl) Get_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field) (
 	pending_audits *PendingAudits, err error) {
@@ -9557,39 +12793,271 @@ func (obj *sqlite3Impl) Find_AccountingTimestamps_Value_By_Name(ctx context.Cont
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return row, nil
+	return row, nil
+
+}
+
+func (obj *sqlite3Impl) Get_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	accounting_rollup *AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_rollup = &AccountingRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_rollup, nil
+
+}
+
+func (obj *sqlite3Impl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_rollup := &AccountingRollup{}
+		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	node *Node, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *sqlite3Impl) All_Node_Id(ctx context.Context) (
+	rows []*Id_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Id_Row{}
+		err = __rows.Scan(&row.Id)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx context.Context,
+	node_id_greater_or_equal Node_Id_Field,
+	limit int, offset int64) (
+	rows []*Node, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node := &Node{}
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Node_Id_By_ExitInitiatedAt_IsNot_Null_And_ExitLoopCompletedAt_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context) (
+	rows []*Id_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes WHERE nodes.exit_initiated_at is not NULL AND nodes.exit_loop_completed_at is NULL AND nodes.exit_finished_at is NULL")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Id_Row{}
+		err = __rows.Scan(&row.Id)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_GracefulExitProgress_By_NodeId(ctx context.Context,
+	graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
+	graceful_exit_progress *GracefulExitProgress, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_progress.node_id, graceful_exit_progress.bytes_transferred, graceful_exit_progress.pieces_transferred, graceful_exit_progress.pieces_failed, graceful_exit_progress.updated_at FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_progress_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit_progress = &GracefulExitProgress{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit_progress.NodeId, &graceful_exit_progress.BytesTransferred, &graceful_exit_progress.PiecesTransferred, &graceful_exit_progress.PiecesFailed, &graceful_exit_progress.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit_progress, nil
+
+}
+
+func (obj *sqlite3Impl) Get_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
+	graceful_exit_transfer_queue *GracefulExitTransferQueue, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_transfer_queue.node_id, graceful_exit_transfer_queue.path, graceful_exit_transfer_queue.piece_num, graceful_exit_transfer_queue.queued_at, graceful_exit_transfer_queue.requested_at, graceful_exit_transfer_queue.last_failed_at, graceful_exit_transfer_queue.failed_count, graceful_exit_transfer_queue.finished_at FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit_transfer_queue = &GracefulExitTransferQueue{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit_transfer_queue.NodeId, &graceful_exit_transfer_queue.Path, &graceful_exit_transfer_queue.PieceNum, &graceful_exit_transfer_queue.QueuedAt, &graceful_exit_transfer_queue.RequestedAt, &graceful_exit_transfer_queue.LastFailedAt, &graceful_exit_transfer_queue.FailedCount, &graceful_exit_transfer_queue.FinishedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit_transfer_queue, nil
 
 }
 
-func (obj *sqlite3Impl) Get_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	accounting_rollup *AccountingRollup, err error) {
+func (obj *sqlite3Impl) Limited_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_Is_Null_OrderBy_Asc_QueuedAt(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	limit int, offset int64) (
+	rows []*GracefulExitTransferQueue, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_transfer_queue.node_id, graceful_exit_transfer_queue.path, graceful_exit_transfer_queue.piece_num, graceful_exit_transfer_queue.queued_at, graceful_exit_transfer_queue.requested_at, graceful_exit_transfer_queue.last_failed_at, graceful_exit_transfer_queue.failed_count, graceful_exit_transfer_queue.finished_at FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.finished_at is NULL ORDER BY graceful_exit_transfer_queue.queued_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	accounting_rollup = &AccountingRollup{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return accounting_rollup, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		graceful_exit_transfer_queue := &GracefulExitTransferQueue{}
+		err = __rows.Scan(&graceful_exit_transfer_queue.NodeId, &graceful_exit_transfer_queue.Path, &graceful_exit_transfer_queue.PieceNum, &graceful_exit_transfer_queue.QueuedAt, &graceful_exit_transfer_queue.RequestedAt, &graceful_exit_transfer_queue.LastFailedAt, &graceful_exit_transfer_queue.FailedCount, &graceful_exit_transfer_queue.FinishedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, graceful_exit_transfer_queue)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
-	rows []*AccountingRollup, err error) {
+func (obj *sqlite3Impl) All_DamagedPiece(ctx context.Context) (
+	rows []*DamagedPiece, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT damaged_pieces.node_id, damaged_pieces.piece_id, damaged_pieces.reported_at FROM damaged_pieces")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+	__values = append(__values)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -9601,12 +13069,12 @@ func (obj *sqlite3Impl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx con
 	defer __rows.Close()
 
 	for __rows.Next() {
-		accounting_rollup := &AccountingRollup{}
-		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		damaged_piece := &DamagedPiece{}
+		err = __rows.Scan(&damaged_piece.NodeId, &damaged_piece.PieceId, &damaged_piece.ReportedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, accounting_rollup)
+		rows = append(rows, damaged_piece)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -9615,35 +13083,75 @@ func (obj *sqlite3Impl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx con
 
 }
 
-func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) Get_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+	damaged_piece_node_id DamagedPiece_NodeId_Field,
+	damaged_piece_piece_id DamagedPiece_PieceId_Field) (
+	damaged_piece *DamagedPiece, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT damaged_pieces.node_id, damaged_pieces.piece_id, damaged_pieces.reported_at FROM damaged_pieces WHERE damaged_pieces.node_id = ? AND damaged_pieces.piece_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, damaged_piece_node_id.value(), damaged_piece_piece_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+	damaged_piece = &DamagedPiece{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&damaged_piece.NodeId, &damaged_piece.PieceId, &damaged_piece.ReportedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return damaged_piece, nil
 
 }
 
-func (obj *sqlite3Impl) All_Node_Id(ctx context.Context) (
-	rows []*Id_Row, err error) {
+func (obj *sqlite3Impl) Count_AuditLog_By_ProjectId(ctx context.Context,
+	audit_log_project_id AuditLog_ProjectId_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
+	var __cond_0 = &__sqlbundle_Condition{Left: "audit_logs.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT COUNT(*) FROM audit_logs WHERE "), __cond_0}}
+
+	var __values []interface{}
+	__values = append(__values)
+
+	if !audit_log_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, audit_log_project_id.value())
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_AuditLog_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	audit_log_project_id AuditLog_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*AuditLog, err error) {
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "audit_logs.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor_id, audit_logs.project_id, audit_logs.action, audit_logs.target, audit_logs.ip_address, audit_logs.created_at FROM audit_logs WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY audit_logs.created_at DESC LIMIT ? OFFSET ?")}}
 
 	var __values []interface{}
 	__values = append(__values)
 
+	if !audit_log_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, audit_log_project_id.value())
+	}
+
+	__values = append(__values, limit, offset)
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
@@ -9654,12 +13162,12 @@ func (obj *sqlite3Impl) All_Node_Id(ctx context.Context) (
 	defer __rows.Close()
 
 	for __rows.Next() {
-		row := &Id_Row{}
-		err = __rows.Scan(&row.Id)
+		audit_log := &AuditLog{}
+		err = __rows.Scan(&audit_log.Id, &audit_log.ActorId, &audit_log.ProjectId, &audit_log.Action, &audit_log.Target, &audit_log.IpAddress, &audit_log.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, row)
+		rows = append(rows, audit_log)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -9668,17 +13176,15 @@ func (obj *sqlite3Impl) All_Node_Id(ctx context.Context) (
 
 }
 
-func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx context.Context,
-	node_id_greater_or_equal Node_Id_Field,
-	limit int, offset int64) (
-	rows []*Node, err error) {
+func (obj *sqlite3Impl) All_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+	audit_log_created_at_less AuditLog_CreatedAt_Field) (
+	rows []*AuditLog, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor_id, audit_logs.project_id, audit_logs.action, audit_logs.target, audit_logs.ip_address, audit_logs.created_at FROM audit_logs WHERE audit_logs.created_at >= ? AND audit_logs.created_at < ? ORDER BY audit_logs.created_at")
 
 	var __values []interface{}
-	__values = append(__values, node_id_greater_or_equal.value())
-
-	__values = append(__values, limit, offset)
+	__values = append(__values, audit_log_created_at_greater_or_equal.value(), audit_log_created_at_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -9690,12 +13196,12 @@ func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx con
 	defer __rows.Close()
 
 	for __rows.Next() {
-		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+		audit_log := &AuditLog{}
+		err = __rows.Scan(&audit_log.Id, &audit_log.ActorId, &audit_log.ProjectId, &audit_log.Action, &audit_log.Target, &audit_log.IpAddress, &audit_log.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, node)
+		rows = append(rows, audit_log)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -9708,7 +13214,7 @@ func (obj *sqlite3Impl) Get_User_By_Email_And_Status_Not_Number(ctx context.Cont
 	user_email User_Email_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_email.value())
@@ -9730,7 +13236,7 @@ func (obj *sqlite3Impl) Get_User_By_Email_And_Status_Not_Number(ctx context.Cont
 	}
 
 	user = &User{}
-	err = __rows.Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = __rows.Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9751,7 +13257,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -9760,7 +13266,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9793,7 +13299,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -9802,7 +13308,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9813,7 +13319,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -9829,7 +13335,7 @@ func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -9846,7 +13352,7 @@ func (obj *sqlite3Impl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 	project_created_at_less Project_CreatedAt_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -9862,7 +13368,7 @@ func (obj *sqlite3Impl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -9879,7 +13385,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -9895,7 +13401,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -10053,7 +13559,7 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -10069,7 +13575,7 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -10087,7 +13593,7 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 	limit int, offset int64) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_project_id.value())
@@ -10105,7 +13611,7 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -10118,6 +13624,94 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Get_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	project_invitation *ProjectInvitation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.member_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value(), project_invitation_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_invitation = &ProjectInvitation{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invitation, nil
+
+}
+
+func (obj *sqlite3Impl) All_ProjectInvitation_By_MemberId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	rows []*ProjectInvitation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at FROM project_invitations WHERE project_invitations.member_id = ? ORDER BY project_invitations.created_at")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_invitation := &ProjectInvitation{}
+		err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_invitation)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_ProjectInvitation_By_ProjectId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field) (
+	rows []*ProjectInvitation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ? ORDER BY project_invitations.created_at")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_invitation := &ProjectInvitation{}
+		err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_invitation)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	api_key *ApiKey, err error) {
@@ -11012,6 +14606,11 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
 	}
 
+	if update.LastIpAddress._set {
+		__values = append(__values, update.LastIpAddress.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_address = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -11107,42 +14706,179 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
 	}
 
-	if update.Contained._set {
-		__values = append(__values, update.Contained.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
-	}
+	if update.Contained._set {
+		__values = append(__values, update.Contained.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
+
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
+
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	}
+
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *sqlite3Impl) Update_NodeCountry_By_NodeId(ctx context.Context,
+	node_country_node_id NodeCountry_NodeId_Field,
+	update NodeCountry_Update_Fields) (
+	node_country *NodeCountry, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_countries SET "), __sets, __sqlbundle_Literal(" WHERE node_countries.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_country_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_country = &NodeCountry{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT node_countries.node_id, node_countries.country_code, node_countries.updated_at FROM node_countries WHERE node_countries.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node_country.NodeId, &node_country.CountryCode, &node_country.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_country, nil
+}
+
+func (obj *sqlite3Impl) Update_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
+	update GracefulExitTransferQueue_Update_Fields) (
+	graceful_exit_transfer_queue *GracefulExitTransferQueue, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE graceful_exit_transfer_queue SET "), __sets, __sqlbundle_Literal(" WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ?")}}
 
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
-	}
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	if update.RequestedAt._set {
+		__values = append(__values, update.RequestedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("requested_at = ?"))
 	}
 
-	if update.UptimeReputationAlpha._set {
-		__values = append(__values, update.UptimeReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	if update.LastFailedAt._set {
+		__values = append(__values, update.LastFailedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_failed_at = ?"))
 	}
 
-	if update.UptimeReputationBeta._set {
-		__values = append(__values, update.UptimeReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	if update.FailedCount._set {
+		__values = append(__values, update.FailedCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("failed_count = ?"))
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
+	if update.FinishedAt._set {
+		__values = append(__values, update.FinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("finished_at = ?"))
+	}
 
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
 
-	__args = append(__args, node_id.value())
+	__args = append(__args, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -11150,25 +14886,25 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
+	graceful_exit_transfer_queue = &GracefulExitTransferQueue{}
 	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT graceful_exit_transfer_queue.node_id, graceful_exit_transfer_queue.path, graceful_exit_transfer_queue.piece_num, graceful_exit_transfer_queue.queued_at, graceful_exit_transfer_queue.requested_at, graceful_exit_transfer_queue.last_failed_at, graceful_exit_transfer_queue.failed_count, graceful_exit_transfer_queue.finished_at FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&graceful_exit_transfer_queue.NodeId, &graceful_exit_transfer_queue.Path, &graceful_exit_transfer_queue.PieceNum, &graceful_exit_transfer_queue.QueuedAt, &graceful_exit_transfer_queue.RequestedAt, &graceful_exit_transfer_queue.LastFailedAt, &graceful_exit_transfer_queue.FailedCount, &graceful_exit_transfer_queue.FinishedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return graceful_exit_transfer_queue, nil
 }
 
 func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
@@ -11208,6 +14944,36 @@ func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
+	if update.MfaLastStep._set {
+		__values = append(__values, update.MfaLastStep.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_step = ?"))
+	}
+
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
+	}
+
+	if update.MfaFailedAt._set {
+		__values = append(__values, update.MfaFailedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_at = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -11226,12 +14992,12 @@ func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at FROM users WHERE users.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -11263,6 +15029,26 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("usage_limit = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
+	if update.BucketLimit._set {
+		__values = append(__values, update.BucketLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bucket_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -11281,12 +15067,12 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -11296,6 +15082,57 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *sqlite3Impl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
 func (obj *sqlite3Impl) Update_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	update ApiKey_Update_Fields) (
@@ -11513,44 +15350,202 @@ func (obj *sqlite3Impl) Update_Offer_By_Id(ctx context.Context,
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, offer_id.value())
+	__args = append(__args, offer_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	offer = &Offer{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.award_credit_in_cents, offers.invitee_credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status, offers.type FROM offers WHERE offers.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.AwardCreditInCents, &offer.InviteeCreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status, &offer.Type)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return offer, nil
+}
+
+func (obj *sqlite3Impl) Delete_ValueAttribution_By_ProjectId_And_BucketName(ctx context.Context,
+	value_attribution_project_id ValueAttribution_ProjectId_Field,
+	value_attribution_bucket_name ValueAttribution_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM value_attributions WHERE value_attributions.project_id = ? AND value_attributions.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, value_attribution_project_id.value(), value_attribution_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_placements WHERE bucket_placements.project_id = ? AND bucket_placements.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_placement_project_id.value(), bucket_placement_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audits_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_segmentpath.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	offer = &Offer{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.award_credit_in_cents, offers.invitee_credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status, offers.type FROM offers WHERE offers.id = ?")
-
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
-
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.AwardCreditInCents, &offer.InviteeCreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status, &offer.Type)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	__count, err := __res.RowsAffected()
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	return offer, nil
+
+	return __count > 0, nil
+
 }
 
-func (obj *sqlite3Impl) Delete_ValueAttribution_By_ProjectId_And_BucketName(ctx context.Context,
-	value_attribution_project_id ValueAttribution_ProjectId_Field,
-	value_attribution_bucket_name ValueAttribution_BucketName_Field) (
+func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM value_attributions WHERE value_attributions.project_id = ? AND value_attributions.bucket_name = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, value_attribution_project_id.value(), value_attribution_bucket_name.value())
+	__values = append(__values, node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -11569,40 +15564,41 @@ func (obj *sqlite3Impl) Delete_ValueAttribution_By_ProjectId_And_BucketName(ctx
 
 }
 
-func (obj *sqlite3Impl) Delete_PendingAudits_By_NodeId(ctx context.Context,
-	pending_audits_node_id PendingAudits_NodeId_Field) (
-	deleted bool, err error) {
+func (obj *sqlite3Impl) Delete_NodeTag_By_NodeId(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_tags WHERE node_tags.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, pending_audits_node_id.value())
+	__values = append(__values, node_tag_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+func (obj *sqlite3Impl) Delete_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ?")
 
 	var __values []interface{}
-	__values = append(__values, irreparabledb_segmentpath.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -11621,40 +15617,41 @@ func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	deleted bool, err error) {
+func (obj *sqlite3Impl) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
+func (obj *sqlite3Impl) Delete_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+	damaged_piece_node_id DamagedPiece_NodeId_Field,
+	damaged_piece_piece_id DamagedPiece_PieceId_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM damaged_pieces WHERE damaged_pieces.node_id = ? AND damaged_pieces.piece_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, damaged_piece_node_id.value(), damaged_piece_piece_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -11752,6 +15749,33 @@ func (obj *sqlite3Impl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx conte
 
 }
 
+func (obj *sqlite3Impl) Delete_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.member_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value(), project_invitation_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *sqlite3Impl) Delete_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	deleted bool, err error) {
@@ -11953,6 +15977,42 @@ func (obj *sqlite3Impl) getLastValueAttribution(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) getLastBucketLifecycle(ctx context.Context,
+	pk int64) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *sqlite3Impl) getLastBucketPlacement(ctx context.Context,
+	pk int64) (
+	bucket_placement *BucketPlacement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placements.project_id, bucket_placements.bucket_name, bucket_placements.policy, bucket_placements.updated_at FROM bucket_placements WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_placement.ProjectId, &bucket_placement.BucketName, &bucket_placement.Policy, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
 func (obj *sqlite3Impl) getLastPendingAudits(ctx context.Context,
 	pk int64) (
 	pending_audits *PendingAudits, err error) {
@@ -12029,13 +16089,13 @@ func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 	pk int64) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta FROM nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpAddress, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -12043,17 +16103,71 @@ func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) getLastNodeCountry(ctx context.Context,
+	pk int64) (
+	node_country *NodeCountry, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_countries.node_id, node_countries.country_code, node_countries.updated_at FROM node_countries WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	node_country = &NodeCountry{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node_country.NodeId, &node_country.CountryCode, &node_country.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_country, nil
+
+}
+
+func (obj *sqlite3Impl) getLastNodeTag(ctx context.Context,
+	pk int64) (
+	node_tag *NodeTag, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_tags.node_id, node_tags.tag FROM node_tags WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	node_tag = &NodeTag{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node_tag.NodeId, &node_tag.Tag)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_tag, nil
+
+}
+
+func (obj *sqlite3Impl) getLastAuditLog(ctx context.Context,
+	pk int64) (
+	audit_log *AuditLog, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor_id, audit_logs.project_id, audit_logs.action, audit_logs.target, audit_logs.ip_address, audit_logs.created_at FROM audit_logs WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	audit_log = &AuditLog{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&audit_log.Id, &audit_log.ActorId, &audit_log.ProjectId, &audit_log.Action, &audit_log.Target, &audit_log.IpAddress, &audit_log.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_log, nil
+
+}
+
 func (obj *sqlite3Impl) getLastUser(ctx context.Context,
 	pk int64) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at FROM users WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.full_name, users.short_name, users.password_hash, users.status, users.created_at, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_step, users.mfa_failed_attempts, users.mfa_failed_at FROM users WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.Email, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.CreatedAt, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastStep, &user.MfaFailedAttempts, &user.MfaFailedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -12083,13 +16197,13 @@ func (obj *sqlite3Impl) getLastProject(ctx context.Context,
 	pk int64) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.created_at FROM projects WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.storage_limit, projects.bandwidth_limit, projects.segment_limit, projects.bucket_limit, projects.created_at FROM projects WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.StorageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.BucketLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -12137,13 +16251,13 @@ func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
 	pk int64) (
 	project_member *ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -12151,6 +16265,24 @@ func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) getLastProjectInvitation(ctx context.Context,
+	pk int64) (
+	project_invitation *ProjectInvitation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.member_id, project_invitations.inviter_id, project_invitations.role, project_invitations.created_at FROM project_invitations WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_invitation = &ProjectInvitation{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_invitation.ProjectId, &project_invitation.MemberId, &project_invitation.InviterId, &project_invitation.Role, &project_invitation.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invitation, nil
+
+}
+
 func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
 	pk int64) (
 	api_key *ApiKey, err error) {
@@ -12430,6 +16562,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_invitations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -12560,6 +16702,36 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_throughputs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_countries;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -12590,6 +16762,36 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exit_transfer_queue;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exit_progress;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM damaged_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -12620,6 +16822,26 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_placements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_lifecycles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -12630,6 +16852,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -12722,6 +16954,26 @@ func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 	return tx.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, api_key_project_id)
 }
 
+func (rx *Rx) All_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+	audit_log_created_at_less AuditLog_CreatedAt_Field) (
+	rows []*AuditLog, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx, audit_log_created_at_greater_or_equal, audit_log_created_at_less)
+}
+
+func (rx *Rx) All_BucketLifecycle(ctx context.Context) (
+	rows []*BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BucketLifecycle(ctx)
+}
+
 func (rx *Rx) All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx context.Context,
 	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
 	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
@@ -12735,6 +16987,15 @@ func (rx *Rx) All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalSta
 	return tx.All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx, bucket_storage_tally_project_id, bucket_storage_tally_bucket_name, bucket_storage_tally_interval_start_greater_or_equal, bucket_storage_tally_interval_start_less_or_equal)
 }
 
+func (rx *Rx) All_DamagedPiece(ctx context.Context) (
+	rows []*DamagedPiece, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_DamagedPiece(ctx)
+}
+
 func (rx *Rx) All_Node_Id(ctx context.Context) (
 	rows []*Id_Row, err error) {
 	var tx *Tx
@@ -12744,6 +17005,15 @@ func (rx *Rx) All_Node_Id(ctx context.Context) (
 	return tx.All_Node_Id(ctx)
 }
 
+func (rx *Rx) All_Node_Id_By_ExitInitiatedAt_IsNot_Null_And_ExitLoopCompletedAt_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context) (
+	rows []*Id_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Node_Id_By_ExitInitiatedAt_IsNot_Null_And_ExitLoopCompletedAt_Is_Null_And_ExitFinishedAt_Is_Null(ctx)
+}
+
 func (rx *Rx) All_Offer(ctx context.Context) (
 	rows []*Offer, err error) {
 	var tx *Tx
@@ -12762,6 +17032,26 @@ func (rx *Rx) All_Project(ctx context.Context) (
 	return tx.All_Project(ctx)
 }
 
+func (rx *Rx) All_ProjectInvitation_By_MemberId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	rows []*ProjectInvitation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ProjectInvitation_By_MemberId_OrderBy_Asc_CreatedAt(ctx, project_invitation_member_id)
+}
+
+func (rx *Rx) All_ProjectInvitation_By_ProjectId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field) (
+	rows []*ProjectInvitation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ProjectInvitation_By_ProjectId_OrderBy_Asc_CreatedAt(ctx, project_invitation_project_id)
+}
+
 func (rx *Rx) All_ProjectInvoiceStamp_By_ProjectId_OrderBy_Desc_StartDate(ctx context.Context,
 	project_invoice_stamp_project_id ProjectInvoiceStamp_ProjectId_Field) (
 	rows []*ProjectInvoiceStamp, err error) {
@@ -12842,6 +17132,16 @@ func (rx *Rx) All_UserCredit_By_UserId_And_ExpiresAt_Greater_And_CreditsUsedInCe
 	return tx.All_UserCredit_By_UserId_And_ExpiresAt_Greater_And_CreditsUsedInCents_Less_CreditsEarnedInCents_OrderBy_Asc_ExpiresAt(ctx, user_credit_user_id, user_credit_expires_at_greater)
 }
 
+func (rx *Rx) Count_AuditLog_By_ProjectId(ctx context.Context,
+	audit_log_project_id AuditLog_ProjectId_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Count_AuditLog_By_ProjectId(ctx, audit_log_project_id)
+}
+
 func (rx *Rx) Count_UserCredit_By_ReferredBy(ctx context.Context,
 	user_credit_referred_by UserCredit_ReferredBy_Field) (
 	count int64, err error) {
@@ -12897,6 +17197,35 @@ func (rx *Rx) Create_ApiKey(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_AuditLog(ctx context.Context,
+	audit_log_id AuditLog_Id_Field,
+	audit_log_actor_id AuditLog_ActorId_Field,
+	audit_log_action AuditLog_Action_Field,
+	audit_log_target AuditLog_Target_Field,
+	audit_log_ip_address AuditLog_IpAddress_Field,
+	optional AuditLog_Create_Fields) (
+	audit_log *AuditLog, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_AuditLog(ctx, audit_log_id, audit_log_actor_id, audit_log_action, audit_log_target, audit_log_ip_address, optional)
+
+}
+
+func (rx *Rx) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketLifecycle(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name, bucket_lifecycle_rules)
+
+}
+
 func (rx *Rx) Create_BucketMetainfo(ctx context.Context,
 	bucket_metainfo_id BucketMetainfo_Id_Field,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
@@ -12920,6 +17249,19 @@ func (rx *Rx) Create_BucketMetainfo(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_BucketPlacement(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field,
+	bucket_placement_policy BucketPlacement_Policy_Field) (
+	bucket_placement *BucketPlacement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketPlacement(ctx, bucket_placement_project_id, bucket_placement_bucket_name, bucket_placement_policy)
+
+}
+
 func (rx *Rx) Create_BucketStorageTally(ctx context.Context,
 	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
 	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
@@ -13016,13 +17358,38 @@ func (rx *Rx) Create_Node(ctx context.Context,
 	node_audit_reputation_beta Node_AuditReputationBeta_Field,
 	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_exit_success Node_ExitSuccess_Field,
 	optional Node_Create_Fields) (
 	node *Node, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Node(ctx, node_id, node_address, node_last_net, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_major, node_minor, node_patch, node_hash, node_timestamp, node_release, node_latency_90, node_audit_success_count, node_total_audit_count, node_uptime_success_count, node_total_uptime_count, node_last_contact_success, node_last_contact_failure, node_contained, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_reputation_alpha, node_uptime_reputation_beta, optional)
+	return tx.Create_Node(ctx, node_id, node_address, node_last_net, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_major, node_minor, node_patch, node_hash, node_timestamp, node_release, node_latency_90, node_audit_success_count, node_total_audit_count, node_uptime_success_count, node_total_uptime_count, node_last_contact_success, node_last_contact_failure, node_contained, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_reputation_alpha, node_uptime_reputation_beta, node_exit_success, optional)
+
+}
+
+func (rx *Rx) Create_NodeCountry(ctx context.Context,
+	node_country_node_id NodeCountry_NodeId_Field,
+	node_country_country_code NodeCountry_CountryCode_Field) (
+	node_country *NodeCountry, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_NodeCountry(ctx, node_country_node_id, node_country_country_code)
+
+}
+
+func (rx *Rx) Create_NodeTag(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_tag NodeTag_Tag_Field) (
+	node_tag *NodeTag, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_NodeTag(ctx, node_tag_node_id, node_tag_tag)
 
 }
 
@@ -13066,13 +17433,28 @@ func (rx *Rx) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
-	project_usage_limit Project_UsageLimit_Field) (
+	project_usage_limit Project_UsageLimit_Field,
+	optional Project_Create_Fields) (
 	project *Project, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Project(ctx, project_id, project_name, project_description, project_usage_limit)
+	return tx.Create_Project(ctx, project_id, project_name, project_description, project_usage_limit, optional)
+
+}
+
+func (rx *Rx) Create_ProjectInvitation(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field,
+	project_invitation_inviter_id ProjectInvitation_InviterId_Field,
+	project_invitation_role ProjectInvitation_Role_Field) (
+	project_invitation *ProjectInvitation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ProjectInvitation(ctx, project_invitation_project_id, project_invitation_member_id, project_invitation_inviter_id, project_invitation_role)
 
 }
 
@@ -13093,13 +17475,14 @@ func (rx *Rx) Create_ProjectInvoiceStamp(ctx context.Context,
 
 func (rx *Rx) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id)
+	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id, project_member_role)
 
 }
 
@@ -13184,13 +17567,14 @@ func (rx *Rx) Create_User(ctx context.Context,
 	user_email User_Email_Field,
 	user_full_name User_FullName_Field,
 	user_password_hash User_PasswordHash_Field,
+	user_mfa_enabled User_MfaEnabled_Field,
 	optional User_Create_Fields) (
 	user *User, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_User(ctx, user_id, user_email, user_full_name, user_password_hash, optional)
+	return tx.Create_User(ctx, user_id, user_email, user_full_name, user_password_hash, user_mfa_enabled, optional)
 
 }
 
@@ -13254,6 +17638,17 @@ func (rx *Rx) Delete_ApiKey_By_Id(ctx context.Context,
 	return tx.Delete_ApiKey_By_Id(ctx, api_key_id)
 }
 
+func (rx *Rx) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name)
+}
+
 func (rx *Rx) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -13265,6 +17660,17 @@ func (rx *Rx) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	return tx.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
+func (rx *Rx) Delete_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_BucketPlacement_By_ProjectId_And_BucketName(ctx, bucket_placement_project_id, bucket_placement_bucket_name)
+}
+
 func (rx *Rx) Delete_BucketUsage_By_Id(ctx context.Context,
 	bucket_usage_id BucketUsage_Id_Field) (
 	deleted bool, err error) {
@@ -13285,6 +17691,39 @@ func (rx *Rx) Delete_CertRecord_By_Id(ctx context.Context,
 	return tx.Delete_CertRecord_By_Id(ctx, certRecord_id)
 }
 
+func (rx *Rx) Delete_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+	damaged_piece_node_id DamagedPiece_NodeId_Field,
+	damaged_piece_piece_id DamagedPiece_PieceId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_DamagedPiece_By_NodeId_And_PieceId(ctx, damaged_piece_node_id, damaged_piece_piece_id)
+}
+
+func (rx *Rx) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_GracefulExitTransferQueue_By_NodeId(ctx, graceful_exit_transfer_queue_node_id)
+
+}
+
+func (rx *Rx) Delete_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_GracefulExitTransferQueue_By_NodeId_And_Path(ctx, graceful_exit_transfer_queue_node_id, graceful_exit_transfer_queue_path)
+}
+
 func (rx *Rx) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 	deleted bool, err error) {
@@ -13295,6 +17734,17 @@ func (rx *Rx) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 	return tx.Delete_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath)
 }
 
+func (rx *Rx) Delete_NodeTag_By_NodeId(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_NodeTag_By_NodeId(ctx, node_tag_node_id)
+
+}
+
 func (rx *Rx) Delete_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	deleted bool, err error) {
@@ -13315,6 +17765,17 @@ func (rx *Rx) Delete_PendingAudits_By_NodeId(ctx context.Context,
 	return tx.Delete_PendingAudits_By_NodeId(ctx, pending_audits_node_id)
 }
 
+func (rx *Rx) Delete_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_ProjectInvitation_By_ProjectId_And_MemberId(ctx, project_invitation_project_id, project_invitation_member_id)
+}
+
 func (rx *Rx) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
 	project_member_project_id ProjectMember_ProjectId_Field) (
@@ -13473,6 +17934,17 @@ func (rx *Rx) Get_ApiKey_By_Id(ctx context.Context,
 	return tx.Get_ApiKey_By_Id(ctx, api_key_id)
 }
 
+func (rx *Rx) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name)
+}
+
 func (rx *Rx) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -13484,6 +17956,17 @@ func (rx *Rx) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	return tx.Get_BucketMetainfo_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
+func (rx *Rx) Get_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_project_id BucketPlacement_ProjectId_Field,
+	bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+	bucket_placement *BucketPlacement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketPlacement_By_ProjectId_And_BucketName(ctx, bucket_placement_project_id, bucket_placement_bucket_name)
+}
+
 func (rx *Rx) Get_BucketUsage_By_Id(ctx context.Context,
 	bucket_usage_id BucketUsage_Id_Field) (
 	bucket_usage *BucketUsage, err error) {
@@ -13504,6 +17987,38 @@ func (rx *Rx) Get_CertRecord_By_Id(ctx context.Context,
 	return tx.Get_CertRecord_By_Id(ctx, certRecord_id)
 }
 
+func (rx *Rx) Get_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+	damaged_piece_node_id DamagedPiece_NodeId_Field,
+	damaged_piece_piece_id DamagedPiece_PieceId_Field) (
+	damaged_piece *DamagedPiece, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_DamagedPiece_By_NodeId_And_PieceId(ctx, damaged_piece_node_id, damaged_piece_piece_id)
+}
+
+func (rx *Rx) Get_GracefulExitProgress_By_NodeId(ctx context.Context,
+	graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
+	graceful_exit_progress *GracefulExitProgress, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_GracefulExitProgress_By_NodeId(ctx, graceful_exit_progress_node_id)
+}
+
+func (rx *Rx) Get_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
+	graceful_exit_transfer_queue *GracefulExitTransferQueue, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_GracefulExitTransferQueue_By_NodeId_And_Path(ctx, graceful_exit_transfer_queue_node_id, graceful_exit_transfer_queue_path)
+}
+
 func (rx *Rx) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 	irreparabledb *Irreparabledb, err error) {
@@ -13544,6 +18059,17 @@ func (rx *Rx) Get_PendingAudits_By_NodeId(ctx context.Context,
 	return tx.Get_PendingAudits_By_NodeId(ctx, pending_audits_node_id)
 }
 
+func (rx *Rx) Get_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_member_id ProjectInvitation_MemberId_Field) (
+	project_invitation *ProjectInvitation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ProjectInvitation_By_ProjectId_And_MemberId(ctx, project_invitation_project_id, project_invitation_member_id)
+}
+
 func (rx *Rx) Get_ProjectInvoiceStamp_By_ProjectId_And_StartDate(ctx context.Context,
 	project_invoice_stamp_project_id ProjectInvoiceStamp_ProjectId_Field,
 	project_invoice_stamp_start_date ProjectInvoiceStamp_StartDate_Field) (
@@ -13676,6 +18202,17 @@ func (rx *Rx) Get_ValueAttribution_By_ProjectId_And_BucketName(ctx context.Conte
 	return tx.Get_ValueAttribution_By_ProjectId_And_BucketName(ctx, value_attribution_project_id, value_attribution_bucket_name)
 }
 
+func (rx *Rx) Limited_AuditLog_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	audit_log_project_id AuditLog_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*AuditLog, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_AuditLog_By_ProjectId_OrderBy_Desc_CreatedAt(ctx, audit_log_project_id, limit, offset)
+}
+
 func (rx *Rx) Limited_BucketUsage_By_BucketId_And_RollupEndTime_Greater_And_RollupEndTime_LessOrEqual_OrderBy_Asc_RollupEndTime(ctx context.Context,
 	bucket_usage_bucket_id BucketUsage_BucketId_Field,
 	bucket_usage_rollup_end_time_greater BucketUsage_RollupEndTime_Field,
@@ -13702,6 +18239,17 @@ func (rx *Rx) Limited_BucketUsage_By_BucketId_And_RollupEndTime_Greater_And_Roll
 	return tx.Limited_BucketUsage_By_BucketId_And_RollupEndTime_Greater_And_RollupEndTime_LessOrEqual_OrderBy_Desc_RollupEndTime(ctx, bucket_usage_bucket_id, bucket_usage_rollup_end_time_greater, bucket_usage_rollup_end_time_less_or_equal, limit, offset)
 }
 
+func (rx *Rx) Limited_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_Is_Null_OrderBy_Asc_QueuedAt(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	limit int, offset int64) (
+	rows []*GracefulExitTransferQueue, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_Is_Null_OrderBy_Asc_QueuedAt(ctx, graceful_exit_transfer_queue_node_id, limit, offset)
+}
+
 func (rx *Rx) Limited_Irreparabledb_OrderBy_Asc_Segmentpath(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Irreparabledb, err error) {
@@ -13767,6 +18315,18 @@ func (rx *Rx) Update_CertRecord_By_Id(ctx context.Context,
 	return tx.Update_CertRecord_By_Id(ctx, certRecord_id, update)
 }
 
+func (rx *Rx) Update_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
+	update GracefulExitTransferQueue_Update_Fields) (
+	graceful_exit_transfer_queue *GracefulExitTransferQueue, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_GracefulExitTransferQueue_By_NodeId_And_Path(ctx, graceful_exit_transfer_queue_node_id, graceful_exit_transfer_queue_path, update)
+}
+
 func (rx *Rx) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -13778,6 +18338,17 @@ func (rx *Rx) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	return tx.Update_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath, update)
 }
 
+func (rx *Rx) Update_NodeCountry_By_NodeId(ctx context.Context,
+	node_country_node_id NodeCountry_NodeId_Field,
+	update NodeCountry_Update_Fields) (
+	node_country *NodeCountry, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_NodeCountry_By_NodeId(ctx, node_country_node_id, update)
+}
+
 func (rx *Rx) Update_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
@@ -13811,6 +18382,18 @@ func (rx *Rx) Update_PendingAudits_By_NodeId(ctx context.Context,
 	return tx.Update_PendingAudits_By_NodeId(ctx, pending_audits_node_id, update)
 }
 
+func (rx *Rx) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -13853,6 +18436,14 @@ type Methods interface {
 		api_key_project_id ApiKey_ProjectId_Field) (
 		rows []*ApiKey, err error)
 
+	All_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+		audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+		audit_log_created_at_less AuditLog_CreatedAt_Field) (
+		rows []*AuditLog, err error)
+
+	All_BucketLifecycle(ctx context.Context) (
+		rows []*BucketLifecycle, err error)
+
 	All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx context.Context,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
@@ -13860,15 +18451,29 @@ type Methods interface {
 		bucket_storage_tally_interval_start_less_or_equal BucketStorageTally_IntervalStart_Field) (
 		rows []*BucketStorageTally, err error)
 
+	All_DamagedPiece(ctx context.Context) (
+		rows []*DamagedPiece, err error)
+
 	All_Node_Id(ctx context.Context) (
 		rows []*Id_Row, err error)
 
+	All_Node_Id_By_ExitInitiatedAt_IsNot_Null_And_ExitLoopCompletedAt_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context) (
+		rows []*Id_Row, err error)
+
 	All_Offer(ctx context.Context) (
 		rows []*Offer, err error)
 
 	All_Project(ctx context.Context) (
 		rows []*Project, err error)
 
+	All_ProjectInvitation_By_MemberId_OrderBy_Asc_CreatedAt(ctx context.Context,
+		project_invitation_member_id ProjectInvitation_MemberId_Field) (
+		rows []*ProjectInvitation, err error)
+
+	All_ProjectInvitation_By_ProjectId_OrderBy_Asc_CreatedAt(ctx context.Context,
+		project_invitation_project_id ProjectInvitation_ProjectId_Field) (
+		rows []*ProjectInvitation, err error)
+
 	All_ProjectInvoiceStamp_By_ProjectId_OrderBy_Desc_StartDate(ctx context.Context,
 		project_invoice_stamp_project_id ProjectInvoiceStamp_ProjectId_Field) (
 		rows []*ProjectInvoiceStamp, err error)
@@ -13901,6 +18506,10 @@ type Methods interface {
 		user_credit_expires_at_greater UserCredit_ExpiresAt_Field) (
 		rows []*UserCredit, err error)
 
+	Count_AuditLog_By_ProjectId(ctx context.Context,
+		audit_log_project_id AuditLog_ProjectId_Field) (
+		count int64, err error)
+
 	Count_UserCredit_By_ReferredBy(ctx context.Context,
 		user_credit_referred_by UserCredit_ReferredBy_Field) (
 		count int64, err error)
@@ -13929,6 +18538,21 @@ type Methods interface {
 		api_key_secret ApiKey_Secret_Field) (
 		api_key *ApiKey, err error)
 
+	Create_AuditLog(ctx context.Context,
+		audit_log_id AuditLog_Id_Field,
+		audit_log_actor_id AuditLog_ActorId_Field,
+		audit_log_action AuditLog_Action_Field,
+		audit_log_target AuditLog_Target_Field,
+		audit_log_ip_address AuditLog_IpAddress_Field,
+		optional AuditLog_Create_Fields) (
+		audit_log *AuditLog, err error)
+
+	Create_BucketLifecycle(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+		bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
 	Create_BucketMetainfo(ctx context.Context,
 		bucket_metainfo_id BucketMetainfo_Id_Field,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
@@ -13945,6 +18569,12 @@ type Methods interface {
 		bucket_metainfo_default_redundancy_total_shares BucketMetainfo_DefaultRedundancyTotalShares_Field) (
 		bucket_metainfo *BucketMetainfo, err error)
 
+	Create_BucketPlacement(ctx context.Context,
+		bucket_placement_project_id BucketPlacement_ProjectId_Field,
+		bucket_placement_bucket_name BucketPlacement_BucketName_Field,
+		bucket_placement_policy BucketPlacement_Policy_Field) (
+		bucket_placement *BucketPlacement, err error)
+
 	Create_BucketStorageTally(ctx context.Context,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
@@ -14013,9 +18643,20 @@ type Methods interface {
 		node_audit_reputation_beta Node_AuditReputationBeta_Field,
 		node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 		node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+		node_exit_success Node_ExitSuccess_Field,
 		optional Node_Create_Fields) (
 		node *Node, err error)
 
+	Create_NodeCountry(ctx context.Context,
+		node_country_node_id NodeCountry_NodeId_Field,
+		node_country_country_code NodeCountry_CountryCode_Field) (
+		node_country *NodeCountry, err error)
+
+	Create_NodeTag(ctx context.Context,
+		node_tag_node_id NodeTag_NodeId_Field,
+		node_tag_tag NodeTag_Tag_Field) (
+		node_tag *NodeTag, err error)
+
 	Create_Offer(ctx context.Context,
 		offer_name Offer_Name_Field,
 		offer_description Offer_Description_Field,
@@ -14042,9 +18683,17 @@ type Methods interface {
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
 		project_description Project_Description_Field,
-		project_usage_limit Project_UsageLimit_Field) (
+		project_usage_limit Project_UsageLimit_Field,
+		optional Project_Create_Fields) (
 		project *Project, err error)
 
+	Create_ProjectInvitation(ctx context.Context,
+		project_invitation_project_id ProjectInvitation_ProjectId_Field,
+		project_invitation_member_id ProjectInvitation_MemberId_Field,
+		project_invitation_inviter_id ProjectInvitation_InviterId_Field,
+		project_invitation_role ProjectInvitation_Role_Field) (
+		project_invitation *ProjectInvitation, err error)
+
 	Create_ProjectInvoiceStamp(ctx context.Context,
 		project_invoice_stamp_project_id ProjectInvoiceStamp_ProjectId_Field,
 		project_invoice_stamp_invoice_id ProjectInvoiceStamp_InvoiceId_Field,
@@ -14055,7 +18704,8 @@ type Methods interface {
 
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
-		project_member_project_id ProjectMember_ProjectId_Field) (
+		project_member_project_id ProjectMember_ProjectId_Field,
+		project_member_role ProjectMember_Role_Field) (
 		project_member *ProjectMember, err error)
 
 	Create_ProjectPayment(ctx context.Context,
@@ -14097,6 +18747,7 @@ type Methods interface {
 		user_email User_Email_Field,
 		user_full_name User_FullName_Field,
 		user_password_hash User_PasswordHash_Field,
+		user_mfa_enabled User_MfaEnabled_Field,
 		optional User_Create_Fields) (
 		user *User, err error)
 
@@ -14127,11 +18778,21 @@ type Methods interface {
 		api_key_id ApiKey_Id_Field) (
 		deleted bool, err error)
 
+	Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+		deleted bool, err error)
+
 	Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		deleted bool, err error)
 
+	Delete_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_placement_project_id BucketPlacement_ProjectId_Field,
+		bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+		deleted bool, err error)
+
 	Delete_BucketUsage_By_Id(ctx context.Context,
 		bucket_usage_id BucketUsage_Id_Field) (
 		deleted bool, err error)
@@ -14140,10 +18801,28 @@ type Methods interface {
 		certRecord_id CertRecord_Id_Field) (
 		deleted bool, err error)
 
+	Delete_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+		damaged_piece_node_id DamagedPiece_NodeId_Field,
+		damaged_piece_piece_id DamagedPiece_PieceId_Field) (
+		deleted bool, err error)
+
+	Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
+		graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+		count int64, err error)
+
+	Delete_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+		graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+		graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
+		deleted bool, err error)
+
 	Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 		deleted bool, err error)
 
+	Delete_NodeTag_By_NodeId(ctx context.Context,
+		node_tag_node_id NodeTag_NodeId_Field) (
+		count int64, err error)
+
 	Delete_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		deleted bool, err error)
@@ -14152,6 +18831,11 @@ type Methods interface {
 		pending_audits_node_id PendingAudits_NodeId_Field) (
 		deleted bool, err error)
 
+	Delete_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+		project_invitation_project_id ProjectInvitation_ProjectId_Field,
+		project_invitation_member_id ProjectInvitation_MemberId_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
@@ -14219,11 +18903,21 @@ type Methods interface {
 		api_key_id ApiKey_Id_Field) (
 		api_key *ApiKey, err error)
 
+	Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
 	Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		bucket_metainfo *BucketMetainfo, err error)
 
+	Get_BucketPlacement_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_placement_project_id BucketPlacement_ProjectId_Field,
+		bucket_placement_bucket_name BucketPlacement_BucketName_Field) (
+		bucket_placement *BucketPlacement, err error)
+
 	Get_BucketUsage_By_Id(ctx context.Context,
 		bucket_usage_id BucketUsage_Id_Field) (
 		bucket_usage *BucketUsage, err error)
@@ -14232,6 +18926,20 @@ type Methods interface {
 		certRecord_id CertRecord_Id_Field) (
 		certRecord *CertRecord, err error)
 
+	Get_DamagedPiece_By_NodeId_And_PieceId(ctx context.Context,
+		damaged_piece_node_id DamagedPiece_NodeId_Field,
+		damaged_piece_piece_id DamagedPiece_PieceId_Field) (
+		damaged_piece *DamagedPiece, err error)
+
+	Get_GracefulExitProgress_By_NodeId(ctx context.Context,
+		graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
+		graceful_exit_progress *GracefulExitProgress, err error)
+
+	Get_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+		graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+		graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field) (
+		graceful_exit_transfer_queue *GracefulExitTransferQueue, err error)
+
 	Get_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 		irreparabledb *Irreparabledb, err error)
@@ -14248,6 +18956,11 @@ type Methods interface {
 		pending_audits_node_id PendingAudits_NodeId_Field) (
 		pending_audits *PendingAudits, err error)
 
+	Get_ProjectInvitation_By_ProjectId_And_MemberId(ctx context.Context,
+		project_invitation_project_id ProjectInvitation_ProjectId_Field,
+		project_invitation_member_id ProjectInvitation_MemberId_Field) (
+		project_invitation *ProjectInvitation, err error)
+
 	Get_ProjectInvoiceStamp_By_ProjectId_And_StartDate(ctx context.Context,
 		project_invoice_stamp_project_id ProjectInvoiceStamp_ProjectId_Field,
 		project_invoice_stamp_start_date ProjectInvoiceStamp_StartDate_Field) (
@@ -14302,6 +19015,11 @@ type Methods interface {
 		value_attribution_bucket_name ValueAttribution_BucketName_Field) (
 		value_attribution *ValueAttribution, err error)
 
+	Limited_AuditLog_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+		audit_log_project_id AuditLog_ProjectId_Field,
+		limit int, offset int64) (
+		rows []*AuditLog, err error)
+
 	Limited_BucketUsage_By_BucketId_And_RollupEndTime_Greater_And_RollupEndTime_LessOrEqual_OrderBy_Asc_RollupEndTime(ctx context.Context,
 		bucket_usage_bucket_id BucketUsage_BucketId_Field,
 		bucket_usage_rollup_end_time_greater BucketUsage_RollupEndTime_Field,
@@ -14316,6 +19034,11 @@ type Methods interface {
 		limit int, offset int64) (
 		rows []*BucketUsage, err error)
 
+	Limited_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_Is_Null_OrderBy_Asc_QueuedAt(ctx context.Context,
+		graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+		limit int, offset int64) (
+		rows []*GracefulExitTransferQueue, err error)
+
 	Limited_Irreparabledb_OrderBy_Asc_Segmentpath(ctx context.Context,
 		limit int, offset int64) (
 		rows []*Irreparabledb, err error)
@@ -14345,11 +19068,22 @@ type Methods interface {
 		update CertRecord_Update_Fields) (
 		certRecord *CertRecord, err error)
 
+	Update_GracefulExitTransferQueue_By_NodeId_And_Path(ctx context.Context,
+		graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+		graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
+		update GracefulExitTransferQueue_Update_Fields) (
+		graceful_exit_transfer_queue *GracefulExitTransferQueue, err error)
+
 	Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 		update Irreparabledb_Update_Fields) (
 		irreparabledb *Irreparabledb, err error)
 
+	Update_NodeCountry_By_NodeId(ctx context.Context,
+		node_country_node_id NodeCountry_NodeId_Field,
+		update NodeCountry_Update_Fields) (
+		node_country *NodeCountry, err error)
+
 	Update_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field,
 		update Node_Update_Fields) (
@@ -14365,6 +19099,12 @@ type Methods interface {
 		update PendingAudits_Update_Fields) (
 		pending_audits *PendingAudits, err error)
 
+	Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_member_id ProjectMember_MemberId_Field,
+		project_member_project_id ProjectMember_ProjectId_Field,
+		update ProjectMember_Update_Fields) (
+		project_member *ProjectMember, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
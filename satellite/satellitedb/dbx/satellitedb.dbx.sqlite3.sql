@@ -17,6 +17,16 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_logs (
+	id BLOB NOT NULL,
+	actor_id BLOB NOT NULL,
+	project_id BLOB,
+	action TEXT NOT NULL,
+	target TEXT NOT NULL,
+	ip_address TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name BLOB NOT NULL,
 	project_id BLOB NOT NULL,
@@ -28,6 +38,20 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled INTEGER NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_lifecycles (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	rules BLOB NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_placements (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	policy BLOB NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name BLOB NOT NULL,
 	project_id BLOB NOT NULL,
@@ -61,10 +85,36 @@ CREATE TABLE certRecords (
 	update_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE damaged_pieces (
+	node_id BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	reported_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
+CREATE TABLE graceful_exit_progress (
+	node_id BLOB NOT NULL,
+	bytes_transferred INTEGER NOT NULL,
+	pieces_transferred INTEGER NOT NULL,
+	pieces_failed INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE graceful_exit_transfer_queue (
+	node_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_num INTEGER NOT NULL,
+	queued_at TIMESTAMP NOT NULL,
+	requested_at TIMESTAMP,
+	last_failed_at TIMESTAMP,
+	failed_count INTEGER,
+	finished_at TIMESTAMP,
+	PRIMARY KEY ( node_id, path )
+);
 CREATE TABLE injuredsegments (
 	path TEXT NOT NULL,
 	data BLOB NOT NULL,
 	attempted TIMESTAMP,
+	segment_health INTEGER NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -79,6 +129,7 @@ CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	address TEXT NOT NULL,
 	last_net TEXT NOT NULL,
+	last_ip_address TEXT,
 	protocol INTEGER NOT NULL,
 	type INTEGER NOT NULL,
 	email TEXT NOT NULL,
@@ -106,8 +157,32 @@ CREATE TABLE nodes (
 	audit_reputation_beta REAL NOT NULL,
 	uptime_reputation_alpha REAL NOT NULL,
 	uptime_reputation_beta REAL NOT NULL,
+	exit_initiated_at TIMESTAMP,
+	exit_loop_completed_at TIMESTAMP,
+	exit_finished_at TIMESTAMP,
+	exit_success INTEGER NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_countries (
+	node_id BLOB NOT NULL,
+	country_code TEXT NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_tags (
+	node_id BLOB NOT NULL,
+	tag TEXT NOT NULL,
+	PRIMARY KEY ( node_id, tag )
+);
+CREATE TABLE node_throughputs (
+	node_id BLOB NOT NULL,
+	upload_bytes_per_second REAL NOT NULL,
+	upload_count INTEGER NOT NULL,
+	download_bytes_per_second REAL NOT NULL,
+	download_count INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE offers (
 	id INTEGER NOT NULL,
 	name TEXT NOT NULL,
@@ -138,6 +213,10 @@ CREATE TABLE projects (
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
 	usage_limit INTEGER NOT NULL,
+	storage_limit INTEGER,
+	bandwidth_limit INTEGER,
+	segment_limit INTEGER,
+	bucket_limit INTEGER,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -187,6 +266,12 @@ CREATE TABLE users (
 	password_hash BLOB NOT NULL,
 	status INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
+	mfa_enabled INTEGER NOT NULL,
+	mfa_secret_key TEXT,
+	mfa_recovery_codes TEXT,
+	mfa_last_step INTEGER,
+	mfa_failed_attempts INTEGER,
+	mfa_failed_at TIMESTAMP,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -234,10 +319,19 @@ CREATE TABLE project_invoice_stamps (
 	PRIMARY KEY ( project_id, start_date, end_date ),
 	UNIQUE ( invoice_id )
 );
+CREATE TABLE project_invitations (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	inviter_id BLOB NOT NULL,
+	role INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, member_id )
+);
 CREATE TABLE project_members (
 	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at TIMESTAMP NOT NULL,
+	role INTEGER NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
 CREATE TABLE used_serials (
@@ -270,8 +364,10 @@ CREATE TABLE project_payments (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( project_id )
 );
+CREATE INDEX audit_logs_project_id_created_at_index ON audit_logs ( project_id, created_at );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
+CREATE INDEX injuredsegments_segment_health_index ON injuredsegments ( segment_health );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
@@ -0,0 +1,177 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/gracefulexit"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type gracefulexitDB struct {
+	db *dbx.DB
+}
+
+// IncrementProgress adds to the transferred bytes and pieces of a node
+func (db *gracefulexitDB) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statement := db.db.Rebind(
+		`INSERT INTO graceful_exit_progress (node_id, bytes_transferred, pieces_transferred, pieces_failed, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(node_id)
+		DO UPDATE SET
+			bytes_transferred = graceful_exit_progress.bytes_transferred + excluded.bytes_transferred,
+			pieces_transferred = graceful_exit_progress.pieces_transferred + excluded.pieces_transferred,
+			pieces_failed = graceful_exit_progress.pieces_failed + excluded.pieces_failed,
+			updated_at = excluded.updated_at`,
+	)
+	_, err = db.db.ExecContext(ctx, statement, nodeID.Bytes(), bytes, successfulTransfers, failedTransfers, time.Now().UTC())
+	return Error.Wrap(err)
+}
+
+// GetProgress returns the progress of a node
+func (db *gracefulexitDB) GetProgress(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxProgress, err := db.db.Get_GracefulExitProgress_By_NodeId(ctx, dbx.GracefulExitProgress_NodeId(nodeID.Bytes()))
+	if err == sql.ErrNoRows {
+		return &gracefulexit.Progress{NodeID: nodeID}, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &gracefulexit.Progress{
+		NodeID:            nodeID,
+		BytesTransferred:  dbxProgress.BytesTransferred,
+		PiecesTransferred: dbxProgress.PiecesTransferred,
+		PiecesFailed:      dbxProgress.PiecesFailed,
+		UpdatedAt:         dbxProgress.UpdatedAt,
+	}, nil
+}
+
+// Enqueue adds items to the transfer queue, ignoring the already queued ones
+func (db *gracefulexitDB) Enqueue(ctx context.Context, items []gracefulexit.TransferQueueItem) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	statement := db.db.Rebind(
+		`INSERT INTO graceful_exit_transfer_queue (node_id, path, piece_num, queued_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT DO NOTHING`,
+	)
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		now := time.Now().UTC()
+		for _, item := range items {
+			_, err := tx.Tx.ExecContext(ctx, statement, item.NodeID.Bytes(), item.Path, item.PieceNum, now)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// UpdateTransferQueueItem updates the timestamps and failure count of a queued item
+func (db *gracefulexitDB) UpdateTransferQueueItem(ctx context.Context, item gracefulexit.TransferQueueItem) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Update_GracefulExitTransferQueue_By_NodeId_And_Path(ctx,
+		dbx.GracefulExitTransferQueue_NodeId(item.NodeID.Bytes()),
+		dbx.GracefulExitTransferQueue_Path(item.Path),
+		dbx.GracefulExitTransferQueue_Update_Fields{
+			RequestedAt:  dbx.GracefulExitTransferQueue_RequestedAt_Raw(item.RequestedAt),
+			LastFailedAt: dbx.GracefulExitTransferQueue_LastFailedAt_Raw(item.LastFailedAt),
+			FailedCount:  dbx.GracefulExitTransferQueue_FailedCount_Raw(item.FailedCount),
+			FinishedAt:   dbx.GracefulExitTransferQueue_FinishedAt_Raw(item.FinishedAt),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// DeleteTransferQueueItem removes an item from the transfer queue
+func (db *gracefulexitDB) DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_GracefulExitTransferQueue_By_NodeId_And_Path(ctx,
+		dbx.GracefulExitTransferQueue_NodeId(nodeID.Bytes()),
+		dbx.GracefulExitTransferQueue_Path(path),
+	)
+	return Error.Wrap(err)
+}
+
+// DeleteTransferQueueItems removes all items of a node from the transfer queue
+func (db *gracefulexitDB) DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_GracefulExitTransferQueue_By_NodeId(ctx,
+		dbx.GracefulExitTransferQueue_NodeId(nodeID.Bytes()),
+	)
+	return Error.Wrap(err)
+}
+
+// GetTransferQueueItem returns a queued item
+func (db *gracefulexitDB) GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte) (_ *gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxItem, err := db.db.Get_GracefulExitTransferQueue_By_NodeId_And_Path(ctx,
+		dbx.GracefulExitTransferQueue_NodeId(nodeID.Bytes()),
+		dbx.GracefulExitTransferQueue_Path(path),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	item, err := transferQueueItemFromDBX(dbxItem)
+	return item, Error.Wrap(err)
+}
+
+// GetIncomplete returns the queued items of a node, which aren't finished
+func (db *gracefulexitDB) GetIncomplete(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) (_ []*gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxItems, err := db.db.Limited_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_Is_Null_OrderBy_Asc_QueuedAt(ctx,
+		dbx.GracefulExitTransferQueue_NodeId(nodeID.Bytes()),
+		limit, offset,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var items []*gracefulexit.TransferQueueItem
+	for _, dbxItem := range dbxItems {
+		item, err := transferQueueItemFromDBX(dbxItem)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func transferQueueItemFromDBX(dbxItem *dbx.GracefulExitTransferQueue) (*gracefulexit.TransferQueueItem, error) {
+	nodeID, err := storj.NodeIDFromBytes(dbxItem.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gracefulexit.TransferQueueItem{
+		NodeID:       nodeID,
+		Path:         dbxItem.Path,
+		PieceNum:     int32(dbxItem.PieceNum),
+		QueuedAt:     dbxItem.QueuedAt,
+		RequestedAt:  dbxItem.RequestedAt,
+		LastFailedAt: dbxItem.LastFailedAt,
+		FailedCount:  dbxItem.FailedCount,
+		FinishedAt:   dbxItem.FinishedAt,
+	}, nil
+}
@@ -13,7 +13,6 @@ import (
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 
-	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/certdb"
@@ -25,7 +24,11 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/damagedpieces"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/placement"
 	"storj.io/storj/satellite/rewards"
 )
 
@@ -176,6 +179,40 @@ func (m *lockedAPIKeys) Update(ctx context.Context, key console.APIKeyInfo) erro
 	return m.db.Update(ctx, key)
 }
 
+// AuditLogs is a getter for AuditLogs repository
+func (m *lockedConsole) AuditLogs() console.AuditLogs {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedAuditLogs{m.Locker, m.db.AuditLogs()}
+}
+
+// lockedAuditLogs implements locking wrapper for console.AuditLogs
+type lockedAuditLogs struct {
+	sync.Locker
+	db console.AuditLogs
+}
+
+// GetByProjectID is a method for querying a page of audit log entries of a project, newest first.
+func (m *lockedAuditLogs) GetByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.AuditLogCursor) (*console.AuditLogPage, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID, cursor)
+}
+
+// Insert is a method for appending an entry to the audit log.
+func (m *lockedAuditLogs) Insert(ctx context.Context, entry *console.AuditLogEntry) (*console.AuditLogEntry, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, entry)
+}
+
+// Iterate calls fn for every audit log entry created in [since, before), oldest first.
+func (m *lockedAuditLogs) Iterate(ctx context.Context, since time.Time, before time.Time, fn func(context.Context, console.AuditLogEntry) error) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Iterate(ctx, since, before, fn)
+}
+
 // BucketUsage is a getter for accounting.BucketUsage repository
 func (m *lockedConsole) BucketUsage() accounting.BucketUsage {
 	m.Lock()
@@ -213,6 +250,54 @@ func (m *lockedBucketUsage) GetPaged(ctx context.Context, cursor *accounting.Buc
 	return m.db.GetPaged(ctx, cursor)
 }
 
+// ProjectInvitations is a getter for ProjectInvitations repository
+func (m *lockedConsole) ProjectInvitations() console.ProjectInvitations {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectInvitations{m.Locker, m.db.ProjectInvitations()}
+}
+
+// lockedProjectInvitations implements locking wrapper for console.ProjectInvitations
+type lockedProjectInvitations struct {
+	sync.Locker
+	db console.ProjectInvitations
+}
+
+// Delete is a method for deleting project invitation.
+func (m *lockedProjectInvitations) Delete(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID, memberID)
+}
+
+// Get is a method for querying project invitation of a user.
+func (m *lockedProjectInvitations) Get(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID) (*console.ProjectInvitation, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, memberID)
+}
+
+// GetByMemberID is a method for querying all pending project invitations of a user.
+func (m *lockedProjectInvitations) GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]console.ProjectInvitation, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByMemberID(ctx, memberID)
+}
+
+// GetByProjectID is a method for querying all pending invitations to a project.
+func (m *lockedProjectInvitations) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]console.ProjectInvitation, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID)
+}
+
+// Insert is a method for inserting or replacing project invitation.
+func (m *lockedProjectInvitations) Insert(ctx context.Context, invitation *console.ProjectInvitation) (*console.ProjectInvitation, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, invitation)
+}
+
 // ProjectInvoiceStamps is a getter for ProjectInvoiceStamps repository
 func (m *lockedConsole) ProjectInvoiceStamps() console.ProjectInvoiceStamps {
 	m.Lock()
@@ -278,11 +363,18 @@ func (m *lockedProjectMembers) GetByProjectID(ctx context.Context, projectID uui
 	return m.db.GetByProjectID(ctx, projectID, pagination)
 }
 
-// Insert is a method for inserting project member into the database.
-func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID) (*console.ProjectMember, error) {
+// Insert is a method for inserting project member with the given role into the database.
+func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID, role console.ProjectMemberRole) (*console.ProjectMember, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, memberID, projectID, role)
+}
+
+// UpdateRole is a method for changing the role of project member.
+func (m *lockedProjectMembers) UpdateRole(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID, role console.ProjectMemberRole) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Insert(ctx, memberID, projectID)
+	return m.db.UpdateRole(ctx, memberID, projectID, role)
 }
 
 // ProjectPayments is a getter for ProjectPayments repository
@@ -560,6 +652,13 @@ type lockedUsers struct {
 	db console.Users
 }
 
+// AddMFAFailure counts a failed two-factor authentication attempt of user.
+func (m *lockedUsers) AddMFAFailure(ctx context.Context, id uuid.UUID, failedAt time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.AddMFAFailure(ctx, id, failedAt)
+}
+
 // Delete is a method for deleting user by Id from the database.
 func (m *lockedUsers) Delete(ctx context.Context, id uuid.UUID) error {
 	m.Lock()
@@ -595,6 +694,27 @@ func (m *lockedUsers) Update(ctx context.Context, user *console.User) error {
 	return m.db.Update(ctx, user)
 }
 
+// UpdateMFA is a method for updating two-factor authentication settings of user.
+func (m *lockedUsers) UpdateMFA(ctx context.Context, user *console.User) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateMFA(ctx, user)
+}
+
+// UpdateMFARecoveryCodes replaces the recovery codes of user, when they haven't changed since reading them.
+func (m *lockedUsers) UpdateMFARecoveryCodes(ctx context.Context, id uuid.UUID, old []string, new []string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateMFARecoveryCodes(ctx, id, old, new)
+}
+
+// UseMFAStep stores the time step of an accepted passcode and resets the failed attempts.
+func (m *lockedUsers) UseMFAStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UseMFAStep(ctx, id, step)
+}
+
 // Containment returns database for containment
 func (m *locked) Containment() audit.Containment {
 	m.Lock()
@@ -640,6 +760,55 @@ func (m *locked) CreateTables() error {
 	return m.db.CreateTables()
 }
 
+// DamagedPieces returns database for the damaged pieces reported by storage nodes
+func (m *locked) DamagedPieces() damagedpieces.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedDamagedPieces{m.Locker, m.db.DamagedPieces()}
+}
+
+// lockedDamagedPieces implements locking wrapper for damagedpieces.DB
+type lockedDamagedPieces struct {
+	sync.Locker
+	db damagedpieces.DB
+}
+
+// Add stores the reported pieces of a node, ignoring the already reported ones
+func (m *lockedDamagedPieces) Add(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID, reportedAt time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Add(ctx, nodeID, pieceIDs, reportedAt)
+}
+
+// Delete removes the report of a piece
+func (m *lockedDamagedPieces) Delete(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, nodeID, pieceID)
+}
+
+// GetAll returns all reported pieces
+func (m *lockedDamagedPieces) GetAll(ctx context.Context) ([]damagedpieces.Report, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetAll(ctx)
+}
+
+// List returns at most limit reported pieces ordered by node and piece ID,
+// which come after the node and piece ID of cursor. The zero cursor lists from the start.
+func (m *lockedDamagedPieces) List(ctx context.Context, cursor damagedpieces.Report, limit int) ([]damagedpieces.Report, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx, cursor, limit)
+}
+
+// Has returns whether the node reported the piece
+func (m *lockedDamagedPieces) Has(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Has(ctx, nodeID, pieceID)
+}
+
 // DropSchema drops the schema
 func (m *locked) DropSchema(schema string) error {
 	m.Lock()
@@ -647,6 +816,75 @@ func (m *locked) DropSchema(schema string) error {
 	return m.db.DropSchema(schema)
 }
 
+// GracefulExit returns database for graceful exit
+func (m *locked) GracefulExit() gracefulexit.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedGracefulExit{m.Locker, m.db.GracefulExit()}
+}
+
+// lockedGracefulExit implements locking wrapper for gracefulexit.DB
+type lockedGracefulExit struct {
+	sync.Locker
+	db gracefulexit.DB
+}
+
+// DeleteTransferQueueItem removes an item from the transfer queue
+func (m *lockedGracefulExit) DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteTransferQueueItem(ctx, nodeID, path)
+}
+
+// DeleteTransferQueueItems removes all items of a node from the transfer queue
+func (m *lockedGracefulExit) DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteTransferQueueItems(ctx, nodeID)
+}
+
+// Enqueue adds items to the transfer queue, ignoring the already queued ones
+func (m *lockedGracefulExit) Enqueue(ctx context.Context, items []gracefulexit.TransferQueueItem) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Enqueue(ctx, items)
+}
+
+// GetIncomplete returns the queued items of a node, which aren't finished
+func (m *lockedGracefulExit) GetIncomplete(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) ([]*gracefulexit.TransferQueueItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetIncomplete(ctx, nodeID, limit, offset)
+}
+
+// GetProgress returns the progress of a node
+func (m *lockedGracefulExit) GetProgress(ctx context.Context, nodeID storj.NodeID) (*gracefulexit.Progress, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProgress(ctx, nodeID)
+}
+
+// GetTransferQueueItem returns a queued item
+func (m *lockedGracefulExit) GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte) (*gracefulexit.TransferQueueItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetTransferQueueItem(ctx, nodeID, path)
+}
+
+// IncrementProgress adds to the transferred bytes and pieces of a node
+func (m *lockedGracefulExit) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementProgress(ctx, nodeID, bytes, successfulTransfers, failedTransfers)
+}
+
+// UpdateTransferQueueItem updates the timestamps and failure count of a queued item
+func (m *lockedGracefulExit) UpdateTransferQueueItem(ctx context.Context, item gracefulexit.TransferQueueItem) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateTransferQueueItem(ctx, item)
+}
+
 // Irreparable returns database for failed repairs
 func (m *locked) Irreparable() irreparable.DB {
 	m.Lock()
@@ -688,6 +926,40 @@ func (m *lockedIrreparable) IncrementRepairAttempts(ctx context.Context, segment
 	return m.db.IncrementRepairAttempts(ctx, segmentInfo)
 }
 
+// Lifecycles returns database for bucket lifecycle rules
+func (m *locked) Lifecycles() lifecycle.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedLifecycles{m.Locker, m.db.Lifecycles()}
+}
+
+// lockedLifecycles implements locking wrapper for lifecycle.DB
+type lockedLifecycles struct {
+	sync.Locker
+	db lifecycle.DB
+}
+
+// Get returns the lifecycle rules of a bucket
+func (m *lockedLifecycles) Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) ([]*pb.LifecycleRule, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucketName)
+}
+
+// List returns the lifecycle configurations of all buckets
+func (m *lockedLifecycles) List(ctx context.Context) ([]*lifecycle.Bucket, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx)
+}
+
+// Set replaces the lifecycle rules of a bucket. Setting no rules removes the configuration.
+func (m *lockedLifecycles) Set(ctx context.Context, projectID uuid.UUID, bucketName []byte, rules []*pb.LifecycleRule) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, projectID, bucketName, rules)
+}
+
 // Orders returns database for orders
 func (m *locked) Orders() orders.DB {
 	m.Lock()
@@ -784,6 +1056,13 @@ type lockedOverlayCache struct {
 	db overlay.DB
 }
 
+// DisqualifyNode disqualifies the node.
+func (m *lockedOverlayCache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DisqualifyNode(ctx, nodeID)
+}
+
 // Get looks up the node by nodeID
 func (m *lockedOverlayCache) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
 	m.Lock()
@@ -791,6 +1070,27 @@ func (m *lockedOverlayCache) Get(ctx context.Context, nodeID storj.NodeID) (*ove
 	return m.db.Get(ctx, nodeID)
 }
 
+// GetExitStatus returns the graceful exit status of a node.
+func (m *lockedOverlayCache) GetExitStatus(ctx context.Context, nodeID storj.NodeID) (*overlay.ExitStatus, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetExitStatus(ctx, nodeID)
+}
+
+// GetExitingNodesLoopIncomplete returns the exiting nodes, whose pieces haven't been queued for transfer yet.
+func (m *lockedOverlayCache) GetExitingNodesLoopIncomplete(ctx context.Context) (storj.NodeIDList, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetExitingNodesLoopIncomplete(ctx)
+}
+
+// GetPerformance returns the measured performance of the nodes.
+func (m *lockedOverlayCache) GetPerformance(ctx context.Context, nodeIDs storj.NodeIDList) (map[storj.NodeID]overlay.NodePerformance, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetPerformance(ctx, nodeIDs)
+}
+
 // IsVetted returns whether or not the node reaches reputable thresholds
 func (m *lockedOverlayCache) IsVetted(ctx context.Context, id storj.NodeID, criteria *overlay.NodeCriteria) (bool, error) {
 	m.Lock()
@@ -819,6 +1119,13 @@ func (m *lockedOverlayCache) Paginate(ctx context.Context, offset int64, limit i
 	return m.db.Paginate(ctx, offset, limit)
 }
 
+// ReinstateNode removes the disqualification of the node and resets its reputation to the initial values.
+func (m *lockedOverlayCache) ReinstateNode(ctx context.Context, nodeID storj.NodeID, defaults overlay.NodeSelectionConfig) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ReinstateNode(ctx, nodeID, defaults)
+}
+
 // SelectNewStorageNodes looks up nodes based on new node criteria
 func (m *lockedOverlayCache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
 	m.Lock()
@@ -840,13 +1147,34 @@ func (m *lockedOverlayCache) UpdateAddress(ctx context.Context, value *pb.Node,
 	return m.db.UpdateAddress(ctx, value, defaults)
 }
 
-// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
+// UpdateCountry updates the country the node is located in.
+func (m *lockedOverlayCache) UpdateCountry(ctx context.Context, node storj.NodeID, countryCode string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateCountry(ctx, node, countryCode)
+}
+
+// UpdateExitStatus updates the graceful exit status of a node.
+func (m *lockedOverlayCache) UpdateExitStatus(ctx context.Context, status *overlay.ExitStatus) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateExitStatus(ctx, status)
+}
+
+// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, tags, capacity, and version.
 func (m *lockedOverlayCache) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *overlay.NodeDossier, err error) {
 	m.Lock()
 	defer m.Unlock()
 	return m.db.UpdateNodeInfo(ctx, node, nodeInfo)
 }
 
+// UpdatePerformance updates the latency and throughput of the nodes with the samples.
+func (m *lockedOverlayCache) UpdatePerformance(ctx context.Context, samples []overlay.PerformanceSample, lambda float64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdatePerformance(ctx, samples, lambda)
+}
+
 // UpdateStats all parts of single storagenode's stats.
 func (m *lockedOverlayCache) UpdateStats(ctx context.Context, request *overlay.UpdateRequest) (stats *overlay.NodeStats, err error) {
 	m.Lock()
@@ -861,6 +1189,33 @@ func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.Node
 	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ)
 }
 
+// Placements returns database for bucket placement policies
+func (m *locked) Placements() placement.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedPlacements{m.Locker, m.db.Placements()}
+}
+
+// lockedPlacements implements locking wrapper for placement.DB
+type lockedPlacements struct {
+	sync.Locker
+	db placement.DB
+}
+
+// Get returns the placement policy of a bucket, nil when the bucket has none
+func (m *lockedPlacements) Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (*pb.PlacementPolicy, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucketName)
+}
+
+// Set replaces the placement policy of a bucket. Setting an empty policy removes it.
+func (m *lockedPlacements) Set(ctx context.Context, projectID uuid.UUID, bucketName []byte, policy *pb.PlacementPolicy) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, projectID, bucketName, policy)
+}
+
 // ProjectAccounting returns database for storing information about project data use
 func (m *locked) ProjectAccounting() accounting.ProjectAccounting {
 	m.Lock()
@@ -888,11 +1243,18 @@ func (m *lockedProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context
 	return m.db.GetAllocatedBandwidthTotal(ctx, projectID, from)
 }
 
-// GetProjectUsageLimits returns project usage limit
-func (m *lockedProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (memory.Size, error) {
+// GetProjectLimits returns the usage limits set for a projectID, zero values mean the limit is not set
+func (m *lockedProjectAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (accounting.ProjectLimits, error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.GetProjectUsageLimits(ctx, projectID)
+	return m.db.GetProjectLimits(ctx, projectID)
+}
+
+// GetSegmentCount returns the number of segments of a projectID in the latest tally
+func (m *lockedProjectAccounting) GetSegmentCount(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetSegmentCount(ctx, projectID)
 }
 
 // GetStorageTotals returns the current inline and remote storage usage for a projectID
@@ -909,6 +1271,13 @@ func (m *lockedProjectAccounting) SaveTallies(ctx context.Context, intervalStart
 	return m.db.SaveTallies(ctx, intervalStart, bucketTallies)
 }
 
+// UpdateProjectLimits sets the usage limits of a projectID, zero values unset the limit
+func (m *lockedProjectAccounting) UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limits accounting.ProjectLimits) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateProjectLimits(ctx, projectID, limits)
+}
+
 // RepairQueue returns queue for segments that need repairing
 func (m *locked) RepairQueue() queue.RepairQueue {
 	m.Lock()
@@ -929,14 +1298,14 @@ func (m *lockedRepairQueue) Delete(ctx context.Context, s *pb.InjuredSegment) er
 	return m.db.Delete(ctx, s)
 }
 
-// Insert adds an injured segment.
-func (m *lockedRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment) error {
+// Insert adds an injured segment or updates the health of an already queued one.
+func (m *lockedRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment, segmentHealth int) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Insert(ctx, s)
+	return m.db.Insert(ctx, s, segmentHealth)
 }
 
-// Select gets an injured segment.
+// Select gets the injured segment with the lowest health.
 func (m *lockedRepairQueue) Select(ctx context.Context) (*pb.InjuredSegment, error) {
 	m.Lock()
 	defer m.Unlock()
@@ -927,6 +927,186 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`UPDATE nodes SET disqualified=NULL WHERE disqualified IS NOT NULL AND audit_reputation_alpha / (audit_reputation_alpha + audit_reputation_beta) >= 0.6;`,
 				},
 			},
+			{
+				Description: "Add bucket lifecycles table",
+				Version:     40,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycles (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						rules bytea NOT NULL,
+						updated_at timestamp NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				Description: "Add graceful exit columns and tables",
+				Version:     41,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN exit_initiated_at timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN exit_loop_completed_at timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN exit_finished_at timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN exit_success boolean NOT NULL DEFAULT false;`,
+					`CREATE TABLE graceful_exit_progress (
+						node_id bytea NOT NULL,
+						bytes_transferred bigint NOT NULL,
+						pieces_transferred bigint NOT NULL,
+						pieces_failed bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+					`CREATE TABLE graceful_exit_transfer_queue (
+						node_id bytea NOT NULL,
+						path bytea NOT NULL,
+						piece_num integer NOT NULL,
+						queued_at timestamp with time zone NOT NULL,
+						requested_at timestamp with time zone,
+						last_failed_at timestamp with time zone,
+						failed_count integer,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( node_id, path )
+					);`,
+				},
+			},
+			{
+				Description: "Add segment_health to injuredsegments for repair priority",
+				Version:     42,
+				Action: migrate.SQL{
+					`ALTER TABLE injuredsegments ADD COLUMN segment_health integer NOT NULL DEFAULT 0;`,
+					`CREATE INDEX injuredsegments_segment_health_index ON injuredsegments ( segment_health );`,
+				},
+			},
+			{
+				Description: "Add damaged_pieces table",
+				Version:     43,
+				Action: migrate.SQL{
+					`CREATE TABLE damaged_pieces (
+						node_id bytea NOT NULL,
+						piece_id bytea NOT NULL,
+						reported_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, piece_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add bucket and node placement tables",
+				Version:     44,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_placements (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						policy bytea NOT NULL,
+						updated_at timestamp NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+					`CREATE TABLE node_countries (
+						node_id bytea NOT NULL,
+						country_code text NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+					`CREATE TABLE node_tags (
+						node_id bytea NOT NULL,
+						tag text NOT NULL,
+						PRIMARY KEY ( node_id, tag )
+					);`,
+				},
+			},
+			{
+				Description: "Add node throughputs table",
+				Version:     45,
+				Action: migrate.SQL{
+					`CREATE TABLE node_throughputs (
+						node_id bytea NOT NULL,
+						upload_bytes_per_second double precision NOT NULL,
+						upload_count bigint NOT NULL,
+						download_bytes_per_second double precision NOT NULL,
+						download_count bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add project member roles and invitations",
+				Version:     46,
+				Action: migrate.SQL{
+					// every member could manage the project before roles were added,
+					// so existing members keep their permissions as admins (3)
+					`ALTER TABLE project_members ADD COLUMN role integer NOT NULL DEFAULT 3;`,
+					// the first member of every project created it and becomes its only owner (4),
+					// members added at the same time are ordered by their id
+					`UPDATE project_members SET role = 4
+						WHERE (member_id, project_id) IN (
+							SELECT DISTINCT ON (project_id) member_id, project_id
+							FROM project_members
+							ORDER BY project_id, created_at, member_id
+						);`,
+					`CREATE TABLE project_invitations (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						inviter_id bytea NOT NULL,
+						role integer NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, member_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add two-factor authentication to users",
+				Version:     47,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD COLUMN mfa_enabled boolean NOT NULL DEFAULT false;`,
+					`ALTER TABLE users ADD COLUMN mfa_secret_key text;`,
+					`ALTER TABLE users ADD COLUMN mfa_recovery_codes text;`,
+				},
+			},
+			{
+				Description: "Add audit_logs table",
+				Version:     48,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_logs (
+						id bytea NOT NULL,
+						actor_id bytea NOT NULL,
+						project_id bytea,
+						action text NOT NULL,
+						target text NOT NULL,
+						ip_address text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX audit_logs_project_id_created_at_index ON audit_logs ( project_id, created_at );`,
+				},
+			},
+			{
+				Description: "Add separate storage, bandwidth, segment and bucket limits to projects",
+				Version:     49,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD COLUMN storage_limit bigint;`,
+					`ALTER TABLE projects ADD COLUMN bandwidth_limit bigint;`,
+					`ALTER TABLE projects ADD COLUMN segment_limit bigint;`,
+					`ALTER TABLE projects ADD COLUMN bucket_limit bigint;`,
+					// usage_limit was shared by storage and bandwidth
+					`UPDATE projects SET storage_limit = usage_limit, bandwidth_limit = usage_limit WHERE usage_limit > 0;`,
+				},
+			},
+			{
+				Description: "Add the IP address of nodes next to their network",
+				Version:     50,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN last_ip_address text;`,
+				},
+			},
+			{
+				Description: "Add two-factor authentication replay and throttling state to users",
+				Version:     51,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD COLUMN mfa_last_step bigint;`,
+					`ALTER TABLE users ADD COLUMN mfa_failed_attempts integer;`,
+					`ALTER TABLE users ADD COLUMN mfa_failed_at timestamp with time zone;`,
+				},
+			},
 		},
 	}
 }
@@ -40,6 +40,7 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 
 	safeQuery := `
 		WHERE disqualified IS NULL
+		AND exit_initiated_at IS NULL
 		AND type = ?
 		AND free_bandwidth >= ?
 		AND free_disk >= ?
@@ -62,31 +63,7 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	if !criteria.DistinctIP {
-		nodes, err = cache.queryNodes(ctx, criteria.ExcludedNodes, count, safeQuery, args...)
-		if err != nil {
-			return nil, err
-		}
-		return nodes, nil
-	}
-
-	// query for distinct IPs
-	for i := 0; i < 3; i++ {
-		moreNodes, err := cache.queryNodesDistinct(ctx, criteria.ExcludedNodes, criteria.ExcludedIPs, count-len(nodes), safeQuery, criteria.DistinctIP, args...)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range moreNodes {
-			nodes = append(nodes, n)
-			criteria.ExcludedNodes = append(criteria.ExcludedNodes, n.Id)
-			criteria.ExcludedIPs = append(criteria.ExcludedIPs, n.LastIp)
-		}
-		if len(nodes) == count {
-			break
-		}
-	}
-
-	return nodes, nil
+	return cache.selectNodes(ctx, count, criteria, safeQuery, args...)
 }
 
 func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*pb.Node, err error) {
@@ -96,6 +73,7 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 
 	safeQuery := `
 		WHERE disqualified IS NULL
+		AND exit_initiated_at IS NULL
 		AND type = ?
 		AND free_bandwidth >= ?
 		AND free_disk >= ?
@@ -116,33 +94,74 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	if !criteria.DistinctIP {
-		nodes, err = cache.queryNodes(ctx, criteria.ExcludedNodes, count, safeQuery, args...)
-		if err != nil {
-			return nil, err
-		}
-		return nodes, nil
-	}
+	return cache.selectNodes(ctx, count, criteria, safeQuery, args...)
+}
+
+// selectNodes selects nodes matching the query and the placement policy of the criteria
+func (cache *overlaycache) selectNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria, safeQuery string, args ...interface{}) (nodes []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	placementQuery, placementArgs := placementConditions(criteria.Placement)
+	safeQuery += placementQuery
+	args = append(args, placementArgs...)
 
-	// query for distinct IPs
-	for i := 0; i < 3; i++ {
-		moreNodes, err := cache.queryNodesDistinct(ctx, criteria.ExcludedNodes, criteria.ExcludedIPs, count-len(nodes), safeQuery, criteria.DistinctIP, args...)
+	excludedNodes := append([]storj.NodeID{}, criteria.ExcludedNodes...)
+	excludedIPs := append([]string{}, criteria.ExcludedIPs...)
+
+	// query again for the nodes that weren't distinct or were in a denied network
+	for i := 0; i < 3 && len(nodes) < count; i++ {
+		var moreNodes []*pb.Node
+		if criteria.DistinctIP {
+			moreNodes, err = cache.queryNodesDistinct(ctx, excludedNodes, excludedIPs, count-len(nodes), safeQuery, criteria.DistinctIP, args...)
+		} else {
+			moreNodes, err = cache.queryNodes(ctx, excludedNodes, count-len(nodes), safeQuery, args...)
+		}
 		if err != nil {
 			return nil, err
 		}
+		if len(moreNodes) == 0 {
+			break
+		}
+
 		for _, n := range moreNodes {
+			excludedNodes = append(excludedNodes, n.Id)
+			if overlay.DeniedNetwork(criteria.Placement, n) {
+				continue
+			}
 			nodes = append(nodes, n)
-			criteria.ExcludedNodes = append(criteria.ExcludedNodes, n.Id)
-			criteria.ExcludedIPs = append(criteria.ExcludedIPs, n.LastIp)
-		}
-		if len(nodes) == count {
-			break
+			if criteria.DistinctIP {
+				excludedIPs = append(excludedIPs, n.LastIp)
+			}
 		}
 	}
 
 	return nodes, nil
 }
 
+// placementConditions returns the conditions restricting the nodes to the countries and tags of the placement policy
+func placementConditions(policy *pb.PlacementPolicy) (safeQuery string, args []interface{}) {
+	if countries := policy.GetAllowedCountries(); len(countries) > 0 {
+		safeQuery += `
+			AND id IN (SELECT node_id FROM node_countries WHERE country_code IN (?` + strings.Repeat(", ?", len(countries)-1) + `))`
+		for _, country := range countries {
+			args = append(args, country)
+		}
+	}
+	if countries := policy.GetDeniedCountries(); len(countries) > 0 {
+		safeQuery += `
+			AND id NOT IN (SELECT node_id FROM node_countries WHERE country_code IN (?` + strings.Repeat(", ?", len(countries)-1) + `))`
+		for _, country := range countries {
+			args = append(args, country)
+		}
+	}
+	for _, tag := range policy.GetRequiredTags() {
+		safeQuery += `
+			AND id IN (SELECT node_id FROM node_tags WHERE tag = ?)`
+		args = append(args, tag)
+	}
+	return safeQuery, args
+}
+
 func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -161,7 +180,7 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 	args = append(args, count)
 
 	var rows *sql.Rows
-	rows, err = cache.db.Query(cache.db.Rebind(`SELECT id, type, address, last_net, 
+	rows, err = cache.db.Query(cache.db.Rebind(`SELECT id, type, address, last_net, last_ip_address,
 	free_bandwidth, free_disk, total_audit_count, audit_success_count, 
 	total_uptime_count, uptime_success_count, disqualified, audit_reputation_alpha,
 	audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta
@@ -178,7 +197,7 @@ func (cache *overlaycache) queryNodes(ctx context.Context, excludedNodes []storj
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
-			&dbNode.Address, &dbNode.LastNet, &dbNode.FreeBandwidth, &dbNode.FreeDisk,
+			&dbNode.Address, &dbNode.LastNet, &dbNode.LastIpAddress, &dbNode.FreeBandwidth, &dbNode.FreeDisk,
 			&dbNode.TotalAuditCount, &dbNode.AuditSuccessCount,
 			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount, &dbNode.Disqualified,
 			&dbNode.AuditReputationAlpha, &dbNode.AuditReputationBeta,
@@ -236,7 +255,7 @@ func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, exclude
 
 	args = append(args, count)
 
-	rows, err := cache.db.Query(cache.db.Rebind(`SELECT id, type, address, last_net, 
+	rows, err := cache.db.Query(cache.db.Rebind(`SELECT id, type, address, last_net, last_ip_address,
 	free_bandwidth, free_disk, total_audit_count, audit_success_count, 
 	total_uptime_count, uptime_success_count, disqualified, audit_reputation_alpha,
 	audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta
@@ -255,7 +274,7 @@ func (cache *overlaycache) sqliteQueryNodesDistinct(ctx context.Context, exclude
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
-			&dbNode.Address, &dbNode.LastNet, &dbNode.FreeBandwidth, &dbNode.FreeDisk,
+			&dbNode.Address, &dbNode.LastNet, &dbNode.LastIpAddress, &dbNode.FreeBandwidth, &dbNode.FreeDisk,
 			&dbNode.TotalAuditCount, &dbNode.AuditSuccessCount,
 			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount, &dbNode.Disqualified,
 			&dbNode.AuditReputationAlpha, &dbNode.AuditReputationBeta,
@@ -305,7 +324,7 @@ func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, exclu
 		`+safeQuery+safeExcludeNodes+safeExcludeIPs+`
 	)
 	SELECT
-		id, type, address, last_net, free_bandwidth, free_disk, total_audit_count,
+		id, type, address, last_net, last_ip_address, free_bandwidth, free_disk, total_audit_count,
 		audit_success_count, total_uptime_count, uptime_success_count,
 		audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha,
 		uptime_reputation_beta
@@ -330,7 +349,7 @@ func (cache *overlaycache) postgresQueryNodesDistinct(ctx context.Context, exclu
 	for rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
-			&dbNode.Address, &dbNode.LastNet, &dbNode.FreeBandwidth, &dbNode.FreeDisk,
+			&dbNode.Address, &dbNode.LastNet, &dbNode.LastIpAddress, &dbNode.FreeBandwidth, &dbNode.FreeDisk,
 			&dbNode.TotalAuditCount, &dbNode.AuditSuccessCount,
 			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount,
 			&dbNode.AuditReputationAlpha, &dbNode.AuditReputationBeta,
@@ -470,6 +489,7 @@ func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteri
 			SELECT id FROM nodes
 			WHERE id IN (?`+strings.Repeat(", ?", len(nodeIds)-1)+`)
 			AND disqualified IS NULL
+			AND exit_finished_at IS NULL
 			AND last_contact_success > ? AND last_contact_success > last_contact_failure
 		`), args...)
 
@@ -478,6 +498,7 @@ func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteri
 			SELECT id FROM nodes
 				WHERE id = any($1::bytea[])
 				AND disqualified IS NULL
+				AND exit_finished_at IS NULL
 				AND last_contact_success > $2 AND last_contact_success > last_contact_failure
 			`, postgresNodeIDList(nodeIds), time.Now().Add(-criteria.OnlineWindow),
 		)
@@ -592,8 +613,10 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, def
 			dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
 			dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
 			dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+			dbx.Node_ExitSuccess(false),
 			dbx.Node_Create_Fields{
-				Disqualified: dbx.Node_Disqualified_Null(),
+				LastIpAddress: dbx.Node_LastIpAddress(info.LastIpAddress),
+				Disqualified:  dbx.Node_Disqualified_Null(),
 			},
 		)
 		if err != nil {
@@ -601,9 +624,10 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, def
 		}
 	} else {
 		update := dbx.Node_Update_Fields{
-			Address:  dbx.Node_Address(address.Address),
-			LastNet:  dbx.Node_LastNet(info.LastIp),
-			Protocol: dbx.Node_Protocol(int(address.Transport)),
+			Address:       dbx.Node_Address(address.Address),
+			LastNet:       dbx.Node_LastNet(info.LastIp),
+			LastIpAddress: dbx.Node_LastIpAddress(info.LastIpAddress),
+			Protocol:      dbx.Node_Protocol(int(address.Transport)),
 		}
 
 		_, err := tx.Update_Node_By_Id(ctx, dbx.Node_Id(info.Id.Bytes()), update)
@@ -752,9 +776,216 @@ func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.Node
 		return nil, Error.Wrap(err)
 	}
 
+	if operator := nodeInfo.GetOperator(); operator != nil {
+		err = cache.updateTags(ctx, nodeID, operator.GetTags())
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
 	return convertDBNode(ctx, updatedDBNode)
 }
 
+// updateTags replaces the tags declared by the node operator
+func (cache *overlaycache) updateTags(ctx context.Context, nodeID storj.NodeID, tags []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Delete_NodeTag_By_NodeId(ctx, dbx.NodeTag_NodeId(nodeID.Bytes()))
+		if err != nil {
+			return err
+		}
+
+		for _, tag := range tags {
+			_, err = tx.Create_NodeTag(ctx, dbx.NodeTag_NodeId(nodeID.Bytes()), dbx.NodeTag_Tag(tag))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateCountry updates the country the node is located in
+func (cache *overlaycache) UpdateCountry(ctx context.Context, nodeID storj.NodeID, countryCode string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(cache.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		updated, err := tx.Update_NodeCountry_By_NodeId(ctx,
+			dbx.NodeCountry_NodeId(nodeID.Bytes()),
+			dbx.NodeCountry_Update_Fields{
+				CountryCode: dbx.NodeCountry_CountryCode(countryCode),
+			},
+		)
+		if err != nil || updated != nil {
+			return err
+		}
+
+		_, err = tx.Create_NodeCountry(ctx, dbx.NodeCountry_NodeId(nodeID.Bytes()), dbx.NodeCountry_CountryCode(countryCode))
+		return err
+	}))
+}
+
+// DisqualifyNode disqualifies the node
+func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		Disqualified: dbx.Node_Disqualified(time.Now().UTC()),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == nil {
+		return overlay.ErrNodeNotFound.New(nodeID.String())
+	}
+	return nil
+}
+
+// ReinstateNode removes the disqualification of the node and resets its reputation to the initial values
+func (cache *overlaycache) ReinstateNode(ctx context.Context, nodeID storj.NodeID, defaults overlay.NodeSelectionConfig) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		Disqualified:          dbx.Node_Disqualified_Null(),
+		Contained:             dbx.Node_Contained(false),
+		AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(defaults.AuditReputationAlpha0),
+		AuditReputationBeta:   dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
+		UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
+		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == nil {
+		return overlay.ErrNodeNotFound.New(nodeID.String())
+	}
+	return nil
+}
+
+// UpdatePerformance updates the latency and throughput of the nodes with the samples
+func (cache *overlaycache) UpdatePerformance(ctx context.Context, samples []overlay.PerformanceSample, lambda float64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, sample := range samples {
+			if sample.Latency > 0 {
+				if err := cache.updateLatency(ctx, tx, sample); err != nil {
+					return err
+				}
+			}
+			if sample.Duration > 0 && sample.Bytes > 0 {
+				if err := cache.updateThroughput(ctx, tx, sample, lambda); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+// updateLatency moves the estimated 90th percentile latency of the node towards the sample.
+// The estimate rises nine times faster than it falls, so it settles where one in ten samples
+// is above it. A step is a tenth of the estimate, but at least 10ms.
+func (cache *overlaycache) updateLatency(ctx context.Context, tx *dbx.Tx, sample overlay.PerformanceSample) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	latencyMs := int64(sample.Latency / time.Millisecond)
+	if latencyMs < 1 {
+		latencyMs = 1
+	}
+
+	_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`
+		UPDATE nodes SET latency_90 = CASE
+			WHEN latency_90 <= 0 THEN ?
+			WHEN latency_90 < ? THEN latency_90 + CASE WHEN latency_90 >= 100 THEN latency_90 * 9 / 100 ELSE 9 END
+			WHEN latency_90 > 1 THEN latency_90 - (latency_90 + 99) / 100
+			ELSE 1
+		END
+		WHERE id = ?`), latencyMs, latencyMs, sample.NodeID.Bytes())
+	return err
+}
+
+// updateThroughput adds the sample to the exponentially weighted moving average of the upload or download throughput of the node.
+// lambda is the "forgetting factor" which determines how much of the previous average is kept.
+func (cache *overlaycache) updateThroughput(ctx context.Context, tx *dbx.Tx, sample overlay.PerformanceSample, lambda float64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rate := float64(sample.Bytes) / sample.Duration.Seconds()
+
+	var uploadRate, downloadRate float64
+	var uploadCount, downloadCount int64
+	if sample.IsUpload() {
+		uploadRate, uploadCount = rate, 1
+	} else {
+		downloadRate, downloadCount = rate, 1
+	}
+
+	_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`
+		INSERT INTO node_throughputs (
+			node_id, upload_bytes_per_second, upload_count, download_bytes_per_second, download_count, updated_at
+		) VALUES ( ?, ?, ?, ?, ?, ? )
+		ON CONFLICT ( node_id )
+		DO UPDATE SET
+			upload_bytes_per_second = CASE
+				WHEN EXCLUDED.upload_count = 0 THEN node_throughputs.upload_bytes_per_second
+				WHEN node_throughputs.upload_count = 0 THEN EXCLUDED.upload_bytes_per_second
+				ELSE ? * node_throughputs.upload_bytes_per_second + ? * EXCLUDED.upload_bytes_per_second
+			END,
+			upload_count = node_throughputs.upload_count + EXCLUDED.upload_count,
+			download_bytes_per_second = CASE
+				WHEN EXCLUDED.download_count = 0 THEN node_throughputs.download_bytes_per_second
+				WHEN node_throughputs.download_count = 0 THEN EXCLUDED.download_bytes_per_second
+				ELSE ? * node_throughputs.download_bytes_per_second + ? * EXCLUDED.download_bytes_per_second
+			END,
+			download_count = node_throughputs.download_count + EXCLUDED.download_count,
+			updated_at = EXCLUDED.updated_at`),
+		sample.NodeID.Bytes(), uploadRate, uploadCount, downloadRate, downloadCount, time.Now().UTC(),
+		lambda, 1-lambda, lambda, 1-lambda)
+	return err
+}
+
+// GetPerformance returns the measured latency and throughput of the nodes
+func (cache *overlaycache) GetPerformance(ctx context.Context, nodeIDs storj.NodeIDList) (_ map[storj.NodeID]overlay.NodePerformance, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	performances := make(map[storj.NodeID]overlay.NodePerformance, len(nodeIDs))
+	if len(nodeIDs) == 0 {
+		return performances, nil
+	}
+
+	args := make([]interface{}, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		args = append(args, id.Bytes())
+	}
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`
+		SELECT nodes.id, nodes.latency_90,
+			COALESCE(node_throughputs.upload_bytes_per_second, 0),
+			COALESCE(node_throughputs.download_bytes_per_second, 0)
+		FROM nodes
+		LEFT JOIN node_throughputs ON node_throughputs.node_id = nodes.id
+		WHERE nodes.id IN (?`+strings.Repeat(", ?", len(nodeIDs)-1)+`)`), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var id storj.NodeID
+		var latency90 int64
+		var performance overlay.NodePerformance
+		err = rows.Scan(&id, &latency90, &performance.UploadBytesPerSecond, &performance.DownloadBytesPerSecond)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		performance.Latency90 = time.Duration(latency90) * time.Millisecond
+		performances[id] = performance
+	}
+	return performances, Error.Wrap(rows.Err())
+}
+
 // UpdateUptime updates a single storagenode's uptime stats in the db
 func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -837,6 +1068,65 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	return getNodeStats(dbNode), Error.Wrap(tx.Commit())
 }
 
+// GetExitStatus returns the graceful exit status of a node
+func (cache *overlaycache) GetExitStatus(ctx context.Context, nodeID storj.NodeID) (_ *overlay.ExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNode, err := cache.db.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
+	if err == sql.ErrNoRows {
+		return nil, overlay.ErrNodeNotFound.New(nodeID.String())
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &overlay.ExitStatus{
+		NodeID:              nodeID,
+		ExitInitiatedAt:     dbNode.ExitInitiatedAt,
+		ExitLoopCompletedAt: dbNode.ExitLoopCompletedAt,
+		ExitFinishedAt:      dbNode.ExitFinishedAt,
+		ExitSuccess:         dbNode.ExitSuccess,
+	}, nil
+}
+
+// UpdateExitStatus updates the graceful exit status of a node
+func (cache *overlaycache) UpdateExitStatus(ctx context.Context, status *overlay.ExitStatus) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(status.NodeID.Bytes()), dbx.Node_Update_Fields{
+		ExitInitiatedAt:     dbx.Node_ExitInitiatedAt_Raw(status.ExitInitiatedAt),
+		ExitLoopCompletedAt: dbx.Node_ExitLoopCompletedAt_Raw(status.ExitLoopCompletedAt),
+		ExitFinishedAt:      dbx.Node_ExitFinishedAt_Raw(status.ExitFinishedAt),
+		ExitSuccess:         dbx.Node_ExitSuccess(status.ExitSuccess),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == nil {
+		return overlay.ErrNodeNotFound.New(status.NodeID.String())
+	}
+	return nil
+}
+
+// GetExitingNodesLoopIncomplete returns the exiting nodes, whose pieces haven't been queued for transfer yet
+func (cache *overlaycache) GetExitingNodesLoopIncomplete(ctx context.Context) (exitingNodes storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.All_Node_Id_By_ExitInitiatedAt_IsNot_Null_And_ExitLoopCompletedAt_Is_Null_And_ExitFinishedAt_Is_Null(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, row := range rows {
+		id, err := storj.NodeIDFromBytes(row.Id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		exitingNodes = append(exitingNodes, id)
+	}
+	return exitingNodes, nil
+}
+
 func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if info == nil {
@@ -858,10 +1148,16 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 		return nil, err
 	}
 
+	var lastIPAddress string
+	if info.LastIpAddress != nil {
+		lastIPAddress = *info.LastIpAddress
+	}
+
 	node := &overlay.NodeDossier{
 		Node: pb.Node{
-			Id:     id,
-			LastIp: info.LastNet,
+			Id:            id,
+			LastIp:        info.LastNet,
+			LastIpAddress: lastIPAddress,
 			Address: &pb.NodeAddress{
 				Address:   info.Address,
 				Transport: pb.NodeTransport(info.Protocol),
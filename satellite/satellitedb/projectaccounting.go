@@ -115,12 +115,76 @@ func (db *ProjectAccounting) GetStorageTotals(ctx context.Context, projectID uui
 	return inlineSum.Int64, remoteSum.Int64, err
 }
 
-// GetProjectUsageLimits returns project usage limit
-func (db *ProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ memory.Size, err error) {
+// GetSegmentCount returns the number of segments of a projectID in the latest tally
+func (db *ProjectAccounting) GetSegmentCount(ctx context.Context, projectID uuid.UUID) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
-	project, err := db.db.Get_Project_By_Id(ctx, dbx.Project_Id(projectID[:]))
+	var segmentSum sql.NullInt64
+
+	// Sum the segment counts of the most recent tally run, see GetStorageTotals.
+	query := `SELECT SUM(inline_segments_count) + SUM(remote_segments_count)
+		FROM bucket_storage_tallies
+		WHERE project_id = ?
+		GROUP BY interval_start
+		ORDER BY interval_start DESC LIMIT 1;`
+
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(query), projectID[:]).Scan(&segmentSum)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
 	if err != nil {
 		return 0, err
 	}
-	return memory.Size(project.UsageLimit), nil
+	return segmentSum.Int64, nil
+}
+
+// GetProjectLimits returns the usage limits set for a projectID, zero values mean the limit is not set
+func (db *ProjectAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ accounting.ProjectLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := db.db.Get_Project_By_Id(ctx, dbx.Project_Id(projectID[:]))
+	if err != nil {
+		return accounting.ProjectLimits{}, err
+	}
+
+	return accounting.ProjectLimits{
+		Storage:   memory.Size(limitValue(project.StorageLimit)),
+		Bandwidth: memory.Size(limitValue(project.BandwidthLimit)),
+		Segments:  limitValue(project.SegmentLimit),
+		Buckets:   limitValue(project.BucketLimit),
+	}, nil
+}
+
+// UpdateProjectLimits sets the usage limits of a projectID, zero values unset the limit
+func (db *ProjectAccounting) UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limits accounting.ProjectLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := db.db.Update_Project_By_Id(ctx, dbx.Project_Id(projectID[:]), dbx.Project_Update_Fields{
+		StorageLimit:   dbx.Project_StorageLimit_Raw(nullLimit(limits.Storage.Int64())),
+		BandwidthLimit: dbx.Project_BandwidthLimit_Raw(nullLimit(limits.Bandwidth.Int64())),
+		SegmentLimit:   dbx.Project_SegmentLimit_Raw(nullLimit(limits.Segments)),
+		BucketLimit:    dbx.Project_BucketLimit_Raw(nullLimit(limits.Buckets)),
+	})
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return Error.New("project %s not found", projectID)
+	}
+	return nil
+}
+
+// nullLimit converts an unset limit to NULL
+func nullLimit(limit int64) *int64 {
+	if limit <= 0 {
+		return nil
+	}
+	return &limit
+}
+
+// limitValue converts a NULL limit to zero
+func limitValue(limit *int64) int64 {
+	if limit == nil {
+		return 0
+	}
+	return *limit
 }
@@ -0,0 +1,143 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// projectInvitations exposes methods to manage project_invitations table in database.
+type projectInvitations struct {
+	methods dbx.Methods
+}
+
+// Insert is a method for inserting or replacing project invitation.
+// It should be called within a transaction, since replacing is done by delete and create.
+func (invitations *projectInvitations) Insert(ctx context.Context, invitation *console.ProjectInvitation) (_ *console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = invitations.methods.Delete_ProjectInvitation_By_ProjectId_And_MemberId(ctx,
+		dbx.ProjectInvitation_ProjectId(invitation.ProjectID[:]),
+		dbx.ProjectInvitation_MemberId(invitation.MemberID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := invitations.methods.Create_ProjectInvitation(ctx,
+		dbx.ProjectInvitation_ProjectId(invitation.ProjectID[:]),
+		dbx.ProjectInvitation_MemberId(invitation.MemberID[:]),
+		dbx.ProjectInvitation_InviterId(invitation.InviterID[:]),
+		dbx.ProjectInvitation_Role(int(invitation.Role)))
+	if err != nil {
+		return nil, err
+	}
+
+	return projectInvitationFromDBX(ctx, created)
+}
+
+// Get is a method for querying project invitation of a user.
+func (invitations *projectInvitations) Get(ctx context.Context, projectID, memberID uuid.UUID) (_ *console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invitation, err := invitations.methods.Get_ProjectInvitation_By_ProjectId_And_MemberId(ctx,
+		dbx.ProjectInvitation_ProjectId(projectID[:]),
+		dbx.ProjectInvitation_MemberId(memberID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return projectInvitationFromDBX(ctx, invitation)
+}
+
+// GetByMemberID is a method for querying all pending project invitations of a user.
+func (invitations *projectInvitations) GetByMemberID(ctx context.Context, memberID uuid.UUID) (_ []console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invitationsDbx, err := invitations.methods.All_ProjectInvitation_By_MemberId_OrderBy_Asc_CreatedAt(ctx,
+		dbx.ProjectInvitation_MemberId(memberID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return projectInvitationsFromDbxSlice(ctx, invitationsDbx)
+}
+
+// GetByProjectID is a method for querying all pending invitations to a project.
+func (invitations *projectInvitations) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invitationsDbx, err := invitations.methods.All_ProjectInvitation_By_ProjectId_OrderBy_Asc_CreatedAt(ctx,
+		dbx.ProjectInvitation_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return projectInvitationsFromDbxSlice(ctx, invitationsDbx)
+}
+
+// Delete is a method for deleting project invitation.
+func (invitations *projectInvitations) Delete(ctx context.Context, projectID, memberID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = invitations.methods.Delete_ProjectInvitation_By_ProjectId_And_MemberId(ctx,
+		dbx.ProjectInvitation_ProjectId(projectID[:]),
+		dbx.ProjectInvitation_MemberId(memberID[:]))
+	return err
+}
+
+// projectInvitationFromDBX is used for creating ProjectInvitation entity from autogenerated dbx.ProjectInvitation struct
+func projectInvitationFromDBX(ctx context.Context, invitation *dbx.ProjectInvitation) (_ *console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if invitation == nil {
+		return nil, errs.New("invitation parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(invitation.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := bytesToUUID(invitation.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	inviterID, err := bytesToUUID(invitation.InviterId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.ProjectInvitation{
+		ProjectID: projectID,
+		MemberID:  memberID,
+		InviterID: inviterID,
+		Role:      console.ProjectMemberRole(invitation.Role),
+		CreatedAt: invitation.CreatedAt,
+	}, nil
+}
+
+// projectInvitationsFromDbxSlice is used for creating []ProjectInvitation entities from autogenerated []*dbx.ProjectInvitation struct
+func projectInvitationsFromDbxSlice(ctx context.Context, invitationsDbx []*dbx.ProjectInvitation) (_ []console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var invitations []console.ProjectInvitation
+	var errors []error
+
+	for _, invitationDbx := range invitationsDbx {
+		invitation, err := projectInvitationFromDBX(ctx, invitationDbx)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		invitations = append(invitations, *invitation)
+	}
+
+	return invitations, errs.Combine(errors...)
+}
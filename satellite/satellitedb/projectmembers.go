@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
@@ -17,7 +18,7 @@ import (
 // ProjectMembers exposes methods to manage ProjectMembers table in database.
 type projectMembers struct {
 	methods dbx.Methods
-	db      *dbx.DB
+	db      queryer
 }
 
 // GetByMemberID is a method for querying project member from the database by memberID.
@@ -44,7 +45,7 @@ func (pm *projectMembers) GetByProjectID(ctx context.Context, projectID uuid.UUI
 
 	// TODO: LIKE is case-sensitive postgres, however this should be case-insensitive and possibly allow typos
 	reboundQuery := pm.db.Rebind(`
-		SELECT pm.*
+		SELECT pm.member_id, pm.project_id, pm.role, pm.created_at
 			FROM project_members pm
 				INNER JOIN users u ON pm.member_id = u.id
 					WHERE pm.project_id = ?
@@ -55,51 +56,33 @@ func (pm *projectMembers) GetByProjectID(ctx context.Context, projectID uuid.UUI
 						LIMIT ? OFFSET ?
 					`)
 
-	rows, err := pm.db.Query(reboundQuery, projectID[:], searchSubQuery, searchSubQuery, searchSubQuery, pagination.Limit, pagination.Offset)
-
-	defer func() {
-		err = errs.Combine(err, rows.Close())
-	}()
-
+	rows, err := pm.db.QueryContext(ctx, reboundQuery, projectID[:], searchSubQuery, searchSubQuery, searchSubQuery, pagination.Limit, pagination.Offset)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
 
 	for rows.Next() {
-		pm := console.ProjectMember{}
-		var memberIDBytes, projectIDBytes []uint8
-		var memberID, projectID uuid.UUID
-
-		err = rows.Scan(&memberIDBytes, &projectIDBytes, &pm.CreatedAt)
-		if err != nil {
-			return nil, err
-		}
-
-		memberID, err := bytesToUUID(memberIDBytes)
-		if err != nil {
-			return nil, err
-		}
-
-		projectID, err = bytesToUUID(projectIDBytes)
+		member, err := scanProjectMember(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		pm.ProjectID = projectID
-		pm.MemberID = memberID
-
-		projectMembers = append(projectMembers, pm)
+		projectMembers = append(projectMembers, member)
 	}
 
-	return projectMembers, err
+	return projectMembers, rows.Err()
 }
 
-// Insert is a method for inserting project member into the database.
-func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID) (_ *console.ProjectMember, err error) {
+// Insert is a method for inserting project member with the given role into the database.
+func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) (_ *console.ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 	createdProjectMember, err := pm.methods.Create_ProjectMember(ctx,
 		dbx.ProjectMember_MemberId(memberID[:]),
-		dbx.ProjectMember_ProjectId(projectID[:]))
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Role(int(role)))
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +90,25 @@ func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.U
 	return projectMemberFromDBX(ctx, createdProjectMember)
 }
 
+// UpdateRole is a method for changing the role of project member.
+func (pm *projectMembers) UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	updated, err := pm.methods.Update_ProjectMember_By_MemberId_And_ProjectId(ctx,
+		dbx.ProjectMember_MemberId(memberID[:]),
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Update_Fields{
+			Role: dbx.ProjectMember_Role(int(role)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 // Delete is a method for deleting project member by memberID and projectID from the database.
 func (pm *projectMembers) Delete(ctx context.Context, memberID, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -139,10 +141,37 @@ func projectMemberFromDBX(ctx context.Context, projectMember *dbx.ProjectMember)
 	return &console.ProjectMember{
 		MemberID:  memberID,
 		ProjectID: projectID,
+		Role:      console.ProjectMemberRole(projectMember.Role),
 		CreatedAt: projectMember.CreatedAt,
 	}, nil
 }
 
+// scanProjectMember scans project member from a row selecting member_id, project_id, role and created_at.
+func scanProjectMember(row interface {
+	Scan(dest ...interface{}) error
+}) (member console.ProjectMember, err error) {
+	var memberIDBytes, projectIDBytes []byte
+	var role int
+
+	err = row.Scan(&memberIDBytes, &projectIDBytes, &role, &member.CreatedAt)
+	if err != nil {
+		return member, err
+	}
+
+	member.MemberID, err = bytesToUUID(memberIDBytes)
+	if err != nil {
+		return member, err
+	}
+
+	member.ProjectID, err = bytesToUUID(projectIDBytes)
+	if err != nil {
+		return member, err
+	}
+
+	member.Role = console.ProjectMemberRole(role)
+	return member, nil
+}
+
 // sanitizedOrderColumnName return valid order by column
 func sanitizedOrderColumnName(pmo console.ProjectMemberOrder) string {
 	switch pmo {
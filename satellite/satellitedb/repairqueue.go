@@ -11,8 +11,6 @@ import (
 	"github.com/lib/pq"
 	sqlite3 "github.com/mattn/go-sqlite3"
 
-	"storj.io/storj/internal/dbutil/pgutil"
-	"storj.io/storj/internal/dbutil/sqliteutil"
 	"storj.io/storj/pkg/pb"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/storage"
@@ -22,16 +20,14 @@ type repairQueue struct {
 	db *dbx.DB
 }
 
-func (r *repairQueue) Insert(ctx context.Context, seg *pb.InjuredSegment) (err error) {
+func (r *repairQueue) Insert(ctx context.Context, seg *pb.InjuredSegment, segmentHealth int) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = r.db.ExecContext(ctx, r.db.Rebind(`INSERT INTO injuredsegments ( path, data ) VALUES ( ?, ? )`), seg.Path, seg)
-	if err != nil {
-		if pgutil.IsConstraintError(err) || sqliteutil.IsConstraintError(err) {
-			return nil // quietly fail on reinsert
-		}
-		return err
-	}
-	return nil
+	// reinserting updates the priority of the segment, without resetting the repair attempt
+	_, err = r.db.ExecContext(ctx, r.db.Rebind(`
+		INSERT INTO injuredsegments ( path, data, segment_health ) VALUES ( ?, ?, ? )
+		ON CONFLICT ( path ) DO UPDATE SET data = excluded.data, segment_health = excluded.segment_health`),
+		seg.Path, seg, segmentHealth)
+	return err
 }
 
 func (r *repairQueue) postgresSelect(ctx context.Context) (seg *pb.InjuredSegment, err error) {
@@ -40,7 +36,7 @@ func (r *repairQueue) postgresSelect(ctx context.Context) (seg *pb.InjuredSegmen
 	UPDATE injuredsegments SET attempted = timezone('utc', now()) WHERE path = (
 		SELECT path FROM injuredsegments
 		WHERE attempted IS NULL OR attempted < timezone('utc', now()) - interval '1 hour'
-		ORDER BY path FOR UPDATE SKIP LOCKED LIMIT 1
+		ORDER BY segment_health, path FOR UPDATE SKIP LOCKED LIMIT 1
 	) RETURNING data`).Scan(&seg)
 	if err == sql.ErrNoRows {
 		err = storage.ErrEmptyQueue.New("")
@@ -56,7 +52,7 @@ func (r *repairQueue) sqliteSelect(ctx context.Context) (seg *pb.InjuredSegment,
 			SELECT path, data FROM injuredsegments
 			WHERE attempted IS NULL
 			OR attempted < datetime('now','-1 hours')
-			ORDER BY path LIMIT 1`)).Scan(&path, &seg)
+			ORDER BY segment_health, path LIMIT 1`)).Scan(&path, &seg)
 		if err != nil {
 			return err
 		}
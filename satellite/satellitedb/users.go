@@ -5,6 +5,8 @@ package satellitedb
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
@@ -15,7 +17,8 @@ import (
 
 // implementation of Users interface repository using spacemonkeygo/dbx orm
 type users struct {
-	db dbx.Methods
+	db      dbx.Methods
+	queryer queryer
 }
 
 // Get is a method for querying user from the database by id
@@ -54,6 +57,7 @@ func (users *users) Insert(ctx context.Context, user *console.User) (_ *console.
 		dbx.User_Email(user.Email),
 		dbx.User_FullName(user.FullName),
 		dbx.User_PasswordHash(user.PasswordHash),
+		dbx.User_MfaEnabled(false),
 		dbx.User_Create_Fields{
 			ShortName: dbx.User_ShortName(user.ShortName),
 		},
@@ -86,6 +90,98 @@ func (users *users) Update(ctx context.Context, user *console.User) (err error)
 	return err
 }
 
+// UpdateMFA is a method for updating two-factor authentication settings of user
+func (users *users) UpdateMFA(ctx context.Context, user *console.User) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.User_Update_Fields{
+		MfaEnabled:       dbx.User_MfaEnabled(user.MFAEnabled),
+		MfaSecretKey:     dbx.User_MfaSecretKey_Null(),
+		MfaRecoveryCodes: dbx.User_MfaRecoveryCodes_Null(),
+	}
+	if user.MFASecretKey != "" {
+		update.MfaSecretKey = dbx.User_MfaSecretKey(user.MFASecretKey)
+	}
+	if len(user.MFARecoveryCodes) > 0 {
+		codes, err := json.Marshal(user.MFARecoveryCodes)
+		if err != nil {
+			return err
+		}
+		update.MfaRecoveryCodes = dbx.User_MfaRecoveryCodes(string(codes))
+	}
+
+	_, err = users.db.Update_User_By_Id(ctx, dbx.User_Id(user.ID[:]), update)
+	return err
+}
+
+// UseMFAStep stores the time step of an accepted passcode and resets the failed attempts.
+// It returns false when the step isn't newer than the step of the last accepted passcode.
+func (users *users) UseMFAStep(ctx context.Context, id uuid.UUID, step int64) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := users.queryer.ExecContext(ctx, users.queryer.Rebind(`
+		UPDATE users SET mfa_last_step = ?, mfa_failed_attempts = NULL, mfa_failed_at = NULL
+		WHERE id = ? AND (mfa_last_step IS NULL OR mfa_last_step < ?)`),
+		step, id[:], step)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// UpdateMFARecoveryCodes replaces the recovery codes of user, when they haven't changed since reading them.
+// It returns false when the stored recovery codes differ from old.
+func (users *users) UpdateMFARecoveryCodes(ctx context.Context, id uuid.UUID, old, new []string) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(old) == 0 {
+		return false, nil
+	}
+	oldCodes, err := json.Marshal(old)
+	if err != nil {
+		return false, err
+	}
+
+	var newCodes interface{}
+	if len(new) > 0 {
+		codes, err := json.Marshal(new)
+		if err != nil {
+			return false, err
+		}
+		newCodes = string(codes)
+	}
+
+	result, err := users.queryer.ExecContext(ctx, users.queryer.Rebind(`
+		UPDATE users SET mfa_recovery_codes = ?
+		WHERE id = ? AND mfa_recovery_codes = ?`),
+		newCodes, id[:], string(oldCodes))
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// AddMFAFailure counts a failed two-factor authentication attempt of user.
+func (users *users) AddMFAFailure(ctx context.Context, id uuid.UUID, failedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = users.queryer.ExecContext(ctx, users.queryer.Rebind(`
+		UPDATE users SET mfa_failed_attempts = COALESCE(mfa_failed_attempts, 0) + 1, mfa_failed_at = ?
+		WHERE id = ?`),
+		failedAt.UTC(), id[:])
+	return err
+}
+
 // toUpdateUser creates dbx.User_Update_Fields with only non-empty fields as updatable
 func toUpdateUser(user *console.User) dbx.User_Update_Fields {
 	update := dbx.User_Update_Fields{
@@ -122,11 +218,30 @@ func userFromDBX(ctx context.Context, user *dbx.User) (_ *console.User, err erro
 		PasswordHash: user.PasswordHash,
 		Status:       console.UserStatus(user.Status),
 		CreatedAt:    user.CreatedAt,
+		MFAEnabled:   user.MfaEnabled,
 	}
 
 	if user.ShortName != nil {
 		result.ShortName = *user.ShortName
 	}
 
+	if user.MfaSecretKey != nil {
+		result.MFASecretKey = *user.MfaSecretKey
+	}
+
+	if user.MfaRecoveryCodes != nil {
+		if err := json.Unmarshal([]byte(*user.MfaRecoveryCodes), &result.MFARecoveryCodes); err != nil {
+			return nil, err
+		}
+	}
+
+	if user.MfaFailedAttempts != nil {
+		result.MFAFailedAttempts = *user.MfaFailedAttempts
+	}
+
+	if user.MfaFailedAt != nil {
+		result.MFAFailedAt = *user.MfaFailedAt
+	}
+
 	return &result, nil
 }
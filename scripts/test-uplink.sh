@@ -13,8 +13,11 @@ trap cleanup EXIT
 BUCKET=bucket-123
 SRC_DIR=$TMPDIR/source
 DST_DIR=$TMPDIR/dst
+SYNC_DIR=$TMPDIR/sync
+EMPTY_DIR=$TMPDIR/empty
+RECURSIVE_DIR=$TMPDIR/recursive
 
-mkdir -p "$SRC_DIR" "$DST_DIR"
+mkdir -p "$SRC_DIR" "$DST_DIR" "$EMPTY_DIR"
 
 random_bytes_file () {
     size=$1
@@ -32,12 +35,41 @@ uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" mb "sj://
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "$SRC_DIR/small-upload-testfile" "sj://$BUCKET/"
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "$SRC_DIR/big-upload-testfile" "sj://$BUCKET/"
 
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp --resume "$SRC_DIR/big-upload-testfile" "sj://$BUCKET/resumed-upload-testfile"
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rm "sj://$BUCKET/resumed-upload-testfile"
+
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "sj://$BUCKET/small-upload-testfile" "$DST_DIR"
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp "sj://$BUCKET/big-upload-testfile" "$DST_DIR"
 
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rm "sj://$BUCKET/small-upload-testfile"
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rm "sj://$BUCKET/big-upload-testfile"
 
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" sync "$SRC_DIR" "sj://$BUCKET/sync/"
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" sync "sj://$BUCKET/sync/" "$SYNC_DIR"
+
+if diff -r "$SRC_DIR" "$SYNC_DIR"
+then
+    echo "synced directory matches source directory"
+else
+    echo "synced directory does not match source directory"
+    exit 1
+fi
+
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" sync --delete "$EMPTY_DIR" "sj://$BUCKET/sync/"
+
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp --recursive "$SRC_DIR" "sj://$BUCKET/recursive/"
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" cp --recursive "sj://$BUCKET/recursive/" "$RECURSIVE_DIR"
+
+if diff -r "$SRC_DIR" "$RECURSIVE_DIR"
+then
+    echo "recursively copied directory matches source directory"
+else
+    echo "recursively copied directory does not match source directory"
+    exit 1
+fi
+
+uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rm --recursive "sj://$BUCKET/recursive/"
+
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" ls "sj://$BUCKET"
 
 uplink --config-dir "$GATEWAY_0_DIR" --debug.addr "$UPLINK_DEBUG_ADDR" rb "sj://$BUCKET"
@@ -6,6 +6,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 )
@@ -54,6 +55,18 @@ type Blobs interface {
 	Open(ctx context.Context, ref BlobRef) (BlobReader, error)
 	// Delete deletes the blob with the namespace and key
 	Delete(ctx context.Context, ref BlobRef) error
+	// Trash moves the blob with the namespace and key to the trash
+	Trash(ctx context.Context, ref BlobRef) error
+	// RestoreTrash restores all blobs in the namespace, which were trashed after the specified time
+	RestoreTrash(ctx context.Context, namespace []byte, trashedAfter time.Time) (keysRestored [][]byte, err error)
+	// EmptyTrash permanently deletes all blobs in the namespace, which were trashed before the specified time
+	EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keysDeleted [][]byte, err error)
+	// ListNamespaces returns all namespaces that have blobs or trash
+	ListNamespaces(ctx context.Context) ([][]byte, error)
+	// WalkNamespace calls fn for every blob in the namespace
+	WalkNamespace(ctx context.Context, namespace []byte, fn func(key []byte) error) error
+	// SpaceUsed returns how much space the blobs and the trash use
+	SpaceUsed(ctx context.Context) (int64, error)
 	// FreeSpace return how much free space left for writing
 	FreeSpace() (int64, error)
 }
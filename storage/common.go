@@ -23,6 +23,9 @@ var ErrKeyNotFound = errs.Class("key not found")
 // ErrEmptyKey is returned when an empty key is used in Put
 var ErrEmptyKey = errs.Class("empty key")
 
+// ErrValueChanged is returned when the current value of the key does not match the oldValue in CompareAndSwap
+var ErrValueChanged = errs.Class("value changed")
+
 // ErrEmptyQueue is returned when attempting to Dequeue from an empty queue
 var ErrEmptyQueue = errs.Class("empty queue")
 
@@ -64,6 +67,9 @@ type KeyValueStore interface {
 	GetAll(context.Context, Keys) (Values, error)
 	// Delete deletes key and the value
 	Delete(context.Context, Key) error
+	// CompareAndSwap atomically replaces oldValue with newValue. A nil
+	// oldValue requires the key not to exist and a nil newValue deletes it.
+	CompareAndSwap(ctx context.Context, key Key, oldValue, newValue Value) error
 	// List lists all keys starting from start and upto limit items
 	List(ctx context.Context, start Key, limit int) (Keys, error)
 	// Iterate iterates over items based on opts
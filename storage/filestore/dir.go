@@ -11,7 +11,9 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -42,6 +44,7 @@ func NewDir(path string) (*Dir, error) {
 	return dir, errs.Combine(
 		os.MkdirAll(dir.blobdir(), dirPermission),
 		os.MkdirAll(dir.tempdir(), dirPermission),
+		os.MkdirAll(dir.garbagedir(), dirPermission),
 		os.MkdirAll(dir.trashdir(), dirPermission),
 	)
 }
@@ -49,9 +52,13 @@ func NewDir(path string) (*Dir, error) {
 // Path returns the directory path
 func (dir *Dir) Path() string { return dir.path }
 
-func (dir *Dir) blobdir() string  { return filepath.Join(dir.path, "blob") }
-func (dir *Dir) tempdir() string  { return filepath.Join(dir.path, "tmp") }
-func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
+func (dir *Dir) blobdir() string    { return filepath.Join(dir.path, "blob") }
+func (dir *Dir) tempdir() string    { return filepath.Join(dir.path, "tmp") }
+func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage") }
+func (dir *Dir) trashdir() string   { return filepath.Join(dir.path, "trash") }
+
+// trashDateFormat is the format of the per day folders in the trash
+const trashDateFormat = "2006-01-02"
 
 // CreateTemporaryFile creates a preallocated temporary file in the temp directory
 // prealloc preallocates file to make writing faster
@@ -88,21 +95,49 @@ func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
 	}
 
 	namespace := pathEncoding.EncodeToString(ref.Namespace)
-	key := pathEncoding.EncodeToString(ref.Key)
-	if len(key) < 3 {
-		// ensure we always have at least
-		key = "11" + key
-	}
+	key := encodeKey(ref.Key)
 	return filepath.Join(dir.blobdir(), namespace, key[:2], key[2:]), nil
 }
 
-// blobToTrashPath converts blob reference to a filepath in transient storage
-// the files in trash are deleted in an interval (in case the initial deletion didn't work for some reason)
-func (dir *Dir) blobToTrashPath(ref storage.BlobRef) string {
+// blobToTrashPath converts blob reference to a filepath in the trash,
+// the blobs are grouped per namespace and by the day they were trashed
+func (dir *Dir) blobToTrashPath(ref storage.BlobRef, trashedAt time.Time) (string, error) {
+	if !ref.IsValid() {
+		return "", storage.ErrInvalidBlobRef.New("")
+	}
+
+	namespace := pathEncoding.EncodeToString(ref.Namespace)
+	key := encodeKey(ref.Key)
+	day := trashedAt.UTC().Format(trashDateFormat)
+	return filepath.Join(dir.trashdir(), namespace, day, key[:2], key[2:]), nil
+}
+
+// blobToGarbagePath converts blob reference to a filepath in transient storage
+// the files in garbage are deleted in an interval (in case the initial deletion didn't work for some reason)
+func (dir *Dir) blobToGarbagePath(ref storage.BlobRef) string {
 	name := []byte{}
 	name = append(name, ref.Namespace...)
 	name = append(name, ref.Key...)
-	return filepath.Join(dir.trashdir(), pathEncoding.EncodeToString(name))
+	return filepath.Join(dir.garbagedir(), pathEncoding.EncodeToString(name))
+}
+
+// encodeKey encodes the key for use in a path
+func encodeKey(key []byte) string {
+	encoded := pathEncoding.EncodeToString(key)
+	if len(encoded) < 3 {
+		// ensure we always have at least
+		encoded = "11" + encoded
+	}
+	return encoded
+}
+
+// decodeKey decodes the key from the prefix folder and the file name
+func decodeKey(prefix, name string) ([]byte, error) {
+	encoded := prefix + name
+	if strings.HasPrefix(encoded, "11") {
+		encoded = encoded[2:]
+	}
+	return pathEncoding.DecodeString(encoded)
 }
 
 // Commit commits temporary file to the permanent storage
@@ -169,21 +204,21 @@ func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 		return err
 	}
 
-	trashPath := dir.blobToTrashPath(ref)
+	garbagePath := dir.blobToGarbagePath(ref)
 
-	// move to trash folder, this is allowed for some OS-es
-	moveErr := rename(path, trashPath)
+	// move to garbage folder, this is allowed for some OS-es
+	moveErr := rename(path, garbagePath)
 
 	// ignore concurrent delete
 	if os.IsNotExist(moveErr) {
 		return nil
 	}
 	if moveErr != nil {
-		trashPath = path
+		garbagePath = path
 	}
 
 	// try removing the file
-	err = os.Remove(trashPath)
+	err = os.Remove(garbagePath)
 
 	// ignore concurrent deletes
 	if os.IsNotExist(err) {
@@ -193,7 +228,7 @@ func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 	// this may fail, because someone might be still reading it
 	if err != nil {
 		dir.mu.Lock()
-		dir.deleteQueue = append(dir.deleteQueue, trashPath)
+		dir.deleteQueue = append(dir.deleteQueue, garbagePath)
 		dir.mu.Unlock()
 	}
 
@@ -233,11 +268,304 @@ func (dir *Dir) GarbageCollect(ctx context.Context) (err error) {
 		dir.mu.Unlock()
 	}
 
-	// remove anything left in the trashdir
-	_ = removeAllContent(ctx, dir.trashdir())
+	// remove anything left in the garbagedir
+	_ = removeAllContent(ctx, dir.garbagedir())
+	// older versions used the trashdir for pending deletions
+	_ = dir.removeLegacyTrash(ctx)
+	return nil
+}
+
+// removeLegacyTrash removes files left directly in the trashdir by older versions,
+// which used it for pending deletions instead of the garbagedir
+func (dir *Dir) removeLegacyTrash(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	names, err := readDirNames(dir.trashdir())
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		path := filepath.Join(dir.trashdir(), name)
+		info, err := os.Lstat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		// the file might be still in use, so ignore the error
+		_ = os.Remove(path)
+	}
 	return nil
 }
 
+// Trash moves the file with the specified ref to the trash.
+// The file is kept there until it's restored or the trash is emptied.
+func (dir *Dir) Trash(ctx context.Context, ref storage.BlobRef, trashedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	path, err := dir.blobToPath(ref)
+	if err != nil {
+		return err
+	}
+
+	trashPath, err := dir.blobToTrashPath(ref, trashedAt)
+	if err != nil {
+		return err
+	}
+
+	// check whether the blob exists, to avoid creating empty folders in trash
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+
+	mkdirErr := os.MkdirAll(filepath.Dir(trashPath), dirPermission)
+	if mkdirErr != nil && !os.IsExist(mkdirErr) {
+		return mkdirErr
+	}
+
+	err = rename(path, trashPath)
+	if err != nil {
+		return err
+	}
+
+	// the modification time is used to find out when the blob was trashed
+	return os.Chtimes(trashPath, trashedAt, trashedAt)
+}
+
+// RestoreTrash moves the files in the namespace, which were trashed after
+// the specified time, back to the permanent storage.
+func (dir *Dir) RestoreTrash(ctx context.Context, namespace []byte, trashedAfter time.Time) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	firstDay := trashedAfter.UTC().Format(trashDateFormat)
+	err = dir.walkTrash(ctx, namespace, func(day string, key []byte, path string, info os.FileInfo) error {
+		if day < firstDay || info.ModTime().Before(trashedAfter) {
+			return nil
+		}
+
+		blobPath, err := dir.blobToPath(storage.BlobRef{Namespace: namespace, Key: key})
+		if err != nil {
+			return err
+		}
+
+		// a blob with the same key has been written in the meantime, keep the newer one
+		if _, err := os.Stat(blobPath); err == nil {
+			return nil
+		}
+
+		mkdirErr := os.MkdirAll(filepath.Dir(blobPath), dirPermission)
+		if mkdirErr != nil && !os.IsExist(mkdirErr) {
+			return mkdirErr
+		}
+
+		if err := rename(path, blobPath); err != nil {
+			return err
+		}
+
+		keysRestored = append(keysRestored, key)
+		return nil
+	})
+	return keysRestored, err
+}
+
+// EmptyTrash permanently deletes the files in the namespace, which were
+// trashed before the specified time.
+func (dir *Dir) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keysDeleted [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastDay := trashedBefore.UTC().Format(trashDateFormat)
+	err = dir.walkTrash(ctx, namespace, func(day string, key []byte, path string, info os.FileInfo) error {
+		if day > lastDay || !info.ModTime().Before(trashedBefore) {
+			return nil
+		}
+
+		err := os.Remove(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		bytesEmptied += info.Size()
+		keysDeleted = append(keysDeleted, key)
+		return nil
+	})
+	return bytesEmptied, keysDeleted, err
+}
+
+// walkTrash calls fn for every file in the trash of the namespace,
+// empty folders are removed afterwards.
+func (dir *Dir) walkTrash(ctx context.Context, namespace []byte, fn func(day string, key []byte, path string, info os.FileInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaceDir := filepath.Join(dir.trashdir(), pathEncoding.EncodeToString(namespace))
+
+	days, err := readDirNames(namespaceDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var errlist errs.Group
+	for _, day := range days {
+		dayDir := filepath.Join(namespaceDir, day)
+
+		prefixes, err := readDirNames(dayDir)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		for _, prefix := range prefixes {
+			prefixDir := filepath.Join(dayDir, prefix)
+
+			names, err := readDirNames(prefixDir)
+			if err != nil {
+				errlist.Add(err)
+				continue
+			}
+
+			for _, name := range names {
+				if err := ctx.Err(); err != nil {
+					return errs.Combine(errlist.Err(), err)
+				}
+
+				path := filepath.Join(prefixDir, name)
+				info, err := os.Stat(path)
+				if err != nil {
+					if !os.IsNotExist(err) {
+						errlist.Add(err)
+					}
+					continue
+				}
+
+				key, err := decodeKey(prefix, name)
+				if err != nil {
+					errlist.Add(err)
+					continue
+				}
+
+				errlist.Add(fn(day, key, path, info))
+			}
+
+			// only removes the folder when it's empty
+			_ = os.Remove(prefixDir)
+		}
+		_ = os.Remove(dayDir)
+	}
+
+	return errlist.Err()
+}
+
+// ListNamespaces returns all namespaces that have blobs or trash.
+func (dir *Dir) ListNamespaces(ctx context.Context) (namespaces [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := map[string]bool{}
+	for _, root := range []string{dir.blobdir(), dir.trashdir()} {
+		names, err := readDirNames(root)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			if seen[name] {
+				continue
+			}
+			if info, err := os.Stat(filepath.Join(root, name)); err != nil || !info.IsDir() {
+				continue
+			}
+			namespace, err := pathEncoding.DecodeString(name)
+			if err != nil {
+				// ignore entries which weren't created by us
+				continue
+			}
+			seen[name] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces, nil
+}
+
+// WalkNamespace calls fn for every blob in the namespace.
+func (dir *Dir) WalkNamespace(ctx context.Context, namespace []byte, fn func(key []byte) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaceDir := filepath.Join(dir.blobdir(), pathEncoding.EncodeToString(namespace))
+
+	prefixes, err := readDirNames(namespaceDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, prefix := range prefixes {
+		names, err := readDirNames(filepath.Join(namespaceDir, prefix))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+
+		for _, name := range names {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			key, err := decodeKey(prefix, name)
+			if err != nil {
+				// ignore entries which weren't created by us
+				continue
+			}
+			if err := fn(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SpaceUsed returns the total size of the blobs and the trash.
+func (dir *Dir) SpaceUsed(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, root := range []string{dir.blobdir(), dir.trashdir()} {
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				// the file might have been deleted while walking
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				total += info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// readDirNames returns the names of all entries in the folder
+func readDirNames(path string) ([]string, error) {
+	dir, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	names, err := dir.Readdirnames(-1)
+	return names, errs.Combine(err, dir.Close())
+}
+
 // removeAllContent deletes everything in the folder
 func removeAllContent(ctx context.Context, path string) (err error) {
 	defer mon.Task()(&ctx)(&err)
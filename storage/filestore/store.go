@@ -6,6 +6,7 @@ package filestore
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -64,6 +65,50 @@ func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) (err error)
 	return Error.Wrap(err)
 }
 
+// Trash moves the blob with the specified ref to the trash
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.dir.Trash(ctx, ref, time.Now())
+	if os.IsNotExist(err) {
+		return err
+	}
+	return Error.Wrap(err)
+}
+
+// RestoreTrash restores the blobs in the namespace, which were trashed after the specified time
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte, trashedAfter time.Time) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	keysRestored, err := store.dir.RestoreTrash(ctx, namespace, trashedAfter)
+	return keysRestored, Error.Wrap(err)
+}
+
+// EmptyTrash permanently deletes the blobs in the namespace, which were trashed before the specified time
+func (store *Store) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (_ int64, _ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bytesEmptied, keysDeleted, err := store.dir.EmptyTrash(ctx, namespace, trashedBefore)
+	return bytesEmptied, keysDeleted, Error.Wrap(err)
+}
+
+// ListNamespaces returns all namespaces that have blobs or trash
+func (store *Store) ListNamespaces(ctx context.Context) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	namespaces, err := store.dir.ListNamespaces(ctx)
+	return namespaces, Error.Wrap(err)
+}
+
+// WalkNamespace calls fn for every blob in the namespace
+func (store *Store) WalkNamespace(ctx context.Context, namespace []byte, fn func(key []byte) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(store.dir.WalkNamespace(ctx, namespace, fn))
+}
+
+// SpaceUsed returns how much space the blobs and the trash use
+func (store *Store) SpaceUsed(ctx context.Context) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	spaceUsed, err := store.dir.SpaceUsed(ctx)
+	return spaceUsed, Error.Wrap(err)
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted
 func (store *Store) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -219,3 +220,128 @@ func TestDeleteWhileReading(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTrashAndRestore(t *testing.T) {
+	const blobSize = 1 << 10
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	namespace := testrand.Bytes(32)
+	data := testrand.Bytes(blobSize)
+
+	refs := []storage.BlobRef{
+		{Namespace: namespace, Key: testrand.Bytes(32)},
+		{Namespace: namespace, Key: testrand.Bytes(32)},
+		// short keys are padded in the path
+		{Namespace: namespace, Key: []byte{1}},
+	}
+
+	for _, ref := range refs {
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{namespace}, namespaces)
+
+	beforeTrash := time.Now().Add(-time.Second)
+
+	for _, ref := range refs {
+		require.NoError(t, store.Trash(ctx, ref))
+
+		_, err = store.Open(ctx, ref)
+		require.True(t, os.IsNotExist(err), "trashed blob shouldn't be readable")
+	}
+
+	// trashing a missing blob should fail
+	err = store.Trash(ctx, refs[0])
+	require.True(t, os.IsNotExist(err))
+
+	// restoring pieces trashed in the future shouldn't do anything
+	restored, err := store.RestoreTrash(ctx, namespace, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, restored)
+
+	restored, err = store.RestoreTrash(ctx, namespace, beforeTrash)
+	require.NoError(t, err)
+	require.Len(t, restored, len(refs))
+
+	for _, ref := range refs {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		result, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		require.Equal(t, data, result)
+
+		require.NoError(t, store.Trash(ctx, ref))
+	}
+
+	// emptying pieces trashed before they were trashed shouldn't do anything
+	emptied, deleted, err := store.EmptyTrash(ctx, namespace, beforeTrash)
+	require.NoError(t, err)
+	require.Zero(t, emptied)
+	require.Empty(t, deleted)
+
+	emptied, deleted, err = store.EmptyTrash(ctx, namespace, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(refs)*blobSize), emptied)
+	require.Len(t, deleted, len(refs))
+
+	restored, err = store.RestoreTrash(ctx, namespace, beforeTrash)
+	require.NoError(t, err)
+	require.Empty(t, restored)
+
+	for _, ref := range refs {
+		_, err = store.Open(ctx, ref)
+		require.True(t, os.IsNotExist(err), "emptied blob shouldn't be readable")
+	}
+}
+
+func TestWalkNamespaceAndSpaceUsed(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	keys := map[string]bool{}
+	for i := 0; i < 5; i++ {
+		ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		keys[string(ref.Key)] = true
+
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(100))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	walked := map[string]bool{}
+	err = store.WalkNamespace(ctx, namespace, func(key []byte) error {
+		walked[string(key)] = true
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, keys, walked)
+
+	// walking an unknown namespace finds nothing
+	err = store.WalkNamespace(ctx, testrand.Bytes(32), func(key []byte) error {
+		return errors.New("unexpected blob")
+	})
+	require.NoError(t, err)
+
+	used, err := store.SpaceUsed(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 500, used)
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("cas/key")
+	defer func() { _ = store.Delete(ctx, key) }()
+
+	t.Run("Create", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("missing"), storage.Value("a"))
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("swapping a missing key should fail with key not found: %v", err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, nil, storage.Value("a"))
+		if err != nil {
+			t.Fatalf("failed to create %q: %v", key, err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, nil, storage.Value("b"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("creating an existing key should fail with value changed: %v", err)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("b"), storage.Value("c"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("swapping a changed value should fail with value changed: %v", err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, storage.Value("a"), storage.Value("b"))
+		if err != nil {
+			t.Fatalf("failed to update %q: %v", key, err)
+		}
+
+		value, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("failed to get %q: %v", key, err)
+		}
+		if !bytes.Equal(value, storage.Value("b")) {
+			t.Fatalf("invalid value for %q = b: got %v", key, value)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("a"), nil)
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("deleting a changed value should fail with value changed: %v", err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, storage.Value("b"), nil)
+		if err != nil {
+			t.Fatalf("failed to delete %q: %v", key, err)
+		}
+
+		_, err = store.Get(ctx, key)
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("%q should be deleted: %v", key, err)
+		}
+	})
+}
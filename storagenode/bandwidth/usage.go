@@ -82,6 +82,11 @@ func TotalMonthlySummary(ctx context.Context, db DB) (*Usage, error) {
 	return db.Summary(ctx, getBeginningOfMonth(), time.Now())
 }
 
+// MonthlySummaryBySatellite returns bandwidth usage for current month per satellite
+func MonthlySummaryBySatellite(ctx context.Context, db DB) (map[storj.NodeID]*Usage, error) {
+	return db.SummaryBySatellite(ctx, getBeginningOfMonth(), time.Now())
+}
+
 func getBeginningOfMonth() time.Time {
 	t := time.Now()
 	y, m, _ := t.Date()
@@ -1,13 +1,14 @@
 // Copyright (C) 2019 Storj Labs, Inc.
 // See LICENSE for copying information.
 
-// Package collector implements expired piece deletion from storage node.
+// Package collector implements expired piece and trash deletion from storage node.
 package collector
 
 import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
@@ -21,7 +22,8 @@ var mon = monkit.Package()
 
 // Config defines parameters for storage node Collector.
 type Config struct {
-	Interval time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	Interval        time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	TrashExpiration time.Duration `help:"how long deleted pieces are kept in the trash before they are permanently deleted" default:"168h0m0s"`
 }
 
 // Service implements collecting expired pieces on the storage node.
@@ -31,6 +33,8 @@ type Service struct {
 	pieceinfos  pieces.DB
 	usedSerials piecestore.UsedSerials
 
+	trashExpiration time.Duration
+
 	Loop sync2.Cycle
 }
 
@@ -41,7 +45,10 @@ func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, use
 		pieces:      pieces,
 		pieceinfos:  pieceinfos,
 		usedSerials: usedSerials,
-		Loop:        *sync2.NewCycle(config.Interval),
+
+		trashExpiration: config.TrashExpiration,
+
+		Loop: *sync2.NewCycle(config.Interval),
 	}
 }
 
@@ -54,6 +61,10 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		if err != nil {
 			service.log.Error("error during collecting pieces: ", zap.Error(err))
 		}
+		err = service.EmptyTrash(ctx, time.Now())
+		if err != nil {
+			service.log.Error("error during emptying trash: ", zap.Error(err))
+		}
 		return nil
 	})
 }
@@ -116,3 +127,32 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 
 	return nil
 }
+
+// EmptyTrash permanently deletes pieces that have been in the trash for longer than the trash expiration.
+func (service *Service) EmptyTrash(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trashedBefore := now.Add(-service.trashExpiration)
+
+	satellites, err := service.pieces.Satellites(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errlist errs.Group
+	for _, satellite := range satellites {
+		bytes, pieceIDs, err := service.pieces.EmptyTrash(ctx, satellite, trashedBefore)
+		if err != nil {
+			service.log.Error("unable to empty trash", zap.Stringer("satellite id", satellite), zap.Error(err))
+			errlist.Add(err)
+		}
+
+		errlist.Add(service.pieceinfos.DeleteTrashed(ctx, satellite, trashedBefore))
+
+		if len(pieceIDs) > 0 {
+			service.log.Info("empty trash", zap.Stringer("satellite id", satellite), zap.Int("count", len(pieceIDs)), zap.Stringer("size", memory.Size(bytes)))
+		}
+	}
+
+	return errlist.Err()
+}
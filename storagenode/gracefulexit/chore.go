@@ -0,0 +1,295 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package gracefulexit implements the storage node side of leaving a
+// satellite gracefully, by transferring the pieces of the satellite to the
+// storage nodes it selects.
+package gracefulexit
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error is the default error class for graceful exit errors
+	Error = errs.Class("graceful exit")
+
+	mon = monkit.Package()
+)
+
+// deleteBatchSize is the number of pieces deleted at once after an exit
+const deleteBatchSize = 1000
+
+// Config contains configurable values for graceful exit
+type Config struct {
+	ChoreInterval time.Duration `help:"how often to process the graceful exits from satellites" releaseDefault:"15m" devDefault:"10s"`
+}
+
+// Chore processes the graceful exits from satellites. It asks the satellites
+// which pieces to transfer to which storage nodes, until they report the exit
+// as finished.
+type Chore struct {
+	log  *zap.Logger
+	Loop sync2.Cycle
+
+	identity  *identity.FullIdentity
+	db        DB
+	store     *pieces.Store
+	pieceinfo pieces.DB
+	kademlia  *kademlia.Kademlia
+	transport transport.Client
+}
+
+// NewChore creates a new graceful exit chore
+func NewChore(log *zap.Logger, config Config, identity *identity.FullIdentity, db DB, store *pieces.Store, pieceinfo pieces.DB, kademlia *kademlia.Kademlia, transport transport.Client) *Chore {
+	return &Chore{
+		log:  log,
+		Loop: *sync2.NewCycle(config.ChoreInterval),
+
+		identity:  identity,
+		db:        db,
+		store:     store,
+		pieceinfo: pieceinfo,
+		kademlia:  kademlia,
+		transport: transport,
+	}
+}
+
+// Run starts the chore
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		if err := chore.ProcessExits(ctx); err != nil {
+			chore.log.Error("error processing graceful exits", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the chore
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// ProcessExits processes all graceful exits, which aren't finished yet
+func (chore *Chore) ProcessExits(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := chore.db.List(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, exit := range exits {
+		if exit.FinishedAt != nil {
+			continue
+		}
+		if err := chore.processExit(ctx, exit.SatelliteID); err != nil {
+			group.Add(Error.New("satellite %s: %v", exit.SatelliteID, err))
+		}
+	}
+	return group.Err()
+}
+
+// processExit asks the satellite for the pieces to transfer, until it has no
+// more work or reports the exit as finished.
+func (chore *Chore) processExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellite, err := chore.kademlia.FindNode(ctx, satelliteID)
+	if err != nil {
+		return Error.New("unable to find satellite on the network: %v", err)
+	}
+
+	conn, err := chore.transport.DialNode(ctx, &satellite)
+	if err != nil {
+		return Error.New("unable to connect to the satellite: %v", err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	stream, err := pb.NewSatelliteGracefulExitClient(conn).Process(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, stream.CloseSend()) }()
+
+	for {
+		message, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		switch {
+		case message.NotReady != nil:
+			chore.log.Info("graceful exit not ready yet", zap.Stringer("satellite ID", satelliteID))
+			return nil
+		case message.TransferPiece != nil:
+			response := chore.transfer(ctx, satelliteID, message.TransferPiece)
+			if err := stream.Send(response); err != nil {
+				return Error.Wrap(err)
+			}
+		case message.DeletePiece != nil:
+			pieceID := message.DeletePiece.PieceId
+			if err := chore.deleteTransferred(ctx, satelliteID, pieceID); err != nil {
+				chore.log.Error("unable to delete transferred piece", zap.Stringer("piece ID", pieceID), zap.Error(err))
+			}
+		case message.ExitCompleted != nil:
+			return chore.complete(ctx, satelliteID, true)
+		case message.ExitFailed != nil:
+			chore.log.Warn("graceful exit failed", zap.Stringer("satellite ID", satelliteID), zap.Stringer("reason", message.ExitFailed.Reason))
+			return chore.complete(ctx, satelliteID, false)
+		}
+	}
+}
+
+// transfer uploads a piece to the storage node selected by the satellite
+func (chore *Chore) transfer(ctx context.Context, satelliteID storj.NodeID, transfer *pb.TransferPiece) *pb.StorageNodeMessage {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	pieceID := transfer.PieceId
+	failed := func(code pb.TransferFailed_Error, cause error) *pb.StorageNodeMessage {
+		err = cause
+		chore.log.Warn("piece transfer failed", zap.Stringer("piece ID", pieceID), zap.Error(cause))
+		if err := chore.db.IncrementProgress(ctx, satelliteID, 0, 1, 0); err != nil {
+			chore.log.Error("unable to update graceful exit progress", zap.Error(err))
+		}
+		return &pb.StorageNodeMessage{
+			Failed: &pb.TransferFailed{PieceId: pieceID, Error: code},
+		}
+	}
+
+	if transfer.Limit == nil {
+		return failed(pb.TransferFailed_UNKNOWN, Error.New("missing order limit"))
+	}
+
+	info, err := chore.pieceinfo.Get(ctx, satelliteID, pieceID)
+	if err != nil {
+		return failed(pb.TransferFailed_NOT_FOUND, err)
+	}
+	reader, err := chore.store.Reader(ctx, satelliteID, pieceID)
+	if err != nil {
+		return failed(pb.TransferFailed_NOT_FOUND, err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			chore.log.Debug("unable to close piece reader", zap.Error(err))
+		}
+	}()
+
+	client, err := piecestore.Dial(ctx, chore.transport, &pb.Node{
+		Id:      transfer.Limit.StorageNodeId,
+		Address: transfer.StorageNodeAddress,
+	}, chore.log.Named("piecestore"), signing.SignerFromFullIdentity(chore.identity), piecestore.DefaultConfig)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE, err)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			chore.log.Debug("unable to close piecestore client", zap.Error(err))
+		}
+	}()
+
+	upload, err := client.Upload(ctx, transfer.Limit)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE, err)
+	}
+	// the piece reader wraps io.EOF, so limit the reads to the piece size
+	if _, err := io.Copy(upload, io.LimitReader(reader, reader.Size())); err != nil {
+		return failed(pb.TransferFailed_UNKNOWN, errs.Combine(err, upload.Cancel(ctx)))
+	}
+	hash, err := upload.Commit(ctx)
+	if err != nil {
+		return failed(pb.TransferFailed_UNKNOWN, err)
+	}
+
+	return &pb.StorageNodeMessage{
+		Succeeded: &pb.TransferSucceeded{
+			PieceId:              pieceID,
+			OriginalPieceHash:    info.UplinkPieceHash,
+			OriginalUplinkId:     info.Uplink.ID,
+			ReplacementPieceHash: hash,
+		},
+	}
+}
+
+// deleteTransferred deletes a piece the satellite confirmed as transferred
+func (chore *Chore) deleteTransferred(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := chore.pieceinfo.Get(ctx, satelliteID, pieceID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := chore.db.IncrementProgress(ctx, satelliteID, 1, 0, info.PieceSize); err != nil {
+		return Error.Wrap(err)
+	}
+	return chore.deletePiece(ctx, satelliteID, pieceID)
+}
+
+// complete finishes the exit. After a successful exit the pieces of the
+// satellite, which weren't transferred, aren't needed anymore.
+func (chore *Chore) complete(ctx context.Context, satelliteID storj.NodeID, successful bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := chore.db.Complete(ctx, satelliteID, time.Now(), successful); err != nil {
+		return Error.Wrap(err)
+	}
+	chore.log.Info("graceful exit finished", zap.Stringer("satellite ID", satelliteID), zap.Bool("successful", successful))
+
+	if !successful {
+		return nil
+	}
+
+	var cursor storj.PieceID
+	createdBefore := time.Now()
+	for {
+		pieceIDs, err := chore.pieceinfo.GetPieceIDs(ctx, satelliteID, createdBefore, cursor, deleteBatchSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(pieceIDs) == 0 {
+			return nil
+		}
+		cursor = pieceIDs[len(pieceIDs)-1]
+
+		for _, pieceID := range pieceIDs {
+			if err := chore.deletePiece(ctx, satelliteID, pieceID); err != nil {
+				chore.log.Error("unable to delete piece", zap.Stringer("piece ID", pieceID), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (chore *Chore) deletePiece(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := chore.store.Delete(ctx, satelliteID, pieceID); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(chore.pieceinfo.Delete(ctx, satelliteID, pieceID))
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Status is the progress of the graceful exit from a satellite
+type Status struct {
+	SatelliteID       storj.NodeID
+	InitiatedAt       time.Time
+	FinishedAt        *time.Time
+	PiecesTransferred int64
+	PiecesFailed      int64
+	BytesTransferred  int64
+	Successful        bool
+}
+
+// DB stores the graceful exits from satellites
+type DB interface {
+	// Initiate starts the graceful exit from a satellite
+	Initiate(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time) error
+	// IncrementProgress adds to the transferred pieces and bytes of an exit
+	IncrementProgress(ctx context.Context, satelliteID storj.NodeID, piecesTransferred, piecesFailed, bytesTransferred int64) error
+	// Complete finishes the graceful exit from a satellite
+	Complete(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, successful bool) error
+	// Get returns the graceful exit from a satellite, nil if there's none
+	Get(ctx context.Context, satelliteID storj.NodeID) (*Status, error)
+	// List returns all graceful exits
+	List(ctx context.Context) ([]*Status, error)
+}
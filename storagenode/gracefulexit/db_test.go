@@ -0,0 +1,64 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestDB(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		exits := db.GracefulExit()
+
+		satellite0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		satellite1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+		status, err := exits.Get(ctx, satellite0)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+
+		now := time.Now()
+		require.NoError(t, exits.Initiate(ctx, satellite0, now))
+		require.NoError(t, exits.Initiate(ctx, satellite1, now.Add(time.Second)))
+		// an exit can be initiated only once
+		require.Error(t, exits.Initiate(ctx, satellite0, now))
+
+		require.NoError(t, exits.IncrementProgress(ctx, satellite0, 2, 1, 1024))
+		require.NoError(t, exits.IncrementProgress(ctx, satellite0, 1, 0, 512))
+		require.NoError(t, exits.Complete(ctx, satellite0, now.Add(time.Hour), true))
+
+		status, err = exits.Get(ctx, satellite0)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		assert.Equal(t, satellite0, status.SatelliteID)
+		assert.True(t, status.InitiatedAt.Equal(now))
+		require.NotNil(t, status.FinishedAt)
+		assert.True(t, status.FinishedAt.Equal(now.Add(time.Hour)))
+		assert.EqualValues(t, 3, status.PiecesTransferred)
+		assert.EqualValues(t, 1, status.PiecesFailed)
+		assert.EqualValues(t, 1536, status.BytesTransferred)
+		assert.True(t, status.Successful)
+
+		statuses, err := exits.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		assert.Equal(t, satellite0, statuses[0].SatelliteID)
+		assert.Equal(t, satellite1, statuses[1].SatelliteID)
+		assert.Nil(t, statuses[1].FinishedAt)
+		assert.False(t, statuses[1].Successful)
+	})
+}
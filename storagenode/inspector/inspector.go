@@ -4,8 +4,10 @@
 package inspector
 
 import (
+	"bytes"
 	"context"
 	"net"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,8 +20,13 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/trust"
 )
 
 var (
@@ -29,12 +36,21 @@ var (
 	Error = errs.Class("piecestore inspector")
 )
 
+// maxExportOrders is the largest number of orders returned by a single ExportOrders call.
+const maxExportOrders = 1000
+
 // Endpoint does inspectory things
 type Endpoint struct {
 	log       *zap.Logger
+	pieces    *pieces.Store
 	pieceInfo pieces.DB
 	kademlia  *kademlia.Kademlia
 	usageDB   bandwidth.DB
+	orders    orders.DB
+	monitor   *monitor.Service
+	exits     gracefulexit.DB
+	trust     *trust.Pool
+	scrubber  *scrubber.Service
 
 	startTime        time.Time
 	pieceStoreConfig piecestore.OldConfig
@@ -44,17 +60,29 @@ type Endpoint struct {
 // NewEndpoint creates piecestore inspector instance
 func NewEndpoint(
 	log *zap.Logger,
+	pieces *pieces.Store,
 	pieceInfo pieces.DB,
 	kademlia *kademlia.Kademlia,
 	usageDB bandwidth.DB,
+	orders orders.DB,
+	monitor *monitor.Service,
+	exits gracefulexit.DB,
+	trust *trust.Pool,
+	scrubber *scrubber.Service,
 	pieceStoreConfig piecestore.OldConfig,
 	dashbaordAddress net.Addr) *Endpoint {
 
 	return &Endpoint{
 		log:              log,
+		pieces:           pieces,
 		pieceInfo:        pieceInfo,
 		kademlia:         kademlia,
 		usageDB:          usageDB,
+		orders:           orders,
+		monitor:          monitor,
+		exits:            exits,
+		trust:            trust,
+		scrubber:         scrubber,
 		pieceStoreConfig: pieceStoreConfig,
 		dashboardAddress: dashbaordAddress,
 		startTime:        time.Now(),
@@ -135,17 +163,41 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *pb.Dashboar
 		queried = nil
 	}
 
+	usages, err := inspector.monitor.SatelliteUsages(ctx)
+	if err != nil {
+		return &pb.DashboardResponse{}, Error.Wrap(err)
+	}
+	var satellites []*pb.SatelliteUsage
+	for _, usage := range usages {
+		satellites = append(satellites, &pb.SatelliteUsage{
+			SatelliteId:    usage.SatelliteID,
+			SpaceUsed:      usage.SpaceUsed,
+			SpaceQuota:     usage.SpaceQuota,
+			BandwidthUsed:  usage.BandwidthUsed,
+			BandwidthQuota: usage.BandwidthQuota,
+		})
+	}
+
+	expiredOrders, expiredBytes, err := inspector.orders.StatusTotals(ctx, orders.StatusExpired)
+	if err != nil {
+		return &pb.DashboardResponse{}, Error.Wrap(err)
+	}
+
 	return &pb.DashboardResponse{
-		NodeId:           inspector.kademlia.Local().Id,
-		NodeConnections:  int64(len(nodes)),
-		BootstrapAddress: strings.Join(bsNodes, ", "),
-		InternalAddress:  "",
-		ExternalAddress:  inspector.kademlia.Local().Address.Address,
-		DashboardAddress: inspector.dashboardAddress.String(),
-		LastPinged:       pinged,
-		LastQueried:      queried,
-		Uptime:           ptypes.DurationProto(time.Since(inspector.startTime)),
-		Stats:            statsSummary,
+		NodeId:            inspector.kademlia.Local().Id,
+		NodeConnections:   int64(len(nodes)),
+		BootstrapAddress:  strings.Join(bsNodes, ", "),
+		InternalAddress:   "",
+		ExternalAddress:   inspector.kademlia.Local().Address.Address,
+		DashboardAddress:  inspector.dashboardAddress.String(),
+		LastPinged:        pinged,
+		LastQueried:       queried,
+		Uptime:            ptypes.DurationProto(time.Since(inspector.startTime)),
+		DamagedPieces:     int64(len(inspector.scrubber.Status().Damaged)),
+		Satellites:        satellites,
+		ExpiredOrders:     expiredOrders,
+		ExpiredOrderBytes: expiredBytes,
+		Stats:             statsSummary,
 	}, nil
 }
 
@@ -160,3 +212,194 @@ func (inspector *Endpoint) Dashboard(ctx context.Context, in *pb.DashboardReques
 	}
 	return data, nil
 }
+
+// RestoreTrash restores all pieces of a satellite, which were trashed after the specified time
+func (inspector *Endpoint) RestoreTrash(ctx context.Context, in *pb.RestoreTrashRequest) (out *pb.RestoreTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	restored, err := inspector.pieces.RestoreTrash(ctx, in.SatelliteId, in.TrashedAfter)
+	if err != nil {
+		inspector.log.Error("unable to restore trash", zap.Stringer("satellite id", in.SatelliteId), zap.Error(err))
+	}
+
+	// only the pieces, which were moved out of the trash, are unmarked, because a piece
+	// with the same ID may have been uploaded again in the meantime
+	infoErr := inspector.pieceInfo.RestoreTrash(ctx, in.SatelliteId, restored)
+	if err := errs.Combine(err, infoErr); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	inspector.log.Info("restored trash", zap.Stringer("satellite id", in.SatelliteId), zap.Int("count", len(restored)))
+
+	return &pb.RestoreTrashResponse{RestoredCount: int64(len(restored))}, nil
+}
+
+// GracefulExitSatellite initiates the graceful exit from a satellite
+func (inspector *Endpoint) GracefulExitSatellite(ctx context.Context, in *pb.GracefulExitSatelliteRequest) (out *pb.GracefulExitSatelliteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := inspector.trust.VerifySatelliteID(ctx, in.SatelliteId); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	existing, err := inspector.exits.Get(ctx, in.SatelliteId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if existing != nil {
+		return nil, Error.New("graceful exit from satellite %s already initiated", in.SatelliteId)
+	}
+
+	if err := inspector.exits.Initiate(ctx, in.SatelliteId, time.Now()); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	inspector.log.Info("initiated graceful exit", zap.Stringer("satellite id", in.SatelliteId))
+
+	return &pb.GracefulExitSatelliteResponse{}, nil
+}
+
+// GracefulExitStatus returns the progress of all graceful exits
+func (inspector *Endpoint) GracefulExitStatus(ctx context.Context, in *pb.GracefulExitStatusRequest) (out *pb.GracefulExitStatusResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := inspector.exits.List(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	out = &pb.GracefulExitStatusResponse{}
+	for _, exit := range exits {
+		out.Progress = append(out.Progress, &pb.GracefulExitProgress{
+			SatelliteId:       exit.SatelliteID,
+			InitiatedAt:       exit.InitiatedAt,
+			FinishedAt:        exit.FinishedAt,
+			PiecesTransferred: exit.PiecesTransferred,
+			PiecesFailed:      exit.PiecesFailed,
+			BytesTransferred:  exit.BytesTransferred,
+			Successful:        exit.Successful,
+		})
+	}
+	return out, nil
+}
+
+// ScrubStatus returns the results of the piece scrubber
+func (inspector *Endpoint) ScrubStatus(ctx context.Context, in *pb.ScrubStatusRequest) (out *pb.ScrubStatusResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status := inspector.scrubber.Status()
+
+	out = &pb.ScrubStatusResponse{PiecesChecked: status.PiecesChecked}
+	if !status.LastStarted.IsZero() {
+		out.LastStarted = &status.LastStarted
+	}
+	if !status.LastFinished.IsZero() {
+		out.LastFinished = &status.LastFinished
+	}
+	for _, piece := range status.Damaged {
+		out.Damaged = append(out.Damaged, &pb.DamagedPieceStatus{
+			SatelliteId: piece.SatelliteID,
+			PieceId:     piece.PieceID,
+			Reason:      piece.Reason.String(),
+			DetectedAt:  piece.DetectedAt,
+			Reported:    piece.Reported,
+		})
+	}
+	return out, nil
+}
+
+// ExportOrders returns a page of archived orders, or unsent orders when requested.
+func (inspector *Endpoint) ExportOrders(ctx context.Context, in *pb.ExportOrdersRequest) (out *pb.ExportOrdersResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := int(in.Limit)
+	if limit <= 0 || limit > maxExportOrders {
+		limit = maxExportOrders
+	}
+	if in.Offset < 0 {
+		return nil, Error.New("invalid offset %d", in.Offset)
+	}
+
+	out = &pb.ExportOrdersResponse{}
+
+	if in.Unsent {
+		bySatellite, err := inspector.orders.ListUnsentBySatellite(ctx)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		var unsent []*orders.Info
+		for _, infos := range bySatellite {
+			unsent = append(unsent, infos...)
+		}
+		sort.Slice(unsent, func(i, k int) bool {
+			a, b := unsent[i].Limit, unsent[k].Limit
+			if a.SatelliteId != b.SatelliteId {
+				return a.SatelliteId.Less(b.SatelliteId)
+			}
+			return bytes.Compare(a.SerialNumber[:], b.SerialNumber[:]) < 0
+		})
+
+		if in.Offset >= int64(len(unsent)) {
+			return out, nil
+		}
+		unsent = unsent[in.Offset:]
+		if len(unsent) > limit {
+			unsent = unsent[:limit]
+		}
+
+		for _, info := range unsent {
+			out.Orders = append(out.Orders, exportedOrder(info.Limit, info.Order, orders.StatusUnsent.String(), nil))
+		}
+		return out, nil
+	}
+
+	archived, err := inspector.orders.ListArchivedAfter(ctx, in.ArchivedAfter, limit, int(in.Offset))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, info := range archived {
+		archivedAt := info.ArchivedAt
+		out.Orders = append(out.Orders, exportedOrder(info.Limit, info.Order, info.Status.String(), &archivedAt))
+	}
+	return out, nil
+}
+
+// exportedOrder converts an order limit and order into its exported form.
+func exportedOrder(limit *pb.OrderLimit, order *pb.Order, status string, archivedAt *time.Time) *pb.ExportedOrder {
+	return &pb.ExportedOrder{
+		SatelliteId:   limit.SatelliteId,
+		SerialNumber:  limit.SerialNumber,
+		PieceId:       limit.PieceId,
+		Action:        limit.Action.String(),
+		Limit:         limit.Limit,
+		Amount:        order.Amount,
+		OrderCreation: limit.OrderCreation,
+		Status:        status,
+		ArchivedAt:    archivedAt,
+	}
+}
+
+// ArchiveSummaries returns the totals of archived orders removed by the retention policy.
+func (inspector *Endpoint) ArchiveSummaries(ctx context.Context, in *pb.ArchiveSummariesRequest) (out *pb.ArchiveSummariesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	summaries, err := inspector.orders.ListArchiveSummaries(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	out = &pb.ArchiveSummariesResponse{}
+	for _, summary := range summaries {
+		out.Summaries = append(out.Summaries, &pb.ArchiveSummary{
+			SatelliteId: summary.SatelliteID,
+			Day:         summary.Day,
+			Action:      summary.Action.String(),
+			Status:      summary.Status.String(),
+			OrderCount:  summary.OrderCount,
+			TotalAmount: summary.TotalAmount,
+		})
+	}
+	return out, nil
+}
@@ -16,6 +16,7 @@ import (
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/uplink"
 )
 
@@ -132,3 +133,65 @@ func TestInspectorDashboard(t *testing.T) {
 		}
 	})
 }
+
+func TestInspectorRestoreTrash(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		// find the pieces stored on the storage nodes
+		listResponse, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 0, 0)
+		require.NoError(t, err)
+
+		var remote *pb.RemoteSegment
+		for _, item := range listResponse {
+			pointer, err := satellite.Metainfo.Service.Get(ctx, item.GetPath())
+			require.NoError(t, err)
+			if pointer.GetType() == pb.Pointer_REMOTE {
+				remote = pointer.GetRemote()
+				break
+			}
+		}
+		require.NotNil(t, remote)
+
+		beforeDelete := time.Now().Add(-time.Second)
+
+		err = planet.Uplinks[0].Delete(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+
+		var totalRestored int64
+		for _, storageNode := range planet.StorageNodes {
+			response, err := storageNode.Storage2.Inspector.RestoreTrash(ctx, &pb.RestoreTrashRequest{
+				SatelliteId:  satellite.ID(),
+				TrashedAfter: beforeDelete,
+			})
+			require.NoError(t, err)
+			totalRestored += response.RestoredCount
+		}
+		require.Equal(t, int64(len(remote.RemotePieces)), totalRestored)
+
+		// the restored pieces should be readable again
+		for _, piece := range remote.RemotePieces {
+			var storageNode *storagenode.Peer
+			for _, node := range planet.StorageNodes {
+				if node.ID() == piece.NodeId {
+					storageNode = node
+				}
+			}
+			require.NotNil(t, storageNode)
+
+			pieceID := remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+
+			_, err := storageNode.DB.PieceInfo().Get(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err)
+
+			reader, err := storageNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+	})
+}
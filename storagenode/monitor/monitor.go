@@ -5,6 +5,8 @@ package monitor
 
 import (
 	"context"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -15,6 +17,7 @@ import (
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/pieces"
 )
@@ -31,6 +34,7 @@ type Config struct {
 	Interval         time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 	MinimumDiskSpace memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
 	MinimumBandwidth memory.Size   `help:"how much bandwidth a node at minimum has to advertise" default:"500GB"`
+	SatelliteQuotas  Quotas        `help:"a comma-separated list of per satellite quotas as satellite-id[;space=<size>][;bandwidth=<size>]" default:""`
 }
 
 // Service which monitors disk usage and updates kademlia network as necessary.
@@ -44,6 +48,9 @@ type Service struct {
 	allocatedBandwidth int64
 	Loop               sync2.Cycle
 	Config             Config
+
+	mu                sync.Mutex
+	satelliteCapacity map[storj.NodeID]pb.NodeCapacity
 }
 
 // TODO: should it be responsible for monitoring actual bandwidth as well?
@@ -67,8 +74,12 @@ func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pie
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	// get the disk space details
-	// The returned path ends in a slash only if it represents a root directory, such as "/" on Unix or `C:\` on Windows.
+	if err := service.store.RefreshSpaceUsed(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
+	// get the disk space details, the free space is limited by the
+	// allocated space of the individual storage directories
 	storageStatus, err := service.store.StorageStatus(ctx)
 	if err != nil {
 		return Error.Wrap(err)
@@ -152,14 +163,58 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 
-	service.routingTable.UpdateSelf(&pb.NodeCapacity{
+	freeDisk := service.allocatedDiskSpace - usedSpace
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if storageStatus.DiskFree < freeDisk {
+		freeDisk = storageStatus.DiskFree
+	}
+
+	capacity := pb.NodeCapacity{
 		FreeBandwidth: service.allocatedBandwidth - usedBandwidth,
-		FreeDisk:      service.allocatedDiskSpace - usedSpace,
-	})
+		FreeDisk:      freeDisk,
+	}
+	service.routingTable.UpdateSelf(&capacity)
+
+	bandwidthUsage, err := bandwidth.MonthlySummaryBySatellite(ctx, service.usageDB)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	satelliteCapacity := map[storj.NodeID]pb.NodeCapacity{}
+	for _, quota := range service.Config.SatelliteQuotas {
+		usage, err := service.satelliteUsage(ctx, quota.SatelliteID, bandwidthUsage)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		satelliteCapacity[quota.SatelliteID] = pb.NodeCapacity{
+			FreeBandwidth: limitByQuota(capacity.FreeBandwidth, usage.BandwidthQuota, usage.BandwidthUsed),
+			FreeDisk:      limitByQuota(capacity.FreeDisk, usage.SpaceQuota, usage.SpaceUsed),
+		}
+	}
+
+	service.mu.Lock()
+	service.satelliteCapacity = satelliteCapacity
+	service.mu.Unlock()
 
 	return nil
 }
 
+// SatelliteCapacity returns the capacity advertised to the satellite, it
+// returns nil when the satellite doesn't have a quota.
+func (service *Service) SatelliteCapacity(ctx context.Context, satelliteID storj.NodeID) *pb.NodeCapacity {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	capacity, ok := service.satelliteCapacity[satelliteID]
+	if !ok {
+		return nil
+	}
+	return &capacity
+}
+
 func (service *Service) usedSpace(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	usedSpace, err := service.pieceInfo.SpaceUsed(ctx)
@@ -186,7 +241,17 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		return 0, Error.Wrap(err)
 	}
 	allocatedSpace := service.allocatedDiskSpace
-	return allocatedSpace - usedSpace, nil
+	available := allocatedSpace - usedSpace
+
+	// the storage directories may have less space left
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if storageStatus.DiskFree < available {
+		available = storageStatus.DiskFree
+	}
+	return available, nil
 }
 
 // AvailableBandwidth returns available bandwidth for upload/download
@@ -199,3 +264,115 @@ func (service *Service) AvailableBandwidth(ctx context.Context) (_ int64, err er
 	allocatedBandwidth := service.allocatedBandwidth
 	return allocatedBandwidth - usage.Total(), nil
 }
+
+// AvailableSpaceForSatellite returns available disk space for uploads from the satellite
+func (service *Service) AvailableSpaceForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	available, err := service.AvailableSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	quota, ok := service.Config.SatelliteQuotas.Find(satelliteID)
+	if !ok || quota.Space <= 0 {
+		return available, nil
+	}
+	usedSpace, err := service.pieceInfo.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return limitByQuota(available, quota.Space.Int64(), usedSpace), nil
+}
+
+// AvailableBandwidthForSatellite returns available bandwidth for uploads and downloads of the satellite
+func (service *Service) AvailableBandwidthForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	available, err := service.AvailableBandwidth(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	quota, ok := service.Config.SatelliteQuotas.Find(satelliteID)
+	if !ok || quota.Bandwidth <= 0 {
+		return available, nil
+	}
+	usage, err := bandwidth.MonthlySummaryBySatellite(ctx, service.usageDB)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	var usedBandwidth int64
+	if satelliteUsage, ok := usage[satelliteID]; ok {
+		usedBandwidth = satelliteUsage.Total()
+	}
+	return limitByQuota(available, quota.Bandwidth.Int64(), usedBandwidth), nil
+}
+
+// SatelliteUsage contains the consumption of a satellite and its quota,
+// a zero quota means no limit.
+type SatelliteUsage struct {
+	SatelliteID    storj.NodeID
+	SpaceUsed      int64
+	SpaceQuota     int64
+	BandwidthUsed  int64
+	BandwidthQuota int64
+}
+
+// SatelliteUsages returns the consumption of the satellites, which have a
+// quota or used bandwidth this month.
+func (service *Service) SatelliteUsages(ctx context.Context) (_ []SatelliteUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bandwidthUsage, err := bandwidth.MonthlySummaryBySatellite(ctx, service.usageDB)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var satellites storj.NodeIDList
+	for _, quota := range service.Config.SatelliteQuotas {
+		satellites = append(satellites, quota.SatelliteID)
+	}
+	for satelliteID := range bandwidthUsage {
+		if _, ok := service.Config.SatelliteQuotas.Find(satelliteID); !ok {
+			satellites = append(satellites, satelliteID)
+		}
+	}
+	sort.Slice(satellites, func(i, k int) bool {
+		return satellites[i].Less(satellites[k])
+	})
+
+	var usages []SatelliteUsage
+	for _, satelliteID := range satellites {
+		usage, err := service.satelliteUsage(ctx, satelliteID, bandwidthUsage)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+func (service *Service) satelliteUsage(ctx context.Context, satelliteID storj.NodeID, bandwidthUsage map[storj.NodeID]*bandwidth.Usage) (_ SatelliteUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	usage := SatelliteUsage{SatelliteID: satelliteID}
+	if quota, ok := service.Config.SatelliteQuotas.Find(satelliteID); ok {
+		usage.SpaceQuota = quota.Space.Int64()
+		usage.BandwidthQuota = quota.Bandwidth.Int64()
+	}
+
+	usage.SpaceUsed, err = service.pieceInfo.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return usage, err
+	}
+	if satelliteUsage, ok := bandwidthUsage[satelliteID]; ok {
+		usage.BandwidthUsed = satelliteUsage.Total()
+	}
+	return usage, nil
+}
+
+// limitByQuota limits the available amount by the amount left in the quota,
+// a zero quota means no limit.
+func limitByQuota(available, quota, used int64) int64 {
+	if quota > 0 && quota-used < available {
+		return quota - used
+	}
+	return available
+}
@@ -14,6 +14,7 @@ import (
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode/monitor"
 )
 
 func TestMonitor(t *testing.T) {
@@ -53,3 +54,79 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestSatelliteQuotas(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		limited, unlimited := planet.Satellites[0], planet.Satellites[1]
+
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Storage2.Monitor.Loop.Pause()
+			storageNode.Storage2.Monitor.Config.SatelliteQuotas = monitor.Quotas{{
+				SatelliteID: limited.ID(),
+				Space:       10 * memory.KiB,
+				Bandwidth:   memory.MiB,
+			}}
+			storageNode.Storage2.Monitor.Loop.TriggerWait()
+		}
+
+		for _, storageNode := range planet.StorageNodes {
+			space, err := storageNode.Storage2.Monitor.AvailableSpaceForSatellite(ctx, limited.ID())
+			require.NoError(t, err)
+			assert.EqualValues(t, 10*memory.KiB, space)
+
+			bandwidth, err := storageNode.Storage2.Monitor.AvailableBandwidthForSatellite(ctx, limited.ID())
+			require.NoError(t, err)
+			assert.EqualValues(t, memory.MiB, bandwidth)
+
+			// the limited satellite sees the capacity of its quota
+			info, err := limited.Kademlia.Service.FetchInfo(ctx, storageNode.Local().Node)
+			require.NoError(t, err)
+			assert.EqualValues(t, 10*memory.KiB, info.Capacity.FreeDisk)
+			assert.EqualValues(t, memory.MiB, info.Capacity.FreeBandwidth)
+
+			// other satellites see the capacity of the node
+			info, err = unlimited.Kademlia.Service.FetchInfo(ctx, storageNode.Local().Node)
+			require.NoError(t, err)
+			assert.True(t, info.Capacity.FreeDisk > 10*memory.KiB.Int64())
+		}
+
+		// the satellite still selects the nodes by the capacity it cached
+		// before the quota, but the nodes reject the pieces exceeding it
+		err := planet.Uplinks[0].Upload(ctx, limited, "testbucket", "test/path", testrand.Bytes(100*memory.KiB))
+		require.Error(t, err)
+
+		for _, storageNode := range planet.StorageNodes {
+			usages, err := storageNode.Storage2.Monitor.SatelliteUsages(ctx)
+			require.NoError(t, err)
+			for _, usage := range usages {
+				if usage.SatelliteID == limited.ID() {
+					assert.EqualValues(t, 10*memory.KiB, usage.SpaceQuota)
+				} else {
+					assert.Zero(t, usage.SpaceQuota)
+				}
+			}
+		}
+	})
+}
+
+func TestParseQuotas(t *testing.T) {
+	satelliteID := testrand.NodeID()
+
+	var quotas monitor.Quotas
+	require.NoError(t, quotas.Set(satelliteID.String()+";space=1TB;bandwidth=2TB"))
+	require.Len(t, quotas, 1)
+	assert.Equal(t, monitor.Quota{SatelliteID: satelliteID, Space: memory.TB, Bandwidth: 2 * memory.TB}, quotas[0])
+
+	var reparsed monitor.Quotas
+	require.NoError(t, reparsed.Set(quotas.String()))
+	assert.Equal(t, quotas, reparsed)
+
+	_, ok := quotas.Find(testrand.NodeID())
+	assert.False(t, ok)
+
+	for _, invalid := range []string{"invalid", satelliteID.String() + ";space", satelliteID.String() + ";disk=1TB"} {
+		assert.Error(t, quotas.Set(invalid), invalid)
+	}
+}
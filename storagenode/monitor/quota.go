@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"strings"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/storj"
+)
+
+// Quota limits the disk space and the monthly bandwidth a satellite may use.
+// A zero limit means the satellite is only limited by the node allocation.
+type Quota struct {
+	SatelliteID storj.NodeID
+	Space       memory.Size
+	Bandwidth   memory.Size
+}
+
+// ParseQuota parses a quota in the form of
+// satellite-id[;space=<size>][;bandwidth=<size>].
+func ParseQuota(s string) (Quota, error) {
+	parts := strings.Split(s, ";")
+	satelliteID, err := storj.NodeIDFromString(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return Quota{}, Error.New("invalid satellite id %q: %v", parts[0], err)
+	}
+
+	quota := Quota{SatelliteID: satelliteID}
+	for _, option := range parts[1:] {
+		option = strings.TrimSpace(option)
+		i := strings.IndexByte(option, '=')
+		if i < 0 {
+			return Quota{}, Error.New("invalid quota option %q", option)
+		}
+
+		name, value := option[:i], option[i+1:]
+		switch name {
+		case "space":
+			err = quota.Space.Set(value)
+		case "bandwidth":
+			err = quota.Bandwidth.Set(value)
+		default:
+			return Quota{}, Error.New("unknown quota option %q", option)
+		}
+		if err != nil {
+			return Quota{}, Error.New("invalid %s %q: %v", name, value, err)
+		}
+	}
+	return quota, nil
+}
+
+// String returns the quota in the form accepted by ParseQuota.
+func (quota Quota) String() string {
+	s := quota.SatelliteID.String()
+	if quota.Space > 0 {
+		s += ";space=" + quota.Space.String()
+	}
+	if quota.Bandwidth > 0 {
+		s += ";bandwidth=" + quota.Bandwidth.String()
+	}
+	return s
+}
+
+// Quotas defines a comma delimited flag for defining per satellite quotas.
+type Quotas []Quota
+
+// Find returns the quota of the satellite.
+func (quotas Quotas) Find(satelliteID storj.NodeID) (Quota, bool) {
+	for _, quota := range quotas {
+		if quota.SatelliteID == satelliteID {
+			return quota, true
+		}
+	}
+	return Quota{}, false
+}
+
+// String converts Quotas to a string
+func (quotas Quotas) String() string {
+	var xs []string
+	for _, quota := range quotas {
+		xs = append(xs, quota.String())
+	}
+	return strings.Join(xs, ",")
+}
+
+// Set implements flag.Value interface
+func (quotas *Quotas) Set(s string) error {
+	var parsed Quotas
+	if strings.TrimSpace(s) != "" {
+		for _, quota := range strings.Split(s, ",") {
+			q, err := ParseQuota(quota)
+			if err != nil {
+				return err
+			}
+			parsed = append(parsed, q)
+		}
+	}
+	*quotas = parsed
+	return nil
+}
+
+// Type implements pflag.Value
+func (Quotas) Type() string { return "monitor.Quotas" }
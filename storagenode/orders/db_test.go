@@ -129,5 +129,56 @@ func TestOrders(t *testing.T) {
 			},
 		}, archived, cmp.Comparer(pb.Equal)))
 
+		// export archived orders
+		exported, err := ordersdb.ListArchivedAfter(ctx, now.Add(-time.Hour), 100, 0)
+		require.NoError(t, err)
+		require.Len(t, exported, 1)
+		require.True(t, pb.Equal(order, exported[0].Order))
+
+		exported, err = ordersdb.ListArchivedAfter(ctx, now.Add(-time.Hour), 100, 1)
+		require.NoError(t, err)
+		require.Len(t, exported, 0)
+
+		exported, err = ordersdb.ListArchivedAfter(ctx, now.Add(time.Hour), 100, 0)
+		require.NoError(t, err)
+		require.Len(t, exported, 0)
+
+		// retention shouldn't remove recent orders
+		removed, err := ordersdb.CleanArchive(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 0, removed)
+
+		summaries, err := ordersdb.ListArchiveSummaries(ctx)
+		require.NoError(t, err)
+		require.Len(t, summaries, 0)
+
+		// retention should summarize and remove old orders
+		removed, err = ordersdb.CleanArchive(ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 1, removed)
+
+		archived, err = ordersdb.ListArchived(ctx, 100)
+		require.NoError(t, err)
+		require.Len(t, archived, 0)
+
+		summaries, err = ordersdb.ListArchiveSummaries(ctx)
+		require.NoError(t, err)
+		require.Len(t, summaries, 1)
+		require.Equal(t, satellite0.ID, summaries[0].SatelliteID)
+		require.Equal(t, pb.PieceAction_GET, summaries[0].Action)
+		require.Equal(t, orders.StatusAccepted, summaries[0].Status)
+		require.Equal(t, int64(1), summaries[0].OrderCount)
+		require.Equal(t, int64(50), summaries[0].TotalAmount)
+
+		// totals include the summarized orders
+		count, amount, err := ordersdb.StatusTotals(ctx, orders.StatusAccepted)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+		require.Equal(t, int64(50), amount)
+
+		count, amount, err = ordersdb.StatusTotals(ctx, orders.StatusRejected)
+		require.NoError(t, err)
+		require.Zero(t, count)
+		require.Zero(t, amount)
 	})
 }
@@ -5,9 +5,13 @@ package orders
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -53,8 +57,36 @@ const (
 	StatusUnsent Status = iota
 	StatusAccepted
 	StatusRejected
+	StatusExpired
 )
 
+// String returns the name of the status.
+func (status Status) String() string {
+	switch status {
+	case StatusUnsent:
+		return "unsent"
+	case StatusAccepted:
+		return "accepted"
+	case StatusRejected:
+		return "rejected"
+	case StatusExpired:
+		return "expired"
+	default:
+		return fmt.Sprintf("status(%d)", byte(status))
+	}
+}
+
+// ArchiveSummary contains the totals of the archived orders of a satellite,
+// which were archived on the same day, and were removed from the archive.
+type ArchiveSummary struct {
+	SatelliteID storj.NodeID
+	Day         time.Time
+	Action      pb.PieceAction
+	Status      Status
+	OrderCount  int64
+	TotalAmount int64
+}
+
 // DB implements storing orders for sending to the satellite.
 type DB interface {
 	// Enqueue inserts order to the list of orders needing to be sent to the satellite.
@@ -69,12 +101,27 @@ type DB interface {
 
 	// ListArchived returns orders that have been sent.
 	ListArchived(ctx context.Context, limit int) ([]*ArchivedInfo, error)
+	// ListArchivedAfter returns orders archived after the specified time, ordered by the archival time.
+	ListArchivedAfter(ctx context.Context, archivedAfter time.Time, limit, offset int) ([]*ArchivedInfo, error)
+
+	// CleanArchive summarizes the orders archived before the specified time
+	// per satellite and day, and removes them from the archive.
+	CleanArchive(ctx context.Context, archivedBefore time.Time) (removed int, err error)
+	// ListArchiveSummaries returns the totals of the orders removed from the archive.
+	ListArchiveSummaries(ctx context.Context) ([]ArchiveSummary, error)
+	// StatusTotals returns the number and the total amount of archived orders with the specified status,
+	// including the orders summarized by CleanArchive.
+	StatusTotals(ctx context.Context, status Status) (count, amount int64, err error)
 }
 
 // SenderConfig defines configuration for sending orders.
 type SenderConfig struct {
-	Interval time.Duration `help:"duration between sending" default:"1h0m0s"`
-	Timeout  time.Duration `help:"timeout for sending" default:"1h0m0s"`
+	Interval         time.Duration `help:"duration between sending" default:"1h0m0s"`
+	Timeout          time.Duration `help:"timeout for sending" default:"1h0m0s"`
+	ArchiveRetention time.Duration `help:"how long archived orders are kept before they are summarized per satellite and day, zero keeps them forever" default:"2160h0m0s"`
+	MaxBatchSize     int           `help:"maximum number of orders sent to a satellite in a single settlement" default:"1000"`
+	RetryBackoff     time.Duration `help:"how long after a failed settlement settling with the satellite is retried, doubled after every consecutive failure, zero retries on the next interval" default:"1m0s"`
+	MaxRetryBackoff  time.Duration `help:"maximum duration between retries of failed settlements with a satellite" default:"1h0m0s"`
 }
 
 // Sender sends every interval unsent orders to the satellite.
@@ -86,9 +133,22 @@ type Sender struct {
 	kademlia  *kademlia.Kademlia
 	orders    DB
 
+	mu          sync.Mutex
+	retries     map[storj.NodeID]*retryState
+	rescheduled chan struct{}
+	closeOnce   sync.Once
+	closed      chan struct{}
+
 	Loop sync2.Cycle
 }
 
+// retryState tracks the consecutive failed settlements with a satellite.
+type retryState struct {
+	failures    int
+	nextAttempt time.Time
+	settling    bool
+}
+
 // NewSender creates an order sender.
 func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.Kademlia, orders DB, config SenderConfig) *Sender {
 	return &Sender{
@@ -97,15 +157,29 @@ func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.K
 		kademlia:  kademlia,
 		orders:    orders,
 		config:    config,
+		retries:   make(map[storj.NodeID]*retryState),
+
+		rescheduled: make(chan struct{}, 1),
+		closed:      make(chan struct{}),
 
 		Loop: *sync2.NewCycle(config.Interval),
 	}
 }
 
 // Run sends orders on every interval to the appropriate satellites.
+// Settlements with satellites that failed are retried separately
+// after a backoff, independent of the interval.
 func (sender *Sender) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return sender.Loop.Run(ctx, sender.runOnce)
+
+	var group errgroup.Group
+	group.Go(func() error {
+		return sender.Loop.Run(ctx, sender.runOnce)
+	})
+	group.Go(func() error {
+		return sender.runRetries(ctx)
+	})
+	return group.Wait()
 }
 
 func (sender *Sender) runOnce(ctx context.Context) (err error) {
@@ -125,9 +199,16 @@ func (sender *Sender) runOnce(ctx context.Context) (err error) {
 
 		for satelliteID, orders := range ordersBySatellite {
 			satelliteID, orders := satelliteID, orders
-			group.Go(func() error {
 
-				sender.Settle(ctx, satelliteID, orders)
+			if nextAttempt, ok := sender.retrying(satelliteID); ok {
+				sender.log.Debug("settlement is retried after failures",
+					zap.Stringer("satellite", satelliteID), zap.Time("next attempt", nextAttempt))
+				continue
+			}
+
+			group.Go(func() error {
+				err := sender.Settle(ctx, satelliteID, orders)
+				sender.recordSettlement(satelliteID, err, time.Now())
 				return nil
 			})
 		}
@@ -136,32 +217,311 @@ func (sender *Sender) runOnce(ctx context.Context) (err error) {
 		sender.log.Debug("no orders to send")
 	}
 
+	if sender.config.ArchiveRetention > 0 {
+		removed, err := sender.orders.CleanArchive(ctx, time.Now().Add(-sender.config.ArchiveRetention))
+		if err != nil {
+			sender.log.Error("cleaning archived orders", zap.Error(err))
+		} else if removed > 0 {
+			sender.log.Info("summarized archived orders", zap.Int("count", removed))
+		}
+	}
+
 	return nil
 }
 
 // Settle uploads orders to the satellite.
-func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orders []*Info) {
+//
+// Orders closest to their expiration are sent first, in batches of at most
+// MaxBatchSize orders, until all orders are settled or a settlement fails.
+// Orders that expire before they can be settled are archived as expired.
+func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orders []*Info) error {
 	log := sender.log.Named(satelliteID.String())
-	err := sender.settle(ctx, log, satelliteID, orders)
-	if err != nil {
+
+	sortByExpiration(orders)
+
+	batchSize := sender.config.MaxBatchSize
+	if batchSize <= 0 {
+		batchSize = len(orders)
+	}
+
+	for {
+		orders = sender.archiveExpired(ctx, log, satelliteID, orders, time.Now())
+		if len(orders) == 0 {
+			return nil
+		}
+
+		batch := orders
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+
+		settled, err := sender.settle(ctx, log, satelliteID, batch)
+		orders = withoutSettled(orders, settled)
+		if err == nil && len(settled) > 0 {
+			continue
+		}
+		if err == nil {
+			err = OrderError.New("satellite did not respond to any order")
+		}
+
+		mon.Meter("settlement_failure").Mark(1)
 		log.Error("failed to settle orders", zap.Error(err))
+		return err
 	}
 }
 
-func (sender *Sender) settle(ctx context.Context, log *zap.Logger, satelliteID storj.NodeID, orders []*Info) (err error) {
+// runRetries retries the settlements with the satellites that failed
+// as soon as their backoff has passed, until ctx is canceled or the
+// sender is closed.
+func (sender *Sender) runRetries(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if sender.config.RetryBackoff <= 0 {
+		return nil
+	}
+
+	for {
+		nextAttempt, ok := sender.nextRetry()
+		if !ok {
+			// wait until a settlement fails
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-sender.closed:
+				return nil
+			case <-sender.rescheduled:
+				continue
+			}
+		}
+
+		timer := time.NewTimer(time.Until(nextAttempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-sender.closed:
+			timer.Stop()
+			return nil
+		case <-sender.rescheduled:
+			timer.Stop()
+		case <-timer.C:
+			sender.retryDue(ctx, time.Now())
+		}
+	}
+}
+
+// retryDue settles with the failed satellites whose backoff has passed at now.
+func (sender *Sender) retryDue(ctx context.Context, now time.Time) {
+	due := sender.dueRetries(now)
+	if len(due) == 0 {
+		return
+	}
+
+	ordersBySatellite, err := sender.orders.ListUnsentBySatellite(ctx)
+	if err != nil {
+		sender.log.Error("listing orders", zap.Error(err))
+		for _, satelliteID := range due {
+			sender.recordSettlement(satelliteID, err, now)
+		}
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sender.config.Timeout)
+	defer cancel()
+
+	var group errgroup.Group
+	for _, satelliteID := range due {
+		satelliteID, orders := satelliteID, ordersBySatellite[satelliteID]
+		if len(orders) == 0 {
+			// nothing left to settle, e.g. the orders have been archived meanwhile
+			sender.recordSettlement(satelliteID, nil, now)
+			continue
+		}
+
+		group.Go(func() error {
+			err := sender.Settle(ctx, satelliteID, orders)
+			sender.recordSettlement(satelliteID, err, time.Now())
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
+}
+
+// retrying returns whether settling with the satellite is retried
+// separately from the interval, and when it is attempted next.
+func (sender *Sender) retrying(satelliteID storj.NodeID) (nextAttempt time.Time, ok bool) {
+	if sender.config.RetryBackoff <= 0 {
+		return time.Time{}, false
+	}
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	retry, ok := sender.retries[satelliteID]
+	if !ok {
+		return time.Time{}, false
+	}
+	return retry.nextAttempt, true
+}
+
+// nextRetry returns when the earliest retry of a failed settlement is due.
+func (sender *Sender) nextRetry() (nextAttempt time.Time, ok bool) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	for _, retry := range sender.retries {
+		if retry.settling {
+			continue
+		}
+		if !ok || retry.nextAttempt.Before(nextAttempt) {
+			nextAttempt, ok = retry.nextAttempt, true
+		}
+	}
+	return nextAttempt, ok
+}
+
+// dueRetries returns the failed satellites whose backoff has passed at now
+// and marks them as being settled.
+func (sender *Sender) dueRetries(now time.Time) (due []storj.NodeID) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	for satelliteID, retry := range sender.retries {
+		if retry.settling || now.Before(retry.nextAttempt) {
+			continue
+		}
+		retry.settling = true
+		due = append(due, satelliteID)
+	}
+	return due
+}
+
+// recordSettlement updates the retry state of the satellite after a settlement
+// finished at now with err. Every consecutive failure doubles the duration
+// after which settling with the satellite is retried.
+func (sender *Sender) recordSettlement(satelliteID storj.NodeID, err error, now time.Time) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	defer sender.reschedule()
+
+	if err == nil {
+		delete(sender.retries, satelliteID)
+		return
+	}
+
+	retry, ok := sender.retries[satelliteID]
+	if !ok {
+		retry = &retryState{}
+		sender.retries[satelliteID] = retry
+	}
+	retry.failures++
+	retry.settling = false
+
+	backoff := sender.config.RetryBackoff
+	for i := 1; i < retry.failures && backoff > 0; i++ {
+		backoff *= 2
+		if sender.config.MaxRetryBackoff > 0 && backoff >= sender.config.MaxRetryBackoff {
+			break
+		}
+	}
+	if sender.config.MaxRetryBackoff > 0 && backoff > sender.config.MaxRetryBackoff {
+		backoff = sender.config.MaxRetryBackoff
+	}
+	retry.nextAttempt = now.Add(backoff)
+
+	sender.log.Info("settlement postponed",
+		zap.Stringer("satellite", satelliteID),
+		zap.Int("failures", retry.failures),
+		zap.Time("next attempt", retry.nextAttempt))
+}
+
+// reschedule wakes up the retries after the retry state has changed.
+func (sender *Sender) reschedule() {
+	select {
+	case sender.rescheduled <- struct{}{}:
+	default:
+	}
+}
+
+// archiveExpired archives the orders that have expired before now and returns the remaining orders.
+func (sender *Sender) archiveExpired(ctx context.Context, log *zap.Logger, satelliteID storj.NodeID, orders []*Info, now time.Time) []*Info {
+	var count, amount int64
+
+	remaining := orders[:0]
+	for _, order := range orders {
+		expiration, err := ptypes.Timestamp(order.Limit.OrderExpiration)
+		if err != nil || expiration.After(now) {
+			remaining = append(remaining, order)
+			continue
+		}
+
+		err = sender.orders.Archive(ctx, satelliteID, order.Limit.SerialNumber, StatusExpired)
+		if err != nil {
+			log.Error("failed to archive order as expired", zap.Stringer("serial", order.Limit.SerialNumber), zap.Error(err))
+			continue
+		}
+
+		count++
+		amount += order.Order.Amount
+	}
+
+	if count > 0 {
+		mon.Meter("orders_expired").Mark64(count)
+		mon.Meter("orders_expired_bytes").Mark64(amount)
+		log.Warn("orders expired before they were settled", zap.Int64("count", count), zap.Int64("bytes", amount))
+	}
+
+	return remaining
+}
+
+// sortByExpiration sorts orders so that the orders closest to their expiration come first.
+func sortByExpiration(orders []*Info) {
+	sort.SliceStable(orders, func(i, k int) bool {
+		a, b := orders[i].Limit.OrderExpiration, orders[k].Limit.OrderExpiration
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		if a.Seconds != b.Seconds {
+			return a.Seconds < b.Seconds
+		}
+		return a.Nanos < b.Nanos
+	})
+}
+
+// withoutSettled returns the orders whose serial number is not in settled.
+func withoutSettled(orders []*Info, settled map[storj.SerialNumber]struct{}) []*Info {
+	if len(settled) == 0 {
+		return orders
+	}
+
+	remaining := orders[:0]
+	for _, order := range orders {
+		if _, ok := settled[order.Limit.SerialNumber]; !ok {
+			remaining = append(remaining, order)
+		}
+	}
+	return remaining
+}
+
+// settle sends the orders to the satellite in a single settlement and returns
+// the serial numbers of the orders the satellite responded to.
+func (sender *Sender) settle(ctx context.Context, log *zap.Logger, satelliteID storj.NodeID, orders []*Info) (settled map[storj.SerialNumber]struct{}, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	log.Info("sending", zap.Int("count", len(orders)))
 	defer log.Info("finished")
 
+	settled = make(map[storj.SerialNumber]struct{})
+
 	satellite, err := sender.kademlia.FindNode(ctx, satelliteID)
 	if err != nil {
-		return OrderError.New("unable to find satellite on the network: %v", err)
+		return settled, OrderError.New("unable to find satellite on the network: %v", err)
 	}
 
 	conn, err := sender.transport.DialNode(ctx, &satellite)
 	if err != nil {
-		return OrderError.New("unable to connect to the satellite: %v", err)
+		return settled, OrderError.New("unable to connect to the satellite: %v", err)
 	}
 	defer func() {
 		if cerr := conn.Close(); cerr != nil {
@@ -171,7 +531,7 @@ func (sender *Sender) settle(ctx context.Context, log *zap.Logger, satelliteID s
 
 	client, err := pb.NewOrdersClient(conn).Settlement(ctx)
 	if err != nil {
-		return OrderError.New("failed to start settlement: %v", err)
+		return settled, OrderError.New("failed to start settlement: %v", err)
 	}
 
 	var group errgroup.Group
@@ -204,6 +564,8 @@ func (sender *Sender) settle(ctx context.Context, log *zap.Logger, satelliteID s
 			break
 		}
 
+		settled[response.SerialNumber] = struct{}{}
+
 		switch response.Status {
 		case pb.SettlementResponse_ACCEPTED:
 			err = sender.orders.Archive(ctx, satelliteID, response.SerialNumber, StatusAccepted)
@@ -224,11 +586,12 @@ func (sender *Sender) settle(ctx context.Context, log *zap.Logger, satelliteID s
 		errHandle(OrderError, "sending agreements returned an error: %v", err)
 	}
 
-	return errList.Err()
+	return settled, errList.Err()
 }
 
 // Close stops the sending service.
 func (sender *Sender) Close() error {
+	sender.closeOnce.Do(func() { close(sender.closed) })
 	sender.Loop.Close()
 	return nil
 }
@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestSenderSchedulesRetriesOfFailedSatellites(t *testing.T) {
+	sender := NewSender(zaptest.NewLogger(t), nil, nil, nil, SenderConfig{
+		Interval:        time.Hour,
+		RetryBackoff:    time.Minute,
+		MaxRetryBackoff: 3 * time.Minute,
+	})
+	defer func() { _ = sender.Close() }()
+
+	satelliteID := testrand.NodeID()
+	now := time.Now()
+
+	_, retrying := sender.retrying(satelliteID)
+	assert.False(t, retrying)
+	_, ok := sender.nextRetry()
+	assert.False(t, ok)
+
+	// every consecutive failure doubles the backoff up to the maximum
+	for _, backoff := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 3 * time.Minute} {
+		sender.recordSettlement(satelliteID, errors.New("failure"), now)
+
+		nextAttempt, retrying := sender.retrying(satelliteID)
+		assert.True(t, retrying)
+		assert.Equal(t, now.Add(backoff), nextAttempt)
+
+		nextAttempt, ok := sender.nextRetry()
+		assert.True(t, ok)
+		assert.Equal(t, now.Add(backoff), nextAttempt)
+
+		assert.Empty(t, sender.dueRetries(now))
+		assert.Equal(t, []storj.NodeID{satelliteID}, sender.dueRetries(now.Add(backoff)))
+
+		// a retry in progress isn't scheduled again
+		assert.Empty(t, sender.dueRetries(now.Add(backoff)))
+		_, ok = sender.nextRetry()
+		assert.False(t, ok)
+	}
+
+	// other satellites aren't retried
+	_, retrying = sender.retrying(testrand.NodeID())
+	assert.False(t, retrying)
+
+	// a successful settlement resets the backoff
+	sender.recordSettlement(satelliteID, nil, now)
+	_, retrying = sender.retrying(satelliteID)
+	assert.False(t, retrying)
+
+	sender.recordSettlement(satelliteID, errors.New("failure"), now)
+	nextAttempt, retrying := sender.retrying(satelliteID)
+	assert.True(t, retrying)
+	assert.Equal(t, now.Add(time.Minute), nextAttempt)
+}
+
+func TestSenderRetriesOnIntervalWithoutBackoff(t *testing.T) {
+	sender := NewSender(zaptest.NewLogger(t), nil, nil, nil, SenderConfig{
+		Interval: time.Hour,
+	})
+	defer func() { _ = sender.Close() }()
+
+	satelliteID := testrand.NodeID()
+	sender.recordSettlement(satelliteID, errors.New("failure"), time.Now())
+
+	_, retrying := sender.retrying(satelliteID)
+	assert.False(t, retrying)
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/orders"
+)
+
+func TestSenderArchivesExpiredOrders(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+		storageNode.Storage2.Sender.Loop.Pause()
+
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+
+		expired := time.Now().Add(-time.Hour)
+		expiredTimestamp, err := ptypes.TimestampProto(expired)
+		require.NoError(t, err)
+
+		serialNumber := testrand.SerialNumber()
+		limit, err := signing.SignOrderLimit(ctx, signing.SignerFromFullIdentity(satellite.Identity), &pb.OrderLimit{
+			SerialNumber:    serialNumber,
+			SatelliteId:     satellite.ID(),
+			UplinkId:        uplink.ID,
+			StorageNodeId:   storageNode.ID(),
+			PieceId:         testrand.PieceID(),
+			Limit:           100,
+			Action:          pb.PieceAction_GET,
+			OrderCreation:   expired.Add(-time.Hour),
+			PieceExpiration: expiredTimestamp,
+			OrderExpiration: expiredTimestamp,
+		})
+		require.NoError(t, err)
+
+		order, err := signing.SignOrder(ctx, signing.SignerFromFullIdentity(uplink), &pb.Order{
+			SerialNumber: serialNumber,
+			Amount:       50,
+		})
+		require.NoError(t, err)
+
+		err = storageNode.DB.Orders().Enqueue(ctx, &orders.Info{
+			Limit:  limit,
+			Order:  order,
+			Uplink: uplink.PeerIdentity(),
+		})
+		require.NoError(t, err)
+
+		storageNode.Storage2.Sender.Loop.TriggerWait()
+
+		unsent, err := storageNode.DB.Orders().ListUnsent(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, unsent, 0)
+
+		archived, err := storageNode.DB.Orders().ListArchived(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, archived, 1)
+		require.Equal(t, orders.StatusExpired, archived[0].Status)
+
+		count, amount, err := storageNode.DB.Orders().StatusTotals(ctx, orders.StatusExpired)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+		require.Equal(t, int64(50), amount)
+
+		dashboard, err := storageNode.Storage2.Inspector.Dashboard(ctx, &pb.DashboardRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), dashboard.ExpiredOrders)
+		require.Equal(t, int64(50), dashboard.ExpiredOrderBytes)
+	})
+}
+
+// failingArchiveDB fails the first failures calls to Archive.
+type failingArchiveDB struct {
+	orders.DB
+	failures int32
+}
+
+func (db *failingArchiveDB) Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status orders.Status) error {
+	if atomic.AddInt32(&db.failures, -1) >= 0 {
+		return errors.New("archive failed")
+	}
+	return db.DB.Archive(ctx, satellite, serial, status)
+}
+
+func TestSenderRetriesFailedSatelliteBeforeInterval(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+		storageNode.Storage2.Sender.Loop.Pause()
+
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+
+		expiration, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		serialNumber := testrand.SerialNumber()
+		limit, err := signing.SignOrderLimit(ctx, signing.SignerFromFullIdentity(satellite.Identity), &pb.OrderLimit{
+			SerialNumber:    serialNumber,
+			SatelliteId:     satellite.ID(),
+			UplinkId:        uplink.ID,
+			StorageNodeId:   storageNode.ID(),
+			PieceId:         testrand.PieceID(),
+			Limit:           100,
+			Action:          pb.PieceAction_GET,
+			OrderCreation:   time.Now(),
+			PieceExpiration: expiration,
+			OrderExpiration: expiration,
+		})
+		require.NoError(t, err)
+
+		order, err := signing.SignOrder(ctx, signing.SignerFromFullIdentity(uplink), &pb.Order{
+			SerialNumber: serialNumber,
+			Amount:       50,
+		})
+		require.NoError(t, err)
+
+		err = storageNode.DB.Orders().Enqueue(ctx, &orders.Info{
+			Limit:  limit,
+			Order:  order,
+			Uplink: uplink.PeerIdentity(),
+		})
+		require.NoError(t, err)
+
+		db := &failingArchiveDB{DB: storageNode.DB.Orders(), failures: 1}
+		sender := orders.NewSender(zaptest.NewLogger(t), storageNode.Transport, storageNode.Kademlia.Service, db, orders.SenderConfig{
+			Interval:        time.Hour,
+			Timeout:         time.Hour,
+			RetryBackoff:    10 * time.Millisecond,
+			MaxRetryBackoff: time.Second,
+		})
+		defer ctx.Check(sender.Close)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error {
+			_ = sender.Run(runCtx)
+			return nil
+		})
+
+		// the first settlement fails, the retry settles the order long before the next interval
+		deadline := time.After(30 * time.Second)
+		for {
+			unsent, err := storageNode.DB.Orders().ListUnsent(ctx, 10)
+			require.NoError(t, err)
+			if len(unsent) == 0 {
+				break
+			}
+
+			select {
+			case <-deadline:
+				t.Fatal("order was not settled")
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+
+		require.True(t, atomic.LoadInt32(&db.failures) < 0)
+
+		archived, err := storageNode.DB.Orders().ListArchived(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, archived, 1)
+	})
+}
@@ -29,12 +29,14 @@ import (
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/trust"
 	"storj.io/storj/storagenode/vouchers"
 )
@@ -59,6 +61,7 @@ type DB interface {
 	UsedSerials() piecestore.UsedSerials
 	Vouchers() vouchers.DB
 	Console() console.DB
+	GracefulExit() gracefulexit.DB
 
 	// TODO: use better interfaces
 	RoutingTable() (kdb, ndb, adb storage.KeyValueStore)
@@ -78,6 +81,10 @@ type Config struct {
 
 	Vouchers vouchers.Config
 
+	GracefulExit gracefulexit.Config
+
+	Scrubber scrubber.Config
+
 	Console consoleserver.Config
 
 	Version version.Config
@@ -118,6 +125,7 @@ type Peer struct {
 		Inspector *inspector.Endpoint
 		Monitor   *monitor.Service
 		Sender    *orders.Sender
+		Drain     *pieces.DrainChore
 	}
 
 	Vouchers *vouchers.Service
@@ -126,6 +134,12 @@ type Peer struct {
 
 	NodeStats *nodestats.Service
 
+	Scrubber *scrubber.Service
+
+	GracefulExit struct {
+		Chore *gracefulexit.Chore
+	}
+
 	// Web server with web UI
 	Console struct {
 		Listener net.Listener
@@ -192,6 +206,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			Operator: pb.NodeOperator{
 				Email:  config.Operator.Email,
 				Wallet: config.Operator.Wallet,
+				Tags:   config.Operator.TagList(),
 			},
 			Version: *pbVersion,
 		}
@@ -223,7 +238,20 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"), peer.DB.Pieces())
+		dirs, err := pieces.OpenDirs(config.Storage2.Pieces, config.Storage.Path, peer.DB.Pieces())
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Storage2.Store, err = pieces.NewMultiStore(peer.Log.Named("pieces"), pieces.Placement(config.Storage2.Pieces.Placement), dirs...)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Storage2.Drain = pieces.NewDrainChore(
+			peer.Log.Named("pieces:drain"),
+			config.Storage2.Pieces.Drain,
+			peer.Storage2.Store,
+		)
 
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
@@ -237,6 +265,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			config.Storage.KBucketRefreshInterval,
 			config.Storage2.Monitor,
 		)
+		peer.Kademlia.Endpoint.SetCapacityFunc(peer.Storage2.Monitor.SatelliteCapacity)
 
 		peer.Storage2.Endpoint, err = piecestore.NewEndpoint(
 			peer.Log.Named("piecestore"),
@@ -254,6 +283,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			return nil, errs.Combine(err, peer.Close())
 		}
 		pb.RegisterPiecestoreServer(peer.Server.GRPC(), peer.Storage2.Endpoint)
+		pb.RegisterGarbageCollectionServer(peer.Server.GRPC(), peer.Storage2.Endpoint)
 
 		peer.Storage2.Sender = orders.NewSender(
 			log.Named("piecestore:orderssender"),
@@ -309,12 +339,28 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 		)
 	}
 
+	peer.Scrubber = scrubber.NewService(
+		peer.Log.Named("scrubber"),
+		config.Scrubber,
+		peer.Storage2.Trust,
+		peer.Storage2.Store,
+		peer.DB.PieceInfo(),
+		peer.Kademlia.Service,
+		peer.Transport,
+	)
+
 	{ // setup storage inspector
 		peer.Storage2.Inspector = inspector.NewEndpoint(
 			peer.Log.Named("pieces:inspector"),
+			peer.Storage2.Store,
 			peer.DB.PieceInfo(),
 			peer.Kademlia.Service,
 			peer.DB.Bandwidth(),
+			peer.DB.Orders(),
+			peer.Storage2.Monitor,
+			peer.DB.GracefulExit(),
+			peer.Storage2.Trust,
+			peer.Scrubber,
 			config.Storage,
 			peer.Console.Listener.Addr(),
 		)
@@ -323,6 +369,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 
 	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.PieceInfo(), peer.DB.UsedSerials(), config.Collector)
 
+	peer.GracefulExit.Chore = gracefulexit.NewChore(
+		peer.Log.Named("gracefulexit"),
+		config.GracefulExit,
+		peer.Identity,
+		peer.DB.GracefulExit(),
+		peer.Storage2.Store,
+		peer.DB.PieceInfo(),
+		peer.Kademlia.Service,
+		peer.Transport,
+	)
+
 	return peer, nil
 }
 
@@ -352,9 +409,18 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Storage2.Monitor.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Storage2.Drain.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Vouchers.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Chore.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Scrubber.Run(ctx))
+	})
 
 	group.Go(func() error {
 		// TODO: move the message into Server instead
@@ -385,12 +451,21 @@ func (peer *Peer) Close() error {
 
 	// close services in reverse initialization order
 
+	if peer.GracefulExit.Chore != nil {
+		errlist.Add(peer.GracefulExit.Chore.Close())
+	}
+	if peer.Scrubber != nil {
+		errlist.Add(peer.Scrubber.Close())
+	}
 	if peer.Vouchers != nil {
 		errlist.Add(peer.Vouchers.Close())
 	}
 	if peer.Storage2.Monitor != nil {
 		errlist.Add(peer.Storage2.Monitor.Close())
 	}
+	if peer.Storage2.Drain != nil {
+		errlist.Add(peer.Storage2.Drain.Close())
+	}
 	if peer.Storage2.Sender != nil {
 		errlist.Add(peer.Storage2.Sender.Close())
 	}
@@ -53,6 +53,7 @@ func TestPieceInfo(t *testing.T) {
 
 			PieceID:         pieceid0,
 			PieceSize:       123,
+			PieceCreation:   now.Add(-time.Hour),
 			PieceExpiration: &now,
 
 			UplinkPieceHash: piecehash0,
@@ -122,6 +123,20 @@ func TestPieceInfo(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, cmp.Diff(info1, info1loaded, cmp.Comparer(pb.Equal)))
 
+		// getting piece ids created before some time
+		pieceIDs, err := pieceinfos.GetPieceIDs(ctx, info0.SatelliteID, now, storj.PieceID{}, 10)
+		require.NoError(t, err)
+		require.Equal(t, []storj.PieceID{info0.PieceID}, pieceIDs)
+
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, info0.SatelliteID, now.Add(-2*time.Hour), storj.PieceID{}, 10)
+		require.NoError(t, err)
+		require.Empty(t, pieceIDs)
+
+		// getting piece ids after the last listed one
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, info0.SatelliteID, now, info0.PieceID, 10)
+		require.NoError(t, err)
+		require.Empty(t, pieceIDs)
+
 		// getting no expired pieces
 		expired, err := pieceinfos.GetExpired(ctx, now.Add(-10*time.Hour), 10)
 		assert.NoError(t, err)
@@ -142,6 +157,52 @@ func TestPieceInfo(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, expired, 2)
 
+		// trashing info1
+		err = pieceinfos.Trash(ctx, info1.SatelliteID, info1.PieceID, now)
+		require.NoError(t, err)
+
+		_, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
+		require.Error(t, err, "getting trashed piece")
+
+		expired, err = pieceinfos.GetExpired(ctx, exp, 10)
+		assert.NoError(t, err)
+		assert.Len(t, expired, 1, "trashed pieces shouldn't expire")
+
+		// restoring other pieces shouldn't restore info1
+		err = pieceinfos.RestoreTrash(ctx, info1.SatelliteID, []storj.PieceID{storj.NewPieceID()})
+		require.NoError(t, err)
+		_, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
+		require.Error(t, err)
+
+		err = pieceinfos.RestoreTrash(ctx, info1.SatelliteID, []storj.PieceID{info1.PieceID})
+		require.NoError(t, err)
+		info1loaded, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(info1, info1loaded, cmp.Comparer(pb.Equal)))
+
+		// uploading a trashed piece again replaces its information
+		err = pieceinfos.Trash(ctx, info1.SatelliteID, info1.PieceID, now)
+		require.NoError(t, err)
+		err = pieceinfos.Add(ctx, info1)
+		require.NoError(t, err)
+		info1loaded, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(info1, info1loaded, cmp.Comparer(pb.Equal)))
+
+		// adding a piece, which isn't trashed, still fails
+		err = pieceinfos.Add(ctx, info1)
+		require.Error(t, err)
+
+		// deleting trashed pieces
+		err = pieceinfos.Trash(ctx, info1.SatelliteID, info1.PieceID, now)
+		require.NoError(t, err)
+		err = pieceinfos.DeleteTrashed(ctx, info1.SatelliteID, now.Add(time.Hour))
+		require.NoError(t, err)
+		err = pieceinfos.RestoreTrash(ctx, info1.SatelliteID, []storj.PieceID{info1.PieceID})
+		require.NoError(t, err)
+		_, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
+		require.Error(t, err, "getting deleted trashed piece")
+
 		// deleting
 		err = pieceinfos.Delete(ctx, info0.SatelliteID, info0.PieceID)
 		require.NoError(t, err)
@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+// Placement defines how new pieces are placed across the storage directories.
+type Placement string
+
+const (
+	// PlacementFreeSpace places new pieces into the directory with the most available space.
+	PlacementFreeSpace = Placement("free-space")
+	// PlacementRoundRobin places new pieces into the directories in turns.
+	PlacementRoundRobin = Placement("round-robin")
+	// PlacementWeight places new pieces randomly, proportional to the weight of the directories.
+	PlacementWeight = Placement("weight")
+)
+
+// Config contains the configuration of the storage directories.
+type Config struct {
+	Placement string     `help:"how new pieces are placed across the storage directories (free-space, round-robin or weight)" default:"free-space"`
+	Dirs      DirConfigs `help:"a comma-separated list of storage directories as path[;allocated=<size>][;weight=<n>][;draining], the main storage path may be listed to configure it" default:""`
+
+	Drain DrainConfig
+}
+
+// Dir is a blob storage directory used by the store.
+type Dir struct {
+	Path  string
+	Blobs storage.Blobs
+	// Allocated limits the space used in the directory, zero means no limit.
+	Allocated int64
+	// Weight is used by the weight placement, it defaults to 1.
+	Weight int
+	// Draining directories don't receive new pieces and their pieces are
+	// migrated to the other directories.
+	Draining bool
+}
+
+// DirConfig contains the configuration of a single storage directory.
+type DirConfig struct {
+	Path      string
+	Allocated memory.Size
+	Weight    int
+	Draining  bool
+}
+
+// ParseDirConfig parses a storage directory in the form of
+// path[;allocated=<size>][;weight=<n>][;draining].
+func ParseDirConfig(s string) (DirConfig, error) {
+	parts := strings.Split(s, ";")
+	config := DirConfig{Path: strings.TrimSpace(parts[0])}
+	if config.Path == "" {
+		return DirConfig{}, Error.New("missing storage directory path: %q", s)
+	}
+
+	for _, option := range parts[1:] {
+		option = strings.TrimSpace(option)
+		name, value := option, ""
+		if i := strings.IndexByte(option, '='); i >= 0 {
+			name, value = option[:i], option[i+1:]
+		}
+
+		switch name {
+		case "allocated":
+			if err := config.Allocated.Set(value); err != nil {
+				return DirConfig{}, Error.New("invalid allocated space %q: %v", value, err)
+			}
+		case "weight":
+			weight, err := strconv.Atoi(value)
+			if err != nil || weight < 0 {
+				return DirConfig{}, Error.New("invalid weight %q", value)
+			}
+			config.Weight = weight
+		case "draining":
+			config.Draining = true
+		default:
+			return DirConfig{}, Error.New("unknown storage directory option %q", option)
+		}
+	}
+	return config, nil
+}
+
+// String returns the configuration in the form accepted by ParseDirConfig.
+func (config DirConfig) String() string {
+	s := config.Path
+	if config.Allocated > 0 {
+		s += ";allocated=" + config.Allocated.String()
+	}
+	if config.Weight > 0 {
+		s += ";weight=" + strconv.Itoa(config.Weight)
+	}
+	if config.Draining {
+		s += ";draining"
+	}
+	return s
+}
+
+// DirConfigs defines a comma delimited flag for defining a list of storage directories.
+type DirConfigs []DirConfig
+
+// String converts DirConfigs to a string
+func (configs DirConfigs) String() string {
+	var xs []string
+	for _, config := range configs {
+		xs = append(xs, config.String())
+	}
+	return strings.Join(xs, ",")
+}
+
+// Set implements flag.Value interface
+func (configs *DirConfigs) Set(s string) error {
+	var parsed DirConfigs
+	if strings.TrimSpace(s) != "" {
+		for _, dir := range strings.Split(s, ",") {
+			config, err := ParseDirConfig(dir)
+			if err != nil {
+				return err
+			}
+			parsed = append(parsed, config)
+		}
+	}
+	*configs = parsed
+	return nil
+}
+
+// Type implements pflag.Value
+func (DirConfigs) Type() string { return "pieces.DirConfigs" }
+
+// OpenDirs opens the configured storage directories. The main directory is
+// opened by the database, listing its path only configures it.
+func OpenDirs(config Config, mainPath string, main storage.Blobs) ([]Dir, error) {
+	dirs := []Dir{{Path: mainPath, Blobs: main}}
+	for _, dirConfig := range config.Dirs {
+		dir := Dir{
+			Path:      dirConfig.Path,
+			Allocated: dirConfig.Allocated.Int64(),
+			Weight:    dirConfig.Weight,
+			Draining:  dirConfig.Draining,
+		}
+
+		if filepath.Clean(dirConfig.Path) == filepath.Clean(mainPath) {
+			dir.Blobs = main
+			dirs[0] = dir
+			continue
+		}
+
+		blobs, err := filestore.NewAt(dirConfig.Path)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		dir.Blobs = blobs
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+)
+
+// DrainConfig contains the configuration of migrating the pieces from the draining storage directories.
+type DrainConfig struct {
+	Interval          time.Duration `help:"how frequently pieces are migrated from the draining storage directories" default:"1h0m0s"`
+	MaxBytesPerSecond memory.Size   `help:"maximum rate of migrating pieces from the draining storage directories, zero means unlimited" default:"10MB"`
+}
+
+// DrainChore migrates the pieces from the draining storage directories to the other directories.
+type DrainChore struct {
+	log    *zap.Logger
+	config DrainConfig
+	store  *Store
+
+	Loop sync2.Cycle
+}
+
+// NewDrainChore creates a new chore, which migrates the pieces of the draining storage directories.
+func NewDrainChore(log *zap.Logger, config DrainConfig, store *Store) *DrainChore {
+	return &DrainChore{
+		log:    log,
+		config: config,
+		store:  store,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run migrates the pieces on every interval.
+func (chore *DrainChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.store.MigrateDraining(ctx, chore.config.MaxBytesPerSecond.Int64()); err != nil {
+			chore.log.Error("error migrating pieces from draining storage directories", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the chore.
+func (chore *DrainChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -23,6 +23,8 @@ type Writer struct {
 	size int64
 
 	closed bool
+	// committed is called with the size of the piece after a commit
+	committed func(size int64)
 }
 
 // NewWriter creates a new writer for storage.BlobWriter.
@@ -58,7 +60,13 @@ func (w *Writer) Commit(ctx context.Context) (err error) {
 	if err := w.buf.Flush(); err != nil {
 		return Error.Wrap(errs.Combine(err, w.blob.Cancel(ctx)))
 	}
-	return Error.Wrap(w.blob.Commit(ctx))
+	if err := w.blob.Commit(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+	if w.committed != nil {
+		w.committed(w.size)
+	}
+	return nil
 }
 
 // Cancel deletes any temporarily written data.
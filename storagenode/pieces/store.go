@@ -5,7 +5,10 @@ package pieces
 
 import (
 	"context"
+	"io"
+	"math/rand"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -13,6 +16,7 @@ import (
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -38,6 +42,7 @@ type Info struct {
 
 	PieceID         storj.PieceID
 	PieceSize       int64
+	PieceCreation   time.Time
 	PieceExpiration *time.Time
 
 	UplinkPieceHash *pb.PieceHash
@@ -67,26 +72,74 @@ type DB interface {
 	SpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (int64, error)
 	// GetExpired gets orders that are expired and were created before some time
 	GetExpired(ctx context.Context, expiredAt time.Time, limit int64) ([]ExpiredInfo, error)
+	// GetPieceIDs gets at most limit pieceIDs of the satellite ordered by piece ID, which come after cursor. The zero cursor lists from the start.
+	GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, cursor storj.PieceID, limit int) (pieceIDs []storj.PieceID, err error)
+	// Trash marks the piece as trashed
+	Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) error
+	// RestoreTrash unmarks the specified trashed pieces of the satellite
+	RestoreTrash(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID) error
+	// DeleteTrashed deletes Info about the pieces of the satellite, which were trashed before the specified time
+	DeleteTrashed(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) error
 }
 
-// Store implements storing pieces onto a blob storage implementation.
+// Store implements storing pieces onto a blob storage implementation, which
+// may span several directories.
 type Store struct {
-	log   *zap.Logger
-	blobs storage.Blobs
+	log       *zap.Logger
+	placement Placement
+	dirs      []*storeDir
+
+	mu   sync.Mutex
+	next int
+	rand *rand.Rand
+}
+
+// storeDir is a storage directory with the space used by it.
+type storeDir struct {
+	Dir
+	used int64
 }
 
 // NewStore creates a new piece store
 func NewStore(log *zap.Logger, blobs storage.Blobs) *Store {
-	return &Store{
-		log:   log,
-		blobs: blobs,
+	store, _ := NewMultiStore(log, PlacementFreeSpace, Dir{Blobs: blobs})
+	return store
+}
+
+// NewMultiStore creates a new piece store spanning several directories
+func NewMultiStore(log *zap.Logger, placement Placement, dirs ...Dir) (*Store, error) {
+	switch placement {
+	case PlacementFreeSpace, PlacementRoundRobin, PlacementWeight:
+	default:
+		return nil, Error.New("unknown placement %q", placement)
+	}
+	if len(dirs) == 0 {
+		return nil, Error.New("no storage directories")
+	}
+
+	store := &Store{
+		log:       log,
+		placement: placement,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, dir := range dirs {
+		if dir.Weight <= 0 {
+			dir.Weight = 1
+		}
+		store.dirs = append(store.dirs, &storeDir{Dir: dir})
 	}
+	return store, nil
 }
 
 // Writer returns a new piece writer.
 func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ *Writer, err error) {
 	defer mon.Task()(&ctx)(&err)
-	blob, err := store.blobs.Create(ctx, storage.BlobRef{
+	dir, err := store.selectDir()
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := dir.Blobs.Create(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
 	}, preallocSize.Int64())
@@ -95,13 +148,17 @@ func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID
 	}
 
 	writer, err := NewWriter(blob, writeBufferSize.Int())
-	return writer, Error.Wrap(err)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	writer.committed = func(size int64) { store.addUsed(dir, size) }
+	return writer, nil
 }
 
 // Reader returns a new piece reader.
 func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ *Reader, err error) {
 	defer mon.Task()(&ctx)(&err)
-	blob, err := store.blobs.Open(ctx, storage.BlobRef{
+	blob, _, err := store.open(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
 	})
@@ -116,31 +173,410 @@ func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID
 	return reader, Error.Wrap(err)
 }
 
+// open looks up the blob in all directories.
+func (store *Store) open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, _ *storeDir, err error) {
+	for _, dir := range store.dirs {
+		var blob storage.BlobReader
+		blob, err = dir.Blobs.Open(ctx, ref)
+		if err == nil {
+			return blob, dir, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+	}
+	// the blob doesn't exist in any of the directories
+	return nil, nil, err
+}
+
 // Delete deletes the specified piece.
 func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	err = store.blobs.Delete(ctx, storage.BlobRef{
+	ref := storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	})
-	return Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, dir := range store.dirs {
+		size, err := blobSize(ctx, dir.Blobs, ref)
+		if os.IsNotExist(err) {
+			continue
+		}
+		errlist.Add(err)
+
+		if err := dir.Blobs.Delete(ctx, ref); err != nil {
+			errlist.Add(err)
+			continue
+		}
+		store.addUsed(dir, -size)
+	}
+	return Error.Wrap(errlist.Err())
+}
+
+// blobSize returns the size of the blob.
+func blobSize(ctx context.Context, blobs storage.Blobs, ref storage.BlobRef) (_ int64, err error) {
+	reader, err := blobs.Open(ctx, ref)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	return reader.Size()
+}
+
+// Trash moves the specified piece to the trash of every directory holding
+// it. It returns an os.IsNotExist error, when none of the directories holds
+// the piece.
+func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	ref := storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	}
+
+	var notExist error
+	var trashed bool
+	var errlist errs.Group
+	for _, dir := range store.dirs {
+		err := dir.Blobs.Trash(ctx, ref)
+		switch {
+		case err == nil:
+			trashed = true
+		case os.IsNotExist(err):
+			notExist = err
+		default:
+			errlist.Add(err)
+		}
+	}
+	if !trashed && errlist.Err() == nil {
+		return notExist
+	}
+	return Error.Wrap(errlist.Err())
+}
+
+// Trash moves the piece to the trash of the store and marks its piece info as
+// trashed, so the piece can be restored until the trash is emptied. The piece
+// info of a piece, which doesn't exist anymore, is deleted, since there's
+// nothing to restore.
+func Trash(ctx context.Context, store *Store, db DB, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trashedAt := time.Now()
+	err = store.Trash(ctx, satellite, pieceID)
+	if os.IsNotExist(err) {
+		return db.Delete(ctx, satellite, pieceID)
+	}
+	if err != nil {
+		// keep the piece info, so we can try again next time
+		return err
+	}
+
+	return db.Trash(ctx, satellite, pieceID, trashedAt)
+}
+
+// RestoreTrash restores the pieces of the satellite, which were trashed after the specified time.
+func (store *Store) RestoreTrash(ctx context.Context, satellite storj.NodeID, trashedAfter time.Time) (_ []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var pieceIDs []storj.PieceID
+	var errlist errs.Group
+	for _, dir := range store.dirs {
+		keys, err := dir.Blobs.RestoreTrash(ctx, satellite.Bytes(), trashedAfter)
+		restored, convErr := keysToPieceIDs(keys)
+		pieceIDs = append(pieceIDs, restored...)
+		errlist.Add(err, convErr)
+	}
+	return pieceIDs, Error.Wrap(errlist.Err())
+}
+
+// EmptyTrash permanently deletes the pieces of the satellite, which were trashed before the specified time.
+func (store *Store) EmptyTrash(ctx context.Context, satellite storj.NodeID, trashedBefore time.Time) (bytesEmptied int64, _ []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var pieceIDs []storj.PieceID
+	var errlist errs.Group
+	for _, dir := range store.dirs {
+		emptied, keys, err := dir.Blobs.EmptyTrash(ctx, satellite.Bytes(), trashedBefore)
+		deleted, convErr := keysToPieceIDs(keys)
+		bytesEmptied += emptied
+		pieceIDs = append(pieceIDs, deleted...)
+		errlist.Add(err, convErr)
+		store.addUsed(dir, -emptied)
+	}
+	return bytesEmptied, pieceIDs, Error.Wrap(errlist.Err())
+}
+
+// Satellites returns the satellites which have pieces or trash in the store.
+func (store *Store) Satellites(ctx context.Context) (_ []storj.NodeID, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var satellites []storj.NodeID
+	seen := map[storj.NodeID]bool{}
+	for _, dir := range store.dirs {
+		namespaces, err := dir.Blobs.ListNamespaces(ctx)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, namespace := range namespaces {
+			satellite, err := storj.NodeIDFromBytes(namespace)
+			if err != nil {
+				store.log.Warn("invalid satellite namespace", zap.Binary("namespace", namespace), zap.Error(err))
+				continue
+			}
+			if !seen[satellite] {
+				seen[satellite] = true
+				satellites = append(satellites, satellite)
+			}
+		}
+	}
+	return satellites, nil
+}
+
+// RefreshSpaceUsed recalculates the space used in every directory by walking
+// all of them. Afterwards the store tracks the space used by itself, as pieces
+// are written, deleted and migrated.
+func (store *Store) RefreshSpaceUsed(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var errlist errs.Group
+	for _, dir := range store.dirs {
+		used, err := dir.Blobs.SpaceUsed(ctx)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		store.mu.Lock()
+		dir.used = used
+		store.mu.Unlock()
+	}
+	return Error.Wrap(errlist.Err())
+}
+
+// MigrateDraining moves the pieces from the draining directories to the
+// other directories, at most maxBytesPerSecond bytes per second. Zero
+// maxBytesPerSecond doesn't limit the rate.
+func (store *Store) MigrateDraining(ctx context.Context, maxBytesPerSecond int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.dirs {
+		if !dir.Draining {
+			continue
+		}
+
+		namespaces, err := dir.Blobs.ListNamespaces(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, namespace := range namespaces {
+			var keys [][]byte
+			err := dir.Blobs.WalkNamespace(ctx, namespace, func(key []byte) error {
+				keys = append(keys, key)
+				return nil
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			for _, key := range keys {
+				ref := storage.BlobRef{Namespace: namespace, Key: key}
+				size, err := store.migrate(ctx, dir, ref)
+				if err != nil {
+					return err
+				}
+
+				if maxBytesPerSecond > 0 && size > 0 {
+					if !sync2.Sleep(ctx, time.Duration(size)*time.Second/time.Duration(maxBytesPerSecond)) {
+						return ctx.Err()
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// migrate copies a blob from the draining directory to another directory
+// and deletes the original. It returns the size of the migrated blob.
+func (store *Store) migrate(ctx context.Context, source *storeDir, ref storage.BlobRef) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := source.Blobs.Open(ctx, ref)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// deleted concurrently
+			return 0, nil
+		}
+		return 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	size, err := reader.Size()
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	target, err := store.selectDir()
+	if err != nil {
+		return 0, err
+	}
+	writer, err := target.Blobs.Create(ctx, ref, size)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if _, err := io.Copy(writer, reader); err != nil {
+		return 0, Error.Wrap(errs.Combine(err, writer.Cancel(ctx)))
+	}
+	if err := writer.Commit(ctx); err != nil {
+		return 0, Error.Wrap(err)
+	}
+	store.addUsed(target, size)
+
+	if err := source.Blobs.Delete(ctx, ref); err != nil {
+		if os.IsNotExist(err) {
+			// deleted concurrently
+			return size, nil
+		}
+		return size, Error.Wrap(err)
+	}
+	store.addUsed(source, -size)
+	return size, nil
+}
+
+// selectDir selects the directory for a new piece.
+func (store *Store) selectDir() (*storeDir, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	var candidates []*storeDir
+	var available []int64
+	for _, dir := range store.dirs {
+		if dir.Draining {
+			continue
+		}
+		free, err := store.availableSpace(dir)
+		if err != nil {
+			store.log.Warn("unable to get free space", zap.String("path", dir.Path), zap.Error(err))
+			continue
+		}
+		if free < preallocSize.Int64() {
+			continue
+		}
+		candidates = append(candidates, dir)
+		available = append(available, free)
+	}
+	if len(candidates) == 0 {
+		return nil, Error.New("no storage directory with enough free space")
+	}
+
+	switch store.placement {
+	case PlacementRoundRobin:
+		// continue with the directory after the previously selected one
+		for i := range store.dirs {
+			dir := store.dirs[(store.next+i)%len(store.dirs)]
+			for _, candidate := range candidates {
+				if candidate == dir {
+					store.next = (store.next + i + 1) % len(store.dirs)
+					return dir, nil
+				}
+			}
+		}
+	case PlacementWeight:
+		total := 0
+		for _, dir := range candidates {
+			total += dir.Weight
+		}
+		n := store.rand.Intn(total)
+		for _, dir := range candidates {
+			if n < dir.Weight {
+				return dir, nil
+			}
+			n -= dir.Weight
+		}
+	}
+
+	best := 0
+	for i := range candidates {
+		if available[i] > available[best] {
+			best = i
+		}
+	}
+	return candidates[best], nil
+}
+
+// availableSpace returns the space available in the directory, considering
+// the allocated space. It must be called with the mutex held.
+func (store *Store) availableSpace(dir *storeDir) (int64, error) {
+	free, err := dir.Blobs.FreeSpace()
+	if err != nil {
+		return 0, err
+	}
+	if dir.Allocated > 0 && dir.Allocated-dir.used < free {
+		free = dir.Allocated - dir.used
+	}
+	if free < 0 {
+		free = 0
+	}
+	return free, nil
+}
+
+func (store *Store) addUsed(dir *storeDir, size int64) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	dir.used += size
+}
+
+// keysToPieceIDs converts blob keys to piece ids.
+func keysToPieceIDs(keys [][]byte) (pieceIDs []storj.PieceID, err error) {
+	var errlist errs.Group
+	for _, key := range keys {
+		pieceID, err := storj.PieceIDFromBytes(key)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs, errlist.Err()
 }
 
 // StorageStatus contains information about the disk store is using.
 type StorageStatus struct {
 	DiskUsed int64
 	DiskFree int64
+
+	Dirs []DirStatus
+}
+
+// DirStatus contains information about a storage directory.
+type DirStatus struct {
+	Path      string
+	Allocated int64
+	Used      int64
+	Free      int64
+	Draining  bool
 }
 
-// StorageStatus returns information about the disk.
+// StorageStatus returns information about the disks. The free space of the
+// draining directories isn't included, since no pieces are placed there.
 func (store *Store) StorageStatus(ctx context.Context) (_ StorageStatus, err error) {
 	defer mon.Task()(&ctx)(&err)
-	diskFree, err := store.blobs.FreeSpace()
-	if err != nil {
-		return StorageStatus{}, err
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	var status StorageStatus
+	for _, dir := range store.dirs {
+		free, err := store.availableSpace(dir)
+		if err != nil {
+			return StorageStatus{}, err
+		}
+		status.Dirs = append(status.Dirs, DirStatus{
+			Path:      dir.Path,
+			Allocated: dir.Allocated,
+			Used:      dir.used,
+			Free:      free,
+			Draining:  dir.Draining,
+		})
+		status.DiskUsed += dir.used
+		if !dir.Draining {
+			status.DiskFree += free
+		}
 	}
-	return StorageStatus{
-		DiskUsed: -1, // TODO set value
-		DiskFree: diskFree,
-	}, nil
+	return status, nil
 }
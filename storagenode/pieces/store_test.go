@@ -6,17 +6,21 @@ package pieces_test
 import (
 	"bytes"
 	"io"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/pieces"
 )
@@ -109,3 +113,171 @@ func TestPieces(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestMultipleDirs(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var dirs []pieces.Dir
+	for _, name := range []string{"first", "second"} {
+		blobs, err := filestore.NewAt(ctx.Dir(name))
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+		dirs = append(dirs, pieces.Dir{Path: ctx.Dir(name), Blobs: blobs})
+	}
+
+	store, err := pieces.NewMultiStore(zaptest.NewLogger(t), pieces.PlacementRoundRobin, dirs...)
+	require.NoError(t, err)
+
+	satelliteID := testrand.NodeID()
+	contents := map[storj.PieceID][]byte{}
+	for i := 0; i < 4; i++ {
+		pieceID := testrand.PieceID()
+		contents[pieceID] = testrand.Bytes(1000)
+
+		writer, err := store.Writer(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(contents[pieceID])
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	countPieces := func(dir pieces.Dir) (count int) {
+		err := dir.Blobs.WalkNamespace(ctx, satelliteID.Bytes(), func(key []byte) error {
+			count++
+			return nil
+		})
+		require.NoError(t, err)
+		return count
+	}
+
+	// round-robin placement uses both directories
+	assert.Equal(t, 2, countPieces(dirs[0]))
+	assert.Equal(t, 2, countPieces(dirs[1]))
+
+	status, err := store.StorageStatus(ctx)
+	require.NoError(t, err)
+	require.Len(t, status.Dirs, 2)
+	assert.EqualValues(t, 4000, status.DiskUsed)
+
+	readAll := func() {
+		for pieceID, content := range contents {
+			reader, err := store.Reader(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(io.LimitReader(reader, reader.Size()))
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+			assert.Equal(t, content, data)
+		}
+	}
+	readAll()
+
+	// draining the first directory moves its pieces to the second
+	dirs[0].Draining = true
+	store, err = pieces.NewMultiStore(zaptest.NewLogger(t), pieces.PlacementRoundRobin, dirs...)
+	require.NoError(t, err)
+	require.NoError(t, store.RefreshSpaceUsed(ctx))
+	require.NoError(t, store.MigrateDraining(ctx, 0))
+
+	assert.Equal(t, 0, countPieces(dirs[0]))
+	assert.Equal(t, 4, countPieces(dirs[1]))
+	readAll()
+
+	status, err = store.StorageStatus(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, status.Dirs[0].Used)
+	assert.EqualValues(t, 4000, status.Dirs[1].Used)
+
+	// new pieces are not placed into the draining directory
+	pieceID := testrand.PieceID()
+	writer, err := store.Writer(ctx, satelliteID, pieceID)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(1000))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+	assert.Equal(t, 0, countPieces(dirs[0]))
+
+	for pieceID := range contents {
+		require.NoError(t, store.Delete(ctx, satelliteID, pieceID))
+		_, err := store.Reader(ctx, satelliteID, pieceID)
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	// the space used is tracked without walking the directories
+	status, err = store.StorageStatus(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, status.Dirs[0].Used)
+	assert.EqualValues(t, 1000, status.Dirs[1].Used)
+
+	// trashing removes a piece from every directory holding it
+	for _, dir := range dirs {
+		blob, err := dir.Blobs.Create(ctx, storage.BlobRef{
+			Namespace: satelliteID.Bytes(),
+			Key:       pieceID.Bytes(),
+		}, 1000)
+		require.NoError(t, err)
+		_, err = blob.Write(testrand.Bytes(1000))
+		require.NoError(t, err)
+		require.NoError(t, blob.Commit(ctx))
+	}
+	require.NoError(t, store.Trash(ctx, satelliteID, pieceID))
+	assert.Equal(t, 0, countPieces(dirs[0]))
+	assert.Equal(t, 0, countPieces(dirs[1]))
+
+	err = store.Trash(ctx, satelliteID, pieceID)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestAllocatedDirSpace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	blobs, err := filestore.NewAt(ctx.Dir("pieces"))
+	require.NoError(t, err)
+	defer ctx.Check(blobs.Close)
+
+	store, err := pieces.NewMultiStore(zaptest.NewLogger(t), pieces.PlacementFreeSpace, pieces.Dir{
+		Path:      ctx.Dir("pieces"),
+		Blobs:     blobs,
+		Allocated: 5 * memory.MiB.Int64(),
+	})
+	require.NoError(t, err)
+
+	status, err := store.StorageStatus(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5*memory.MiB, status.DiskFree)
+
+	satelliteID := testrand.NodeID()
+	writer, err := store.Writer(ctx, satelliteID, testrand.PieceID())
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(2 * memory.MiB))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	status, err = store.StorageStatus(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3*memory.MiB, status.DiskFree)
+
+	// there isn't enough space left for the preallocation of a new piece
+	_, err = store.Writer(ctx, satelliteID, testrand.PieceID())
+	assert.Error(t, err)
+}
+
+func TestParseDirConfigs(t *testing.T) {
+	var configs pieces.DirConfigs
+	require.NoError(t, configs.Set("/mnt/a;allocated=2TB;weight=3,/mnt/b;draining"))
+	require.Len(t, configs, 2)
+	assert.Equal(t, pieces.DirConfig{Path: "/mnt/a", Allocated: 2 * memory.TB, Weight: 3}, configs[0])
+	assert.Equal(t, pieces.DirConfig{Path: "/mnt/b", Draining: true}, configs[1])
+
+	var reparsed pieces.DirConfigs
+	require.NoError(t, reparsed.Set(configs.String()))
+	assert.Equal(t, configs, reparsed)
+
+	require.NoError(t, configs.Set(""))
+	assert.Empty(t, configs)
+
+	for _, invalid := range []string{";weight=1", "/mnt/a;weight=x", "/mnt/a;allocated=lots", "/mnt/a;unknown"} {
+		assert.Error(t, configs.Set(invalid), invalid)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/bloomfilter"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -42,6 +43,7 @@ var (
 	ErrInternal = errs.Class("piecestore internal")
 )
 var _ pb.PiecestoreServer = (*Endpoint)(nil)
+var _ pb.GarbageCollectionServer = (*Endpoint)(nil)
 
 // OldConfig contains everything necessary for a server
 type OldConfig struct {
@@ -58,9 +60,12 @@ type Config struct {
 	ExpirationGracePeriod time.Duration `help:"how soon before expiration date should things be considered expired" default:"48h0m0s"`
 	MaxConcurrentRequests int           `help:"how many concurrent requests are allowed, before uploads are rejected." default:"6"`
 	OrderLimitGracePeriod time.Duration `help:"how long after OrderLimit creation date are OrderLimits no longer accepted" default:"1h0m0s"`
+	RetainTimeBuffer      time.Duration `help:"allows for small differences in the satellite and storagenode clocks" default:"1h0m0s"`
+	RetainEnabled         bool          `help:"if true, pieces not contained in a garbage collection filter are deleted" default:"true"`
 
 	Monitor monitor.Config
 	Sender  orders.SenderConfig
+	Pieces  pieces.Config
 }
 
 // Endpoint implements uploading, downloading and deleting for a storage node.
@@ -117,11 +122,8 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 		return nil, Error.Wrap(err)
 	}
 
-	// TODO: parallelize this and maybe return early
-	pieceInfoErr := endpoint.pieceinfo.Delete(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
-	pieceErr := endpoint.store.Delete(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
-
-	if err := errs.Combine(pieceInfoErr, pieceErr); err != nil {
+	// pieces are moved to the trash, so that a mistaken delete can be restored
+	if err := pieces.Trash(ctx, endpoint.store, endpoint.pieceinfo, delete.Limit.SatelliteId, delete.Limit.PieceId); err != nil {
 		// explicitly ignoring error because the errors
 		// TODO: add more debug info
 		endpoint.log.Error("delete failed", zap.Stringer("Piece ID", delete.Limit.PieceId), zap.Error(err))
@@ -222,12 +224,12 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 		}
 	}()
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidthForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
 
-	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	availableSpace, err := endpoint.monitor.AvailableSpaceForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
@@ -307,6 +309,7 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 
 					PieceID:         limit.PieceId,
 					PieceSize:       pieceWriter.Size(),
+					PieceCreation:   limit.OrderCreation,
 					PieceExpiration: expiration,
 
 					UplinkPieceHash: message.Done,
@@ -430,7 +433,7 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 		return Error.New("requested more data than available, requesting=%v available=%v", chunk.Offset+chunk.ChunkSize, pieceReader.Size())
 	}
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidthForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
@@ -527,6 +530,65 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 	return Error.Wrap(errs.Combine(sendErr, recvErr))
 }
 
+// Retain keeps only piece ids specified in the request
+func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainRequest) (res *pb.RetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, Error.New("retain called with untrusted ID").Error())
+	}
+
+	filter, err := bloomfilter.NewFromBytes(retainReq.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	}
+
+	// subtract some time to leave room for clock difference between the satellite and storage node
+	createdBefore := retainReq.GetCreationDate().Add(-endpoint.config.RetainTimeBuffer)
+
+	const limit = 1000
+	var cursor storj.PieceID
+	numDeleted := 0
+	for {
+		pieceIDs, err := endpoint.pieceinfo.GetPieceIDs(ctx, peer.ID, createdBefore, cursor, limit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+
+		for _, pieceID := range pieceIDs {
+			if filter.Contains(pieceID) {
+				continue
+			}
+			if !endpoint.config.RetainEnabled {
+				endpoint.log.Debug("garbage collection would delete", zap.Stringer("Piece ID", pieceID))
+				continue
+			}
+
+			if err := pieces.Trash(ctx, endpoint.store, endpoint.pieceinfo, peer.ID, pieceID); err != nil {
+				endpoint.log.Error("failed to trash a piece", zap.Stringer("Piece ID", pieceID), zap.Error(err))
+				continue
+			}
+			numDeleted++
+		}
+
+		if len(pieceIDs) < limit {
+			break
+		}
+		cursor = pieceIDs[len(pieceIDs)-1]
+	}
+
+	mon.IntVal("garbage_collection_pieces_deleted").Observe(int64(numDeleted))
+	endpoint.log.Info("retain completed", zap.Stringer("SatelliteID", peer.ID), zap.Int("deleted", numDeleted))
+
+	return &pb.RetainResponse{}, nil
+}
+
 // SaveOrder saves the order with all necessary information. It assumes it has been already verified.
 func (endpoint *Endpoint) SaveOrder(ctx context.Context, limit *pb.OrderLimit, order *pb.Order, uplink *identity.PeerIdentity) {
 	var err error
@@ -0,0 +1,304 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrubber implements a service, which periodically verifies the
+// stored pieces against the hashes signed by the uplinks, so that the node
+// finds damaged pieces before the satellite audits them.
+package scrubber
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for scrubber errors
+	Error = errs.Class("scrubber")
+
+	mon = monkit.Package()
+)
+
+const (
+	// batchSize is the number of piece IDs loaded at once
+	batchSize = 1000
+	// maxPiecesPerReport is the number of damaged pieces sent to a satellite at once
+	maxPiecesPerReport = 1000
+)
+
+// Config contains configurable values for the scrubber
+type Config struct {
+	Interval          time.Duration `help:"how frequently the stored pieces are checked for damage" releaseDefault:"168h" devDefault:"1m"`
+	ReportDamaged     bool          `help:"report damaged pieces to the satellites, so they can be repaired early" default:"true"`
+	MaxBytesPerSecond memory.Size   `help:"maximum rate of reading pieces while checking them for damage, zero means unlimited" default:"10MB"`
+}
+
+// DamagedPiece is a piece, which failed verification
+type DamagedPiece struct {
+	SatelliteID storj.NodeID
+	PieceID     storj.PieceID
+	Reason      pb.DamagedPiece_Reason
+	DetectedAt  time.Time
+	Reported    bool
+}
+
+// Status contains the results of the last scrub
+type Status struct {
+	LastStarted   time.Time
+	LastFinished  time.Time
+	PiecesChecked int64
+	Damaged       []DamagedPiece
+}
+
+// Service verifies the stored pieces
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	trust     *trust.Pool
+	store     *pieces.Store
+	pieceinfo pieces.DB
+	kademlia  *kademlia.Kademlia
+	transport transport.Client
+
+	mu     sync.Mutex
+	status Status
+}
+
+// NewService creates a new scrubber service
+func NewService(log *zap.Logger, config Config, trust *trust.Pool, store *pieces.Store, pieceinfo pieces.DB, kademlia *kademlia.Kademlia, transport transport.Client) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		trust:     trust,
+		store:     store,
+		pieceinfo: pieceinfo,
+		kademlia:  kademlia,
+		transport: transport,
+	}
+}
+
+// Run starts the scrubber
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		if err := service.Scrub(ctx); err != nil {
+			service.log.Error("error scrubbing pieces", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the scrubber
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Status returns the results of the last scrub
+func (service *Service) Status() Status {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	status := service.status
+	status.Damaged = append([]DamagedPiece(nil), service.status.Damaged...)
+	return status
+}
+
+// Scrub verifies all pieces of the trusted satellites
+func (service *Service) Scrub(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	started := time.Now()
+	service.mu.Lock()
+	service.status.LastStarted = started
+	service.mu.Unlock()
+
+	var checked int64
+	var damaged []DamagedPiece
+	var group errs.Group
+	for _, satelliteID := range service.trust.GetSatellites(ctx) {
+		satelliteDamaged, err := service.scrubSatellite(ctx, satelliteID, started, &checked)
+		if err != nil {
+			group.Add(Error.New("satellite %s: %v", satelliteID, err))
+		}
+
+		if len(satelliteDamaged) > 0 && service.config.ReportDamaged {
+			if err := service.report(ctx, satelliteID, satelliteDamaged); err != nil {
+				service.log.Warn("unable to report damaged pieces", zap.Stringer("satellite ID", satelliteID), zap.Error(err))
+			}
+		}
+		damaged = append(damaged, satelliteDamaged...)
+	}
+
+	mon.IntVal("pieces_checked").Observe(checked)
+	mon.IntVal("pieces_damaged").Observe(int64(len(damaged)))
+
+	service.mu.Lock()
+	service.status.LastFinished = time.Now()
+	service.status.PiecesChecked = checked
+	service.status.Damaged = damaged
+	service.mu.Unlock()
+
+	return group.Err()
+}
+
+// scrubSatellite verifies the pieces of a satellite, which were created before the scrub started
+func (service *Service) scrubSatellite(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, checked *int64) (damaged []DamagedPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var cursor storj.PieceID
+	for {
+		pieceIDs, err := service.pieceinfo.GetPieceIDs(ctx, satelliteID, createdBefore, cursor, batchSize)
+		if err != nil {
+			return damaged, Error.Wrap(err)
+		}
+		if len(pieceIDs) == 0 {
+			return damaged, nil
+		}
+		cursor = pieceIDs[len(pieceIDs)-1]
+
+		for _, pieceID := range pieceIDs {
+			if err := ctx.Err(); err != nil {
+				return damaged, err
+			}
+
+			size, ok, reason, err := service.verify(ctx, satelliteID, pieceID)
+			if err := service.throttle(ctx, size); err != nil {
+				return damaged, err
+			}
+			if err != nil {
+				service.log.Debug("unable to verify piece", zap.Stringer("piece ID", pieceID), zap.Error(err))
+				continue
+			}
+			*checked++
+
+			if !ok {
+				service.log.Warn("damaged piece", zap.Stringer("satellite ID", satelliteID), zap.Stringer("piece ID", pieceID), zap.Stringer("reason", reason))
+				damaged = append(damaged, DamagedPiece{
+					SatelliteID: satelliteID,
+					PieceID:     pieceID,
+					Reason:      reason,
+					DetectedAt:  time.Now(),
+				})
+			}
+		}
+	}
+}
+
+// throttle waits long enough after reading size bytes to keep the scrub
+// below the configured rate.
+func (service *Service) throttle(ctx context.Context, size int64) error {
+	maxBytesPerSecond := service.config.MaxBytesPerSecond.Int64()
+	if maxBytesPerSecond <= 0 || size <= 0 {
+		return nil
+	}
+	if !sync2.Sleep(ctx, time.Duration(size)*time.Second/time.Duration(maxBytesPerSecond)) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// verify compares the hash of the stored piece with the one signed by the uplink.
+// It returns the number of bytes read from the piece.
+func (service *Service) verify(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (size int64, ok bool, reason pb.DamagedPiece_Reason, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := service.pieceinfo.Get(ctx, satelliteID, pieceID)
+	if err != nil {
+		// the piece may have been deleted since listing
+		return 0, false, reason, err
+	}
+	if info.UplinkPieceHash == nil {
+		return 0, false, reason, Error.New("missing uplink piece hash")
+	}
+
+	reader, err := service.store.Reader(ctx, satelliteID, pieceID)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, pb.DamagedPiece_MISSING, nil
+		}
+		return 0, false, reason, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	hash := pkcrypto.NewHash()
+	// the piece reader wraps io.EOF, so limit the reads to the piece size
+	size, err = io.Copy(hash, io.LimitReader(reader, reader.Size()))
+	if err != nil {
+		return size, false, pb.DamagedPiece_CORRUPTED, nil
+	}
+	if !bytes.Equal(hash.Sum(nil), info.UplinkPieceHash.Hash) {
+		return size, false, pb.DamagedPiece_CORRUPTED, nil
+	}
+	return size, true, reason, nil
+}
+
+// report sends the damaged pieces to the satellite and trashes them, once the
+// satellite has acknowledged the report. Pieces, which weren't reported, are
+// kept, so they are reported again by the next scrub.
+func (service *Service) report(ctx context.Context, satelliteID storj.NodeID, damaged []DamagedPiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellite, err := service.kademlia.FindNode(ctx, satelliteID)
+	if err != nil {
+		return Error.New("unable to find satellite on the network: %v", err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, &satellite)
+	if err != nil {
+		return Error.New("unable to connect to the satellite: %v", err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	client := pb.NewDamagedPiecesClient(conn)
+	for len(damaged) > 0 {
+		batch := damaged
+		if len(batch) > maxPiecesPerReport {
+			batch = batch[:maxPiecesPerReport]
+		}
+		damaged = damaged[len(batch):]
+
+		request := &pb.DamagedPiecesReportRequest{}
+		for _, piece := range batch {
+			request.Pieces = append(request.Pieces, &pb.DamagedPiece{
+				PieceId: piece.PieceID,
+				Reason:  piece.Reason,
+			})
+		}
+		if _, err := client.Report(ctx, request); err != nil {
+			return Error.Wrap(err)
+		}
+
+		for i := range batch {
+			batch[i].Reported = true
+			if err := pieces.Trash(ctx, service.store, service.pieceinfo, satelliteID, batch[i].PieceID); err != nil {
+				service.log.Warn("unable to trash damaged piece", zap.Stringer("piece ID", batch[i].PieceID), zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
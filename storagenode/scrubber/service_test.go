@@ -0,0 +1,141 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/uplink"
+)
+
+func TestScrubReportsDamagedPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+		for _, node := range planet.StorageNodes {
+			node.Scrubber.Loop.Pause()
+		}
+
+		redundancy := uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+		err := planet.Uplinks[0].UploadWithConfig(ctx, satellite, &redundancy, "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		path, pointer := getRemotePointer(ctx, t, satellite)
+		remote := pointer.GetRemote()
+		damaged := remote.GetRemotePieces()[0]
+		pieceID := remote.RootPieceId.Derive(damaged.NodeId, damaged.PieceNum)
+
+		var node *storagenode.Peer
+		for _, peer := range planet.StorageNodes {
+			if peer.ID() == damaged.NodeId {
+				node = peer
+			}
+		}
+		require.NotNil(t, node)
+
+		// overwrite the piece with garbage
+		writer, err := node.Storage2.Store.Writer(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		// the scrubber skips pieces created in the same second it starts
+		time.Sleep(time.Second)
+		require.NoError(t, node.Scrubber.Scrub(ctx))
+
+		status := node.Scrubber.Status()
+		assert.EqualValues(t, 1, status.PiecesChecked)
+		require.Len(t, status.Damaged, 1)
+		assert.Equal(t, pieceID, status.Damaged[0].PieceID)
+		assert.Equal(t, pb.DamagedPiece_CORRUPTED, status.Damaged[0].Reason)
+		assert.True(t, status.Damaged[0].Reported)
+
+		// the reported piece is trashed on the node
+		_, err = node.DB.PieceInfo().Get(ctx, satellite.ID(), pieceID)
+		assert.Error(t, err)
+		_, err = node.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+		assert.Error(t, err)
+
+		reports, err := satellite.DB.DamagedPieces().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, damaged.NodeId, reports[0].NodeID)
+		assert.Equal(t, pieceID, reports[0].PieceID)
+
+		// the checker queues the segment for repair without changing the pointer
+		require.NoError(t, satellite.Repair.Checker.IdentifyInjuredSegments(ctx))
+
+		injured, err := satellite.DB.RepairQueue().SelectN(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, injured, 1)
+		assert.Equal(t, path, injured[0].Path)
+		assert.Contains(t, injured[0].LostPieces, damaged.PieceNum)
+
+		unchanged, err := satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		assert.Len(t, unchanged.GetRemote().GetRemotePieces(), 4)
+
+		// the repairer replaces the damaged piece and removes the report
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.Limiter.Wait()
+
+		pointer, err = satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		pieces := pointer.GetRemote().GetRemotePieces()
+		require.Len(t, pieces, 4)
+		for _, piece := range pieces {
+			assert.NotEqual(t, damaged.NodeId, piece.NodeId)
+		}
+
+		reports, err = satellite.DB.DamagedPieces().GetAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, reports)
+	})
+}
+
+func getRemotePointer(ctx context.Context, t *testing.T, satellite *satellite.Peer) (path string, pointer *pb.Pointer) {
+	err := satellite.Metainfo.Service.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer = &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return err
+				}
+				if pointer.GetRemote() != nil {
+					path = item.Key.String()
+					return nil
+				}
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	require.NotEmpty(t, path)
+	return path, pointer
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/gracefulexit"
+)
+
+type gracefulexitdb struct{ *InfoDB }
+
+// GracefulExit returns database for storing the graceful exits from satellites
+func (db *DB) GracefulExit() gracefulexit.DB { return db.info.GracefulExit() }
+
+// GracefulExit returns database for storing the graceful exits from satellites
+func (db *InfoDB) GracefulExit() gracefulexit.DB { return &gracefulexitdb{db} }
+
+// Initiate starts the graceful exit from a satellite
+func (db *gracefulexitdb) Initiate(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Exec(`
+		INSERT INTO graceful_exit_status (satellite_id, initiated_at)
+		VALUES (?, ?)
+	`, satelliteID, initiatedAt.UTC())
+	return ErrInfo.Wrap(err)
+}
+
+// IncrementProgress adds to the transferred pieces and bytes of an exit
+func (db *gracefulexitdb) IncrementProgress(ctx context.Context, satelliteID storj.NodeID, piecesTransferred, piecesFailed, bytesTransferred int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Exec(`
+		UPDATE graceful_exit_status SET
+			pieces_transferred = pieces_transferred + ?,
+			pieces_failed = pieces_failed + ?,
+			bytes_transferred = bytes_transferred + ?
+		WHERE satellite_id = ?
+	`, piecesTransferred, piecesFailed, bytesTransferred, satelliteID)
+	return ErrInfo.Wrap(err)
+}
+
+// Complete finishes the graceful exit from a satellite
+func (db *gracefulexitdb) Complete(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, successful bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Exec(`
+		UPDATE graceful_exit_status SET
+			finished_at = ?,
+			successful = ?
+		WHERE satellite_id = ?
+	`, finishedAt.UTC(), successful, satelliteID)
+	return ErrInfo.Wrap(err)
+}
+
+// Get returns the graceful exit from a satellite, nil if there's none
+func (db *gracefulexitdb) Get(ctx context.Context, satelliteID storj.NodeID) (_ *gracefulexit.Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, initiated_at, finished_at, pieces_transferred, pieces_failed, bytes_transferred, successful
+		FROM graceful_exit_status
+		WHERE satellite_id = ?
+	`, satelliteID)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	statuses, err := scanGracefulExitStatuses(rows)
+	if err != nil || len(statuses) == 0 {
+		return nil, ErrInfo.Wrap(err)
+	}
+	return statuses[0], nil
+}
+
+// List returns all graceful exits
+func (db *gracefulexitdb) List(ctx context.Context) (_ []*gracefulexit.Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, initiated_at, finished_at, pieces_transferred, pieces_failed, bytes_transferred, successful
+		FROM graceful_exit_status
+		ORDER BY initiated_at
+	`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	statuses, err := scanGracefulExitStatuses(rows)
+	return statuses, ErrInfo.Wrap(err)
+}
+
+func scanGracefulExitStatuses(rows *sql.Rows) (statuses []*gracefulexit.Status, err error) {
+	for rows.Next() {
+		status := &gracefulexit.Status{}
+		err := rows.Scan(&status.SatelliteID, &status.InitiatedAt, &status.FinishedAt,
+			&status.PiecesTransferred, &status.PiecesFailed, &status.BytesTransferred, &status.Successful)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, rows.Err()
+}
@@ -217,6 +217,68 @@ func (db *InfoDB) Migration() *migrate.Migration {
 					)`,
 				},
 			},
+			{
+				Description: "Add creation date.",
+				Version:     6,
+				Action: migrate.SQL{
+					`ALTER TABLE pieceinfo ADD COLUMN piece_creation TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00+00:00'`,
+				},
+			},
+			{
+				Description: "Add trashed date.",
+				Version:     7,
+				Action: migrate.SQL{
+					`ALTER TABLE pieceinfo ADD COLUMN trashed_at TIMESTAMP`,
+				},
+			},
+			{
+				Description: "Add graceful exit status table.",
+				Version:     8,
+				Action: migrate.SQL{
+					`CREATE TABLE graceful_exit_status (
+						satellite_id       BLOB      PRIMARY KEY NOT NULL,
+						initiated_at       TIMESTAMP NOT NULL,
+						finished_at        TIMESTAMP,
+						pieces_transferred INTEGER   NOT NULL DEFAULT 0,
+						pieces_failed      INTEGER   NOT NULL DEFAULT 0,
+						bytes_transferred  INTEGER   NOT NULL DEFAULT 0,
+						successful         INTEGER   NOT NULL DEFAULT 0
+					)`,
+				},
+			},
+			{
+				Description: "Add order archive summary table.",
+				Version:     9,
+				Action: migrate.SQL{
+					`CREATE TABLE order_archive_summary (
+						satellite_id BLOB      NOT NULL,
+						day          TIMESTAMP NOT NULL, -- the day the orders were archived
+						action       INTEGER   NOT NULL,
+						status       INTEGER   NOT NULL,
+						order_count  INTEGER   NOT NULL,
+						total_amount INTEGER   NOT NULL,
+						PRIMARY KEY (satellite_id, day, action, status)
+					)`,
+					`CREATE INDEX idx_order_archive_archived_at ON order_archive(archived_at)`,
+				},
+			},
+			{
+				Description: "Add order status totals table.",
+				Version:     10,
+				Action: migrate.Func(func(log *zap.Logger, _ migrate.DB, tx *sql.Tx) error {
+					_, err := tx.Exec(`
+						CREATE TABLE order_status_totals (
+							status       INTEGER PRIMARY KEY NOT NULL,
+							order_count  INTEGER NOT NULL,
+							total_amount INTEGER NOT NULL
+						)`)
+					if err != nil {
+						return ErrInfo.Wrap(err)
+					}
+
+					return ErrInfo.Wrap(fillOrderStatusTotals(tx))
+				}),
+			},
 		},
 	}
 }
@@ -168,7 +168,37 @@ func (db *ordersdb) ListUnsentBySatellite(ctx context.Context) (_ map[storj.Node
 func (db *ordersdb) Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status orders.Status) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	result, err := db.db.Exec(`
+	tx, err := db.Begin()
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrInfo.Wrap(tx.Rollback()))
+		} else {
+			err = ErrInfo.Wrap(tx.Commit())
+		}
+	}()
+
+	var orderSerialized []byte
+	err = tx.QueryRow(`
+		SELECT order_serialized
+		FROM unsent_order
+		WHERE satellite_id = ? AND serial_number = ?
+	`, satellite, serial).Scan(&orderSerialized)
+	if err == sql.ErrNoRows {
+		return ErrInfo.New("order was not in unsent list")
+	}
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	order := &pb.Order{}
+	if err := proto.Unmarshal(orderSerialized, order); err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	_, err = tx.Exec(`
 		INSERT INTO order_archive (
 			satellite_id, serial_number,
 			order_limit_serialized, order_serialized,
@@ -184,20 +214,19 @@ func (db *ordersdb) Archive(ctx context.Context, satellite storj.NodeID, serial
 
 		DELETE FROM unsent_order
 		WHERE satellite_id = ? AND serial_number = ?;
-	`, int(status), time.Now(), satellite, serial, satellite, serial)
+	`, int(status), time.Now().UTC(), satellite, serial, satellite, serial)
 	if err != nil {
 		return ErrInfo.Wrap(err)
 	}
 
-	count, err := result.RowsAffected()
-	if err != nil {
-		return ErrInfo.Wrap(err)
-	}
-	if count == 0 {
-		return ErrInfo.New("order was not in unsent list")
-	}
-
-	return nil
+	_, err = tx.Exec(`
+		INSERT INTO order_status_totals(status, order_count, total_amount)
+		VALUES (?, 1, ?)
+		ON CONFLICT(status) DO UPDATE SET
+			order_count = order_count + 1,
+			total_amount = total_amount + excluded.total_amount
+	`, int(status), order.Amount)
+	return ErrInfo.Wrap(err)
 }
 
 // ListArchived returns orders that have been sent.
@@ -219,6 +248,35 @@ func (db *ordersdb) ListArchived(ctx context.Context, limit int) (_ []*orders.Ar
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
 
+	return scanArchived(ctx, rows)
+}
+
+// ListArchivedAfter returns orders archived after the specified time, oldest first.
+func (db *ordersdb) ListArchivedAfter(ctx context.Context, archivedAfter time.Time, limit, offset int) (_ []*orders.ArchivedInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(`
+		SELECT order_limit_serialized, order_serialized, certificate.peer_identity,
+			status, archived_at
+		FROM order_archive
+		INNER JOIN certificate on order_archive.uplink_cert_id = certificate.cert_id
+		WHERE datetime(archived_at) > datetime(?)
+		ORDER BY archived_at, order_archive.serial_number
+		LIMIT ? OFFSET ?
+	`, archivedAfter.UTC(), limit, offset)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	return scanArchived(ctx, rows)
+}
+
+// scanArchived reads archived orders from rows selected by ListArchived and ListArchivedAfter.
+func scanArchived(ctx context.Context, rows *sql.Rows) (_ []*orders.ArchivedInfo, err error) {
 	var infos []*orders.ArchivedInfo
 	for rows.Next() {
 		var limitSerialized []byte
@@ -260,3 +318,243 @@ func (db *ordersdb) ListArchived(ctx context.Context, limit int) (_ []*orders.Ar
 
 	return infos, ErrInfo.Wrap(rows.Err())
 }
+
+// cleanArchiveBatch is the number of archived orders summarized per transaction.
+const cleanArchiveBatch = 1000
+
+// CleanArchive summarizes archived orders older than archivedBefore into
+// per satellite and per day totals and then deletes them.
+func (db *ordersdb) CleanArchive(ctx context.Context, archivedBefore time.Time) (removed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		count, err := db.cleanArchiveBatch(ctx, archivedBefore.UTC())
+		removed += count
+		if err != nil || count < cleanArchiveBatch {
+			return removed, err
+		}
+	}
+}
+
+// cleanArchiveBatch summarizes and deletes up to cleanArchiveBatch archived orders.
+func (db *ordersdb) cleanArchiveBatch(ctx context.Context, archivedBefore time.Time) (removed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrInfo.Wrap(tx.Rollback()))
+		} else {
+			err = ErrInfo.Wrap(tx.Commit())
+		}
+	}()
+
+	rows, err := tx.Query(`
+		SELECT rowid, satellite_id, order_limit_serialized, order_serialized, status, archived_at
+		FROM order_archive
+		WHERE datetime(archived_at) < datetime(?)
+		LIMIT ?
+	`, archivedBefore, cleanArchiveBatch)
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+
+	type summaryKey struct {
+		satelliteID storj.NodeID
+		day         time.Time
+		action      pb.PieceAction
+		status      orders.Status
+	}
+
+	var rowids []int64
+	summaries := map[summaryKey]*orders.ArchiveSummary{}
+	for rows.Next() {
+		var rowid int64
+		var satelliteID storj.NodeID
+		var limitSerialized []byte
+		var orderSerialized []byte
+		var status int
+		var archivedAt time.Time
+
+		err := rows.Scan(&rowid, &satelliteID, &limitSerialized, &orderSerialized, &status, &archivedAt)
+		if err != nil {
+			return 0, errs.Combine(ErrInfo.Wrap(err), ErrInfo.Wrap(rows.Close()))
+		}
+
+		limit := &pb.OrderLimit{}
+		if err := proto.Unmarshal(limitSerialized, limit); err != nil {
+			return 0, errs.Combine(ErrInfo.Wrap(err), ErrInfo.Wrap(rows.Close()))
+		}
+		order := &pb.Order{}
+		if err := proto.Unmarshal(orderSerialized, order); err != nil {
+			return 0, errs.Combine(ErrInfo.Wrap(err), ErrInfo.Wrap(rows.Close()))
+		}
+
+		archivedAt = archivedAt.UTC()
+		key := summaryKey{
+			satelliteID: satelliteID,
+			day:         time.Date(archivedAt.Year(), archivedAt.Month(), archivedAt.Day(), 0, 0, 0, 0, time.UTC),
+			action:      limit.Action,
+			status:      orders.Status(status),
+		}
+
+		summary, ok := summaries[key]
+		if !ok {
+			summary = &orders.ArchiveSummary{
+				SatelliteID: key.satelliteID,
+				Day:         key.day,
+				Action:      key.action,
+				Status:      key.status,
+			}
+			summaries[key] = summary
+		}
+		summary.OrderCount++
+		summary.TotalAmount += order.Amount
+
+		rowids = append(rowids, rowid)
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+
+	for _, summary := range summaries {
+		_, err = tx.Exec(`
+			INSERT INTO order_archive_summary(
+				satellite_id, day, action, status,
+				order_count, total_amount
+			) VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT(satellite_id, day, action, status) DO UPDATE SET
+				order_count = order_count + excluded.order_count,
+				total_amount = total_amount + excluded.total_amount
+		`, summary.SatelliteID, summary.Day, int(summary.Action), int(summary.Status),
+			summary.OrderCount, summary.TotalAmount)
+		if err != nil {
+			return 0, ErrInfo.Wrap(err)
+		}
+	}
+
+	for _, rowid := range rowids {
+		_, err = tx.Exec(`DELETE FROM order_archive WHERE rowid = ?`, rowid)
+		if err != nil {
+			return 0, ErrInfo.Wrap(err)
+		}
+	}
+
+	return len(rowids), nil
+}
+
+// ListArchiveSummaries returns the totals of archived orders that have been cleaned up.
+func (db *ordersdb) ListArchiveSummaries(ctx context.Context) (_ []orders.ArchiveSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, day, action, status, order_count, total_amount
+		FROM order_archive_summary
+		ORDER BY day, satellite_id, action, status
+	`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(rows.Close())) }()
+
+	var summaries []orders.ArchiveSummary
+	for rows.Next() {
+		var summary orders.ArchiveSummary
+		var action, status int
+
+		err := rows.Scan(&summary.SatelliteID, &summary.Day, &action, &status, &summary.OrderCount, &summary.TotalAmount)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		summary.Action = pb.PieceAction(action)
+		summary.Status = orders.Status(status)
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, ErrInfo.Wrap(rows.Err())
+}
+
+// StatusTotals returns the number and the total amount of archived orders with the specified status,
+// including the orders summarized by CleanArchive. The totals are kept up to date by Archive.
+func (db *ordersdb) StatusTotals(ctx context.Context, status orders.Status) (count, amount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRow(`
+		SELECT order_count, total_amount
+		FROM order_status_totals
+		WHERE status = ?
+	`, int(status)).Scan(&count, &amount)
+	if err == sql.ErrNoRows {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, ErrInfo.Wrap(err)
+	}
+
+	return count, amount, nil
+}
+
+// fillOrderStatusTotals sums the archived and the summarized orders into order_status_totals.
+func fillOrderStatusTotals(tx *sql.Tx) (err error) {
+	type total struct{ count, amount int64 }
+	totals := map[int]*total{}
+
+	rows, err := tx.Query(`SELECT status, order_count, total_amount FROM order_archive_summary`)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var status int
+		var count, amount int64
+		if err := rows.Scan(&status, &count, &amount); err != nil {
+			return errs.Combine(err, rows.Close())
+		}
+		if totals[status] == nil {
+			totals[status] = &total{}
+		}
+		totals[status].count += count
+		totals[status].amount += amount
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return err
+	}
+
+	rows, err = tx.Query(`SELECT status, order_serialized FROM order_archive`)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var status int
+		var orderSerialized []byte
+		if err := rows.Scan(&status, &orderSerialized); err != nil {
+			return errs.Combine(err, rows.Close())
+		}
+
+		order := &pb.Order{}
+		if err := proto.Unmarshal(orderSerialized, order); err != nil {
+			return errs.Combine(err, rows.Close())
+		}
+
+		if totals[status] == nil {
+			totals[status] = &total{}
+		}
+		totals[status].count++
+		totals[status].amount += order.Amount
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return err
+	}
+
+	for status, total := range totals {
+		_, err = tx.Exec(`INSERT INTO order_status_totals(status, order_count, total_amount) VALUES (?, ?, ?)`,
+			status, total.count, total.amount)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
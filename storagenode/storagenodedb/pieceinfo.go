@@ -25,6 +25,7 @@ func (db *DB) PieceInfo() pieces.DB { return db.info.PieceInfo() }
 func (db *InfoDB) PieceInfo() pieces.DB { return &pieceinfo{db} }
 
 // Add inserts piece information into the database.
+// Information about a trashed piece with the same ID is replaced.
 func (db *pieceinfo) Add(ctx context.Context, info *pieces.Info) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	certdb := db.CertDB()
@@ -38,11 +39,33 @@ func (db *pieceinfo) Add(ctx context.Context, info *pieces.Info) (err error) {
 		return ErrInfo.Wrap(err)
 	}
 
-	_, err = db.db.ExecContext(ctx, db.Rebind(`
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrInfo.Wrap(tx.Rollback()))
+		} else {
+			err = ErrInfo.Wrap(tx.Commit())
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, db.Rebind(`
+		DELETE FROM pieceinfo
+		WHERE satellite_id = ?
+		  AND piece_id = ?
+		  AND trashed_at IS NOT NULL
+	`), info.SatelliteID, info.PieceID)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	_, err = tx.ExecContext(ctx, db.Rebind(`
 		INSERT INTO
-			pieceinfo(satellite_id, piece_id, piece_size, piece_expiration, uplink_piece_hash, uplink_cert_id)
-		VALUES (?,?,?,?,?,?)
-	`), info.SatelliteID, info.PieceID, info.PieceSize, info.PieceExpiration, uplinkPieceHash, certid)
+			pieceinfo(satellite_id, piece_id, piece_size, piece_creation, piece_expiration, uplink_piece_hash, uplink_cert_id)
+		VALUES (?,?,?,?,?,?,?)
+	`), info.SatelliteID, info.PieceID, info.PieceSize, info.PieceCreation, info.PieceExpiration, uplinkPieceHash, certid)
 
 	return ErrInfo.Wrap(err)
 }
@@ -58,11 +81,11 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 	var uplinkIdentity []byte
 
 	err = db.db.QueryRowContext(ctx, db.Rebind(`
-		SELECT piece_size, piece_expiration, uplink_piece_hash, certificate.peer_identity
+		SELECT piece_size, piece_creation, piece_expiration, uplink_piece_hash, certificate.peer_identity
 		FROM pieceinfo
 		INNER JOIN certificate ON pieceinfo.uplink_cert_id = certificate.cert_id
-		WHERE satellite_id = ? AND piece_id = ?
-	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceExpiration, &uplinkPieceHash, &uplinkIdentity)
+		WHERE satellite_id = ? AND piece_id = ? AND trashed_at IS NULL
+	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceCreation, &info.PieceExpiration, &uplinkPieceHash, &uplinkIdentity)
 
 	if err != nil {
 		return nil, ErrInfo.Wrap(err)
@@ -82,6 +105,44 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 	return info, nil
 }
 
+// GetPieceIDs gets at most limit pieceIDs of the satellite ordered by piece ID,
+// which come after cursor. The zero cursor lists from the start.
+func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, cursor storj.PieceID, limit int) (pieceIDs []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rows *sql.Rows
+	if cursor.IsZero() {
+		rows, err = db.db.QueryContext(ctx, db.Rebind(`
+			SELECT piece_id
+			FROM pieceinfo
+			WHERE satellite_id = ? AND datetime(piece_creation) < datetime(?) AND trashed_at IS NULL
+			ORDER BY piece_id
+			LIMIT ?
+		`), satelliteID, createdBefore, limit)
+	} else {
+		rows, err = db.db.QueryContext(ctx, db.Rebind(`
+			SELECT piece_id
+			FROM pieceinfo
+			WHERE satellite_id = ? AND datetime(piece_creation) < datetime(?) AND trashed_at IS NULL AND piece_id > ?
+			ORDER BY piece_id
+			LIMIT ?
+		`), satelliteID, createdBefore, cursor, limit)
+	}
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		var pieceID storj.PieceID
+		err = rows.Scan(&pieceID)
+		if err != nil {
+			return pieceIDs, ErrInfo.Wrap(err)
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs, nil
+}
+
 // Delete deletes piece information.
 func (db *pieceinfo) Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -109,6 +170,65 @@ func (db *pieceinfo) DeleteFailed(ctx context.Context, satelliteID storj.NodeID,
 	return ErrInfo.Wrap(err)
 }
 
+// Trash marks the piece as trashed.
+func (db *pieceinfo) Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		UPDATE pieceinfo
+		SET trashed_at = ?
+		WHERE satellite_id = ?
+		  AND piece_id = ?
+	`), trashedAt, satelliteID, pieceID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// RestoreTrash unmarks the specified trashed pieces of the satellite.
+func (db *pieceinfo) RestoreTrash(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrInfo.Wrap(tx.Rollback()))
+		} else {
+			err = ErrInfo.Wrap(tx.Commit())
+		}
+	}()
+
+	for _, pieceID := range pieceIDs {
+		_, err = tx.ExecContext(ctx, db.Rebind(`
+			UPDATE pieceinfo
+			SET trashed_at = NULL
+			WHERE satellite_id = ?
+			  AND piece_id = ?
+			  AND trashed_at IS NOT NULL
+		`), satelliteID, pieceID)
+		if err != nil {
+			return ErrInfo.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteTrashed deletes piece information of the pieces, which were trashed before the specified time.
+func (db *pieceinfo) DeleteTrashed(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		DELETE FROM pieceinfo
+		WHERE satellite_id = ?
+		  AND datetime(trashed_at) < datetime(?)
+	`), satelliteID, trashedBefore)
+
+	return ErrInfo.Wrap(err)
+}
+
 // GetExpired gets pieceinformation identites that are expired.
 func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit int64) (infos []pieces.ExpiredInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -116,7 +236,7 @@ func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit
 	rows, err := db.db.QueryContext(ctx, db.Rebind(`
 		SELECT satellite_id, piece_id, piece_size
 		FROM pieceinfo
-		WHERE piece_expiration < ? AND ((deletion_failed_at IS NULL) OR deletion_failed_at <> ?)
+		WHERE piece_expiration < ? AND ((deletion_failed_at IS NULL) OR deletion_failed_at <> ?) AND trashed_at IS NULL
 		ORDER BY satellite_id
 		LIMIT ?
 	`), expiredAt, expiredAt, limit)
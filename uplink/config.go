@@ -35,12 +35,14 @@ type EncryptionConfig struct {
 // ClientConfig is a configuration struct for the uplink that controls how
 // to talk to the rest of the network.
 type ClientConfig struct {
-	APIKey         string        `default:"" help:"the api key to use for the satellite" noprefix:"true"`
-	SatelliteAddr  string        `releaseDefault:"127.0.0.1:7777" devDefault:"127.0.0.1:10000" help:"the address to use for the satellite" noprefix:"true"`
-	MaxInlineSize  memory.Size   `help:"max inline segment size in bytes" default:"4KiB"`
-	SegmentSize    memory.Size   `help:"the size of a segment in bytes" default:"64MiB"`
-	RequestTimeout time.Duration `help:"timeout for request" default:"0h0m20s"`
-	DialTimeout    time.Duration `help:"timeout for dials" default:"0h0m20s"`
+	APIKey              string        `default:"" help:"the api key to use for the satellite" noprefix:"true"`
+	SatelliteAddr       string        `releaseDefault:"127.0.0.1:7777" devDefault:"127.0.0.1:10000" help:"the address to use for the satellite" noprefix:"true"`
+	MaxInlineSize       memory.Size   `help:"max inline segment size in bytes" default:"4KiB"`
+	SegmentSize         memory.Size   `help:"the size of a segment in bytes" default:"64MiB"`
+	UploadParallelism   int           `help:"the number of segments of an object uploaded concurrently" default:"1"`
+	DownloadParallelism int           `help:"the number of segments of an object downloaded ahead while reading it" default:"1"`
+	RequestTimeout      time.Duration `help:"timeout for request" default:"0h0m20s"`
+	DialTimeout         time.Duration `help:"timeout for dials" default:"0h0m20s"`
 }
 
 // Config uplink configuration
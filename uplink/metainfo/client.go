@@ -226,6 +226,197 @@ func (client *Client) ListSegments(ctx context.Context, bucket string, prefix, s
 	return items, response.GetMore(), nil
 }
 
+// ReplacedObject is the object at the new path of a move, which is kept at another path instead of being deleted
+type ReplacedObject struct {
+	// Segments is the encrypted metadata of the segments, which has to be re-encrypted for EncryptedPath
+	Segments []*pb.ObjectMoveSegment
+	// Revision is empty, if there's no object at the new path
+	Revision []byte
+	// EncryptedPath is the path in the new bucket where the object is kept
+	EncryptedPath storj.Path
+}
+
+// BeginMoveObject returns the encrypted metadata of all segments of the object, which has to be re-encrypted for the new path, and the revision of the object. If keepReplaced is set, it also returns the object at the new path.
+func (client *Client) BeginMoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, keepReplaced bool) (segments []*pb.ObjectMoveSegment, revision []byte, replaced *ReplacedObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.BeginMoveObject(ctx, &pb.ObjectBeginMoveRequest{
+		Bucket:           []byte(bucket),
+		EncryptedPath:    []byte(path),
+		NewBucket:        []byte(newBucket),
+		NewEncryptedPath: []byte(newPath),
+		KeepReplaced:     keepReplaced,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, nil, nil, Error.Wrap(err)
+	}
+
+	if keepReplaced {
+		replaced = &ReplacedObject{
+			Segments: response.GetReplacedSegments(),
+			Revision: response.GetReplacedRevision(),
+		}
+	}
+
+	return response.GetSegments(), response.GetRevision(), replaced, nil
+}
+
+// FinishMoveObject moves the object to the new path, replacing the metadata of its segments, if it's still at the revision returned by BeginMoveObject. If replaced isn't nil, the object at the new path is moved to the path of replaced in the same operation.
+func (client *Client) FinishMoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, segments []*pb.ObjectMoveSegment, revision []byte, replaced *ReplacedObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := &pb.ObjectFinishMoveRequest{
+		Bucket:           []byte(bucket),
+		EncryptedPath:    []byte(path),
+		NewBucket:        []byte(newBucket),
+		NewEncryptedPath: []byte(newPath),
+		Segments:         segments,
+		Revision:         revision,
+	}
+	if replaced != nil {
+		req.KeepReplaced = true
+		req.ReplacedEncryptedPath = []byte(replaced.EncryptedPath)
+		req.ReplacedSegments = replaced.Segments
+		req.ReplacedRevision = replaced.Revision
+	}
+
+	_, err = client.client.FinishMoveObject(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// BeginConcatObjects returns the encrypted metadata of all segments of the objects, which have to be re-encrypted for the new path, and the revisions of the objects
+func (client *Client) BeginConcatObjects(ctx context.Context, bucket string, paths []storj.Path, newPath storj.Path) (sources []*pb.ObjectConcatSource, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encryptedPaths := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		encryptedPaths = append(encryptedPaths, []byte(path))
+	}
+
+	response, err := client.client.BeginConcatObjects(ctx, &pb.ObjectBeginConcatRequest{
+		Bucket:           []byte(bucket),
+		EncryptedPaths:   encryptedPaths,
+		NewEncryptedPath: []byte(newPath),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetSources(), nil
+}
+
+// FinishConcatObjects concatenates the objects into a new object at the new path, which ends with the inline last segment, if they are still at the revisions returned by BeginConcatObjects
+func (client *Client) FinishConcatObjects(ctx context.Context, bucket string, newPath storj.Path, sources []*pb.ObjectConcatSource, inlineSegment, metadata []byte, expiration time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := &pb.ObjectFinishConcatRequest{
+		Bucket:                 []byte(bucket),
+		NewEncryptedPath:       []byte(newPath),
+		Sources:                sources,
+		EncryptedInlineSegment: inlineSegment,
+		EncryptedMetadata:      metadata,
+	}
+	if !expiration.IsZero() {
+		req.ExpirationDate, err = ptypes.TimestampProto(expiration)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	_, err = client.client.FinishConcatObjects(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of the bucket
+func (client *Client) SetBucketLifecycle(ctx context.Context, bucket string, rules []*pb.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.SetBucketLifecycle(ctx, &pb.SetBucketLifecycleRequest{
+		Bucket: []byte(bucket),
+		Rules:  rules,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetBucketLifecycle returns the lifecycle rules of the bucket
+func (client *Client) GetBucketLifecycle(ctx context.Context, bucket string) (rules []*pb.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.GetBucketLifecycle(ctx, &pb.GetBucketLifecycleRequest{
+		Bucket: []byte(bucket),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetRules(), nil
+}
+
+// SetBucketPlacement replaces the placement policy of the bucket
+func (client *Client) SetBucketPlacement(ctx context.Context, bucket string, policy *pb.PlacementPolicy) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.SetBucketPlacement(ctx, &pb.SetBucketPlacementRequest{
+		Bucket: []byte(bucket),
+		Policy: policy,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetBucketPlacement returns the placement policy of the bucket
+func (client *Client) GetBucketPlacement(ctx context.Context, bucket string) (policy *pb.PlacementPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.GetBucketPlacement(ctx, &pb.GetBucketPlacementRequest{
+		Bucket: []byte(bucket),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetPolicy(), nil
+}
+
 // SetAttribution tries to set the attribution information on the bucket.
 func (client *Client) SetAttribution(ctx context.Context, bucket string, partnerID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)